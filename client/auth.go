@@ -15,8 +15,11 @@
 package client
 
 import (
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/opensds/opensds/pkg/utils/constants"
 	"github.com/opensds/opensds/pkg/utils/pwd"
@@ -38,6 +41,11 @@ const (
 	EnableEncrypted = "ENABLE_ENCRYPTED"
 	Keystone        = "keystone"
 	Noauth          = "noauth"
+
+	// Oidc Auth ENVs, the token is a JWT issued by the OpenID Connect
+	// provider which the api server trusts.
+	OpensdsAuthToken = "OPENSDS_AUTH_TOKEN"
+	Oidc             = "oidc"
 )
 
 type AuthOptions interface {
@@ -79,6 +87,21 @@ func (n *NoAuthOptions) GetTenantId() string {
 	return n.TenantID
 }
 
+func NewTokenAuthOptions(token, tenantId string) *TokenAuthOptions {
+	return &TokenAuthOptions{TokenID: token, TenantID: tenantId}
+}
+
+// TokenAuthOptions carries the bearer token which is obtained out of band,
+// such as from an OpenID Connect provider.
+type TokenAuthOptions struct {
+	TokenID  string
+	TenantID string
+}
+
+func (t *TokenAuthOptions) GetTenantId() string {
+	return t.TenantID
+}
+
 func LoadKeystoneAuthOptionsFromEnv() (*KeystoneAuthOptions, error) {
 	opt := NewKeystoneAuthOptions()
 	opt.IdentityEndpoint = os.Getenv(OsAuthUrl)
@@ -117,3 +140,40 @@ func LoadNoAuthOptionsFromEnv() *NoAuthOptions {
 	}
 	return NewNoauthOptions(tenantId)
 }
+
+// LoadTokenAuthOptionsFromEnv loads the token from OPENSDS_AUTH_TOKEN, the
+// tenant id is taken from the tenant_id claim of the token if
+// OPENSDS_TENANT_ID is not set.
+func LoadTokenAuthOptionsFromEnv() (*TokenAuthOptions, error) {
+	token := strings.TrimSpace(os.Getenv(OpensdsAuthToken))
+	if token == "" {
+		return nil, fmt.Errorf("The token can not be empty if auth strategy is oidc.")
+	}
+
+	tenantId, ok := os.LookupEnv(OpensdsTenantId)
+	if !ok {
+		tenantId = tenantIdOfToken(token)
+	}
+	if tenantId == "" {
+		return nil, fmt.Errorf("The tenant id is neither set by %s nor found in token.", OpensdsTenantId)
+	}
+	return NewTokenAuthOptions(token, tenantId), nil
+}
+
+// tenantIdOfToken returns the tenant_id claim of the JWT, the token is not
+// verified here since it's verified by the api server.
+func tenantIdOfToken(token string) string {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return ""
+	}
+	b, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return ""
+	}
+	var claims struct {
+		TenantId string `json:"tenant_id"`
+	}
+	json.Unmarshal(b, &claims)
+	return claims.TenantId
+}
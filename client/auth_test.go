@@ -0,0 +1,78 @@
+// Copyright (c) 2018 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/opensds/opensds/pkg/utils/constants"
+)
+
+// The signature of token is not verified by the client.
+var token = "eyJhbGciOiJSUzI1NiJ9." +
+	base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"user001","tenant_id":"3769855c"}`)) +
+	".c2lnbmF0dXJl"
+
+func TestLoadTokenAuthOptionsFromEnv(t *testing.T) {
+	defer os.Unsetenv(OpensdsAuthToken)
+	defer os.Unsetenv(OpensdsTenantId)
+
+	os.Unsetenv(OpensdsAuthToken)
+	if _, err := LoadTokenAuthOptionsFromEnv(); err == nil {
+		t.Error("Expected error of missing token, got nil")
+	}
+
+	// The tenant id is taken from the token.
+	os.Setenv(OpensdsAuthToken, token)
+	opt, err := LoadTokenAuthOptionsFromEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if opt.TokenID != token || opt.GetTenantId() != "3769855c" {
+		t.Errorf("Expected token with tenant 3769855c, got %+v", opt)
+	}
+
+	os.Setenv(OpensdsTenantId, "e93b4c09")
+	if opt, _ = LoadTokenAuthOptionsFromEnv(); opt.GetTenantId() != "e93b4c09" {
+		t.Errorf("Expected tenant e93b4c09, got %s", opt.GetTenantId())
+	}
+
+	os.Setenv(OpensdsAuthToken, "opaque-token")
+	os.Unsetenv(OpensdsTenantId)
+	if _, err := LoadTokenAuthOptionsFromEnv(); err == nil {
+		t.Error("Expected error of missing tenant id, got nil")
+	}
+}
+
+func TestTokenReceiver(t *testing.T) {
+	var authz string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authz = r.Header.Get(constants.AuthorizationHeader)
+		w.Write([]byte(`{}`))
+	}))
+	defer ts.Close()
+
+	r := NewTokenReceiver(NewTokenAuthOptions(token, "3769855c"))
+	if err := r.Recv(ts.URL, "GET", nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if authz != "Bearer "+token {
+		t.Errorf("Expected bearer token in header, got %q", authz)
+	}
+}
@@ -76,6 +76,8 @@ func NewClient(c *Config) (*Client, error) {
 		r = NewReceiver()
 	case *KeystoneAuthOptions:
 		r = NewKeystoneReciver(c.AuthOptions.(*KeystoneAuthOptions))
+	case *TokenAuthOptions:
+		r = NewTokenReceiver(c.AuthOptions.(*TokenAuthOptions))
 	default:
 		fmt.Println("Warning: Not support auth options, use default.")
 		r = NewReceiver()
@@ -35,6 +35,7 @@ func TestGetDock(t *testing.T) {
 		Description: "sample backend service",
 		Endpoint:    "localhost:50050",
 		DriverName:  "sample",
+		BackendName: "sample",
 	}
 
 	dck, err := fd.GetDock(dckID)
@@ -59,6 +60,7 @@ func TestListDocks(t *testing.T) {
 			Description: "sample backend service",
 			Endpoint:    "localhost:50050",
 			DriverName:  "sample",
+			BackendName: "sample",
 		},
 	}
 
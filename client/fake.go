@@ -232,6 +232,11 @@ func (*fakeVolumeReceiver) Recv(
 				return err
 			}
 			break
+		case *model.SnapshotAttachmentSpec:
+			if err := json.Unmarshal([]byte(ByteSnapshotAttachment), out); err != nil {
+				return err
+			}
+			break
 		case *model.VolumeGroupSpec:
 			if err := json.Unmarshal([]byte(ByteVolumeGroup), out); err != nil {
 				return err
@@ -273,6 +278,16 @@ func (*fakeVolumeReceiver) Recv(
 				return err
 			}
 			break
+		case *model.SnapshotAttachmentSpec:
+			if err := json.Unmarshal([]byte(ByteSnapshotAttachment), out); err != nil {
+				return err
+			}
+			break
+		case *[]*model.SnapshotAttachmentSpec:
+			if err := json.Unmarshal([]byte(ByteSnapshotAttachments), out); err != nil {
+				return err
+			}
+			break
 		case *model.VolumeGroupSpec:
 			if err := json.Unmarshal([]byte(ByteVolumeGroup), out); err != nil {
 				return err
@@ -283,6 +298,16 @@ func (*fakeVolumeReceiver) Recv(
 				return err
 			}
 			break
+		case *model.BackupScheduleSpec:
+			if err := json.Unmarshal([]byte(ByteBackupSchedule), out); err != nil {
+				return err
+			}
+			break
+		case *[]*model.BackupScheduleSpec:
+			if err := json.Unmarshal([]byte(ByteBackupSchedules), out); err != nil {
+				return err
+			}
+			break
 		default:
 			return errors.New("output format not supported")
 		}
@@ -88,6 +88,14 @@ func TestListPools(t *testing.T) {
 					"diskType": "SSD",
 					"latency":  "3ms",
 				},
+				Capabilities: model.DriverCapabilitiesSpec{
+					VolumeGroup:        true,
+					SnapshotAttachment: true,
+					Clone:              true,
+					Qos:                true,
+					OnlineExtend:       true,
+					Replication:        true,
+				},
 			},
 		},
 		{
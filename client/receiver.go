@@ -217,6 +217,22 @@ func (k *KeystoneReciver) Recv(url string, method string, body interface{}, outp
 	})
 }
 
+func NewTokenReceiver(auth *TokenAuthOptions) Receiver {
+	return &TokenReceiver{Auth: auth}
+}
+
+// TokenReceiver sends the requests with the bearer token, which can't be
+// renewed by the client itself.
+type TokenReceiver struct {
+	Auth *TokenAuthOptions
+}
+
+func (t *TokenReceiver) Recv(url string, method string, body interface{}, output interface{}) error {
+	headers := HeaderOption{}
+	headers[constants.AuthorizationHeader] = constants.BearerPrefix + t.Auth.TokenID
+	return request(url, method, headers, body, output)
+}
+
 func checkHTTPResponseStatusCode(resp *http.Response) error {
 	if 400 <= resp.StatusCode && resp.StatusCode <= 599 {
 		return fmt.Errorf("response == %d, %s", resp.StatusCode, http.StatusText(resp.StatusCode))
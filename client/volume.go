@@ -31,6 +31,11 @@ type VolumeBuilder *model.VolumeSpec
 // could be discussed if it's better to define an interface.
 type ExtendVolumeBuilder *model.ExtendVolumeSpec
 
+// VolumeQosBuilder contains request body of handling a volume qos request.
+// Currently it's assigned as the pointer of QosSpec struct, but it
+// could be discussed if it's better to define an interface.
+type VolumeQosBuilder *model.QosSpec
+
 // VolumeAttachmentBuilder contains request body of handling a volume request.
 // Currently it's assigned as the pointer of VolumeSpec struct, but it
 // could be discussed if it's better to define an interface.
@@ -41,6 +46,12 @@ type VolumeAttachmentBuilder *model.VolumeAttachmentSpec
 // struct, but it could be discussed if it's better to define an interface.
 type VolumeSnapshotBuilder *model.VolumeSnapshotSpec
 
+// SnapshotAttachmentBuilder contains request body of handling a snapshot
+// attachment request. Currently it's assigned as the pointer of
+// SnapshotAttachmentSpec struct, but it could be discussed if it's better to
+// define an interface.
+type SnapshotAttachmentBuilder *model.SnapshotAttachmentSpec
+
 // VolumeGroupBuilder contains request body of handling a volume group
 // request. Currently it's assigned as the pointer of VolumeGroupSpec
 // struct, but it could be discussed if it's better to define an interface.
@@ -149,6 +160,49 @@ func (v *VolumeMgr) ExtendVolume(volID string, body ExtendVolumeBuilder) (*model
 	return &res, nil
 }
 
+// UpdateVolumeQos changes the qos limits of the volume, which can be in use.
+func (v *VolumeMgr) UpdateVolumeQos(volID string, body VolumeQosBuilder) (*model.VolumeSpec, error) {
+	var res model.VolumeSpec
+	url := strings.Join([]string{
+		v.Endpoint,
+		urls.GenerateVolumeURL(urls.Client, v.TenantId, volID, "qos")}, "/")
+
+	if err := v.Recv(url, "PUT", body, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// GetBackupSchedule shows the next run and the last result of the backup
+// policy of specified volume.
+func (v *VolumeMgr) GetBackupSchedule(volID string) (*model.BackupScheduleSpec, error) {
+	var res model.BackupScheduleSpec
+	url := strings.Join([]string{
+		v.Endpoint,
+		urls.GenerateVolumeURL(urls.Client, v.TenantId, volID, "backupSchedule")}, "/")
+
+	if err := v.Recv(url, "GET", nil, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// ListBackupSchedules
+func (v *VolumeMgr) ListBackupSchedules() ([]*model.BackupScheduleSpec, error) {
+	var res []*model.BackupScheduleSpec
+	url := strings.Join([]string{
+		v.Endpoint,
+		urls.GenerateBackupScheduleURL(urls.Client, v.TenantId)}, "/")
+
+	if err := v.Recv(url, "GET", nil, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
 // CreateVolumeAttachment
 func (v *VolumeMgr) CreateVolumeAttachment(body VolumeAttachmentBuilder) (*model.VolumeAttachmentSpec, error) {
 	var res model.VolumeAttachmentSpec
@@ -296,6 +350,79 @@ func (v *VolumeMgr) UpdateVolumeSnapshot(snpID string, body VolumeSnapshotBuilde
 	return &res, nil
 }
 
+// VerifyVolumeSnapshot checks the backup of the snapshot in the cloud without
+// restoring it, the result is shown by the status of the snapshot afterwards.
+func (v *VolumeMgr) VerifyVolumeSnapshot(snpID string) (*model.VolumeSnapshotSpec, error) {
+	var res model.VolumeSnapshotSpec
+	url := strings.Join([]string{
+		v.Endpoint,
+		urls.GenerateSnapshotURL(urls.Client, v.TenantId, snpID, "verify")}, "/")
+
+	if err := v.Recv(url, "POST", nil, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// CreateSnapshotAttachment attaches the snapshot read-only to the host.
+func (v *VolumeMgr) CreateSnapshotAttachment(body SnapshotAttachmentBuilder) (*model.SnapshotAttachmentSpec, error) {
+	var res model.SnapshotAttachmentSpec
+	url := strings.Join([]string{
+		v.Endpoint,
+		urls.GenerateSnapshotAttachmentURL(urls.Client, v.TenantId)}, "/")
+
+	if err := v.Recv(url, "POST", body, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// GetSnapshotAttachment
+func (v *VolumeMgr) GetSnapshotAttachment(atcID string) (*model.SnapshotAttachmentSpec, error) {
+	var res model.SnapshotAttachmentSpec
+	url := strings.Join([]string{
+		v.Endpoint,
+		urls.GenerateSnapshotAttachmentURL(urls.Client, v.TenantId, atcID)}, "/")
+
+	if err := v.Recv(url, "GET", nil, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// ListSnapshotAttachments
+func (v *VolumeMgr) ListSnapshotAttachments(args ...interface{}) ([]*model.SnapshotAttachmentSpec, error) {
+	url := strings.Join([]string{
+		v.Endpoint,
+		urls.GenerateSnapshotAttachmentURL(urls.Client, v.TenantId)}, "/")
+
+	param, err := processListParam(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if param != "" {
+		url += "?" + param
+	}
+	var res []*model.SnapshotAttachmentSpec
+	if err := v.Recv(url, "GET", nil, &res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// DeleteSnapshotAttachment
+func (v *VolumeMgr) DeleteSnapshotAttachment(atcID string) error {
+	url := strings.Join([]string{
+		v.Endpoint,
+		urls.GenerateSnapshotAttachmentURL(urls.Client, v.TenantId, atcID)}, "/")
+
+	return v.Recv(url, "DELETE", nil, nil)
+}
+
 // CreateVolumeGroup
 func (v *VolumeMgr) CreateVolumeGroup(body VolumeGroupBuilder) (*model.VolumeGroupSpec, error) {
 	var res model.VolumeGroupSpec
@@ -173,6 +173,79 @@ func TestExtendVolume(t *testing.T) {
 	}
 }
 
+func TestUpdateVolumeQos(t *testing.T) {
+	var volID = "bd5b12a8-a101-11e7-941e-d77981b584d8"
+	body := model.QosSpec{
+		MaxIOPS: 1000,
+		MaxBWS:  100,
+	}
+
+	result, err := fv.UpdateVolumeQos(volID, &body)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	expected := &model.VolumeSpec{
+		BaseModel: &model.BaseModel{
+			Id: "bd5b12a8-a101-11e7-941e-d77981b584d8",
+		},
+		Name:        "sample-volume",
+		Description: "This is a sample volume for testing",
+		Size:        int64(1),
+		Status:      "available",
+		PoolId:      "084bf71e-a102-11e7-88a8-e31fe6d52248",
+		ProfileId:   "1106b972-66ef-11e7-b172-db03f3689c9c",
+	}
+
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+		return
+	}
+}
+
+func TestGetBackupSchedule(t *testing.T) {
+	var volID = "bd5b12a8-a101-11e7-941e-d77981b584d8"
+	result, err := fv.GetBackupSchedule(volID)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	expected := &model.BackupScheduleSpec{
+		BaseModel: &model.BaseModel{
+			Id: "5f5c7f1e-a7d2-11e8-8c4e-3b6d86f2e1a7",
+		},
+		VolumeId:         "bd5b12a8-a101-11e7-941e-d77981b584d8",
+		ProfileId:        "1106b972-66ef-11e7-b172-db03f3689c9c",
+		NextRunAt:        "2018-08-28T10:00:00",
+		LastRunAt:        "2018-08-27T10:00:00",
+		LastResult:       "success",
+		LastBackupId:     "3769855c-a102-11e7-b772-17b880d2f537",
+		LastBackupMode:   "full",
+		LastFullBackupId: "3769855c-a102-11e7-b772-17b880d2f537",
+		RunCount:         1,
+	}
+
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+		return
+	}
+}
+
+func TestListBackupSchedules(t *testing.T) {
+	result, err := fv.ListBackupSchedules()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if len(result) != 1 || result[0].VolumeId != "bd5b12a8-a101-11e7-941e-d77981b584d8" {
+		t.Errorf("Unexpected backup schedules %v", result)
+		return
+	}
+}
+
 func TestCreateVolumeAttachment(t *testing.T) {
 	var volID = "bd5b12a8-a101-11e7-941e-d77981b584d8"
 	expected := &model.VolumeAttachmentSpec{
@@ -447,6 +520,78 @@ func TestUpdateVolumeSnapshot(t *testing.T) {
 	}
 }
 
+func TestVerifyVolumeSnapshot(t *testing.T) {
+	var snpID = "3769855c-a102-11e7-b772-17b880d2f537"
+
+	result, err := fv.VerifyVolumeSnapshot(snpID)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if result.Id != snpID {
+		t.Errorf("Expected snapshot %s, got %s", snpID, result.Id)
+		return
+	}
+}
+
+func TestCreateSnapshotAttachment(t *testing.T) {
+	expected := &model.SnapshotAttachmentSpec{
+		BaseModel: &model.BaseModel{
+			Id: "a0cbbd38-c6a1-11e8-9c6b-0f5a3b8e2d51",
+		},
+		Status:     "available",
+		SnapshotId: "3769855c-a102-11e7-b772-17b880d2f537",
+		HostInfo:   model.HostInfo{},
+		ConnectionInfo: model.ConnectionInfo{
+			DriverVolumeType: "iscsi",
+			ConnectionData: map[string]interface{}{
+				"targetDiscovered": true,
+				"targetIqn":        "iqn.2017-10.io.opensds:volume:00000001",
+				"targetPortal":     "127.0.0.0.1:3260",
+				"discard":          false,
+				"readOnly":         true,
+			},
+		},
+	}
+
+	atc, err := fv.CreateSnapshotAttachment(&model.SnapshotAttachmentSpec{
+		SnapshotId: "3769855c-a102-11e7-b772-17b880d2f537",
+	})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if !reflect.DeepEqual(atc, expected) {
+		t.Errorf("Expected %v, got %v", expected, atc)
+		return
+	}
+}
+
+func TestListSnapshotAttachments(t *testing.T) {
+	atcs, err := fv.ListSnapshotAttachments()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	if len(atcs) != 1 || atcs[0].SnapshotId != "3769855c-a102-11e7-b772-17b880d2f537" {
+		t.Errorf("Unexpected snapshot attachments %v", atcs)
+		return
+	}
+	if readOnly, _ := atcs[0].ConnectionData["readOnly"].(bool); !readOnly {
+		t.Error("Expected snapshot attachment to be read-only")
+	}
+}
+
+func TestDeleteSnapshotAttachment(t *testing.T) {
+	if err := fv.DeleteSnapshotAttachment("a0cbbd38-c6a1-11e8-9c6b-0f5a3b8e2d51"); err != nil {
+		t.Error(err)
+		return
+	}
+}
+
 func TestCreateVolumeGroup(t *testing.T) {
 	expected := &model.VolumeGroupSpec{
 		BaseModel: &model.BaseModel{
@@ -0,0 +1,63 @@
+// Copyright (c) 2018 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+//    Licensed under the Apache License, Version 2.0 (the "License"); you may
+//    not use this file except in compliance with the License. You may obtain
+//    a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//    WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//    License for the specific language governing permissions and limitations
+//    under the License.
+
+/*
+This module implements a entry into the OpenSDS CSI plugin.
+
+*/
+
+package main
+
+import (
+	"flag"
+
+	"github.com/opensds/opensds/pkg/csi"
+	"github.com/opensds/opensds/pkg/db"
+	. "github.com/opensds/opensds/pkg/utils/config"
+	"github.com/opensds/opensds/pkg/utils/logs"
+)
+
+var (
+	csiEndpoint string
+	mode        string
+	zone        string
+)
+
+func init() {
+	// Load global configuration from specified config file.
+	CONF.Load()
+
+	// Parse some configuration fields from command line. and it will override the value which is got from config file.
+	flag.StringVar(&csiEndpoint, "csi-endpoint", "unix:///var/lib/kubelet/plugins/"+csi.PluginName+"/csi.sock", "Listen endpoint of CSI plugin")
+	flag.StringVar(&mode, "mode", string(csi.ModeAll), "CSI services to serve, one of controller, node and all")
+	flag.StringVar(&zone, "availability-zone", "", "Availability zone of the node, which is reported as the node topology")
+	flag.StringVar(&CONF.OsdsLet.ApiEndpoint, "api-endpoint", CONF.OsdsLet.ApiEndpoint, "Endpoint of controller service")
+	flag.StringVar(&CONF.OsdsDock.ApiEndpoint, "dock-endpoint", CONF.OsdsDock.ApiEndpoint, "Endpoint of attacher dock on the node, from which the node id is derived")
+	flag.DurationVar(&CONF.OsdsDock.LogFlushFrequency, "log-flush-frequency", CONF.OsdsDock.LogFlushFrequency, "Maximum number of seconds between log flushes")
+	flag.Parse()
+}
+
+func main() {
+	// Open OpenSDS CSI plugin log file.
+	logs.InitLogs(CONF.OsdsDock.LogFlushFrequency)
+	defer logs.FlushLogs()
+
+	// Set up database session.
+	db.Init(&CONF.Database)
+
+	// Run CSI plugin server process.
+	if err := csi.Run(csiEndpoint, csi.Mode(mode), zone); err != nil {
+		panic(err)
+	}
+}
@@ -15,10 +15,19 @@
 package backup
 
 import (
+	"errors"
 	"fmt"
 	"os"
 )
 
+// DefaultDriver is the backup driver which stores the backups unless the
+// backup policy names another one.
+const DefaultDriver = "multi-cloud"
+
+// ErrNotFound is returned by the backup drivers when the backup to restore
+// doesn't exist.
+var ErrNotFound = errors.New("backup not found")
+
 type BackupSpec struct {
 	Id       string
 	Name     string
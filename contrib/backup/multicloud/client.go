@@ -29,6 +29,7 @@ import (
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack"
 	"github.com/gophercloud/gophercloud/openstack/identity/v3/tokens"
+	"github.com/opensds/opensds/contrib/backup"
 	"github.com/opensds/opensds/pkg/utils/pwd"
 )
 
@@ -174,6 +175,11 @@ func (c *Client) doRequest(method, u string, in interface{}, cb ReqSettingCB) ([
 		log.Errorf("Do http request failed, method: %s\n url: %s\n error: %v", method, u, err)
 		return nil, nil, err
 	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		log.Errorf("Http request %s %s got not found", method, u)
+		return nil, nil, backup.ErrNotFound
+	}
 
 	log.V(5).Infof("%s: %s OK\n", method, u)
 	rbody, err := ioutil.ReadAll(resp.Body)
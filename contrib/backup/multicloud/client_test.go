@@ -0,0 +1,49 @@
+// Copyright (c) 2018 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+//    Licensed under the Apache License, Version 2.0 (the "License"); you may
+//    not use this file except in compliance with the License. You may obtain
+//    a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//    WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//    License for the specific language governing permissions and limitations
+//    under the License.
+
+package multicloud
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/opensds/opensds/contrib/backup"
+)
+
+func TestDownloadPartNotFound(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/s3/bucket/missing" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte("data"))
+	}))
+	defer srv.Close()
+
+	c, err := NewClient(srv.URL, &AuthOptions{Strategy: "noauth"}, DefaultUploadTimeout)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = c.DownloadPart("bucket", "missing", 0, ChunkSize); err != backup.ErrNotFound {
+		t.Errorf("expected %v, got %v", backup.ErrNotFound, err)
+	}
+	data, err := c.DownloadPart("bucket", "existing", 0, ChunkSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "data" {
+		t.Errorf("expected data, got %s", data)
+	}
+}
@@ -0,0 +1,491 @@
+// Copyright (c) 2018 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+//    Licensed under the Apache License, Version 2.0 (the "License"); you may
+//    not use this file except in compliance with the License. You may obtain
+//    a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//    WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//    License for the specific language governing permissions and limitations
+//    under the License.
+
+package backup
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	log "github.com/golang/glog"
+	"github.com/klauspost/compress/zstd"
+)
+
+const (
+	CompressionNone = "none"
+	CompressionGzip = "gzip"
+	CompressionZstd = "zstd"
+
+	DefaultPipelineChunkSize = 1024 * 1024 * 4
+	ManifestVersion          = 1
+	manifestSuffix           = ".manifest"
+)
+
+// PipelineConfig describes how a backup is transformed before it is handed
+// over to the storage backend.
+type PipelineConfig struct {
+	// Compression algorithm applied to every chunk: none, gzip or zstd.
+	Compression string `yaml:"compression,omitempty"`
+	// Path of a file which contains the hex encoded AES key (16, 24 or 32
+	// bytes). Encryption is disabled if it is empty.
+	EncryptionKeyFile string `yaml:"encryptionKeyFile,omitempty"`
+	// Size of the plain data of each chunk in bytes.
+	ChunkSize int64 `yaml:"chunkSize,omitempty"`
+	// Store the backups as they are, without the manifest and the checksums
+	// of chunks, so that they can't be verified.
+	Disabled bool `yaml:"disabled,omitempty"`
+}
+
+// Enabled reports whether the backups go through the pipeline, which is the
+// default even if nothing is configured, so that every backup is checksummed.
+func (c *PipelineConfig) Enabled() bool {
+	return c == nil || !c.Disabled
+}
+
+// ChunkInfo records where a chunk lives in the stored object and the
+// checksums of both its stored and its original content.
+type ChunkInfo struct {
+	Index       int64  `json:"index"`
+	Offset      int64  `json:"offset"`
+	Length      int64  `json:"length"`
+	RawOffset   int64  `json:"rawOffset"`
+	RawLength   int64  `json:"rawLength"`
+	Checksum    string `json:"checksum"`
+	RawChecksum string `json:"rawChecksum"`
+}
+
+// Manifest is stored next to the backup data and describes how the data was
+// produced so that it can be verified and restored later.
+type Manifest struct {
+	Version     int          `json:"version"`
+	Compression string       `json:"compression"`
+	Encrypted   bool         `json:"encrypted"`
+	ChunkSize   int64        `json:"chunkSize"`
+	Size        int64        `json:"size"`
+	Chunks      []*ChunkInfo `json:"chunks"`
+}
+
+// Verifier is implemented by backup drivers which are able to check a stored
+// backup without restoring it.
+type Verifier interface {
+	Verify(backup *BackupSpec, backupId string) error
+}
+
+// Verify checks the backup identified by backupId using drv. It fails if the
+// driver does not support verification.
+func Verify(drv BackupDriver, backup *BackupSpec, backupId string) error {
+	v, ok := drv.(Verifier)
+	if !ok {
+		return fmt.Errorf("backup driver does not support verification")
+	}
+	return v.Verify(backup, backupId)
+}
+
+// Pipeline wraps a BackupDriver and compresses, encrypts and checksums every
+// chunk of the volume before the inner driver stores it.
+type Pipeline struct {
+	BackupDriver
+	conf *PipelineConfig
+	aead cipher.AEAD
+}
+
+// NewPipeline returns drv wrapped into a pipeline configured by conf.
+func NewPipeline(drv BackupDriver, conf *PipelineConfig) (*Pipeline, error) {
+	c := &PipelineConfig{Compression: CompressionNone, ChunkSize: DefaultPipelineChunkSize}
+	if conf != nil {
+		if conf.Compression != "" {
+			c.Compression = conf.Compression
+		}
+		if conf.ChunkSize > 0 {
+			c.ChunkSize = conf.ChunkSize
+		}
+		c.EncryptionKeyFile = conf.EncryptionKeyFile
+	}
+	switch c.Compression {
+	case CompressionNone, CompressionGzip, CompressionZstd:
+	default:
+		return nil, fmt.Errorf("unsupported backup compression %s", c.Compression)
+	}
+
+	p := &Pipeline{BackupDriver: drv, conf: c}
+	if c.EncryptionKeyFile != "" {
+		key, err := loadKey(c.EncryptionKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		if p.aead, err = newAEAD(key); err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+func loadKey(p string) ([]byte, error) {
+	content, err := ioutil.ReadFile(p)
+	if err != nil {
+		log.Errorf("Read backup encryption key file (%s) failed, reason:(%v)", p, err)
+		return nil, err
+	}
+	key, err := hex.DecodeString(strings.TrimSpace(string(content)))
+	if err != nil {
+		return nil, fmt.Errorf("backup encryption key must be hex encoded: %v", err)
+	}
+	return key, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func manifestId(backupId string) string {
+	return backupId + manifestSuffix
+}
+
+func checksum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// additionalData binds a chunk to its backup and position, so that chunks can
+// not be swapped between backups or reordered without being detected.
+func additionalData(backupId string, index int64) []byte {
+	buf := make([]byte, 8)
+	binary.BigEndian.PutUint64(buf, uint64(index))
+	return append([]byte(backupId), buf...)
+}
+
+func (p *Pipeline) compress(data []byte) ([]byte, error) {
+	switch p.conf.Compression {
+	case CompressionGzip:
+		var buf bytes.Buffer
+		w := gzip.NewWriter(&buf)
+		if _, err := w.Write(data); err != nil {
+			return nil, err
+		}
+		if err := w.Close(); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	case CompressionZstd:
+		w, err := zstd.NewWriter(nil)
+		if err != nil {
+			return nil, err
+		}
+		defer w.Close()
+		return w.EncodeAll(data, nil), nil
+	}
+	return data, nil
+}
+
+func decompress(compression string, data []byte) ([]byte, error) {
+	switch compression {
+	case CompressionGzip:
+		r, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		defer r.Close()
+		return ioutil.ReadAll(r)
+	case CompressionZstd:
+		r, err := zstd.NewReader(nil)
+		if err != nil {
+			return nil, err
+		}
+		defer r.Close()
+		return r.DecodeAll(data, nil)
+	case CompressionNone, "":
+		return data, nil
+	}
+	return nil, fmt.Errorf("unsupported backup compression %s", compression)
+}
+
+func (p *Pipeline) encrypt(backupId string, index int64, data []byte) ([]byte, error) {
+	if p.aead == nil {
+		return data, nil
+	}
+	nonce := make([]byte, p.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return p.aead.Seal(nonce, nonce, data, additionalData(backupId, index)), nil
+}
+
+func (p *Pipeline) decrypt(backupId string, index int64, data []byte) ([]byte, error) {
+	if p.aead == nil {
+		return nil, fmt.Errorf("backup is encrypted but no encryption key is configured")
+	}
+	ns := p.aead.NonceSize()
+	if len(data) < ns {
+		return nil, fmt.Errorf("encrypted chunk %d is too short", index)
+	}
+	return p.aead.Open(nil, data[:ns], data[ns:], additionalData(backupId, index))
+}
+
+// Backup transforms the volume chunk by chunk into a temporary file, hands
+// it over to the inner driver and stores the manifest as a separate object.
+func (p *Pipeline) Backup(backup *BackupSpec, volFile *os.File) error {
+	dataFile, err := ioutil.TempFile("", "opensds-backup-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(dataFile.Name())
+	defer dataFile.Close()
+
+	manifest := &Manifest{
+		Version:     ManifestVersion,
+		Compression: p.conf.Compression,
+		Encrypted:   p.aead != nil,
+		ChunkSize:   p.conf.ChunkSize,
+	}
+	buf := make([]byte, p.conf.ChunkSize)
+	var offset int64
+	for index := int64(0); ; index++ {
+		size, err := io.ReadFull(volFile, buf)
+		if err == io.EOF {
+			break
+		}
+		if err != nil && err != io.ErrUnexpectedEOF {
+			return err
+		}
+		raw := buf[:size]
+		data, err := p.compress(raw)
+		if err != nil {
+			log.Errorf("compress chunk %d failed, err: %v", index, err)
+			return err
+		}
+		if data, err = p.encrypt(backup.Id, index, data); err != nil {
+			log.Errorf("encrypt chunk %d failed, err: %v", index, err)
+			return err
+		}
+		if _, err = dataFile.Write(data); err != nil {
+			return err
+		}
+		manifest.Chunks = append(manifest.Chunks, &ChunkInfo{
+			Index:       index,
+			Offset:      offset,
+			Length:      int64(len(data)),
+			RawOffset:   manifest.Size,
+			RawLength:   int64(size),
+			Checksum:    checksum(data),
+			RawChecksum: checksum(raw),
+		})
+		offset += int64(len(data))
+		manifest.Size += int64(size)
+	}
+
+	if _, err = dataFile.Seek(0, 0); err != nil {
+		return err
+	}
+	if err = p.BackupDriver.Backup(backup, dataFile); err != nil {
+		return err
+	}
+
+	if err = p.putManifest(backup, manifest); err != nil {
+		log.Errorf("store manifest of backup %s failed, err: %v", backup.Id, err)
+		return err
+	}
+	log.Infof("backup %s stored %d chunks, %d bytes of %d bytes", backup.Id,
+		len(manifest.Chunks), offset, manifest.Size)
+	return nil
+}
+
+func (p *Pipeline) putManifest(backup *BackupSpec, manifest *Manifest) error {
+	body, err := json.Marshal(manifest)
+	if err != nil {
+		return err
+	}
+	f, err := ioutil.TempFile("", "opensds-manifest-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+	if _, err = f.Write(body); err != nil {
+		return err
+	}
+	if _, err = f.Seek(0, 0); err != nil {
+		return err
+	}
+	spec := &BackupSpec{Id: manifestId(backup.Id), Name: backup.Name, Metadata: backup.Metadata}
+	return p.BackupDriver.Backup(spec, f)
+}
+
+// GetManifest downloads and decodes the manifest of a stored backup.
+func (p *Pipeline) GetManifest(backup *BackupSpec, backupId string) (*Manifest, error) {
+	f, err := ioutil.TempFile("", "opensds-manifest-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+	if err = p.BackupDriver.Restore(backup, manifestId(backupId), f); err != nil {
+		return nil, err
+	}
+	if _, err = f.Seek(0, 0); err != nil {
+		return nil, err
+	}
+	manifest := &Manifest{}
+	if err = json.NewDecoder(f).Decode(manifest); err != nil {
+		return nil, fmt.Errorf("decode manifest of backup %s failed: %v", backupId, err)
+	}
+	if manifest.Version != ManifestVersion {
+		return nil, fmt.Errorf("unsupported manifest version %d", manifest.Version)
+	}
+	return manifest, nil
+}
+
+// fetch downloads the stored data of a backup. The caller is responsible for
+// closing and removing the returned file.
+func (p *Pipeline) fetch(backup *BackupSpec, backupId string) (*os.File, error) {
+	f, err := ioutil.TempFile("", "opensds-restore-")
+	if err != nil {
+		return nil, err
+	}
+	if err = p.BackupDriver.Restore(backup, backupId, f); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return nil, err
+	}
+	return f, nil
+}
+
+// decodeChunk reads one chunk from the stored data, checks both checksums
+// and returns its original content.
+func (p *Pipeline) decodeChunk(manifest *Manifest, backupId string, dataFile *os.File, c *ChunkInfo) ([]byte, error) {
+	data := make([]byte, c.Length)
+	if _, err := dataFile.ReadAt(data, c.Offset); err != nil {
+		return nil, fmt.Errorf("read chunk %d failed: %v", c.Index, err)
+	}
+	if sum := checksum(data); sum != c.Checksum {
+		return nil, fmt.Errorf("checksum mismatch of chunk %d, expected %s, got %s", c.Index, c.Checksum, sum)
+	}
+	var err error
+	if manifest.Encrypted {
+		if data, err = p.decrypt(backupId, c.Index, data); err != nil {
+			return nil, fmt.Errorf("decrypt chunk %d failed: %v", c.Index, err)
+		}
+	}
+	if data, err = decompress(manifest.Compression, data); err != nil {
+		return nil, fmt.Errorf("decompress chunk %d failed: %v", c.Index, err)
+	}
+	if int64(len(data)) != c.RawLength {
+		return nil, fmt.Errorf("length mismatch of chunk %d, expected %d, got %d", c.Index, c.RawLength, len(data))
+	}
+	if sum := checksum(data); sum != c.RawChecksum {
+		return nil, fmt.Errorf("checksum mismatch of decoded chunk %d, expected %s, got %s", c.Index, c.RawChecksum, sum)
+	}
+	return data, nil
+}
+
+// verify decodes every chunk of the stored data, which fails on the first
+// chunk whose checksums don't match.
+func (p *Pipeline) verify(manifest *Manifest, backupId string, dataFile *os.File) error {
+	for _, c := range manifest.Chunks {
+		if _, err := p.decodeChunk(manifest, backupId, dataFile, c); err != nil {
+			log.Errorf("verify backup %s failed, err: %v", backupId, err)
+			return err
+		}
+	}
+	return nil
+}
+
+// Restore verifies the whole backup before any chunk of it is written back
+// to the volume, so that the volume is left untouched if the backup is
+// corrupted. The backups stored before the pipeline was enabled have no
+// manifest, they are restored by the inner driver as they are.
+func (p *Pipeline) Restore(backup *BackupSpec, backupId string, volFile *os.File) error {
+	manifest, err := p.GetManifest(backup, backupId)
+	if err == ErrNotFound {
+		log.Warningf("backup %s has no manifest, restore it without verification", backupId)
+		return p.BackupDriver.Restore(backup, backupId, volFile)
+	}
+	if err != nil {
+		return err
+	}
+	dataFile, err := p.fetch(backup, backupId)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(dataFile.Name())
+	defer dataFile.Close()
+
+	if err = p.verify(manifest, backupId, dataFile); err != nil {
+		return err
+	}
+	for _, c := range manifest.Chunks {
+		data, err := p.decodeChunk(manifest, backupId, dataFile, c)
+		if err != nil {
+			log.Errorf("restore backup %s failed, err: %v", backupId, err)
+			return err
+		}
+		if _, err = volFile.WriteAt(data, c.RawOffset); err != nil {
+			log.Errorf("write chunk %d failed, err: %v", c.Index, err)
+			return err
+		}
+	}
+	log.Infof("restore backup %s success, %d chunks verified", backupId, len(manifest.Chunks))
+	return nil
+}
+
+// Verify checks every chunk of a stored backup without restoring it. The
+// backups stored without manifest can't be verified.
+func (p *Pipeline) Verify(backup *BackupSpec, backupId string) error {
+	manifest, err := p.GetManifest(backup, backupId)
+	if err == ErrNotFound {
+		return fmt.Errorf("backup %s has no manifest, it was stored without checksums", backupId)
+	}
+	if err != nil {
+		return err
+	}
+	dataFile, err := p.fetch(backup, backupId)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(dataFile.Name())
+	defer dataFile.Close()
+
+	if err = p.verify(manifest, backupId, dataFile); err != nil {
+		return err
+	}
+	log.Infof("verify backup %s success, %d chunks checked", backupId, len(manifest.Chunks))
+	return nil
+}
+
+// Delete removes both the backup data and its manifest, if it has one.
+func (p *Pipeline) Delete(backup *BackupSpec) error {
+	if err := p.BackupDriver.Delete(backup); err != nil {
+		return err
+	}
+	spec := &BackupSpec{Id: manifestId(backup.Id), Name: backup.Name, Metadata: backup.Metadata}
+	if err := p.BackupDriver.Delete(spec); err != nil && err != ErrNotFound {
+		return err
+	}
+	return nil
+}
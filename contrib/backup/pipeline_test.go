@@ -0,0 +1,236 @@
+// Copyright (c) 2018 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+//    Licensed under the Apache License, Version 2.0 (the "License"); you may
+//    not use this file except in compliance with the License. You may obtain
+//    a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//    WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//    License for the specific language governing permissions and limitations
+//    under the License.
+
+package backup
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+type fakeDriver struct {
+	objects map[string][]byte
+}
+
+func (f *fakeDriver) SetUp() error   { return nil }
+func (f *fakeDriver) CleanUp() error { return nil }
+
+func (f *fakeDriver) Backup(backup *BackupSpec, volFile *os.File) error {
+	data, err := ioutil.ReadAll(volFile)
+	if err != nil {
+		return err
+	}
+	f.objects[backup.Id] = data
+	return nil
+}
+
+func (f *fakeDriver) Restore(backup *BackupSpec, backupId string, volFile *os.File) error {
+	data, ok := f.objects[backupId]
+	if !ok {
+		return ErrNotFound
+	}
+	_, err := volFile.WriteAt(data, 0)
+	return err
+}
+
+func (f *fakeDriver) Delete(backup *BackupSpec) error {
+	delete(f.objects, backup.Id)
+	return nil
+}
+
+func writeTempFile(t *testing.T, dir, name string, data []byte) *os.File {
+	p := filepath.Join(dir, name)
+	if err := ioutil.WriteFile(p, data, 0600); err != nil {
+		t.Fatal(err)
+	}
+	f, err := os.OpenFile(p, os.O_RDWR, 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return f
+}
+
+func newTestPipeline(t *testing.T, dir string, conf *PipelineConfig, drv *fakeDriver) *Pipeline {
+	if conf.EncryptionKeyFile != "" {
+		key := filepath.Join(dir, "backup.key")
+		if err := ioutil.WriteFile(key, []byte("000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f\n"), 0600); err != nil {
+			t.Fatal(err)
+		}
+		conf.EncryptionKeyFile = key
+	}
+	p, err := NewPipeline(drv, conf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+func testVolumeData() []byte {
+	data := bytes.Repeat([]byte("opensds backup pipeline "), 1000)
+	for i := range data[:100] {
+		data[i] = byte(i)
+	}
+	return data
+}
+
+func TestPipelineBackupAndRestore(t *testing.T) {
+	var testCases = []*PipelineConfig{
+		{Compression: CompressionNone, ChunkSize: 4096},
+		{Compression: CompressionGzip, ChunkSize: 4096},
+		{Compression: CompressionZstd, ChunkSize: 4096},
+		{Compression: CompressionZstd, ChunkSize: 4096, EncryptionKeyFile: "key"},
+		{Compression: CompressionNone, ChunkSize: 1000, EncryptionKeyFile: "key"},
+	}
+
+	for _, conf := range testCases {
+		dir, err := ioutil.TempDir("", "pipeline-test-")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(dir)
+
+		drv := &fakeDriver{objects: map[string][]byte{}}
+		p := newTestPipeline(t, dir, conf, drv)
+		data := testVolumeData()
+		vol := writeTempFile(t, dir, "volume", data)
+		defer vol.Close()
+
+		b := &BackupSpec{Id: "backup-id", Metadata: map[string]string{"bucket": "test"}}
+		if err := p.Backup(b, vol); err != nil {
+			t.Fatalf("backup with %+v failed: %v", conf, err)
+		}
+		if len(drv.objects) != 2 {
+			t.Errorf("expected data and manifest objects, got %d objects", len(drv.objects))
+		}
+		if conf.EncryptionKeyFile != "" && bytes.Contains(drv.objects[b.Id], []byte("opensds backup pipeline")) {
+			t.Errorf("stored data is not encrypted with %+v", conf)
+		}
+		if err := p.Verify(b, b.Id); err != nil {
+			t.Errorf("verify with %+v failed: %v", conf, err)
+		}
+
+		restored := writeTempFile(t, dir, "restored", make([]byte, len(data)))
+		defer restored.Close()
+		if err := p.Restore(b, b.Id, restored); err != nil {
+			t.Fatalf("restore with %+v failed: %v", conf, err)
+		}
+		result, _ := ioutil.ReadFile(restored.Name())
+		if !bytes.Equal(data, result) {
+			t.Errorf("restored data does not match with %+v", conf)
+		}
+
+		if err := p.Delete(b); err != nil {
+			t.Error(err)
+		}
+		if len(drv.objects) != 0 {
+			t.Errorf("expected all objects to be deleted, got %d objects", len(drv.objects))
+		}
+	}
+}
+
+func TestPipelineDetectCorruption(t *testing.T) {
+	dir, err := ioutil.TempDir("", "pipeline-test-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	drv := &fakeDriver{objects: map[string][]byte{}}
+	p := newTestPipeline(t, dir, &PipelineConfig{Compression: CompressionGzip, ChunkSize: 4096, EncryptionKeyFile: "key"}, drv)
+	vol := writeTempFile(t, dir, "volume", testVolumeData())
+	defer vol.Close()
+
+	b := &BackupSpec{Id: "backup-id"}
+	if err := p.Backup(b, vol); err != nil {
+		t.Fatal(err)
+	}
+	drv.objects[b.Id][len(drv.objects[b.Id])/2] ^= 0xff
+
+	if err := Verify(p, b, b.Id); err == nil {
+		t.Error("expected verify to detect the corrupted chunk")
+	}
+	restored := writeTempFile(t, dir, "restored", nil)
+	defer restored.Close()
+	if err := p.Restore(b, b.Id, restored); err == nil {
+		t.Error("expected restore to detect the corrupted chunk")
+	}
+	// Nothing is written to the volume if any chunk is corrupted.
+	if fi, err := restored.Stat(); err != nil || fi.Size() != 0 {
+		t.Errorf("expected volume untouched by the failed restore, got %v, %v", fi.Size(), err)
+	}
+}
+
+func TestPipelineRestoreWithoutManifest(t *testing.T) {
+	dir, err := ioutil.TempDir("", "pipeline-test-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// The backup was stored by the inner driver before the pipeline was
+	// enabled.
+	data := testVolumeData()
+	drv := &fakeDriver{objects: map[string][]byte{"backup-id": data}}
+	p := newTestPipeline(t, dir, &PipelineConfig{Compression: CompressionZstd, ChunkSize: 4096}, drv)
+
+	b := &BackupSpec{Id: "backup-id"}
+	restored := writeTempFile(t, dir, "restored", make([]byte, len(data)))
+	defer restored.Close()
+	if err := p.Restore(b, b.Id, restored); err != nil {
+		t.Fatalf("restore backup without manifest failed: %v", err)
+	}
+	result, _ := ioutil.ReadFile(restored.Name())
+	if !bytes.Equal(data, result) {
+		t.Error("restored data does not match the backup without manifest")
+	}
+	if err := p.Verify(b, b.Id); err == nil {
+		t.Error("expected verify to fail without manifest")
+	}
+	if err := p.Restore(b, "missing-id", restored); err != ErrNotFound {
+		t.Errorf("expected %v restoring a missing backup, got %v", ErrNotFound, err)
+	}
+}
+
+func TestPipelineEnabled(t *testing.T) {
+	for _, c := range []struct {
+		conf     *PipelineConfig
+		expected bool
+	}{
+		{nil, true},
+		{&PipelineConfig{}, true},
+		{&PipelineConfig{Compression: CompressionZstd}, true},
+		{&PipelineConfig{Disabled: true}, false},
+	} {
+		if got := c.conf.Enabled(); got != c.expected {
+			t.Errorf("expected enabled %v of %+v, got %v", c.expected, c.conf, got)
+		}
+	}
+}
+
+func TestVerifyNotSupported(t *testing.T) {
+	drv := &fakeDriver{objects: map[string][]byte{}}
+	if err := Verify(drv, &BackupSpec{}, "backup-id"); err == nil {
+		t.Error("expected verify to fail without pipeline")
+	}
+}
+
+func TestNewPipelineWithInvalidCompression(t *testing.T) {
+	drv := &fakeDriver{objects: map[string][]byte{}}
+	if _, err := NewPipeline(drv, &PipelineConfig{Compression: "lz4"}); err == nil {
+		t.Error("expected error with unsupported compression")
+	}
+}
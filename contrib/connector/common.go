@@ -16,17 +16,40 @@ package connector
 
 import (
 	"fmt"
+	"io/ioutil"
 	"log"
 	"net"
-	"os/exec"
+	osexec "os/exec"
+	"path/filepath"
 	"strings"
+
+	"github.com/opensds/opensds/pkg/utils/exec"
 )
 
-// ExecCmd Log and convert the result of exec.Command
+// Executer runs the commands of connectors, it can be replaced by tests with
+// an executer replaying the recorded commands.
+var Executer = exec.Recordable(&cmdExecuter{})
+
+// cmdExecuter runs the command and returns its combined output, a command
+// which is not installed, such as mkfs of the file system or findmnt, fails
+// with a clear error.
+type cmdExecuter struct{}
+
+func (*cmdExecuter) Run(name string, arg ...string) (string, error) {
+	if _, err := osexec.LookPath(name); err != nil {
+		if e, ok := err.(*osexec.Error); ok && e.Err == osexec.ErrNotFound {
+			return "", fmt.Errorf("%q executable not found in $PATH", name)
+		}
+		return "", err
+	}
+	info, err := osexec.Command(name, arg...).CombinedOutput()
+	return string(info), err
+}
+
+// ExecCmd Log and run the command by Executer
 func ExecCmd(name string, arg ...string) (string, error) {
 	log.Printf("Command: %s %s:\n", name, strings.Join(arg, " "))
-	info, err := exec.Command(name, arg...).CombinedOutput()
-	return string(info), err
+	return Executer.Run(name, arg...)
 }
 
 // GetFSType returns the File System Type of device
@@ -60,15 +83,6 @@ func Format(device string, fsType string) error {
 	log.Printf("Format device: %s fstype: %s\n", device, fsType)
 
 	mkfsCmd := fmt.Sprintf("mkfs.%s", fsType)
-
-	_, err := exec.LookPath(mkfsCmd)
-	if err != nil {
-		if err == exec.ErrNotFound {
-			return fmt.Errorf("%q executable not found in $PATH", mkfsCmd)
-		}
-		return err
-	}
-
 	mkfsArgs := []string{}
 	mkfsArgs = append(mkfsArgs, device)
 	if fsType == "ext4" || fsType == "ext3" {
@@ -105,7 +119,7 @@ func Mount(device, mountpoint, fsType string, mountFlags []string) error {
 	mountArgs = append(mountArgs, device)
 	mountArgs = append(mountArgs, mountpoint)
 
-	_, err = exec.Command("mount", mountArgs...).CombinedOutput()
+	_, err = ExecCmd("mount", mountArgs...)
 	if err != nil {
 		log.Printf("failed to mount: %v\n", err)
 		return err
@@ -113,6 +127,48 @@ func Mount(device, mountpoint, fsType string, mountFlags []string) error {
 	return nil
 }
 
+// FormatAndMount formats the device with fsType only if it has no file
+// system, so that the data on it is kept, and mounts it into mount point.
+// The device is formatted with ext4 if fsType is empty, but never if it's
+// mounted read-only.
+func FormatAndMount(device, mountpoint, fsType string, mountFlags []string) error {
+	curFSType, err := GetFSType(device)
+	if err != nil {
+		return err
+	}
+	if curFSType == "" {
+		if isReadOnlyMount(mountFlags) {
+			return fmt.Errorf("device %s has no file system, it can't be formatted to be mounted read-only", device)
+		}
+		if fsType == "" {
+			fsType = "ext4"
+		}
+		if err := Format(device, fsType); err != nil {
+			return err
+		}
+	} else {
+		if fsType != "" && fsType != curFSType {
+			log.Printf("device %s is formatted with %s already, not %s\n", device, curFSType, fsType)
+		}
+		fsType = curFSType
+	}
+
+	if mounted, err := IsMounted(mountpoint); err == nil && mounted {
+		log.Printf("mountpoint %s is mounted already\n", mountpoint)
+		return nil
+	}
+	return Mount(device, mountpoint, fsType, mountFlags)
+}
+
+func isReadOnlyMount(mountFlags []string) bool {
+	for _, flag := range mountFlags {
+		if flag == "ro" {
+			return true
+		}
+	}
+	return false
+}
+
 // Umount from mountpoint
 func Umount(mountpoint string) error {
 	log.Printf("Umount mountpoint: %s\n", mountpoint)
@@ -125,6 +181,95 @@ func Umount(mountpoint string) error {
 	return nil
 }
 
+// RescanDevice asks the SCSI device to rescan its capacity, the device can be
+// a link to the real device.
+func RescanDevice(device string) error {
+	realPath, err := filepath.EvalSymlinks(device)
+	if err != nil {
+		return err
+	}
+	log.Printf("Rescan device: %s\n", realPath)
+
+	path := filepath.Join(sysBlockPath, filepath.Base(realPath), "device", "rescan")
+	return ioutil.WriteFile(path, []byte("1"), 0200)
+}
+
+// ResizeFS grows the file system on the device mounted into mount point to
+// the size of device.
+func ResizeFS(device, mountpoint string) error {
+	fsType, err := GetFSType(device)
+	if err != nil {
+		return err
+	}
+	log.Printf("Resize file system: %s device: %s mountpoint: %s\n", fsType, device, mountpoint)
+
+	var out string
+	switch fsType {
+	case "ext2", "ext3", "ext4":
+		out, err = ExecCmd("resize2fs", device)
+	case "xfs":
+		// xfs can only be grown through its mount point.
+		out, err = ExecCmd("xfs_growfs", mountpoint)
+	default:
+		return fmt.Errorf("resizing file system %q on %s is not supported", fsType, device)
+	}
+	if err != nil {
+		return fmt.Errorf("resizing file system failed: %v, output: %q", err, out)
+	}
+	return nil
+}
+
+// FreezeFS suspends the new writes to the file system mounted into mount
+// point and flushes it to disk, so that the device is consistent for a
+// snapshot, or resumes the writes if thaw is set.
+func FreezeFS(mountpoint string, thaw bool) error {
+	flag := "--freeze"
+	if thaw {
+		flag = "--unfreeze"
+	}
+	log.Printf("Freeze file system: mountpoint: %s, flag: %s\n", mountpoint, flag)
+
+	if out, err := ExecCmd("fsfreeze", flag, mountpoint); err != nil {
+		return fmt.Errorf("fsfreeze %s %s failed: %v, output: %q", flag, mountpoint, err, out)
+	}
+	return nil
+}
+
+// IsReadOnly reports whether the connection data asks the connector to
+// attach the volume read-only.
+func IsReadOnly(conn map[string]interface{}) bool {
+	readOnly, _ := conn[ReadOnly].(bool)
+	return readOnly
+}
+
+// SetReadOnly makes the kernel refuse the writes to the attached device, the
+// paths of a multipath device included, so that the volume attached
+// read-only can't be changed on the host even if its target accepts writes.
+// It fails unless the device reads back as read-only.
+func SetReadOnly(device string) error {
+	devices := []string{device}
+	if realPath, err := filepath.EvalSymlinks(device); err == nil {
+		slaves, _ := ioutil.ReadDir(filepath.Join("/sys/class/block", filepath.Base(realPath), "slaves"))
+		for _, slave := range slaves {
+			devices = append(devices, filepath.Join("/dev", slave.Name()))
+		}
+	}
+
+	for _, dev := range devices {
+		if out, err := ExecCmd("blockdev", "--setro", dev); err != nil {
+			return fmt.Errorf("setting device %s read-only failed: %v, output: %q", dev, err, out)
+		}
+	}
+	out, err := ExecCmd("blockdev", "--getro", device)
+	if err != nil {
+		return fmt.Errorf("checking device %s read-only failed: %v, output: %q", device, err, out)
+	}
+	if strings.TrimSpace(out) != "1" {
+		return fmt.Errorf("device %s is still writable", device)
+	}
+	return nil
+}
+
 // GetHostIP return Host IP
 func GetHostIP() string {
 	addrs, err := net.InterfaceAddrs()
@@ -155,17 +300,6 @@ func GetHostName() (string, error) {
 // IsMounted ...
 func IsMounted(target string) (bool, error) {
 	findmntCmd := "findmnt"
-	_, err := exec.LookPath(findmntCmd)
-	if err != nil {
-		if err == exec.ErrNotFound {
-			msg := fmt.Sprintf("%s executable not found in $PATH, err: %v\n", findmntCmd, err)
-			log.Printf(msg)
-			return false, fmt.Errorf(msg)
-		}
-		log.Printf("failed to check IsMounted %v\n", err)
-		return false, err
-	}
-
 	findmntArgs := []string{"--target", target}
 
 	log.Printf("findmnt args is %s\n", findmntArgs)
@@ -0,0 +1,146 @@
+// Copyright (c) 2018 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+//    Licensed under the Apache License, Version 2.0 (the "License"); you may
+//    not use this file except in compliance with the License. You may obtain
+//    a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//    WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//    License for the specific language governing permissions and limitations
+//    under the License.
+
+package connector
+
+import (
+	"testing"
+
+	"github.com/opensds/opensds/pkg/utils/exec"
+)
+
+func TestFormatAndMount(t *testing.T) {
+	var device, mountpoint = "/dev/sdb", "/mnt/volume-0001"
+	var notMounted = exec.Record{Name: "findmnt", Args: []string{"--target", mountpoint}, Error: "exit status 1"}
+	var mkdir = exec.Record{Name: "mkdir", Args: []string{"-p", mountpoint}}
+
+	for _, c := range []struct {
+		fsType  string
+		records []exec.Record
+	}{
+		// A blank device is formatted with ext4 by default.
+		{"", []exec.Record{
+			{Name: "blkid", Args: []string{device}, Error: "exit status 2"},
+			{Name: "mkfs.ext4", Args: []string{"-F", device}},
+			notMounted,
+			mkdir,
+			{Name: "mount", Args: []string{"-t", "ext4", device, mountpoint}},
+		}},
+		// The file system on device is kept even if it's not the one asked.
+		{"ext4", []exec.Record{
+			{Name: "blkid", Args: []string{device}, Output: device + `: UUID="4b2d" TYPE="xfs"` + "\n"},
+			notMounted,
+			mkdir,
+			{Name: "mount", Args: []string{"-t", "xfs", device, mountpoint}},
+		}},
+	} {
+		replayer := exec.NewReplayExecuter(c.records...)
+		oldExecuter := Executer
+		Executer = replayer
+		err := FormatAndMount(device, mountpoint, c.fsType, nil)
+		Executer = oldExecuter
+
+		if err != nil {
+			t.Errorf("Format and mount %q failed: %v", c.fsType, err)
+		}
+		if recs := replayer.Unplayed(); len(recs) != 0 {
+			t.Errorf("Expected all commands of %q to be run, %d are not: %v", c.fsType, len(recs), recs)
+		}
+	}
+}
+
+func TestFormatAndMountReadOnly(t *testing.T) {
+	var device, mountpoint = "/dev/sdb", "/mnt/snapshot-0001"
+	replayer := exec.NewReplayExecuter(
+		exec.Record{Name: "blkid", Args: []string{device}, Error: "exit status 2"},
+	)
+	oldExecuter := Executer
+	Executer = replayer
+	defer func() { Executer = oldExecuter }()
+
+	// A blank device mounted read-only is never formatted.
+	if err := FormatAndMount(device, mountpoint, "", []string{"ro"}); err == nil {
+		t.Error("Expected error of mounting blank device read-only, got nil")
+	}
+	if recs := replayer.Unplayed(); len(recs) != 0 {
+		t.Errorf("Expected all commands to be run, %d are not: %v", len(recs), recs)
+	}
+}
+
+func TestSetReadOnly(t *testing.T) {
+	var device = "/dev/opensds-test-device"
+	for _, c := range []struct {
+		records  []exec.Record
+		expected bool
+	}{
+		{[]exec.Record{
+			{Name: "blockdev", Args: []string{"--setro", device}},
+			{Name: "blockdev", Args: []string{"--getro", device}, Output: "1\n"},
+		}, true},
+		// The attach fails if the device can't be set read-only.
+		{[]exec.Record{
+			{Name: "blockdev", Args: []string{"--setro", device}, Error: "exit status 1"},
+		}, false},
+		{[]exec.Record{
+			{Name: "blockdev", Args: []string{"--setro", device}},
+			{Name: "blockdev", Args: []string{"--getro", device}, Output: "0\n"},
+		}, false},
+	} {
+		replayer := exec.NewReplayExecuter(c.records...)
+		oldExecuter := Executer
+		Executer = replayer
+		err := SetReadOnly(device)
+		Executer = oldExecuter
+
+		if (err == nil) != c.expected {
+			t.Errorf("Expected success %v of setting read-only, got %v", c.expected, err)
+		}
+		if recs := replayer.Unplayed(); len(recs) != 0 {
+			t.Errorf("Expected all commands to be run, %d are not: %v", len(recs), recs)
+		}
+	}
+}
+
+func TestFreezeFS(t *testing.T) {
+	var mountpoint = "/mnt/volume-0001"
+	replayer := exec.NewReplayExecuter(
+		exec.Record{Name: "fsfreeze", Args: []string{"--freeze", mountpoint}},
+		exec.Record{Name: "fsfreeze", Args: []string{"--unfreeze", mountpoint}},
+		exec.Record{Name: "fsfreeze", Args: []string{"--freeze", mountpoint}, Error: "exit status 1"},
+	)
+	oldExecuter := Executer
+	Executer = replayer
+	defer func() { Executer = oldExecuter }()
+
+	if err := FreezeFS(mountpoint, false); err != nil {
+		t.Errorf("Freeze %s failed: %v", mountpoint, err)
+	}
+	if err := FreezeFS(mountpoint, true); err != nil {
+		t.Errorf("Thaw %s failed: %v", mountpoint, err)
+	}
+	if err := FreezeFS(mountpoint, false); err == nil {
+		t.Error("Expected error of fsfreeze, got nil")
+	}
+}
+
+func TestExecCmdNotFound(t *testing.T) {
+	defer func(e exec.Executer) { Executer = e }(Executer)
+	Executer = &cmdExecuter{}
+
+	// Such as mkfs of the file system which is not installed.
+	_, err := ExecCmd("mkfs.opensds-none", "/dev/sdb")
+	if expected := `"mkfs.opensds-none" executable not found in $PATH`; err == nil || err.Error() != expected {
+		t.Errorf("Expected %s, got %v", expected, err)
+	}
+}
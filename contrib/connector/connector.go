@@ -33,12 +33,37 @@ const (
 
 	NvmeofDriver = "nvmeof"
 	Nqn          = "nqn"
+
+	// LocalDriver attaches the volume kept as a file on the host, the path
+	// of the file is the Path of connection data, and it's attached through
+	// a loop device if LoopDevice of connection data is true.
+	LocalDriver = "local"
+	Path        = "path"
+	LoopDevice  = "loopDevice"
+
+	// MultiPath is the key of connection data which asks the connector to
+	// attach the volume through all the paths, it's also the key of initiator
+	// info which tells whether the host supports multipath I/O.
+	MultiPath = "multipath"
+
+	// ReadOnly is the key of connection data which asks the connector to
+	// attach the volume read-only, such as a snapshot exposed to the host.
+	ReadOnly = "readOnly"
+
+	// MaxIOPS and MaxBWS are the keys of connection data which ask the
+	// attacher to throttle the I/O of the volume on the host, the volume
+	// isn't throttled if they are absent and zero means no limit.
+	MaxIOPS = "maxIOPS"
+	MaxBWS  = "maxBWS"
 )
 
 // Connector implementation
 type Connector interface {
 	Attach(map[string]interface{}) (string, error)
 	Detach(map[string]interface{}) error
+	// Expand rescans the attached volume so that the host sees its new
+	// size after it's extended, and returns the device of the volume.
+	Expand(map[string]interface{}) (string, error)
 	GetInitiatorInfo() (string, error)
 }
 
@@ -15,6 +15,8 @@
 package fc
 
 import (
+	"log"
+
 	"github.com/opensds/opensds/contrib/connector"
 )
 
@@ -32,6 +34,16 @@ func (f *FC) Attach(conn map[string]interface{}) (string, error) {
 	if err != nil {
 		return "", err
 	}
+	// The device is set read-only if the connection data asks for it.
+	if !connector.IsReadOnly(conn) {
+		return deviceInfo["path"], nil
+	}
+	if err = connector.SetReadOnly(deviceInfo["path"]); err != nil {
+		if err := disconnectVolume(conn); err != nil {
+			log.Printf("disconnect volume failed: %v\n", err)
+		}
+		return "", err
+	}
 	return deviceInfo["path"], nil
 }
 
@@ -40,6 +52,11 @@ func (f *FC) Detach(conn map[string]interface{}) error {
 	return disconnectVolume(conn)
 }
 
+// Expand ...
+func (f *FC) Expand(conn map[string]interface{}) (string, error) {
+	return expandVolume(conn)
+}
+
 // GetInitiatorInfo ...
 func (f *FC) GetInitiatorInfo() (string, error) {
 	return getInitiatorInfo()
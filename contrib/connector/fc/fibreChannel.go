@@ -40,13 +40,15 @@ type ConnectorInfo struct {
 	TargetWWN  []string `mapstructure:"targetWWN"`
 	VolumeID   string   `mapstructure:"volumeId"`
 	TgtLun     string   `mapstructure:"targetLun"`
+	TgtLuns    []string `mapstructure:"targetLuns"`
 	Encrypted  bool     `mapstructure:"encrypted"`
 }
 
 // ParseIscsiConnectInfo decode
 func parseFCConnectInfo(connectInfo map[string]interface{}) (*ConnectorInfo, error) {
 	var con ConnectorInfo
-	mapstructure.Decode(connectInfo, &con)
+	// The luns are decoded weakly, since the drivers report them as numbers.
+	mapstructure.WeakDecode(connectInfo, &con)
 
 	if len(con.TargetWWN) == 0 || con.TgtLun == "0" {
 		return nil, errors.New("fibrechannel connection data invalid.")
@@ -71,6 +73,10 @@ func connectVolume(connMap map[string]interface{}) (map[string]string, error) {
 		return nil, errors.New(errMsg)
 	}
 
+	if connector.IsMultiPath(connMap) {
+		return connectMultipath(conn, volPaths, hbas)
+	}
+
 	devicePath, deviceName := volPathDiscovery(volPaths, tries, conn.TargetWWN, hbas)
 	if devicePath != "" && deviceName != "" {
 		log.Printf("Found Fibre Channel volume name, devicePath is %s, deviceName is %s\n", devicePath, deviceName)
@@ -84,14 +90,48 @@ func connectVolume(connMap map[string]interface{}) (map[string]string, error) {
 	return map[string]string{"scsi_wwn": deviceWWN, "path": devicePath}, nil
 }
 
+// connectMultipath discovers all the paths of volume and returns the
+// multipath device over them.
+func connectMultipath(conn *ConnectorInfo, volPaths []string, hbas []map[string]string) (map[string]string, error) {
+	devicePaths := volPathsDiscovery(volPaths, tries, conn.TargetWWN, hbas)
+	if len(devicePaths) == 0 {
+		errMsg := "No FC devices found."
+		log.Println(errMsg)
+		return nil, errors.New(errMsg)
+	}
+	log.Printf("Found Fibre Channel volume paths %v\n", devicePaths)
+
+	mpath, err := connector.DiscoverMultipathDevice(devicePaths, 10)
+	if err != nil {
+		return nil, err
+	}
+	deviceWWN, err := getSCSIWWN(devicePaths[0])
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]string{"scsi_wwn": deviceWWN, "path": mpath}, nil
+}
+
 func getVolumePaths(conn *ConnectorInfo, hbas []map[string]string) []string {
 	wwnports := conn.TargetWWN
 	devices := getDevices(hbas, wwnports)
-	lun := conn.TgtLun
-	hostPaths := getHostDevices(devices, lun)
+	hostPaths := getHostDevices(devices, conn)
 	return hostPaths
 }
 
+// targetLun returns the lun of the target port, all the ports share the same
+// lun if the luns of ports are not given, which differ only when the ports
+// belong to different arrays such as the ones of a HyperMetro pair.
+func targetLun(conn *ConnectorInfo, wwn string) string {
+	for i, w := range conn.TargetWWN {
+		if w == wwn && i < len(conn.TgtLuns) {
+			return conn.TgtLuns[i]
+		}
+	}
+	return conn.TgtLun
+}
+
 func volPathDiscovery(volPaths []string, tries int, tgtWWN []string, hbas []map[string]string) (string, string) {
 	for i := 0; i < tries; i++ {
 		for _, path := range volPaths {
@@ -107,11 +147,33 @@ func volPathDiscovery(volPaths []string, tries int, tgtWWN []string, hbas []map[
 	return "", ""
 }
 
-func getHostDevices(devices []map[string]string, lun string) []string {
+// volPathsDiscovery returns all the paths of volume which show up, the hosts
+// are rescanned until all the paths show up or it runs out of tries.
+func volPathsDiscovery(volPaths []string, tries int, tgtWWN []string, hbas []map[string]string) []string {
+	var devicePaths []string
+	for i := 0; i < tries; i++ {
+		devicePaths = devicePaths[:0]
+		for _, path := range volPaths {
+			if pathExists(path) {
+				devicePaths = append(devicePaths, path)
+			}
+		}
+		if len(devicePaths) == len(volPaths) {
+			break
+		}
+		rescanHosts(tgtWWN, hbas)
+
+		time.Sleep(2 * time.Second)
+	}
+	return devicePaths
+}
+
+func getHostDevices(devices []map[string]string, conn *ConnectorInfo) []string {
 	var hostDevices []string
 	for _, device := range devices {
 		var hostDevice string
 		for pciNum, tgtWWN := range device {
+			lun := targetLun(conn, strings.TrimPrefix(tgtWWN, "0x"))
 			hostDevice = fmt.Sprintf("/dev/disk/by-path/pci-%s-fc-%s-lun-%s", pciNum, tgtWWN, processLunID(lun))
 		}
 		hostDevices = append(hostDevices, hostDevice)
@@ -129,6 +191,18 @@ func disconnectVolume(connMap map[string]interface{}) error {
 		return err
 	}
 
+	// The multipath device is removed before the paths under it.
+	if connector.IsMultiPath(connMap) {
+		for _, path := range volPaths {
+			if mpath := connector.GetMultipathDevice(path); mpath != "" {
+				if err := connector.FlushMultipathDevice(mpath); err != nil {
+					return err
+				}
+				break
+			}
+		}
+	}
+
 	var devices []map[string]string
 	for _, path := range volPaths {
 		realPath := getContentfromSymboliclink(path)
@@ -139,6 +213,36 @@ func disconnectVolume(connMap map[string]interface{}) error {
 	return removeDevices(devices)
 }
 
+// expandVolume rescans all the paths of volume so that the host sees its new
+// size, and returns the device of volume.
+func expandVolume(connMap map[string]interface{}) (string, error) {
+	conn, err := parseFCConnectInfo(connMap)
+	if err != nil {
+		return "", err
+	}
+	volPaths, err := getVolumePathsForDetach(conn)
+	if err != nil {
+		return "", err
+	}
+	if len(volPaths) == 0 {
+		return "", errors.New("No FC devices found.")
+	}
+
+	for _, path := range volPaths {
+		if err := connector.RescanDevice(path); err != nil {
+			return "", err
+		}
+	}
+	if !connector.IsMultiPath(connMap) {
+		return volPaths[0], nil
+	}
+	mpath := connector.GetMultipathDevice(volPaths[0])
+	if mpath == "" {
+		return "", fmt.Errorf("No multipath device found over %v", volPaths)
+	}
+	return mpath, connector.ResizeMultipathDevice(mpath)
+}
+
 func removeDevices(devices []map[string]string) error {
 	for _, device := range devices {
 		path := fmt.Sprintf("/sys/block/%s/device/delete", strings.Replace(device["device"], "/dev/", "", -1))
@@ -245,5 +349,7 @@ func getInitiatorInfo() (string, error) {
 		}
 	}
 
+	initiatorInfo = append(initiatorInfo, connector.MultipathInitiatorInfo())
+
 	return strings.Join(initiatorInfo, ","), nil
 }
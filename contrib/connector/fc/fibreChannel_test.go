@@ -0,0 +1,51 @@
+// Copyright (c) 2019 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fc
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGetHostDevices(t *testing.T) {
+	// The luns are reported as numbers, the ones of HyperMetro pair differ
+	// between the target ports of the two arrays.
+	conn, err := parseFCConnectInfo(map[string]interface{}{
+		"targetWWN":  []string{"2100000e1e1a1a1a", "2100000e1e2b2b2b"},
+		"targetLun":  1,
+		"targetLuns": []int{1, 3},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	devices := []map[string]string{
+		{"0000:05:00.0": "0x2100000e1e1a1a1a"},
+		{"0000:05:00.0": "0x2100000e1e2b2b2b"},
+	}
+	expected := []string{
+		"/dev/disk/by-path/pci-0000:05:00.0-fc-0x2100000e1e1a1a1a-lun-1",
+		"/dev/disk/by-path/pci-0000:05:00.0-fc-0x2100000e1e2b2b2b-lun-3",
+	}
+	if paths := getHostDevices(devices, conn); !reflect.DeepEqual(paths, expected) {
+		t.Errorf("Expected %v, got %v", expected, paths)
+	}
+
+	// All the target ports share the same lun if the luns aren't given.
+	conn.TgtLuns = nil
+	expected[1] = "/dev/disk/by-path/pci-0000:05:00.0-fc-0x2100000e1e2b2b2b-lun-1"
+	if paths := getHostDevices(devices, conn); !reflect.DeepEqual(paths, expected) {
+		t.Errorf("Expected %v, got %v", expected, paths)
+	}
+}
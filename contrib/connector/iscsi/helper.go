@@ -39,6 +39,7 @@ type IscsiConnectorInfo struct {
 	TgtPortal  []string `mapstructure:"targetPortal"`
 	VolumeID   string   `mapstructure:"volumeId"`
 	TgtLun     int      `mapstructure:"targetLun"`
+	TgtLuns    []int    `mapstructure:"targetLuns"`
 	Encrypted  bool     `mapstructure:"encrypted"`
 }
 
@@ -227,6 +228,37 @@ func parseIscsiConnectInfo(connectInfo map[string]interface{}) (*IscsiConnectorI
 	return &con, index, nil
 }
 
+// targetIQN returns the target iqn of the i-th portal, all the portals share
+// the first iqn if only one is given.
+func targetIQN(conn *IscsiConnectorInfo, i int) string {
+	if i < len(conn.TgtIQN) {
+		return conn.TgtIQN[i]
+	}
+	return conn.TgtIQN[0]
+}
+
+// targetLun returns the lun of the i-th portal, all the portals share the
+// same lun if the luns of portals are not given, which differ only when the
+// portals belong to different arrays such as the ones of a HyperMetro pair.
+func targetLun(conn *IscsiConnectorInfo, i int) int {
+	if i < len(conn.TgtLuns) {
+		return conn.TgtLuns[i]
+	}
+	return conn.TgtLun
+}
+
+// devicePathOf returns the path of device which the lun of target is
+// attached as through the portal.
+func devicePathOf(portal, targetiqn string, lun int) string {
+	return strings.Join([]string{
+		"/dev/disk/by-path/ip",
+		portal,
+		"iscsi",
+		targetiqn,
+		"lun",
+		strconv.Itoa(lun)}, "-")
+}
+
 // Connect ISCSI Target
 func connect(connMap map[string]interface{}) (string, error) {
 	conn, index, err := parseIscsiConnectInfo(connMap)
@@ -235,9 +267,6 @@ func connect(connMap map[string]interface{}) (string, error) {
 	}
 	log.Println("connmap info: ", connMap)
 	log.Println("conn info is: ", conn)
-	portal := conn.TgtPortal[index]
-	targetiqn := conn.TgtIQN[index]
-	targetlun := strconv.Itoa(conn.TgtLun)
 
 	cmd := "pgrep -f /sbin/iscsid"
 	_, err = connector.ExecCmd("/bin/bash", "-c", cmd)
@@ -250,19 +279,22 @@ func connect(connMap map[string]interface{}) (string, error) {
 		}
 	}
 
-	log.Printf("Connect portal: %s targetiqn: %s targetlun: %s\n", portal, targetiqn, targetlun)
-	devicePath := strings.Join([]string{
-		"/dev/disk/by-path/ip",
-		portal,
-		"iscsi",
-		targetiqn,
-		"lun",
-		targetlun}, "-")
+	if connector.IsMultiPath(connMap) {
+		return connectMultipath(conn)
+	}
+	return connectPortal(conn, conn.TgtPortal[index], targetIQN(conn, index), targetLun(conn, index))
+}
+
+// connectPortal logs into the target through the portal and waits for the
+// device of lun.
+func connectPortal(conn *IscsiConnectorInfo, portal, targetiqn string, lun int) (string, error) {
+	log.Printf("Connect portal: %s targetiqn: %s targetlun: %d\n", portal, targetiqn, lun)
+	devicePath := devicePathOf(portal, targetiqn, lun)
 
 	log.Println("devicepath is ", devicePath)
 
 	// Discovery
-	err = discovery(portal)
+	err := discovery(portal)
 	if err != nil {
 		return "", err
 	}
@@ -284,42 +316,162 @@ func connect(connMap map[string]interface{}) (string, error) {
 	return devicePath, nil
 }
 
+// connectMultipath logs into the target through every portal and returns
+// the multipath device over all the paths. The portals which can't be logged
+// into are skipped, so that the volume is attached as long as one path works.
+func connectMultipath(conn *IscsiConnectorInfo) (string, error) {
+	var paths []string
+	for i, portal := range conn.TgtPortal {
+		devicePath, err := connectPortal(conn, portal, targetIQN(conn, i), targetLun(conn, i))
+		if err != nil {
+			log.Printf("Connect portal %s failed, skip it: %v\n", portal, err)
+			continue
+		}
+		paths = append(paths, devicePath)
+	}
+	if len(paths) == 0 {
+		return "", errors.New("Could not connect volume: no portal is available")
+	}
+	return connector.DiscoverMultipathDevice(paths, 10)
+}
+
 // Disconnect ISCSI Target
 func disconnect(conn map[string]interface{}) error {
 	iscsiCon, index, err := parseIscsiConnectInfo(conn)
 	if err != nil {
 		return err
 	}
-	portal := iscsiCon.TgtPortal[index]
-	targetiqn := iscsiCon.TgtIQN[index]
+	if connector.IsMultiPath(conn) {
+		return disconnectMultipath(iscsiCon)
+	}
+
+	targetiqn := targetIQN(iscsiCon, index)
+	loggedOut, err := disconnectPortal(iscsiCon.TgtPortal[index], targetiqn)
+	if err != nil || !loggedOut {
+		return err
+	}
+	//Delete
+	return delete(targetiqn)
+}
+
+// disconnectPortal logs out of the target through the portal, unless other
+// luns of the target are still attached through it.
+func disconnectPortal(portal, targetiqn string) (bool, error) {
 	cmd := "ls /dev/disk/by-path/ |grep -w " + portal + "|grep -w " + targetiqn + "|wc -l |awk '{if($1>1) print 1; else print 0}'"
 	logoutFlag, err := connector.ExecCmd("/bin/bash", "-c", cmd)
 	if err != nil {
 		log.Printf("Disconnect iscsi target failed, %v\n", err)
-		return err
+		return false, err
 	}
 
 	logoutFlag = strings.Replace(logoutFlag, "\n", "", -1)
 	if logoutFlag == "0" {
 		log.Printf("Disconnect portal: %s targetiqn: %s\n", portal, targetiqn)
 		// Logout
-		err = logout(portal, targetiqn)
-		if err != nil {
+		if err = logout(portal, targetiqn); err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+	log.Println("logoutFlag: ", logoutFlag)
+	return false, nil
+}
+
+// disconnectMultipath removes the multipath device of the volume and then
+// logs out of the target through every portal.
+func disconnectMultipath(conn *IscsiConnectorInfo) error {
+	for i, portal := range conn.TgtPortal {
+		mpath := connector.GetMultipathDevice(devicePathOf(portal, targetIQN(conn, i), targetLun(conn, i)))
+		if mpath == "" {
+			continue
+		}
+		if err := connector.FlushMultipathDevice(mpath); err != nil {
 			return err
 		}
+		break
+	}
 
-		//Delete
-		err = delete(targetiqn)
+	// The node records of target are shared by its portals, so they are
+	// deleted only after logging out of all the portals of the target.
+	var loggedOut = map[string]bool{}
+	for i, portal := range conn.TgtPortal {
+		targetiqn := targetIQN(conn, i)
+		ok, err := disconnectPortal(portal, targetiqn)
 		if err != nil {
 			return err
 		}
+		if prev, exist := loggedOut[targetiqn]; exist {
+			ok = ok && prev
+		}
+		loggedOut[targetiqn] = ok
+	}
+	for targetiqn, ok := range loggedOut {
+		if !ok {
+			continue
+		}
+		if err := delete(targetiqn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
-		return nil
+// Rescan ISCSI Session
+func rescan(portal string, targetiqn string) error {
+	log.Printf("Rescan portal: %s targetiqn: %s\n", portal, targetiqn)
+	info, err := connector.ExecCmd("iscsiadm", "-m", "node", "-p", portal, "-T", targetiqn, "--rescan")
+	if err != nil {
+		log.Printf("Received error on rescan attempt: %v, %s\n", err, info)
+		return err
 	}
-	log.Println("logoutFlag: ", logoutFlag)
 	return nil
 }
 
+// expand rescans the sessions through which the lun is attached so that the
+// host sees its new size, and returns the device of lun.
+func expand(connMap map[string]interface{}) (string, error) {
+	conn, index, err := parseIscsiConnectInfo(connMap)
+	if err != nil {
+		return "", err
+	}
+
+	var indexes = []int{index}
+	if connector.IsMultiPath(connMap) {
+		indexes = indexes[:0]
+		for i := range conn.TgtPortal {
+			indexes = append(indexes, i)
+		}
+	}
+	var paths []string
+	for _, i := range indexes {
+		portal, targetiqn := conn.TgtPortal[i], targetIQN(conn, i)
+		devicePath := devicePathOf(portal, targetiqn, targetLun(conn, i))
+		if _, err := os.Stat(devicePath); err != nil {
+			log.Printf("Device %s is not attached, skip it: %v\n", devicePath, err)
+			continue
+		}
+		if err := rescan(portal, targetiqn); err != nil {
+			return "", err
+		}
+		if err := connector.RescanDevice(devicePath); err != nil {
+			return "", err
+		}
+		paths = append(paths, devicePath)
+	}
+	if len(paths) == 0 {
+		return "", errors.New("Could not expand volume: volume is not attached")
+	}
+
+	if !connector.IsMultiPath(connMap) {
+		return paths[0], nil
+	}
+	mpath := connector.GetMultipathDevice(paths[0])
+	if mpath == "" {
+		return "", fmt.Errorf("Could not expand volume: no multipath device over %v", paths)
+	}
+	return mpath, connector.ResizeMultipathDevice(mpath)
+}
+
 func getTgtPortalAndTgtIQN() (string, string, error) {
 	log.Println("GetTgtPortalAndTgtIQN")
 	var targetiqn, targetportal string
@@ -365,5 +517,5 @@ func getInitiatorInfo() (string, error) {
 		return "", errors.New("the number of iqn is wrong")
 	}
 
-	return initiators[0], nil
+	return strings.Join([]string{initiators[0], connector.MultipathInitiatorInfo()}, ","), nil
 }
@@ -15,6 +15,8 @@
 package iscsi
 
 import (
+	"log"
+
 	"github.com/opensds/opensds/contrib/connector"
 )
 
@@ -24,14 +26,33 @@ func init() {
 	connector.RegisterConnector(connector.IscsiDriver, &Iscsi{})
 }
 
+// Attach connects the volume, the device of which is set read-only if the
+// connection data asks for it.
 func (isc *Iscsi) Attach(conn map[string]interface{}) (string, error) {
-	return connect(conn)
+	device, err := connect(conn)
+	if err != nil {
+		return "", err
+	}
+	if !connector.IsReadOnly(conn) {
+		return device, nil
+	}
+	if err = connector.SetReadOnly(device); err != nil {
+		if err := disconnect(conn); err != nil {
+			log.Printf("disconnect volume failed: %v\n", err)
+		}
+		return "", err
+	}
+	return device, nil
 }
 
 func (isc *Iscsi) Detach(conn map[string]interface{}) error {
 	return disconnect(conn)
 }
 
+func (isc *Iscsi) Expand(conn map[string]interface{}) (string, error) {
+	return expand(conn)
+}
+
 // GetInitiatorInfo implementation
 func (isc *Iscsi) GetInitiatorInfo() (string, error) {
 	return getInitiatorInfo()
@@ -0,0 +1,140 @@
+// Copyright (c) 2018 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+//    Licensed under the Apache License, Version 2.0 (the "License"); you may
+//    not use this file except in compliance with the License. You may obtain
+//    a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//    WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//    License for the specific language governing permissions and limitations
+//    under the License.
+
+package local
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/opensds/opensds/contrib/connector"
+)
+
+// Local attaches the volumes kept as files on the host, which is the host of
+// the dock serving them. The file itself is the device of the volume unless
+// a loop device is asked for, so that no privilege is required to attach it.
+type Local struct{}
+
+var _ connector.Connector = &Local{}
+
+func init() {
+	connector.RegisterConnector(connector.LocalDriver, &Local{})
+}
+
+func parseConn(conn map[string]interface{}) (string, bool, error) {
+	path, ok := conn[connector.Path].(string)
+	if !ok || path == "" {
+		return "", false, os.ErrInvalid
+	}
+	loop, _ := conn[connector.LoopDevice].(bool)
+	return path, loop, nil
+}
+
+// Attach returns the path of the file, or the loop device which the file is
+// set up on.
+func (*Local) Attach(conn map[string]interface{}) (string, error) {
+	path, loop, err := parseConn(conn)
+	if err != nil {
+		return "", err
+	}
+	if _, err := os.Stat(path); err != nil {
+		return "", err
+	}
+	if !loop {
+		return path, nil
+	}
+
+	if device, err := findLoopDevice(path); err == nil && device != "" {
+		log.Printf("file %s is attached to %s already\n", path, device)
+		return device, nil
+	}
+	args := []string{"--find", "--show"}
+	if readOnly, _ := conn[connector.ReadOnly].(bool); readOnly {
+		args = append(args, "--read-only")
+	}
+	out, err := connector.ExecCmd("losetup", append(args, path)...)
+	if err != nil {
+		return "", fmt.Errorf("setting up loop device of %s failed: %v, output: %q", path, err, out)
+	}
+	return strings.TrimSpace(out), nil
+}
+
+// Detach detaches the loop devices of the file if any.
+func (*Local) Detach(conn map[string]interface{}) error {
+	path, loop, err := parseConn(conn)
+	if err != nil {
+		return err
+	}
+	if !loop {
+		return nil
+	}
+
+	device, err := findLoopDevice(path)
+	if err != nil {
+		return err
+	}
+	if device == "" {
+		log.Printf("file %s is not attached to any loop device\n", path)
+		return nil
+	}
+	if out, err := connector.ExecCmd("losetup", "--detach", device); err != nil {
+		return fmt.Errorf("detaching loop device %s failed: %v, output: %q", device, err, out)
+	}
+	return nil
+}
+
+// Expand makes the loop device of the file pick up the new size of it.
+func (*Local) Expand(conn map[string]interface{}) (string, error) {
+	path, loop, err := parseConn(conn)
+	if err != nil {
+		return "", err
+	}
+	if !loop {
+		return path, nil
+	}
+
+	device, err := findLoopDevice(path)
+	if err != nil {
+		return "", err
+	}
+	if device == "" {
+		return "", fmt.Errorf("file %s is not attached to any loop device", path)
+	}
+	if out, err := connector.ExecCmd("losetup", "--set-capacity", device); err != nil {
+		return "", fmt.Errorf("resizing loop device %s failed: %v, output: %q", device, err, out)
+	}
+	return device, nil
+}
+
+// GetInitiatorInfo implementation
+func (*Local) GetInitiatorInfo() (string, error) {
+	return connector.GetHostName()
+}
+
+// findLoopDevice returns the loop device which the file is set up on, it's
+// empty if there isn't any.
+func findLoopDevice(path string) (string, error) {
+	out, err := connector.ExecCmd("losetup", "--associated", path)
+	if err != nil {
+		return "", fmt.Errorf("finding loop device of %s failed: %v, output: %q", path, err, out)
+	}
+	// The output is like "/dev/loop0: [2049]:1234 (/path/to/file)".
+	line := strings.TrimSpace(strings.Split(out, "\n")[0])
+	if i := strings.Index(line, ":"); i > 0 {
+		return line[:i], nil
+	}
+	return "", nil
+}
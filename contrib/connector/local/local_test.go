@@ -0,0 +1,102 @@
+// Copyright (c) 2018 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+//    Licensed under the Apache License, Version 2.0 (the "License"); you may
+//    not use this file except in compliance with the License. You may obtain
+//    a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//    WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//    License for the specific language governing permissions and limitations
+//    under the License.
+
+package local
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/opensds/opensds/contrib/connector"
+	"github.com/opensds/opensds/pkg/utils/exec"
+)
+
+func tempFile(t *testing.T) string {
+	f, err := ioutil.TempFile("", "local-connector-test-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+	return f.Name()
+}
+
+func replay(t *testing.T, recs ...exec.Record) func() {
+	replayer := exec.NewReplayExecuter(recs...)
+	origin := connector.Executer
+	connector.Executer = replayer
+	return func() {
+		connector.Executer = origin
+		if recs := replayer.Unplayed(); len(recs) != 0 {
+			t.Errorf("Expected all commands to be run, %d are not: %v", len(recs), recs)
+		}
+	}
+}
+
+func TestAttachFile(t *testing.T) {
+	path := tempFile(t)
+	defer os.Remove(path)
+
+	var l = &Local{}
+	conn := map[string]interface{}{connector.Path: path}
+	dev, err := l.Attach(conn)
+	if err != nil {
+		t.Fatal("Attach failed:", err)
+	}
+	if dev != path {
+		t.Errorf("Expected device %s, got %s", path, dev)
+	}
+	if err := l.Detach(conn); err != nil {
+		t.Error("Detach failed:", err)
+	}
+
+	os.Remove(path)
+	if _, err := l.Attach(conn); err == nil {
+		t.Error("Expected error of attaching missing file, got nil")
+	}
+}
+
+func TestAttachLoopDevice(t *testing.T) {
+	path := tempFile(t)
+	defer os.Remove(path)
+
+	defer replay(t,
+		exec.Record{Name: "losetup", Args: []string{"--associated", path}},
+		exec.Record{Name: "losetup", Args: []string{"--find", "--show", "--read-only", path}, Output: "/dev/loop3\n"},
+		exec.Record{Name: "losetup", Args: []string{"--associated", path}, Output: "/dev/loop3: [2049]:1234 (" + path + ")\n"},
+		exec.Record{Name: "losetup", Args: []string{"--set-capacity", "/dev/loop3"}},
+		exec.Record{Name: "losetup", Args: []string{"--associated", path}, Output: "/dev/loop3: [2049]:1234 (" + path + ")\n"},
+		exec.Record{Name: "losetup", Args: []string{"--detach", "/dev/loop3"}},
+	)()
+
+	var l = &Local{}
+	conn := map[string]interface{}{
+		connector.Path:       path,
+		connector.LoopDevice: true,
+		connector.ReadOnly:   true,
+	}
+	dev, err := l.Attach(conn)
+	if err != nil {
+		t.Fatal("Attach failed:", err)
+	}
+	if dev != "/dev/loop3" {
+		t.Errorf("Expected device /dev/loop3, got %s", dev)
+	}
+	if dev, err = l.Expand(conn); err != nil || dev != "/dev/loop3" {
+		t.Errorf("Expected to expand /dev/loop3, got %s, %v", dev, err)
+	}
+	if err := l.Detach(conn); err != nil {
+		t.Error("Detach failed:", err)
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright (c) 2018 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+//    Licensed under the Apache License, Version 2.0 (the "License"); you may
+//    not use this file except in compliance with the License. You may obtain
+//    a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//    WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//    License for the specific language governing permissions and limitations
+//    under the License.
+
+package connector
+
+import (
+	"fmt"
+	"io/ioutil"
+	"log"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// sysBlockPath is where the kernel exposes the block devices, it's a
+// variable so that it can be faked in unit tests.
+var sysBlockPath = "/sys/block"
+
+// IsMultiPath returns whether the connection data asks for attaching the
+// volume through all the paths.
+func IsMultiPath(conn map[string]interface{}) bool {
+	v, ok := conn[MultiPath].(bool)
+	return ok && v
+}
+
+// MultipathSupported returns whether multipathd is running on the host.
+func MultipathSupported() bool {
+	_, err := ExecCmd("multipathd", "show", "status")
+	return err == nil
+}
+
+// MultipathInitiatorInfo returns the entry of initiator info which reports
+// whether the host supports multipath I/O, such as "multipath:true".
+func MultipathInitiatorInfo() string {
+	return fmt.Sprintf("%s:%t", MultiPath, MultipathSupported())
+}
+
+// GetMultipathDevice returns the dm-multipath device which holds the path
+// device, it's empty if the path isn't held by any multipath device.
+func GetMultipathDevice(path string) string {
+	realPath, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		return ""
+	}
+	holders, _ := filepath.Glob(filepath.Join(sysBlockPath, filepath.Base(realPath), "holders", "dm-*"))
+	for _, holder := range holders {
+		name, err := ioutil.ReadFile(filepath.Join(sysBlockPath, filepath.Base(holder), "dm", "name"))
+		if err == nil {
+			return "/dev/mapper/" + strings.TrimSpace(string(name))
+		}
+	}
+	return ""
+}
+
+// DiscoverMultipathDevice builds the dm-multipath device over the path
+// devices and waits until it shows up.
+func DiscoverMultipathDevice(paths []string, retries int) (string, error) {
+	for i := 0; i < retries; i++ {
+		for _, path := range paths {
+			if mpath := GetMultipathDevice(path); mpath != "" {
+				log.Printf("Found multipath device %s over %v\n", mpath, paths)
+				return mpath, nil
+			}
+		}
+		// multipathd doesn't build the device by itself when find_multipaths
+		// is enabled and the paths are new, so the device is built explicitly.
+		for _, path := range paths {
+			ExecCmd("multipath", path)
+		}
+		if i < retries-1 {
+			time.Sleep(time.Second)
+		}
+	}
+	return "", fmt.Errorf("no multipath device found over %v", paths)
+}
+
+// ResizeMultipathDevice resizes the multipath device to the size of the path
+// devices under it, which must be rescanned first.
+func ResizeMultipathDevice(mpath string) error {
+	log.Printf("Resize multipath device: %s\n", mpath)
+	if out, err := ExecCmd("multipathd", "resize", "map", filepath.Base(mpath)); err != nil {
+		return fmt.Errorf("resize multipath device %s failed: %v, output: %s", mpath, err, out)
+	}
+	return nil
+}
+
+// FlushMultipathDevice flushes the I/O of multipath device and removes it,
+// the path devices under it are left for the caller to clean up.
+func FlushMultipathDevice(mpath string) error {
+	log.Printf("Flush multipath device: %s\n", mpath)
+	if out, err := ExecCmd("blockdev", "--flushbufs", mpath); err != nil {
+		return fmt.Errorf("flush multipath device %s failed: %v, output: %s", mpath, err, out)
+	}
+	if out, err := ExecCmd("multipath", "-f", filepath.Base(mpath)); err != nil {
+		return fmt.Errorf("remove multipath device %s failed: %v, output: %s", mpath, err, out)
+	}
+	return nil
+}
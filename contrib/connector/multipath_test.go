@@ -0,0 +1,74 @@
+// Copyright (c) 2018 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+//    Licensed under the Apache License, Version 2.0 (the "License"); you may
+//    not use this file except in compliance with the License. You may obtain
+//    a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//    WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//    License for the specific language governing permissions and limitations
+//    under the License.
+
+package connector
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestIsMultiPath(t *testing.T) {
+	for conn, expected := range map[*map[string]interface{}]bool{
+		&map[string]interface{}{MultiPath: true}:   true,
+		&map[string]interface{}{MultiPath: false}:  false,
+		&map[string]interface{}{MultiPath: "true"}: false,
+		&map[string]interface{}{}:                  false,
+	} {
+		if IsMultiPath(*conn) != expected {
+			t.Errorf("Expected %v for %v", expected, *conn)
+		}
+	}
+}
+
+func TestGetMultipathDevice(t *testing.T) {
+	dir, err := ioutil.TempDir("", "multipath-test-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// Fake the sysfs entries of sdb and sdc held by dm-0, and the by-path
+	// links of them.
+	defer func(path string) { sysBlockPath = path }(sysBlockPath)
+	sysBlockPath = filepath.Join(dir, "sys", "block")
+	for _, p := range []string{"sdb/holders/dm-0", "sdc/holders", "dm-0/dm"} {
+		if err := os.MkdirAll(filepath.Join(sysBlockPath, p), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := ioutil.WriteFile(filepath.Join(sysBlockPath, "dm-0/dm/name"), []byte("mpatha\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	for _, dev := range []string{"sdb", "sdc"} {
+		if err := ioutil.WriteFile(filepath.Join(dir, dev), nil, 0644); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Symlink(filepath.Join(dir, dev), filepath.Join(dir, "path-"+dev)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if mpath := GetMultipathDevice(filepath.Join(dir, "path-sdb")); mpath != "/dev/mapper/mpatha" {
+		t.Errorf("Expected /dev/mapper/mpatha, got %s", mpath)
+	}
+	if mpath := GetMultipathDevice(filepath.Join(dir, "path-sdc")); mpath != "" {
+		t.Errorf("Expected no multipath device, got %s", mpath)
+	}
+	if mpath := GetMultipathDevice(filepath.Join(dir, "not-exist")); mpath != "" {
+		t.Errorf("Expected no multipath device, got %s", mpath)
+	}
+}
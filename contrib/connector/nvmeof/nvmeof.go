@@ -15,6 +15,8 @@
 package nvmeof
 
 import (
+	"log"
+
 	"github.com/opensds/opensds/contrib/connector"
 )
 
@@ -22,11 +24,25 @@ type Nvmeof struct{}
 
 func init() {
 	connector.RegisterConnector(connector.NvmeofDriver, &Nvmeof{})
-	connector.ExecCmd("modprobe", "nvme-rdma")
 }
 
+// Attach connects the volume, the device of which is set read-only if the
+// connection data asks for it.
 func (nof *Nvmeof) Attach(conn map[string]interface{}) (string, error) {
-	return Connect(conn)
+	device, err := Connect(conn)
+	if err != nil {
+		return "", err
+	}
+	if !connector.IsReadOnly(conn) {
+		return device, nil
+	}
+	if err = connector.SetReadOnly(device); err != nil {
+		if err := nof.Detach(conn); err != nil {
+			log.Printf("disconnect volume failed: %v\n", err)
+		}
+		return "", err
+	}
+	return device, nil
 }
 
 func (nof *Nvmeof) Detach(conn map[string]interface{}) error {
@@ -34,6 +50,10 @@ func (nof *Nvmeof) Detach(conn map[string]interface{}) error {
 	return DisConnect(NvmeofCon.Nqn)
 }
 
+func (nof *Nvmeof) Expand(conn map[string]interface{}) (string, error) {
+	return Expand(conn)
+}
+
 // GetInitiatorInfo implementation
 func (nof *Nvmeof) GetInitiatorInfo() (string, error) {
 	return getInitiatorInfo()
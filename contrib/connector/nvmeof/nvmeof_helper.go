@@ -16,7 +16,11 @@ package nvmeof
 
 import (
 	"errors"
+	"fmt"
+	"io/ioutil"
 	"log"
+	"path/filepath"
+	"regexp"
 	"strings"
 	"time"
 
@@ -26,6 +30,20 @@ import (
 
 const (
 	iniNvmePrefix = "nqn.ini."
+
+	// defaultTransport is the transport used when the target doesn't report
+	// one, which keeps the connection data of old targets working.
+	defaultTransport = "rdma"
+	loopTransport    = "loop"
+)
+
+var (
+	// nvmeClassPath is where the kernel exposes the nvme controllers.
+	nvmeClassPath = "/sys/class/nvme"
+	// hiddenNamespace matches the namespace under a controller which is
+	// hidden by native nvme multipath, such as nvme0c1n1, whose block device
+	// is nvme0n1.
+	hiddenNamespace = regexp.MustCompile(`^(nvme\d+)c\d+(n\d+)$`)
 )
 
 // ConnectorInfo define
@@ -33,7 +51,7 @@ type ConnectorInfo struct {
 	Nqn       string `mapstructure:"targetNQN"`     //NVMe subsystem name to the volume to be connected
 	TgtPort   string `mapstructure:"targetPort"`    //NVMe target port that hosts the nqn sybsystem
 	TgtPortal string `mapstructure:"targetIP"`      //NVMe target ip that hosts the nqn sybsystem
-	TranType  string `mapstructure:"transporType "` // Nvme transport type
+	TranType  string `mapstructure:"transportType"` // Nvme transport type
 	HostNqn   string `mapstructure:"hostNqn"`       // host nqn
 }
 
@@ -126,12 +144,33 @@ func GetNvmeSubsystems() (map[string]int, error) {
 	return nqn, nil
 }
 
+// transportArgs returns the arguments of nvme cli which specify the
+// transport and the address of target, the loop target is local so that it
+// has no address.
+func transportArgs(conn *ConnectorInfo) []string {
+	args := []string{"-t", conn.TranType}
+	if conn.TranType != loopTransport {
+		args = append(args, "-a", conn.TgtPortal, "-s", conn.TgtPort)
+	}
+	return args
+}
+
+// loadTransport loads the kernel module of the host transport.
+func loadTransport(transport string) error {
+	if out, err := connector.ExecCmd("modprobe", "nvme-"+transport); err != nil {
+		return fmt.Errorf("load nvme transport %s failed: %v, output: %s", transport, err, out)
+	}
+	return nil
+}
+
 // Discovery NVMe-OF target
 func Discovery(connMap map[string]interface{}) error {
 	conn := ParseNvmeofConnectInfo(connMap)
-	targetip := conn.TgtPortal
-	targetport := conn.TgtPort
-	info, err := connector.ExecCmd("nvme", "discover", "-t", "rdma", "-a", targetip, "-s", targetport)
+	if err := loadTransport(conn.TranType); err != nil {
+		return err
+	}
+	args := append([]string{"discover"}, transportArgs(conn)...)
+	info, err := connector.ExecCmd("nvme", args...)
 	if err != nil {
 		log.Printf("Error encountered in send targets:%v, %v\n",err,info)
 		return err
@@ -144,13 +183,13 @@ func Connect(connMap map[string]interface{}) (string, error) {
 	CurrentNvmeDevice, _ := GetNvmeDevice()
 	conn := ParseNvmeofConnectInfo(connMap)
 	connNqn := conn.Nqn
-	targetPortal := conn.TgtPortal
-	port := conn.TgtPort
-	nvmeTransportType := "rdma"
-	log.Printf("conn information:%s, %s, %s ", connNqn, targetPortal,  port)
+	log.Printf("conn information:%s, %s, %s, %s ", connNqn, conn.TranType, conn.TgtPortal, conn.TgtPort)
 
-	_, err := connector.ExecCmd("nvme", "connect", "-t",
-		nvmeTransportType, "-n", connNqn, "-a", targetPortal, "-s", port)
+	if err := loadTransport(conn.TranType); err != nil {
+		return "", err
+	}
+	args := append([]string{"connect", "-n", connNqn}, transportArgs(conn)...)
+	_, err := connector.ExecCmd("nvme", args...)
 	if err != nil {
 		log.Println("Failed to connect to NVMe nqn :", connNqn)
 		return "", err
@@ -195,5 +234,56 @@ func DisConnect(nqn string) error {
 func ParseNvmeofConnectInfo(connectInfo map[string]interface{}) *ConnectorInfo {
 	var con ConnectorInfo
 	mapstructure.Decode(connectInfo, &con)
+	if con.TranType == "" {
+		con.TranType = defaultTransport
+	}
 	return &con
 }
+
+// getNvmeControllers returns the controllers connected to the subsystem.
+func getNvmeControllers(nqn string) []string {
+	var ctrls []string
+	dirs, _ := filepath.Glob(filepath.Join(nvmeClassPath, "nvme*"))
+	for _, dir := range dirs {
+		subnqn, err := ioutil.ReadFile(filepath.Join(dir, "subsysnqn"))
+		if err == nil && strings.TrimSpace(string(subnqn)) == nqn {
+			ctrls = append(ctrls, filepath.Base(dir))
+		}
+	}
+	return ctrls
+}
+
+// namespaceDevice returns the block device of the first namespace under the
+// controller.
+func namespaceDevice(ctrl string) string {
+	nss, _ := filepath.Glob(filepath.Join(nvmeClassPath, ctrl, "nvme*n*"))
+	if len(nss) == 0 {
+		return ""
+	}
+	return "/dev/" + hiddenNamespace.ReplaceAllString(filepath.Base(nss[0]), "$1$2")
+}
+
+// Expand rescans the namespaces of the NVMe-OF target so that the host sees
+// the new size of volume, return the device path of it in this node
+func Expand(connMap map[string]interface{}) (string, error) {
+	conn := ParseNvmeofConnectInfo(connMap)
+	ctrls := getNvmeControllers(conn.Nqn)
+	if len(ctrls) == 0 {
+		return "", fmt.Errorf("nvme nqn %s is not connected", conn.Nqn)
+	}
+
+	var device string
+	for _, ctrl := range ctrls {
+		out, err := connector.ExecCmd("nvme", "ns-rescan", "/dev/"+ctrl)
+		if err != nil {
+			return "", fmt.Errorf("rescan nvme controller %s failed: %v, output: %s", ctrl, err, out)
+		}
+		if device == "" {
+			device = namespaceDevice(ctrl)
+		}
+	}
+	if device == "" {
+		return "", fmt.Errorf("no namespace of nvme nqn %s found", conn.Nqn)
+	}
+	return device, nil
+}
@@ -0,0 +1,59 @@
+// Copyright (c) 2019 Intel Corporation, Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nvmeof
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTransportArgs(t *testing.T) {
+	var testCases = []struct {
+		connData map[string]interface{}
+		expected []string
+	}{
+		{
+			connData: map[string]interface{}{
+				"targetNQN":  "nqn.2019-01.io.opensds:volume:01",
+				"targetIP":   "192.168.0.10",
+				"targetPort": "4420",
+			},
+			expected: []string{"-t", "rdma", "-a", "192.168.0.10", "-s", "4420"},
+		},
+		{
+			connData: map[string]interface{}{
+				"targetNQN":     "nqn.2019-01.io.opensds:volume:01",
+				"targetIP":      "192.168.0.10",
+				"targetPort":    "4420",
+				"transportType": "tcp",
+			},
+			expected: []string{"-t", "tcp", "-a", "192.168.0.10", "-s", "4420"},
+		},
+		{
+			connData: map[string]interface{}{
+				"targetNQN":     "nqn.2019-01.io.opensds:volume:01",
+				"transportType": "loop",
+			},
+			expected: []string{"-t", "loop"},
+		},
+	}
+
+	for _, c := range testCases {
+		args := transportArgs(ParseNvmeofConnectInfo(c.connData))
+		if !reflect.DeepEqual(args, c.expected) {
+			t.Errorf("Expected %v, got %v", c.expected, args)
+		}
+	}
+}
@@ -18,7 +18,6 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
-	"os/exec"
 	"path"
 	"path/filepath"
 	"strings"
@@ -62,8 +61,9 @@ func (*RBD) Attach(conn map[string]interface{}) (string, error) {
 	}
 	ports := conn["ports"].([]interface{})
 
+	readOnly, _ := conn[connector.ReadOnly].(bool)
 	poolName, imageName := fields[0], fields[1]
-	device, err := mapDevice(poolName, imageName, hosts, ports)
+	device, err := mapDevice(poolName, imageName, hosts, ports, readOnly)
 	if err != nil {
 		return "", err
 	}
@@ -88,10 +88,37 @@ func (*RBD) Detach(conn map[string]interface{}) error {
 		return err
 	}
 
-	_, err = exec.Command("rbd", "unmap", device).CombinedOutput()
+	_, err = connector.ExecCmd("rbd", "unmap", device)
 	return err
 }
 
+// Expand implementation
+func (*RBD) Expand(conn map[string]interface{}) (string, error) {
+	if _, ok := conn["name"]; !ok {
+		return "", os.ErrInvalid
+	}
+
+	name := conn["name"].(string)
+	fields := strings.Split(name, "/")
+	if len(fields) != 2 {
+		return "", os.ErrInvalid
+	}
+
+	poolName, imageName := fields[0], fields[1]
+	devName, err := findDeviceTree(poolName, imageName)
+	if err != nil {
+		return "", err
+	}
+
+	// The mapped device picks up the new size of image after its header is
+	// refreshed.
+	refreshPath := filepath.Join(rbdDevicePath, devName, "refresh")
+	if err := ioutil.WriteFile(refreshPath, []byte("1"), 0200); err != nil {
+		return "", err
+	}
+	return rbdDev + devName, nil
+}
+
 // GetInitiatorInfo implementation
 func (*RBD) GetInitiatorInfo() (string, error) {
 	hostName, err := connector.GetHostName()
@@ -103,17 +130,32 @@ func (*RBD) GetInitiatorInfo() (string, error) {
 	return hostName, nil
 }
 
-func mapDevice(poolName, imageName string, hosts, ports []interface{}) (string, error) {
+// splitSnapName splits the image name in the form of "image@snapshot" into
+// the name of image and snapshot, the snapshot name of an image which is not
+// a snapshot is "-" as reported in sysfs.
+func splitSnapName(imageName string) (string, string) {
+	if i := strings.Index(imageName, "@"); i >= 0 {
+		return imageName[:i], imageName[i+1:]
+	}
+	return imageName, "-"
+}
+
+func mapDevice(poolName, imageName string, hosts, ports []interface{}, readOnly bool) (string, error) {
 	devName, err := findDevice(poolName, imageName, 1)
 	if err == nil {
 		return devName, nil
 	}
 
 	// modprobe
-	exec.Command("modprobe", "rbd").CombinedOutput()
+	connector.ExecCmd("modprobe", "rbd")
 
+	args := []string{"map", imageName, "--pool", poolName}
+	// Snapshot of rbd image can only be mapped read-only.
+	if _, snapName := splitSnapName(imageName); readOnly || snapName != "-" {
+		args = append(args, "--read-only")
+	}
 	for i := 0; i < len(hosts); i++ {
-		_, err = exec.Command("rbd", "map", imageName, "--pool", poolName).CombinedOutput()
+		_, err = connector.ExecCmd("rbd", args...)
 		if err == nil {
 			break
 		}
@@ -151,6 +193,7 @@ func findDeviceTree(poolName, imageName string) (string, error) {
 		return "", fmt.Errorf("Could not locate devices directory")
 	}
 
+	imgName, snapName := splitSnapName(imageName)
 	for _, f := range fi {
 		namePath := filepath.Join(rbdDevicePath, f.Name(), "name")
 		content, err := ioutil.ReadFile(namePath)
@@ -158,15 +201,23 @@ func findDeviceTree(poolName, imageName string) (string, error) {
 			return "", err
 		}
 
-		if strings.TrimSpace(string(content)) == imageName {
+		if strings.TrimSpace(string(content)) == imgName {
 			poolPath := filepath.Join(rbdDevicePath, f.Name(), "pool")
 			content, err := ioutil.ReadFile(poolPath)
 			if err != nil {
 				return "", err
 			}
+			if strings.TrimSpace(string(content)) != poolName {
+				continue
+			}
 
-			if strings.TrimSpace(string(content)) == poolName {
-				return f.Name(), err
+			snapPath := filepath.Join(rbdDevicePath, f.Name(), "current_snap")
+			content, err = ioutil.ReadFile(snapPath)
+			if err != nil {
+				return "", err
+			}
+			if strings.TrimSpace(string(content)) == snapName {
+				return f.Name(), nil
 			}
 		}
 	}
@@ -0,0 +1,217 @@
+// Copyright (c) 2018 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+//    Licensed under the Apache License, Version 2.0 (the "License"); you may
+//    not use this file except in compliance with the License. You may obtain
+//    a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//    WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//    License for the specific language governing permissions and limitations
+//    under the License.
+
+package connector
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+
+	"golang.org/x/sys/unix"
+)
+
+// cgroupPath is where the cgroup file systems are mounted, it's a variable
+// so that it can be faked in unit tests.
+var cgroupPath = "/sys/fs/cgroup"
+
+// IsThrottled returns whether the connection data asks for throttling the
+// I/O of the volume on the host.
+func IsThrottled(conn map[string]interface{}) bool {
+	_, ok := conn[MaxIOPS]
+	return ok
+}
+
+// throttleGroup is the cgroup under which each throttled device gets a
+// dedicated cgroup of its own.
+const throttleGroup = "opensds"
+
+// Throttle limits the I/O of the attached device to the maxIOPS and maxBWS
+// of connection data through the blkio controller of cgroup v1 or the io
+// controller of cgroup v2, reads and writes are limited separately. The
+// limits are set on a cgroup dedicated to the device, see ThrottleCgroup,
+// so that the other devices and cgroups of the host are left untouched.
+// Nothing is done if the connection data doesn't ask for throttling.
+func Throttle(device string, conn map[string]interface{}) error {
+	if !IsThrottled(conn) {
+		return nil
+	}
+	iops, bws := limitOf(conn[MaxIOPS]), limitOf(conn[MaxBWS])
+	log.Printf("Throttle device %s to %d IOPS and %d MB/s\n", device, iops, bws)
+
+	devNum, err := deviceNumber(device)
+	if err != nil {
+		return err
+	}
+	dir, err := ThrottleCgroup(device)
+	if err != nil {
+		return err
+	}
+	if isCgroupV1() {
+		return throttleBlkio(dir, devNum, iops, bws<<20)
+	}
+	return throttleIoMax(dir, devNum, iops, bws<<20)
+}
+
+// Unthrottle removes the cgroup dedicated to the device, which fails if any
+// process is still in it. Nothing is done if the device isn't throttled.
+func Unthrottle(device string) error {
+	dir, err := ThrottleCgroup(device)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(dir); err != nil && !os.IsNotExist(err) {
+		log.Printf("failed to remove cgroup %s of device %s: %v\n", dir, device, err)
+		return err
+	}
+	return nil
+}
+
+// ThrottleCgroup returns the directory of the cgroup dedicated to the
+// device, the I/O of the processes put into it, by writing their pids to
+// its cgroup.procs, is limited by Throttle. An error is returned if neither
+// cgroup v1 nor cgroup v2 is able to throttle I/O on the host.
+func ThrottleCgroup(device string) (string, error) {
+	if dev, err := filepath.EvalSymlinks(device); err == nil {
+		device = dev
+	}
+	name := filepath.Base(device)
+	if isCgroupV1() {
+		return filepath.Join(cgroupPath, "blkio", throttleGroup, name), nil
+	}
+	if isCgroupV2() {
+		return filepath.Join(cgroupPath, throttleGroup, name), nil
+	}
+	return "", fmt.Errorf("neither blkio controller of cgroup v1 nor io controller of cgroup v2 is available on the host, device %s can't be throttled", device)
+}
+
+func isCgroupV1() bool {
+	_, err := os.Stat(filepath.Join(cgroupPath, "blkio"))
+	return err == nil
+}
+
+func isCgroupV2() bool {
+	b, err := ioutil.ReadFile(filepath.Join(cgroupPath, "cgroup.controllers"))
+	if err != nil {
+		return false
+	}
+	for _, c := range strings.Fields(string(b)) {
+		if c == "io" {
+			return true
+		}
+	}
+	return false
+}
+
+// throttleBlkio sets the limits of cgroup v1 on the cgroup dir, zero removes
+// the limit.
+func throttleBlkio(dir, devNum string, iops, bps int64) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		log.Printf("failed to create cgroup %s: %v\n", dir, err)
+		return err
+	}
+	for file, limit := range map[string]int64{
+		"blkio.throttle.read_iops_device":  iops,
+		"blkio.throttle.write_iops_device": iops,
+		"blkio.throttle.read_bps_device":   bps,
+		"blkio.throttle.write_bps_device":  bps,
+	} {
+		rule := fmt.Sprintf("%s %d", devNum, limit)
+		if err := ioutil.WriteFile(filepath.Join(dir, file), []byte(rule), 0644); err != nil {
+			log.Printf("failed to set %s of device %s: %v\n", file, devNum, err)
+			return err
+		}
+	}
+	return nil
+}
+
+// throttleIoMax sets the limits of cgroup v2 on the cgroup dir, zero removes
+// the limit.
+func throttleIoMax(dir, devNum string, iops, bps int64) error {
+	// The io controller has to be enabled for the children of every ancestor
+	// of the cgroup.
+	for parent := filepath.Dir(dir); ; parent = filepath.Dir(parent) {
+		if err := os.MkdirAll(parent, 0755); err != nil {
+			log.Printf("failed to create cgroup %s: %v\n", parent, err)
+			return err
+		}
+		control := filepath.Join(parent, "cgroup.subtree_control")
+		if err := ioutil.WriteFile(control, []byte("+io"), 0644); err != nil {
+			log.Printf("failed to enable io controller in %s: %v\n", control, err)
+			return err
+		}
+		if parent == filepath.Clean(cgroupPath) || parent == filepath.Dir(parent) {
+			break
+		}
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		log.Printf("failed to create cgroup %s: %v\n", dir, err)
+		return err
+	}
+
+	rule := fmt.Sprintf("%s riops=%s wiops=%s rbps=%s wbps=%s", devNum,
+		ioMaxLimit(iops), ioMaxLimit(iops), ioMaxLimit(bps), ioMaxLimit(bps))
+	if err := ioutil.WriteFile(filepath.Join(dir, "io.max"), []byte(rule), 0644); err != nil {
+		log.Printf("failed to set io.max of device %s: %v\n", devNum, err)
+		return err
+	}
+	return nil
+}
+
+func ioMaxLimit(limit int64) string {
+	if limit <= 0 {
+		return "max"
+	}
+	return fmt.Sprint(limit)
+}
+
+// deviceNumber returns the major and minor number of the block device, such
+// as "8:16".
+func deviceNumber(device string) (string, error) {
+	fi, err := os.Stat(device)
+	if err != nil {
+		return "", err
+	}
+	st, ok := fi.Sys().(*syscall.Stat_t)
+	if !ok || fi.Mode()&os.ModeDevice == 0 {
+		return "", fmt.Errorf("%s is not a device", device)
+	}
+	return fmt.Sprintf("%d:%d", unix.Major(uint64(st.Rdev)), unix.Minor(uint64(st.Rdev))), nil
+}
+
+// limitOf converts the limit in connection data, which is a float64 after
+// the connection data is decoded from json, to int64.
+func limitOf(v interface{}) int64 {
+	switch l := v.(type) {
+	case int64:
+		return l
+	case int:
+		return int64(l)
+	case float64:
+		return int64(l)
+	case json.Number:
+		n, _ := l.Int64()
+		return n
+	case string:
+		var n int64
+		fmt.Sscan(strings.TrimSpace(l), &n)
+		return n
+	}
+	return 0
+}
@@ -0,0 +1,106 @@
+// Copyright (c) 2018 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+//    Licensed under the Apache License, Version 2.0 (the "License"); you may
+//    not use this file except in compliance with the License. You may obtain
+//    a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//    WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//    License for the specific language governing permissions and limitations
+//    under the License.
+
+package connector
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestThrottle(t *testing.T) {
+	dir, err := ioutil.TempDir("", "throttle-test-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	defer func(p string) { cgroupPath = p }(cgroupPath)
+	cgroupPath = dir
+
+	// The connection data decoded from json carries the limits as float64.
+	var conn = map[string]interface{}{MaxIOPS: float64(1000), MaxBWS: float64(10)}
+
+	// cgroup v1, the limits are set on the cgroup of the device only.
+	os.MkdirAll(filepath.Join(dir, "blkio"), 0755)
+	if err := Throttle("/dev/null", conn); err != nil {
+		t.Fatal(err)
+	}
+	for file, expected := range map[string]string{
+		"blkio.throttle.read_iops_device":  "1:3 1000",
+		"blkio.throttle.write_iops_device": "1:3 1000",
+		"blkio.throttle.read_bps_device":   "1:3 10485760",
+		"blkio.throttle.write_bps_device":  "1:3 10485760",
+	} {
+		b, _ := ioutil.ReadFile(filepath.Join(dir, "blkio", "opensds", "null", file))
+		if string(b) != expected {
+			t.Errorf("Expected %q in %s, got %q", expected, file, string(b))
+		}
+		if _, err := os.Stat(filepath.Join(dir, "blkio", file)); err == nil {
+			t.Errorf("Expected %s of root cgroup untouched", file)
+		}
+	}
+	// The cgroup of the device is removed once it's detached, the control
+	// files of a real cgroup don't have to be removed before.
+	files, _ := filepath.Glob(filepath.Join(dir, "blkio", "opensds", "null", "*"))
+	for _, file := range files {
+		os.Remove(file)
+	}
+	if err := Unthrottle("/dev/null"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "blkio", "opensds", "null")); !os.IsNotExist(err) {
+		t.Errorf("Expected cgroup of device removed, got %v", err)
+	}
+
+	// cgroup v2, the limit of bandwidth is removed.
+	os.RemoveAll(filepath.Join(dir, "blkio"))
+	ioutil.WriteFile(filepath.Join(dir, "cgroup.controllers"), []byte("cpu io memory"), 0644)
+	for _, c := range []string{"system.slice", "user.slice"} {
+		os.MkdirAll(filepath.Join(dir, c), 0755)
+		ioutil.WriteFile(filepath.Join(dir, c, "io.max"), nil, 0644)
+	}
+	conn[MaxBWS] = float64(0)
+	if err := Throttle("/dev/null", conn); err != nil {
+		t.Fatal(err)
+	}
+	b, _ := ioutil.ReadFile(filepath.Join(dir, "opensds", "null", "io.max"))
+	if expected := "1:3 riops=1000 wiops=1000 rbps=max wbps=max"; string(b) != expected {
+		t.Errorf("Expected %q in io.max, got %q", expected, string(b))
+	}
+	for _, c := range []string{".", "opensds"} {
+		b, _ := ioutil.ReadFile(filepath.Join(dir, c, "cgroup.subtree_control"))
+		if string(b) != "+io" {
+			t.Errorf("Expected io controller enabled in %s, got %q", c, string(b))
+		}
+	}
+	for _, c := range []string{"system.slice", "user.slice"} {
+		if b, _ := ioutil.ReadFile(filepath.Join(dir, c, "io.max")); len(b) != 0 {
+			t.Errorf("Expected io.max of %s untouched, got %q", c, string(b))
+		}
+	}
+
+	// It fails clearly if the host can't throttle I/O.
+	os.Remove(filepath.Join(dir, "cgroup.controllers"))
+	if err := Throttle("/dev/null", conn); err == nil {
+		t.Error("Expected an error without io controller, got nil")
+	}
+
+	// Nothing is done if the connection data doesn't ask for throttling.
+	os.RemoveAll(dir)
+	if err := Throttle("/dev/null", map[string]interface{}{}); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+}
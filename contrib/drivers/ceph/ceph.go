@@ -23,6 +23,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/ceph/go-ceph/rados"
 	"github.com/ceph/go-ceph/rbd"
@@ -31,6 +33,7 @@ import (
 	"github.com/opensds/opensds/pkg/model"
 	pb "github.com/opensds/opensds/pkg/model/proto"
 	"github.com/opensds/opensds/pkg/utils/config"
+	"github.com/opensds/opensds/pkg/utils/exec"
 	"github.com/satori/go.uuid"
 )
 
@@ -44,17 +47,42 @@ const (
 const (
 	KPoolName  = "CephPoolName"
 	KImageName = "CephImageName"
+	// KSnapName and KSnapId are recorded in the metadata of the snapshot
+	// taken along with the rbd group snapshot, whose snapshot of image is
+	// in the namespace of the group and not named after the snapshot.
+	KSnapName = "CephSnapName"
+	KSnapId   = "CephSnapId"
 )
 
 type CephConfig struct {
-	ConfigFile string                    `yaml:"configFile,omitempty"`
-	Pool       map[string]PoolProperties `yaml:"pool,flow"`
+	ConfigFile  string                    `yaml:"configFile,omitempty"`
+	Pool        map[string]PoolProperties `yaml:"pool,flow"`
+	Replication ReplicationConfig         `yaml:"replication,omitempty"`
 }
 
 func EncodeName(id string) string {
 	return opensdsPrefix + id
 }
 
+// imageName returns the name of image of the volume, which is the one in
+// volume metadata if the image is not created by the driver itself, such as
+// the mirrored image of replication.
+func imageName(volId string, metadata map[string]string) string {
+	if name := metadata[KImageName]; name != "" {
+		return name
+	}
+	return EncodeName(volId)
+}
+
+// snapshotName returns the name of snapshot of the image, which is the one in
+// snapshot metadata if the snapshot is taken along with a group snapshot.
+func snapshotName(snapId string, metadata map[string]string) string {
+	if name := metadata[KSnapName]; name != "" {
+		return name
+	}
+	return EncodeName(snapId)
+}
+
 func NewSrcMgr(conf *CephConfig) *SrcMgr {
 	return &SrcMgr{conf: conf}
 }
@@ -139,12 +167,15 @@ func (s *SrcMgr) destroy() {
 }
 
 type Driver struct {
+	// ConfigPath is the path of driver configuration file of the backend.
+	ConfigPath string
+
 	conf *CephConfig
 }
 
 func (d *Driver) Setup() error {
 	d.conf = &CephConfig{ConfigFile: "/etc/ceph/ceph.conf"}
-	p := config.CONF.OsdsDock.Backends.Ceph.ConfigPath
+	p := d.ConfigPath
 	if "" == p {
 		p = defaultConfPath
 	}
@@ -155,6 +186,9 @@ func (d *Driver) Setup() error {
 func (d *Driver) Unset() error { return nil }
 
 func (d *Driver) createVolumeFromSnapshot(opt *pb.CreateVolumeOpts) (*model.VolumeSpec, error) {
+	if _, ok := opt.GetMetadata()[KSnapId]; ok {
+		return d.createVolumeFromGroupSnapshot(opt)
+	}
 	poolName := opt.GetPoolName()
 	srcSnapName := EncodeName(opt.GetSnapshotId())
 	srcImgName := opt.GetMetadata()[KImageName]
@@ -181,12 +215,55 @@ func (d *Driver) createVolumeFromSnapshot(opt *pb.CreateVolumeOpts) (*model.Volu
 		defer snap.Unprotect()
 	}
 
-	_, err = img.Clone(srcSnapName, ioctx, destImgName, rbd.RbdFeatureLayering, 20)
+	destImg, err := img.Clone(srcSnapName, ioctx, destImgName, rbd.RbdFeatureLayering, 20)
 	if err != nil {
 		log.Errorf("create volume (%s) from snapshot (%s) failed, %v",
 			opt.GetId(), opt.GetSnapshotId(), err)
 		return nil, err
 	}
+	if opt.GetQos() != nil {
+		if err := d.setImageQos(poolName+"/"+destImgName, opt.GetQos()); err != nil {
+			destImg.Remove()
+			return nil, err
+		}
+	}
+	log.Infof("create volume (%s) from snapshot (%s) success",
+		opt.GetId(), opt.GetSnapshotId())
+	return &model.VolumeSpec{
+		BaseModel: &model.BaseModel{
+			Id: opt.GetId(),
+		},
+		Name:             opt.GetName(),
+		Size:             opt.GetSize(),
+		Description:      opt.GetDescription(),
+		AvailabilityZone: opt.GetAvailabilityZone(),
+		Metadata: map[string]string{
+			KPoolName: opt.GetPoolName(),
+		},
+	}, nil
+}
+
+// createVolumeFromGroupSnapshot clones the image from the snapshot taken
+// along with the group snapshot. The snapshot is not in the user namespace,
+// which can't be opened by name or protected, so it is cloned by id with the
+// clone format v2 which doesn't need the snapshot protected.
+func (d *Driver) createVolumeFromGroupSnapshot(opt *pb.CreateVolumeOpts) (*model.VolumeSpec, error) {
+	poolName := opt.GetPoolName()
+	srcSpec := poolName + "/" + opt.GetMetadata()[KImageName]
+	destSpec := poolName + "/" + EncodeName(opt.GetId())
+
+	if _, err := d.rbd("clone", "--snap-id", opt.GetMetadata()[KSnapId],
+		"--rbd-default-clone-format", "2", srcSpec, destSpec); err != nil {
+		log.Errorf("create volume (%s) from snapshot (%s) failed, %v",
+			opt.GetId(), opt.GetSnapshotId(), err)
+		return nil, err
+	}
+	if opt.GetQos() != nil {
+		if err := d.setImageQos(destSpec, opt.GetQos()); err != nil {
+			d.rbd("remove", destSpec)
+			return nil, err
+		}
+	}
 	log.Infof("create volume (%s) from snapshot (%s) success",
 		opt.GetId(), opt.GetSnapshotId())
 	return &model.VolumeSpec{
@@ -213,11 +290,17 @@ func (d *Driver) createVolume(opt *pb.CreateVolumeOpts) (*model.VolumeSpec, erro
 	}
 
 	name := EncodeName(opt.GetId())
-	_, err = rbd.Create(ioctx, name, uint64(opt.GetSize())<<sizeShiftBit, 20)
+	img, err := rbd.Create(ioctx, name, uint64(opt.GetSize())<<sizeShiftBit, 20)
 	if err != nil {
 		log.Errorf("Create rbd image (%s) failed, (%v)", name, err)
 		return nil, err
 	}
+	if opt.GetQos() != nil {
+		if err := d.setImageQos(opt.GetPoolName()+"/"+name, opt.GetQos()); err != nil {
+			img.Remove()
+			return nil, err
+		}
+	}
 
 	log.Infof("Create volume %s (%s) success.", opt.GetName(), opt.GetId())
 	return &model.VolumeSpec{
@@ -247,7 +330,7 @@ func (d *Driver) ExtendVolume(opt *pb.ExtendVolumeOpts) (*model.VolumeSpec, erro
 	mgr := NewSrcMgr(d.conf)
 	defer mgr.destroy()
 
-	img, err := mgr.GetImage(opt.GetPoolName(), EncodeName(opt.GetId()))
+	img, err := mgr.GetImage(opt.GetPoolName(), imageName(opt.GetId(), opt.GetMetadata()))
 	if err != nil {
 		return nil, err
 	}
@@ -269,6 +352,22 @@ func (d *Driver) ExtendVolume(opt *pb.ExtendVolumeOpts) (*model.VolumeSpec, erro
 	}, nil
 }
 
+// UpdateVolumeQos changes the qos limits of the image, the clients which
+// have opened the image pick up the new limits without reopening it.
+func (d *Driver) UpdateVolumeQos(opt *pb.UpdateVolumeQosOpts) (*model.VolumeSpec, error) {
+	spec := opt.GetMetadata()[KPoolName] + "/" + imageName(opt.GetId(), opt.GetMetadata())
+	if err := d.setImageQos(spec, opt.GetQos()); err != nil {
+		return nil, err
+	}
+
+	log.Infof("Update qos of volume (%s) success", opt.GetId())
+	return &model.VolumeSpec{
+		BaseModel: &model.BaseModel{
+			Id: opt.GetId(),
+		},
+	}, nil
+}
+
 func (d *Driver) PullVolume(volID string) (*model.VolumeSpec, error) {
 	// Not used, do nothing.
 	return nil, nil
@@ -278,13 +377,14 @@ func (d *Driver) DeleteVolume(opt *pb.DeleteVolumeOpts) error {
 	mgr := NewSrcMgr(d.conf)
 	defer mgr.destroy()
 
-	log.Info(opt.GetMetadata()[KPoolName], EncodeName(opt.GetId()))
+	name := imageName(opt.GetId(), opt.GetMetadata())
+	log.Info(opt.GetMetadata()[KPoolName], name)
 	ioctx, err := mgr.GetIoctx(opt.GetMetadata()[KPoolName])
 	if err != nil {
 		return err
 	}
 
-	err = rbd.GetImage(ioctx, EncodeName(opt.GetId())).Remove()
+	err = rbd.GetImage(ioctx, name).Remove()
 	if err != nil && err != rbd.RbdErrorNotFound {
 		log.Errorf("Remove volume(%s) filed, %v", opt.GetId(), err)
 		return err
@@ -305,7 +405,7 @@ func (d *Driver) InitializeConnection(opt *pb.CreateVolumeAttachmentOpts) (*mode
 		DriverVolumeType: RBDProtocol,
 		ConnectionData: map[string]interface{}{
 			"secret_type":  "ceph",
-			"name":         poolName + "/" + opensdsPrefix + opt.GetVolumeId(),
+			"name":         poolName + "/" + imageName(opt.GetVolumeId(), opt.GetMetadata()),
 			"cluster_name": "ceph",
 			"hosts":        []string{opt.GetHostInfo().Host},
 			"volume_id":    opt.GetVolumeId(),
@@ -322,7 +422,8 @@ func (d *Driver) CreateSnapshot(opt *pb.CreateVolumeSnapshotOpts) (*model.Volume
 	defer mgr.destroy()
 
 	poolName := opt.GetMetadata()[KPoolName]
-	img, err := mgr.GetImage(poolName, EncodeName(opt.GetVolumeId()))
+	imgName := imageName(opt.GetVolumeId(), opt.GetMetadata())
+	img, err := mgr.GetImage(poolName, imgName)
 	if err != nil {
 		return nil, err
 	}
@@ -345,7 +446,7 @@ func (d *Driver) CreateSnapshot(opt *pb.CreateVolumeSnapshotOpts) (*model.Volume
 		Size:        opt.GetSize(),
 		Metadata: map[string]string{
 			KPoolName:  poolName,
-			KImageName: EncodeName(opt.GetVolumeId()),
+			KImageName: imgName,
 		},
 	}, nil
 
@@ -360,7 +461,7 @@ func (d *Driver) DeleteSnapshot(opt *pb.DeleteVolumeSnapshotOpts) error {
 	defer mgr.destroy()
 
 	poolName := opt.GetMetadata()[KPoolName]
-	img, err := mgr.GetImage(poolName, EncodeName(opt.GetVolumeId()), EncodeName(opt.GetId()))
+	img, err := mgr.GetImage(poolName, imageName(opt.GetVolumeId(), opt.GetMetadata()), EncodeName(opt.GetId()))
 	if err == rbd.RbdErrorNotFound {
 		log.Warningf("Specified snapshot (%s) does not exist, ignore it", opt.GetId())
 		return nil
@@ -450,6 +551,18 @@ func (d *Driver) ListPools() ([]*model.StoragePoolSpec, error) {
 	return pols, nil
 }
 
+// Capabilities declares the operations supported by ceph driver.
+func (d *Driver) Capabilities() model.DriverCapabilitiesSpec {
+	return model.DriverCapabilitiesSpec{
+		VolumeGroup:        true,
+		SnapshotAttachment: true,
+		Clone:              true,
+		Qos:                true,
+		OnlineExtend:       true,
+		Replication:        true,
+	}
+}
+
 func (d *Driver) InitializeSnapshotConnection(opt *pb.CreateSnapshotAttachmentOpts) (*model.ConnectionInfo, error) {
 	poolName, ok := opt.GetMetadata()[KPoolName]
 	if !ok {
@@ -457,32 +570,394 @@ func (d *Driver) InitializeSnapshotConnection(opt *pb.CreateSnapshotAttachmentOp
 		log.Error(err)
 		return nil, err
 	}
+	imgName, ok := opt.GetMetadata()[KImageName]
+	if !ok {
+		err := errors.New("Failed to find imageName in snapshot metadata!")
+		log.Error(err)
+		return nil, err
+	}
+
+	snapName := snapshotName(opt.GetSnapshotId(), opt.GetMetadata())
+	// The snapshot taken along with the group snapshot can't be protected,
+	// it is only removed along with the group snapshot instead.
+	if _, ok := opt.GetMetadata()[KSnapId]; !ok {
+		if err := d.protectSnapshot(poolName, imgName, snapName); err != nil {
+			log.Errorf("protect snapshot(%s) failed, %v", opt.GetSnapshotId(), err)
+			return nil, err
+		}
+	}
+	if _, err := d.updateSnapshotAttachments(poolName+"/"+imgName, snapName, opt.GetId(), true); err != nil {
+		log.Errorf("record attachment of snapshot(%s) failed, %v", opt.GetSnapshotId(), err)
+		return nil, err
+	}
+
 	return &model.ConnectionInfo{
 		DriverVolumeType: RBDProtocol,
 		ConnectionData: map[string]interface{}{
 			"secret_type":  "ceph",
-			"name":         poolName + "/" + opensdsPrefix + opt.GetSnapshotId(),
+			"name":         poolName + "/" + imgName + "@" + snapName,
 			"cluster_name": "ceph",
 			"hosts":        []string{opt.GetHostInfo().Host},
 			"volume_id":    opt.GetSnapshotId(),
-			"access_mode":  "rw",
+			"access_mode":  "ro",
 			"ports":        []string{"6789"},
 		},
 	}, nil
 }
 
 func (d *Driver) TerminateSnapshotConnection(opt *pb.DeleteSnapshotAttachmentOpts) error {
+	poolName := opt.GetMetadata()[KPoolName]
+	imgName := opt.GetMetadata()[KImageName]
+	_, inGroup := opt.GetMetadata()[KSnapId]
+
+	mgr := NewSrcMgr(d.conf)
+	defer mgr.destroy()
+
+	snapName := snapshotName(opt.GetSnapshotId(), opt.GetMetadata())
+	var img *rbd.Image
+	if !inGroup {
+		var err error
+		img, err = mgr.GetImage(poolName, imgName, snapName)
+		if err == rbd.RbdErrorNotFound {
+			log.Warningf("Specified snapshot (%s) does not exist, ignore it", opt.GetSnapshotId())
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+	// The snapshot stays protected while it is still attached to other
+	// hosts.
+	left, err := d.updateSnapshotAttachments(poolName+"/"+imgName, snapName, opt.GetId(), false)
+	if err != nil {
+		log.Errorf("remove attachment of snapshot(%s) failed, %v", opt.GetSnapshotId(), err)
+		return err
+	}
+	if left > 0 {
+		log.Infof("snapshot(%s) is still attached %d times, keep it protected", opt.GetSnapshotId(), left)
+		return nil
+	}
+	if inGroup {
+		return nil
+	}
+	// The snapshot may still have clones, in which case it must stay
+	// protected and is unprotected when it is deleted.
+	snap := img.GetSnapshot(snapName)
+	if ok, _ := snap.IsProtected(); ok {
+		if err := snap.Unprotect(); err != nil {
+			log.Warningf("unprotect snapshot(%s) failed, %v", opt.GetSnapshotId(), err)
+		}
+	}
+	return nil
+}
+
+// protectSnapshot protects the snapshot so that it can not be removed while
+// it is exposed to the host, the attachment is recorded so that it is only
+// unprotected after the last attachment is gone.
+func (d *Driver) protectSnapshot(poolName, imgName, snapName string) error {
+	mgr := NewSrcMgr(d.conf)
+	defer mgr.destroy()
+
+	img, err := mgr.GetImage(poolName, imgName, snapName)
+	if err != nil {
+		return err
+	}
+	snap := img.GetSnapshot(snapName)
+	if ok, _ := snap.IsProtected(); !ok {
+		return snap.Protect()
+	}
+	return nil
+}
+
+// updateSnapshotAttachments adds the attachment to or removes it from the
+// attachments of the snapshot, which are recorded in the metadata of the
+// image of spec, and returns how many attachments are left.
+func (d *Driver) updateSnapshotAttachments(spec, snapName, atcId string, add bool) (int, error) {
+	key := "opensds_attachments_" + snapName
+	var atcs []string
+	// Getting the key which doesn't exist fails, which means no attachment.
+	if out, err := d.rbd("image-meta", "get", spec, key); err == nil {
+		for _, id := range strings.Split(strings.TrimSpace(out), ",") {
+			if id != "" && id != atcId {
+				atcs = append(atcs, id)
+			}
+		}
+	}
+	if add {
+		atcs = append(atcs, atcId)
+	}
+	if len(atcs) == 0 {
+		d.rbd("image-meta", "remove", spec, key)
+		return 0, nil
+	}
+	if _, err := d.rbd("image-meta", "set", spec, key, strings.Join(atcs, ",")); err != nil {
+		return 0, err
+	}
+	return len(atcs), nil
+}
+
+// rbd runs the rbd command, which is used for the operations not provided
+// by go-ceph such as the ones of rbd group.
+func (d *Driver) rbd(args ...string) (string, error) {
+	return rbdCmd(d.conf.ConfigFile, args...)
+}
+
+// rbdCmd runs the rbd command against the cluster of the config file.
+func rbdCmd(configFile string, args ...string) (string, error) {
+	args = append([]string{"-c", configFile}, args...)
+	out, err := exec.NewRootExecuter().Run("rbd", args...)
+	if err != nil {
+		log.Errorf("rbd %s failed: %v, output: %s", strings.Join(args, " "), err, out)
+	}
+	return out, err
+}
+
+// setImageQos overrides the rbd_qos_* options of librbd in the metadata of
+// the image, so the limits are enforced by every client of the image. The
+// limit which is zero is removed to fall back to the cluster default.
+func (d *Driver) setImageQos(spec string, qos *pb.Qos) error {
+	limits := []struct {
+		key   string
+		value int64
+	}{
+		{"conf_rbd_qos_iops_limit", qos.GetMaxIOPS()},
+		{"conf_rbd_qos_bps_limit", qos.GetMaxBWS() << 20},
+	}
+	for _, l := range limits {
+		if l.value > 0 {
+			if _, err := d.rbd("image-meta", "set", spec, l.key, strconv.FormatInt(l.value, 10)); err != nil {
+				return err
+			}
+			continue
+		}
+		// Removing the key which doesn't exist fails, which is harmless.
+		d.rbd("image-meta", "remove", spec, l.key)
+	}
+	return nil
+}
+
+// poolNameOf returns the name of pool whose id is poolId, which is the id of
+// pool discovered from the backend.
+func (d *Driver) poolNameOf(backendName, poolId string) (string, error) {
+	for name := range d.conf.Pool {
+		id := uuid.NewV5(uuid.NamespaceOID, name).String()
+		if config.PoolIdOf(backendName, id) == poolId {
+			return name, nil
+		}
+	}
+	return "", fmt.Errorf("pool %s not found", poolId)
+}
+
+// imageSpecOf returns the spec of image of the volume, which is looked up in
+// the pool of group first and then the other pools.
+func (d *Driver) imageSpecOf(poolName, volId string) (string, error) {
+	var pools = []string{poolName}
+	for name := range d.conf.Pool {
+		if name != poolName {
+			pools = append(pools, name)
+		}
+	}
+	for _, p := range pools {
+		spec := p + "/" + EncodeName(volId)
+		if _, err := d.rbd("info", spec); err == nil {
+			return spec, nil
+		}
+	}
+	return "", fmt.Errorf("image of volume %s not found", volId)
+}
+
+// groupSpecOf returns the spec of rbd group of the volume group, which is
+// created in the pool of volume group.
+func (d *Driver) groupSpecOf(backendName, poolId, groupId string) (string, error) {
+	poolName, err := d.poolNameOf(backendName, poolId)
+	if err != nil {
+		return "", err
+	}
+	return poolName + "/" + EncodeName(groupId), nil
+}
+
+// updateGroupImages adds the images of volumes to the rbd group, or removes
+// them from it if add is false.
+func (d *Driver) updateGroupImages(group string, volIds []string, add bool) error {
+	action := "add"
+	if !add {
+		action = "remove"
+	}
+	poolName := strings.Split(group, "/")[0]
+	for _, volId := range volIds {
+		img, err := d.imageSpecOf(poolName, volId)
+		if err != nil {
+			return err
+		}
+		if _, err := d.rbd("group", "image", action, group, img); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
 func (d *Driver) CreateVolumeGroup(opt *pb.CreateVolumeGroupOpts) (*model.VolumeGroupSpec, error) {
-	return nil, &model.NotImplementError{"method CreateVolumeGroup has not been implemented yet"}
+	group, err := d.groupSpecOf(opt.GetBackendName(), opt.GetPoolId(), opt.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if _, err := d.rbd("group", "create", group); err != nil {
+		return nil, err
+	}
+	if err := d.updateGroupImages(group, opt.GetAddVolumes(), true); err != nil {
+		d.rbd("group", "remove", group)
+		return nil, err
+	}
+
+	log.Infof("Create volume group (%s) success", opt.GetId())
+	return &model.VolumeGroupSpec{
+		BaseModel: &model.BaseModel{
+			Id: opt.GetId(),
+		},
+		Name:             opt.GetName(),
+		Description:      opt.GetDescription(),
+		AvailabilityZone: opt.GetAvailabilityZone(),
+		PoolId:           opt.GetPoolId(),
+	}, nil
 }
 
 func (d *Driver) UpdateVolumeGroup(opt *pb.UpdateVolumeGroupOpts) (*model.VolumeGroupSpec, error) {
-	return nil, &model.NotImplementError{"method UpdateVolumeGroup has not been implemented yet"}
+	group, err := d.groupSpecOf(opt.GetBackendName(), opt.GetPoolId(), opt.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if err := d.updateGroupImages(group, opt.GetAddVolumes(), true); err != nil {
+		return nil, err
+	}
+	if err := d.updateGroupImages(group, opt.GetRemoveVolumes(), false); err != nil {
+		return nil, err
+	}
+
+	log.Infof("Update volume group (%s) success", opt.GetId())
+	return &model.VolumeGroupSpec{
+		BaseModel: &model.BaseModel{
+			Id: opt.GetId(),
+		},
+		PoolId: opt.GetPoolId(),
+	}, nil
 }
 
+// DeleteVolumeGroup removes the rbd group, the images in it are left and
+// deleted one by one afterwards.
 func (d *Driver) DeleteVolumeGroup(opt *pb.DeleteVolumeGroupOpts) error {
-	return &model.NotImplementError{"method DeleteVolumeGroup has not been implemented yet"}
+	group, err := d.groupSpecOf(opt.GetBackendName(), opt.GetPoolId(), opt.GetId())
+	if err != nil {
+		return err
+	}
+	out, err := d.rbd("group", "list", strings.Split(group, "/")[0])
+	if err != nil {
+		return err
+	}
+	for _, name := range strings.Fields(out) {
+		if name == EncodeName(opt.GetId()) {
+			if _, err := d.rbd("group", "remove", group); err != nil {
+				return err
+			}
+			break
+		}
+	}
+
+	log.Infof("Delete volume group (%s) success", opt.GetId())
+	return nil
+}
+
+// CreateGroupSnapshot creates the rbd group snapshot, which is taken of all
+// the images in the group at the same point in time. The snapshots of images
+// are in the namespace of the group snapshot, whose names and ids are
+// recorded in the snapshots so that the volumes can be created from them and
+// they can be attached.
+func (d *Driver) CreateGroupSnapshot(opt *pb.CreateVolumeGroupSnapshotOpts) ([]*model.VolumeSnapshotSpec, error) {
+	group, err := d.groupSpecOf(opt.GetBackendName(), opt.GetPoolId(), opt.GetGroupId())
+	if err != nil {
+		return nil, err
+	}
+	groupSnap := group + "@" + EncodeName(opt.GetId())
+	if _, err := d.rbd("group", "snap", "create", groupSnap); err != nil {
+		return nil, err
+	}
+
+	var snaps []*model.VolumeSnapshotSpec
+	for _, snapOpt := range opt.GetSnapshots() {
+		poolName := snapOpt.GetMetadata()[KPoolName]
+		imgName := imageName(snapOpt.GetVolumeId(), snapOpt.GetMetadata())
+		snapId, snapName, err := d.groupImageSnapOf(poolName+"/"+imgName, EncodeName(opt.GetId()))
+		if err != nil {
+			d.rbd("group", "snap", "remove", groupSnap)
+			return nil, err
+		}
+		snaps = append(snaps, &model.VolumeSnapshotSpec{
+			BaseModel: &model.BaseModel{
+				Id: snapOpt.GetId(),
+			},
+			Name:            snapOpt.GetName(),
+			Description:     snapOpt.GetDescription(),
+			VolumeId:        snapOpt.GetVolumeId(),
+			Size:            snapOpt.GetSize(),
+			GroupSnapshotId: opt.GetId(),
+			Metadata: map[string]string{
+				KPoolName:  poolName,
+				KImageName: imgName,
+				KSnapName:  snapName,
+				KSnapId:    snapId,
+			},
+		})
+	}
+
+	log.Infof("Create volume group snapshot (%s) success", opt.GetId())
+	return snaps, nil
+}
+
+// groupImageSnapOf returns the id and name of snapshot of the image which is
+// taken along with the group snapshot named groupSnapName.
+func (d *Driver) groupImageSnapOf(spec, groupSnapName string) (string, string, error) {
+	out, err := d.rbd("snap", "ls", "--all", "--format", "json", spec)
+	if err != nil {
+		return "", "", err
+	}
+	var snaps []struct {
+		Id        uint64 `json:"id"`
+		Name      string `json:"name"`
+		Namespace struct {
+			Type      string `json:"type"`
+			GroupSnap string `json:"group snap"`
+		} `json:"namespace"`
+	}
+	if err := json.Unmarshal([]byte(out), &snaps); err != nil {
+		return "", "", err
+	}
+	for _, snap := range snaps {
+		if snap.Namespace.Type == "group" && snap.Namespace.GroupSnap == groupSnapName {
+			return strconv.FormatUint(snap.Id, 10), snap.Name, nil
+		}
+	}
+	return "", "", fmt.Errorf("snapshot of image %s in group snapshot %s not found", spec, groupSnapName)
+}
+
+// DeleteGroupSnapshot removes the rbd group snapshot, along with which the
+// snapshots of images in its namespace are removed.
+func (d *Driver) DeleteGroupSnapshot(opt *pb.DeleteVolumeGroupSnapshotOpts) error {
+	group, err := d.groupSpecOf(opt.GetBackendName(), opt.GetPoolId(), opt.GetGroupId())
+	if err != nil {
+		return err
+	}
+	snap := EncodeName(opt.GetId())
+	out, err := d.rbd("group", "snap", "list", group)
+	if err != nil {
+		return err
+	}
+	if !strings.Contains(out, snap) {
+		log.Warningf("Specified group snapshot (%s) does not exist, ignore it", opt.GetId())
+		return nil
+	}
+	if _, err := d.rbd("group", "snap", "remove", group+"@"+snap); err != nil {
+		return err
+	}
+
+	log.Infof("Delete volume group snapshot (%s) success", opt.GetId())
+	return nil
 }
@@ -0,0 +1,500 @@
+// Copyright (c) 2018 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+//    Licensed under the Apache License, Version 2.0 (the "License"); you may
+//    not use this file except in compliance with the License. You may obtain
+//    a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//    WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//    License for the specific language governing permissions and limitations
+//    under the License.
+
+package ceph
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	log "github.com/golang/glog"
+	. "github.com/opensds/opensds/contrib/drivers/utils/config"
+	"github.com/opensds/opensds/pkg/model"
+	pb "github.com/opensds/opensds/pkg/model/proto"
+	"github.com/opensds/opensds/pkg/utils"
+)
+
+const (
+	mirrorModeJournal  = "journal"
+	mirrorModeSnapshot = "snapshot"
+
+	defaultSnapshotInterval = "5m"
+	promoteWaitInterval     = 2 * time.Second
+	promoteWaitTimeout      = 60 * time.Second
+)
+
+const (
+	KMirrorImage    = "CephMirrorImage"
+	KMirrorMode     = "CephMirrorMode"
+	KMirrorInterval = "CephMirrorInterval"
+	KMirrorState    = "CephMirrorState"
+)
+
+// ReplicationConfig is the configuration of rbd mirroring between the cluster
+// of backend and the remote one. The pools are required to have the same
+// names on both clusters, and the peers of pools and the rbd-mirror daemons
+// have to be set up beforehand.
+type ReplicationConfig struct {
+	// RemoteConfigFile is the ceph config file of the remote cluster.
+	RemoteConfigFile string `yaml:"remoteConfigFile,omitempty"`
+	// MirrorMode is journal or snapshot, by default the sync replication is
+	// mirrored in journal mode and the async one in snapshot mode.
+	MirrorMode string `yaml:"mirrorMode,omitempty"`
+	// SnapshotInterval is how often the mirror snapshots are taken in
+	// snapshot mode if the replication period is not specified, such as 5m.
+	SnapshotInterval string `yaml:"snapshotInterval,omitempty"`
+}
+
+type rbdRunner func(args ...string) (string, error)
+
+// mirrorImageStatus is the output of rbd mirror image status.
+type mirrorImageStatus struct {
+	State       string `json:"state"`
+	Description string `json:"description"`
+	PeerSites   []struct {
+		SiteName    string `json:"site_name"`
+		State       string `json:"state"`
+		Description string `json:"description"`
+	} `json:"peer_sites"`
+}
+
+// imageInfo is the output of rbd info.
+type imageInfo struct {
+	Features  []string `json:"features"`
+	Mirroring struct {
+		Mode    string `json:"mode"`
+		State   string `json:"state"`
+		Primary bool   `json:"primary"`
+	} `json:"mirroring"`
+}
+
+// ReplicationDriver replicates the volumes to the remote cluster with rbd
+// mirroring. Like the array-based replication of dorado, all the operations
+// are done on the primary side, which manages both of the clusters.
+type ReplicationDriver struct {
+	// ConfigPath is the path of driver configuration file of the backend.
+	ConfigPath string
+
+	conf *CephConfig
+}
+
+// Setup
+func (r *ReplicationDriver) Setup() error {
+	r.conf = &CephConfig{ConfigFile: "/etc/ceph/ceph.conf"}
+	p := r.ConfigPath
+	if "" == p {
+		p = defaultConfPath
+	}
+	if _, err := Parse(r.conf, p); err != nil {
+		return err
+	}
+
+	switch r.conf.Replication.MirrorMode {
+	case "", mirrorModeJournal, mirrorModeSnapshot:
+	default:
+		return fmt.Errorf("invalid mirror mode %s, it should be %s or %s",
+			r.conf.Replication.MirrorMode, mirrorModeJournal, mirrorModeSnapshot)
+	}
+	if r.conf.Replication.RemoteConfigFile == "" {
+		log.Warning("The remote cluster of ceph replication is not configured")
+	}
+	return nil
+}
+
+// Unset
+func (r *ReplicationDriver) Unset() error { return nil }
+
+func (r *ReplicationDriver) local(args ...string) (string, error) {
+	return rbdCmd(r.conf.ConfigFile, args...)
+}
+
+func (r *ReplicationDriver) remote(args ...string) (string, error) {
+	if r.conf.Replication.RemoteConfigFile == "" {
+		return "", fmt.Errorf("remote cluster of ceph replication is not configured")
+	}
+	return rbdCmd(r.conf.Replication.RemoteConfigFile, args...)
+}
+
+// CreateReplication enables the mirroring of the primary image, whose replica
+// is created on the remote cluster by rbd-mirror with the same name. So the
+// image of the secondary volume is replaced by the replica.
+func (r *ReplicationDriver) CreateReplication(opt *pb.CreateReplicationOpts) (*model.ReplicationSpec, error) {
+	pData, sData := opt.GetPrimaryReplicationDriverData(), opt.GetSecondaryReplicationDriverData()
+	if !opt.GetIsPrimary() {
+		return &model.ReplicationSpec{
+			SecondaryReplicationDriverData: map[string]string{
+				KImageName: imageName(opt.GetPrimaryVolumeId(), pData),
+			},
+		}, nil
+	}
+
+	pool := pData[KPoolName]
+	if pool == "" || pool != sData[KPoolName] {
+		return nil, fmt.Errorf("rbd mirroring requires the pools of the same name, got %s and %s",
+			pool, sData[KPoolName])
+	}
+	img := pool + "/" + imageName(opt.GetPrimaryVolumeId(), pData)
+	mode := r.conf.Replication.MirrorMode
+	if mode == "" {
+		mode = mirrorModeSnapshot
+		if opt.GetReplicationMode() == model.ReplicationModeSync {
+			mode = mirrorModeJournal
+		}
+	}
+
+	// Mirroring is enabled per image, so that only the replicated volumes
+	// are mirrored.
+	for _, run := range []rbdRunner{r.local, r.remote} {
+		if _, err := run("mirror", "pool", "enable", pool, "image"); err != nil {
+			return nil, err
+		}
+	}
+	if mode == mirrorModeJournal {
+		if err := r.enableJournaling(img); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := r.local("mirror", "image", "enable", img, mode); err != nil {
+		return nil, err
+	}
+
+	var interval string
+	if mode == mirrorModeSnapshot {
+		interval = r.conf.Replication.SnapshotInterval
+		if opt.GetReplicationPeriod() > 0 {
+			interval = fmt.Sprintf("%dm", opt.GetReplicationPeriod())
+		}
+		if interval == "" {
+			interval = defaultSnapshotInterval
+		}
+		if err := r.addSnapshotSchedule(r.local, img, interval); err != nil {
+			return nil, err
+		}
+	}
+
+	// The image created for the secondary volume is useless now.
+	if sImg := pool + "/" + imageName(opt.GetSecondaryVolumeId(), sData); sImg != img {
+		if _, err := r.remote("rm", sImg); err != nil {
+			log.Warningf("Failed to remove image %s of secondary volume: %v", sImg, err)
+		}
+	}
+
+	state, _ := r.mirrorState(r.local, img)
+	log.Infof("Create replication of image %s in %s mode success, state: %s", img, mode, state)
+	return &model.ReplicationSpec{
+		BaseModel: &model.BaseModel{
+			Id: opt.GetId(),
+		},
+		Metadata: map[string]string{
+			KMirrorImage:    img,
+			KMirrorMode:     mode,
+			KMirrorInterval: interval,
+			KMirrorState:    state,
+		},
+	}, nil
+}
+
+// DeleteReplication disables the mirroring on the cluster where the image is
+// primary, and the replica on the other cluster is removed by rbd-mirror.
+func (r *ReplicationDriver) DeleteReplication(opt *pb.DeleteReplicationOpts) error {
+	if !opt.GetIsPrimary() {
+		return nil
+	}
+	img, err := mirrorImageOf(opt.GetMetadata())
+	if err != nil {
+		return err
+	}
+
+	run := r.local
+	if primary, _ := r.isPrimary(r.local, img); !primary {
+		run = r.remote
+	}
+	if opt.GetMetadata()[KMirrorMode] == mirrorModeSnapshot {
+		r.removeSnapshotSchedule(run, img)
+	}
+	if _, err := run("mirror", "image", "disable", img); err != nil {
+		return err
+	}
+
+	log.Infof("Delete replication of image %s success", img)
+	return nil
+}
+
+// EnableReplication resumes the mirror snapshots and syncs the replica at once
+// in snapshot mode, the journal-based mirroring is always going on.
+func (r *ReplicationDriver) EnableReplication(opt *pb.EnableReplicationOpts) error {
+	if !opt.GetIsPrimary() {
+		return nil
+	}
+	img, err := mirrorImageOf(opt.GetMetadata())
+	if err != nil {
+		return err
+	}
+
+	if opt.GetMetadata()[KMirrorMode] == mirrorModeSnapshot {
+		interval := opt.GetMetadata()[KMirrorInterval]
+		if interval == "" {
+			interval = defaultSnapshotInterval
+		}
+		if err := r.addSnapshotSchedule(r.local, img, interval); err != nil {
+			return err
+		}
+		if _, err := r.local("mirror", "image", "snapshot", img); err != nil {
+			return err
+		}
+	}
+
+	state, _ := r.mirrorState(r.local, img)
+	log.Infof("Enable replication of image %s success, state: %s", img, state)
+	return nil
+}
+
+// DisableReplication stops taking the mirror snapshots in snapshot mode, so
+// that the replica is not updated any more. The journal-based mirroring can't
+// be paused without removing the replica, which is what delete does.
+func (r *ReplicationDriver) DisableReplication(opt *pb.DisableReplicationOpts) error {
+	if !opt.GetIsPrimary() {
+		return nil
+	}
+	img, err := mirrorImageOf(opt.GetMetadata())
+	if err != nil {
+		return err
+	}
+
+	if opt.GetMetadata()[KMirrorMode] != mirrorModeSnapshot {
+		return &model.NotImplementError{S: "journal-based rbd mirroring can not be disabled, delete the replication instead"}
+	}
+	if err := r.removeSnapshotSchedule(r.local, img); err != nil {
+		return err
+	}
+
+	log.Infof("Disable replication of image %s success", img)
+	return nil
+}
+
+// FailoverReplication promotes the replica on the remote cluster when failing
+// over, and promotes the image on the local cluster back when failing back.
+func (r *ReplicationDriver) FailoverReplication(opt *pb.FailoverReplicationOpts) error {
+	if !opt.GetIsPrimary() {
+		return nil
+	}
+	img, err := mirrorImageOf(opt.GetMetadata())
+	if err != nil {
+		return err
+	}
+
+	from, to := r.local, r.remote
+	if opt.GetSecondaryBackendId() != model.ReplicationDefaultBackendId {
+		from, to = r.remote, r.local
+	}
+	if err := r.switchPrimary(from, to, img); err != nil {
+		return err
+	}
+
+	if opt.GetMetadata()[KMirrorMode] == mirrorModeSnapshot {
+		r.removeSnapshotSchedule(from, img)
+		interval := opt.GetMetadata()[KMirrorInterval]
+		if interval == "" {
+			interval = defaultSnapshotInterval
+		}
+		if err := r.addSnapshotSchedule(to, img, interval); err != nil {
+			return err
+		}
+	}
+
+	state, _ := r.mirrorState(to, img)
+	log.Infof("Failover replication of image %s success, state: %s", img, state)
+	return nil
+}
+
+// GetReplicationState returns the current state of mirroring reported by the
+// cluster where the image is primary, which is the state of its replica.
+func (r *ReplicationDriver) GetReplicationState(metadata map[string]string) (map[string]string, error) {
+	img, err := mirrorImageOf(metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	run := r.local
+	if primary, _ := r.isPrimary(r.local, img); !primary {
+		run = r.remote
+	}
+	state, err := r.mirrorState(run, img)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]string{KMirrorState: state}, nil
+}
+
+// switchPrimary demotes the image on one cluster and promotes the one on the
+// other. The image is demoted gracefully if the cluster is reachable, so that
+// the other one can be promoted without data loss once the demotion is
+// mirrored. Otherwise the other one is promoted by force, and the image left
+// behind diverges, which is resynced at once if its cluster is back, or when
+// it's switched to next time.
+func (r *ReplicationDriver) switchPrimary(from, to rbdRunner, img string) error {
+	// The image which was left behind by a forced promotion is still primary
+	// and diverges, it has to be resynced before being promoted again.
+	if diverged, _ := r.isPrimary(to, img); diverged {
+		if primary, err := r.isPrimary(from, img); err == nil && primary {
+			if err := r.resync(to, img); err != nil {
+				return err
+			}
+			return fmt.Errorf("image %s diverged and is being resynced, retry after it's synced", img)
+		}
+	}
+
+	force := false
+	if _, err := from("mirror", "image", "demote", img); err != nil {
+		log.Warningf("Failed to demote image %s, promote its replica by force: %v", img, err)
+		force = true
+	}
+
+	if primary, _ := r.isPrimary(to, img); !primary {
+		args := []string{"mirror", "image", "promote", img}
+		if force {
+			args = append(args, "--force")
+		}
+		if err := utils.WaitForCondition(func() (bool, error) {
+			_, err := to(args...)
+			return err == nil, nil
+		}, promoteWaitInterval, promoteWaitTimeout); err != nil {
+			log.Errorf("Failed to promote image %s: %v", img, err)
+			return err
+		}
+	}
+
+	if force {
+		if err := r.resync(from, img); err != nil {
+			log.Warningf("Failed to resync image %s left behind, it's resynced when it's switched to: %v", img, err)
+		}
+		return nil
+	}
+	if st, err := r.mirrorStatus(from, img); err == nil && strings.Contains(st.Description, "split-brain") {
+		if _, err := from("mirror", "image", "resync", img); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resync demotes the image which diverges from its peer if it's still
+// primary, and resyncs it from the peer.
+func (r *ReplicationDriver) resync(run rbdRunner, img string) error {
+	if primary, err := r.isPrimary(run, img); err != nil {
+		return err
+	} else if primary {
+		if _, err := run("mirror", "image", "demote", img); err != nil {
+			return err
+		}
+	}
+	_, err := run("mirror", "image", "resync", img)
+	return err
+}
+
+// enableJournaling enables the features required by journal-based mirroring.
+func (r *ReplicationDriver) enableJournaling(img string) error {
+	info, err := r.imageInfo(r.local, img)
+	if err != nil {
+		return err
+	}
+	for _, feature := range []string{"exclusive-lock", "journaling"} {
+		if utils.Contained(feature, info.Features) {
+			continue
+		}
+		if _, err := r.local("feature", "enable", img, feature); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *ReplicationDriver) addSnapshotSchedule(run rbdRunner, img, interval string) error {
+	pool, name := splitImageSpec(img)
+	_, err := run("mirror", "snapshot", "schedule", "add", "--pool", pool, "--image", name, interval)
+	return err
+}
+
+func (r *ReplicationDriver) removeSnapshotSchedule(run rbdRunner, img string) error {
+	pool, name := splitImageSpec(img)
+	_, err := run("mirror", "snapshot", "schedule", "remove", "--pool", pool, "--image", name)
+	return err
+}
+
+func (r *ReplicationDriver) imageInfo(run rbdRunner, img string) (*imageInfo, error) {
+	out, err := run("info", img, "--format", "json")
+	if err != nil {
+		return nil, err
+	}
+	var info = &imageInfo{}
+	if err := json.Unmarshal([]byte(out), info); err != nil {
+		log.Errorf("Failed to parse info of image %s: %v", img, err)
+		return nil, err
+	}
+	return info, nil
+}
+
+func (r *ReplicationDriver) isPrimary(run rbdRunner, img string) (bool, error) {
+	info, err := r.imageInfo(run, img)
+	if err != nil {
+		return false, err
+	}
+	return info.Mirroring.Primary, nil
+}
+
+func (r *ReplicationDriver) mirrorStatus(run rbdRunner, img string) (*mirrorImageStatus, error) {
+	out, err := run("mirror", "image", "status", img, "--format", "json")
+	if err != nil {
+		return nil, err
+	}
+	var st = &mirrorImageStatus{}
+	if err := json.Unmarshal([]byte(out), st); err != nil {
+		log.Errorf("Failed to parse mirror status of image %s: %v", img, err)
+		return nil, err
+	}
+	return st, nil
+}
+
+// mirrorState returns the state of mirroring, which is the one of replica on
+// the peer cluster if it's reported.
+func (r *ReplicationDriver) mirrorState(run rbdRunner, img string) (string, error) {
+	st, err := r.mirrorStatus(run, img)
+	if err != nil {
+		return "", err
+	}
+	if len(st.PeerSites) > 0 {
+		return st.PeerSites[0].State, nil
+	}
+	return st.State, nil
+}
+
+func mirrorImageOf(metadata map[string]string) (string, error) {
+	img, ok := metadata[KMirrorImage]
+	if !ok {
+		err := errors.New("can't find mirror image in metadata")
+		log.Error(err)
+		return "", err
+	}
+	return img, nil
+}
+
+func splitImageSpec(img string) (pool, name string) {
+	if i := strings.Index(img, "/"); i >= 0 {
+		return img[:i], img[i+1:]
+	}
+	return "", img
+}
@@ -23,13 +23,21 @@ import (
 	"github.com/opensds/opensds/contrib/drivers/utils/config"
 	"github.com/opensds/opensds/pkg/model"
 	pb "github.com/opensds/opensds/pkg/model/proto"
+	"github.com/opensds/opensds/pkg/utils/exec"
 )
 
 // ReplicationDriver
-type ReplicationDriver struct{}
+type ReplicationDriver struct {
+	// RootExecuter runs drbdadm, it can be replaced by tests with an
+	// executer replaying the recorded commands.
+	RootExecuter exec.Executer
+}
 
 // Setup
-func (r *ReplicationDriver) Setup() error { return nil }
+func (r *ReplicationDriver) Setup() error {
+	r.RootExecuter = exec.NewRootExecuter()
+	return nil
+}
 
 // Unset
 func (r *ReplicationDriver) Unset() error { return nil }
@@ -167,15 +175,13 @@ func (r *ReplicationDriver) CreateReplication(opt *pb.CreateReplicationOpts) (*m
 	res.WriteConfig(resFilePath(resName))
 
 	// Bring up the resource
-	drbdadm := godrbdutils.NewDrbdAdm([]string{resName})
-
-	drbdadm.CreateMetaData(fmt.Sprintf("--max-peers=%d", maxPeers), "--force")
-	drbdadm.Up()
+	r.drbdadm(resName, "create-md", fmt.Sprintf("--max-peers=%d", maxPeers), "--force")
+	r.drbdadm(resName, "up")
 
 	if isPrimary {
 		// start initial sync
-		drbdadm.Primary("--force")
-		drbdadm.Secondary() // switch back, rest done by auto promote
+		r.drbdadm(resName, "primary", "--force")
+		r.drbdadm(resName, "secondary") // switch back, rest done by auto promote
 	}
 
 	additionalPrimaryData := map[string]string{
@@ -200,8 +206,7 @@ func (r *ReplicationDriver) DeleteReplication(opt *pb.DeleteReplicationOpts) err
 
 	resName := opt.GetId()
 
-	drbdadm := godrbdutils.NewDrbdAdm([]string{resName})
-	if _, err := drbdadm.Down(); err != nil {
+	if _, err := r.drbdadm(resName, "down"); err != nil {
 		return err
 	}
 	if err := os.Remove(resFilePath(resName)); err != nil {
@@ -216,16 +221,14 @@ func (r *ReplicationDriver) DeleteReplication(opt *pb.DeleteReplicationOpts) err
 func (r *ReplicationDriver) EnableReplication(opt *pb.EnableReplicationOpts) error {
 	log.Infof("DRBD enable replication ....")
 
-	drbdadm := godrbdutils.NewDrbdAdm([]string{opt.GetId()})
-	_, err := drbdadm.Adjust()
+	_, err := r.drbdadm(opt.GetId(), "adjust")
 	return err
 }
 
 func (r *ReplicationDriver) DisableReplication(opt *pb.DisableReplicationOpts) error {
 	log.Infof("DRBD disable replication ....")
 
-	drbdadm := godrbdutils.NewDrbdAdm([]string{opt.GetId()})
-	_, err := drbdadm.Disconnect()
+	_, err := r.drbdadm(opt.GetId(), "disconnect")
 	return err
 }
 
@@ -241,3 +244,9 @@ func (r *ReplicationDriver) FailoverReplication(opt *pb.FailoverReplicationOpts)
 	// And it can then be used on the second node by just open(2)ing the device again.
 	return nil
 }
+
+// drbdadm runs the action of drbdadm with the arguments on the resource.
+func (r *ReplicationDriver) drbdadm(resName, action string, arg ...string) (string, error) {
+	args := append([]string{action}, arg...)
+	return r.RootExecuter.Run("drbdadm", append(args, resName)...)
+}
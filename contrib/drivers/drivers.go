@@ -15,7 +15,8 @@
 /*
 This module defines an standard table of storage driver. The default storage
 driver is sample driver used for testing. If you want to use other storage
-plugin, just modify Init() and Clean() method.
+plugin, just modify Init() and Clean() method, or run it as an out-of-tree
+driver plugin which is named in the configuration.
 
 */
 
@@ -24,14 +25,19 @@ package drivers
 import (
 	_ "github.com/opensds/opensds/contrib/backup/multicloud"
 	"github.com/opensds/opensds/contrib/drivers/ceph"
+	"github.com/opensds/opensds/contrib/drivers/file"
 	"github.com/opensds/opensds/contrib/drivers/huawei/dorado"
 	"github.com/opensds/opensds/contrib/drivers/huawei/fusionstorage"
 	"github.com/opensds/opensds/contrib/drivers/lvm"
 	"github.com/opensds/opensds/contrib/drivers/openstack/cinder"
+	"github.com/opensds/opensds/contrib/drivers/plugin"
 	"github.com/opensds/opensds/contrib/drivers/utils/config"
+	"github.com/opensds/opensds/contrib/drivers/zfs"
 	"github.com/opensds/opensds/pkg/model"
 	pb "github.com/opensds/opensds/pkg/model/proto"
+	. "github.com/opensds/opensds/pkg/utils/config"
 	"github.com/opensds/opensds/testutils/driver"
+	"github.com/opensds/opensds/testutils/driver/faulty"
 )
 
 // VolumeDriver is an interface for exposing some operations of different volume
@@ -79,27 +85,76 @@ type VolumeDriver interface {
 	DeleteVolumeGroup(opt *pb.DeleteVolumeGroupOpts) error
 
 	ListPools() ([]*model.StoragePoolSpec, error)
+
+	// Capabilities declares the optional operations which the driver
+	// supports, it's published in the extras of the pools of the driver.
+	Capabilities() model.DriverCapabilitiesSpec
+}
+
+// GroupSnapshotDriver is implemented by the volume drivers which are able to
+// take a consistent snapshot of all the volumes in a volume group at once.
+type GroupSnapshotDriver interface {
+	// CreateGroupSnapshot returns the snapshots of the volumes in the group,
+	// which are taken at the same point in time.
+	CreateGroupSnapshot(opt *pb.CreateVolumeGroupSnapshotOpts) ([]*model.VolumeSnapshotSpec, error)
+
+	DeleteGroupSnapshot(opt *pb.DeleteVolumeGroupSnapshotOpts) error
 }
 
-// Init
-func Init(resourceType string) VolumeDriver {
+// QosDriver is implemented by the volume drivers which are able to limit the
+// I/O of volumes, the limits are given when the volume is created and can be
+// changed while it's in use.
+type QosDriver interface {
+	// UpdateVolumeQos changes the limits of I/O of the volume to the qos of
+	// opt, zero of which means no limit.
+	UpdateVolumeQos(opt *pb.UpdateVolumeQosOpts) (*model.VolumeSpec, error)
+}
+
+// BackupVerifyDriver is implemented by the volume drivers which are able to
+// back up snapshots into the cloud and check the backups afterwards.
+type BackupVerifyDriver interface {
+	// VerifySnapshotBackup checks every chunk of the backup of the snapshot
+	// against its checksums, without restoring it.
+	VerifySnapshotBackup(opt *pb.VerifyVolumeSnapshotOpts) error
+}
+
+// Init method creates the volume driver of the backend and sets it up, every
+// backend gets its own driver instance configured by its own driver
+// configuration file. The name of a driver is accepted as well, in which case
+// the driver is set up with its default configuration.
+func Init(backendName string) VolumeDriver {
+	b, ok := GetBackend(backendName)
+	if !ok {
+		b = BackendProperties{Name: backendName, DriverName: backendName}
+	}
+
 	var d VolumeDriver
-	switch resourceType {
+	switch b.DriverName {
 	case config.CinderDriverType:
-		d = &cinder.Driver{}
+		d = &cinder.Driver{ConfigPath: b.ConfigPath}
 		break
 	case config.CephDriverType:
-		d = &ceph.Driver{}
+		d = &ceph.Driver{ConfigPath: b.ConfigPath}
 		break
 	case config.LVMDriverType:
-		d = &lvm.Driver{}
+		d = &lvm.Driver{ConfigPath: b.ConfigPath}
 		break
+	case config.ZFSDriverType:
+		d = &zfs.Driver{ConfigPath: b.ConfigPath}
+	case config.FileDriverType:
+		d = &file.Driver{ConfigPath: b.ConfigPath}
 	case config.HuaweiDoradoDriverType:
-		d = &dorado.Driver{}
+		d = &dorado.Driver{ConfigPath: b.ConfigPath}
 		break
 	case config.HuaweiFusionStorageDriverType:
-		d = &fusionstorage.Driver{}
+		d = &fusionstorage.Driver{ConfigPath: b.ConfigPath}
+	case config.FaultyDriverType:
+		d = &faulty.Driver{ConfigPath: b.ConfigPath}
 	default:
+		if b.PluginEndpoint != "" {
+			d = plugin.NewVolumeDriverClient(b.PluginEndpoint)
+			break
+		}
 		d = &sample.Driver{}
 		break
 	}
@@ -117,10 +172,16 @@ func Clean(d VolumeDriver) VolumeDriver {
 		break
 	case *lvm.Driver:
 		break
+	case *zfs.Driver:
+		break
+	case *file.Driver:
+		break
 	case *dorado.Driver:
 		break
 	case *fusionstorage.Driver:
 		break
+	case *plugin.VolumeDriverClient:
+		break
 	default:
 		break
 	}
@@ -0,0 +1,566 @@
+// Copyright (c) 2018 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+//    Licensed under the Apache License, Version 2.0 (the "License"); you may
+//    not use this file except in compliance with the License. You may obtain
+//    a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//    WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//    License for the specific language governing permissions and limitations
+//    under the License.
+
+/*
+This module implements the file driver for OpenSDS. Every volume is kept as a
+sparse file in the directory of its pool, and attached through the local
+connector on the host of the dock, so that the whole stack can run without
+any storage or privilege, such as for development and CI. The snapshots are
+reflinks of the volume files if the file system supports them, or copies of
+them otherwise.
+
+*/
+
+package file
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"syscall"
+
+	log "github.com/golang/glog"
+	"github.com/opensds/opensds/contrib/backup"
+	"github.com/opensds/opensds/contrib/connector"
+	. "github.com/opensds/opensds/contrib/drivers/utils/config"
+	"github.com/opensds/opensds/pkg/model"
+	pb "github.com/opensds/opensds/pkg/model/proto"
+	"github.com/opensds/opensds/pkg/utils/exec"
+	"github.com/satori/go.uuid"
+)
+
+const (
+	defaultConfPath  = "/etc/opensds/driver/file.yaml"
+	defaultDirectory = "/var/lib/opensds/file"
+	defaultAZ        = "default"
+	volumePrefix     = "volume-"
+	snapshotPrefix   = "snapshot-"
+	fileSuffix       = ".img"
+	sizeShiftBit     = 30
+)
+
+const (
+	KFilePath         = "filePath"
+	KSnapshotFilePath = "snapshotFilePath"
+)
+
+type FileConfig struct {
+	// Directory holds a sub directory for each pool, which is created if it
+	// doesn't exist.
+	Directory string `yaml:"directory"`
+	// LoopDevice asks the hosts to attach the volumes through loop devices
+	// instead of the files themselves.
+	LoopDevice     bool                      `yaml:"loopDevice"`
+	Pool           map[string]PoolProperties `yaml:"pool,flow"`
+	BackupPipeline *backup.PipelineConfig    `yaml:"backupPipeline,omitempty"`
+}
+
+type Driver struct {
+	// ConfigPath is the path of driver configuration file of the backend.
+	ConfigPath string
+
+	conf *FileConfig
+	// Command executer
+	BaseExecuter exec.Executer
+}
+
+func (d *Driver) Setup() error {
+	// Read file config file
+	d.conf = &FileConfig{Directory: defaultDirectory}
+	p := d.ConfigPath
+	if "" == p {
+		p = defaultConfPath
+	}
+	if _, err := Parse(d.conf, p); err != nil {
+		return err
+	}
+	for name := range d.conf.Pool {
+		if err := os.MkdirAll(d.poolDir(name), 0755); err != nil {
+			log.Errorf("Failed to create directory of pool %s: %v", name, err)
+			return err
+		}
+	}
+	d.BaseExecuter = exec.NewBaseExecuter()
+	return nil
+}
+
+func (*Driver) Unset() error { return nil }
+
+func (d *Driver) poolDir(pool string) string {
+	return filepath.Join(d.conf.Directory, pool)
+}
+
+// findFile returns the path of the file in the directory of any pool, it's
+// empty if the file doesn't exist.
+func (d *Driver) findFile(name string) string {
+	for pool := range d.conf.Pool {
+		p := filepath.Join(d.poolDir(pool), name)
+		if _, err := os.Stat(p); err == nil {
+			return p
+		}
+	}
+	return ""
+}
+
+// filePath returns the path of the file of volume or snapshot by its metadata
+// if any, or finds it in the pools otherwise.
+func (d *Driver) filePath(metadata map[string]string, key, name string) string {
+	if p, ok := metadata[key]; ok {
+		return p
+	}
+	return d.findFile(name)
+}
+
+func fileSize(p string) (int64, error) {
+	fi, err := os.Stat(p)
+	if err != nil {
+		return 0, err
+	}
+	return fi.Size() >> sizeShiftBit, nil
+}
+
+// copyFile makes the file of dst a reflink of the file of src, or a sparse
+// copy of it if the file system doesn't support reflinks.
+func (d *Driver) copyFile(src, dst string) error {
+	if out, err := d.BaseExecuter.Run("cp", "--reflink=auto", "--sparse=always", src, dst); err != nil {
+		return fmt.Errorf("copy %s to %s failed: %v, output: %q", src, dst, err, out)
+	}
+	return nil
+}
+
+// newBackupDriver returns the backup driver named by the backup policy, which
+// is multi-cloud by default, wrapped into the checksum/compression/encryption
+// pipeline unless it is disabled.
+func (d *Driver) newBackupDriver(name string) (backup.BackupDriver, error) {
+	if name == "" {
+		name = backup.DefaultDriver
+	}
+	mc, err := backup.NewBackup(name)
+	if err != nil {
+		return nil, err
+	}
+	if !d.conf.BackupPipeline.Enabled() {
+		return mc, nil
+	}
+	return backup.NewPipeline(mc, d.conf.BackupPipeline)
+}
+
+// downloadSnapshot writes the snapshot backed up into the bucket to the file.
+func (d *Driver) downloadSnapshot(drvName, bucket, backupId, dest string) error {
+	mc, err := d.newBackupDriver(drvName)
+	if err != nil {
+		log.Errorf("get backup driver, err: %v", err)
+		return err
+	}
+	if err := mc.SetUp(); err != nil {
+		return err
+	}
+	defer mc.CleanUp()
+
+	file, err := os.OpenFile(dest, os.O_RDWR, 0644)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	b := &backup.BackupSpec{Metadata: map[string]string{"bucket": bucket}}
+	return mc.Restore(b, backupId, file)
+}
+
+// uploadSnapshot backs up the file of snapshot into the bucket, and returns
+// the id of the backup.
+func (d *Driver) uploadSnapshot(drvName, src, bucket string) (string, error) {
+	mc, err := d.newBackupDriver(drvName)
+	if err != nil {
+		log.Errorf("get backup driver, err: %v", err)
+		return "", err
+	}
+	if err := mc.SetUp(); err != nil {
+		return "", err
+	}
+	defer mc.CleanUp()
+
+	file, err := os.Open(src)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	b := &backup.BackupSpec{
+		Id:       uuid.NewV4().String(),
+		Metadata: map[string]string{"bucket": bucket},
+	}
+	if err := mc.Backup(b, file); err != nil {
+		return "", err
+	}
+	return b.Id, nil
+}
+
+func (d *Driver) deleteUploadedSnapshot(drvName, backupId, bucket string) error {
+	mc, err := d.newBackupDriver(drvName)
+	if err != nil {
+		log.Errorf("get backup driver failed, err: %v", err)
+		return err
+	}
+	if err := mc.SetUp(); err != nil {
+		return err
+	}
+	defer mc.CleanUp()
+
+	b := &backup.BackupSpec{
+		Id:       backupId,
+		Metadata: map[string]string{"bucket": bucket},
+	}
+	return mc.Delete(b)
+}
+
+func (d *Driver) CreateVolume(opt *pb.CreateVolumeOpts) (vol *model.VolumeSpec, err error) {
+	if _, ok := d.conf.Pool[opt.GetPoolName()]; !ok {
+		return nil, fmt.Errorf("pool %s is not configured", opt.GetPoolName())
+	}
+	p := filepath.Join(d.poolDir(opt.GetPoolName()), volumePrefix+opt.GetId()+fileSuffix)
+
+	if opt.GetSnapshotId() != "" && !opt.SnapshotFromCloud {
+		snapPath, ok := opt.GetMetadata()[KSnapshotFilePath]
+		if !ok {
+			return nil, errors.New("can't find 'snapshotFilePath' in snapshot metadata")
+		}
+		if err = d.copyFile(snapPath, p); err != nil {
+			os.Remove(p)
+		}
+	} else {
+		var f *os.File
+		if f, err = os.OpenFile(p, os.O_RDWR|os.O_CREATE|os.O_EXCL, 0644); err == nil {
+			f.Close()
+		}
+	}
+	if err != nil {
+		log.Error("Failed to create volume file:", err)
+		return nil, err
+	}
+
+	// remove created file if got error
+	defer func() {
+		if vol == nil {
+			if err := os.Remove(p); err != nil {
+				log.Error("Failed to remove volume file:", err)
+			}
+		}
+	}()
+
+	// The file grows sparsely, the volume created from snapshot is never
+	// smaller than the snapshot.
+	if err := os.Truncate(p, opt.GetSize()<<sizeShiftBit); err != nil {
+		log.Error("Failed to resize volume file:", err)
+		return nil, err
+	}
+
+	if opt.SnapshotFromCloud {
+		data := opt.GetMetadata()
+		backupId, ok := data["backupId"]
+		if !ok {
+			return nil, errors.New("can't find backupId in metadata")
+		}
+		bucket, ok := data["bucket"]
+		if !ok {
+			return nil, errors.New("can't find bucket name in metadata")
+		}
+		if err := d.downloadSnapshot(data[model.BackupDriverKey], bucket, backupId, p); err != nil {
+			log.Errorf("Download snapshot failed, %v", err)
+			return nil, err
+		}
+	}
+
+	return &model.VolumeSpec{
+		BaseModel: &model.BaseModel{
+			Id: opt.GetId(),
+		},
+		Name:        opt.GetName(),
+		Size:        opt.GetSize(),
+		Description: opt.GetDescription(),
+		Metadata: map[string]string{
+			KFilePath: p,
+		},
+	}, nil
+}
+
+// PullVolume reports the size of the volume file.
+func (d *Driver) PullVolume(volIdentifier string) (*model.VolumeSpec, error) {
+	p := d.findFile(volumePrefix + volIdentifier + fileSuffix)
+	if p == "" {
+		return nil, fmt.Errorf("volume %s does not exist", volIdentifier)
+	}
+	size, err := fileSize(p)
+	if err != nil {
+		return nil, err
+	}
+	return &model.VolumeSpec{
+		BaseModel: &model.BaseModel{
+			Id: volIdentifier,
+		},
+		Size:     size,
+		Metadata: map[string]string{KFilePath: p},
+	}, nil
+}
+
+func (d *Driver) DeleteVolume(opt *pb.DeleteVolumeOpts) error {
+	p := d.filePath(opt.GetMetadata(), KFilePath, volumePrefix+opt.GetId()+fileSuffix)
+	if p == "" {
+		log.Warningf("Volume(%s) does not exist, nothing to remove", opt.GetId())
+		return nil
+	}
+	if err := os.Remove(p); err != nil && !os.IsNotExist(err) {
+		log.Error("Failed to remove volume file:", err)
+		return err
+	}
+	return nil
+}
+
+// ExtendVolume grows the volume file, the hosts which it's attached to pick up
+// the new size by expanding it.
+func (d *Driver) ExtendVolume(opt *pb.ExtendVolumeOpts) (*model.VolumeSpec, error) {
+	p := d.filePath(opt.GetMetadata(), KFilePath, volumePrefix+opt.GetId()+fileSuffix)
+	if p == "" {
+		return nil, fmt.Errorf("volume %s does not exist", opt.GetId())
+	}
+	if _, err := os.Stat(p); err != nil {
+		return nil, err
+	}
+	if err := os.Truncate(p, opt.GetSize()<<sizeShiftBit); err != nil {
+		log.Errorf("extend volume(%s) failed, error: %v", opt.GetId(), err)
+		return nil, err
+	}
+	return &model.VolumeSpec{
+		BaseModel: &model.BaseModel{
+			Id: opt.GetId(),
+		},
+		Name:        opt.GetName(),
+		Size:        opt.GetSize(),
+		Description: opt.GetDescription(),
+		Metadata:    opt.GetMetadata(),
+	}, nil
+}
+
+// connectionData returns the connection data of the local connector.
+func (d *Driver) connectionData(p string) map[string]interface{} {
+	return map[string]interface{}{
+		connector.Path:       p,
+		connector.LoopDevice: d.conf.LoopDevice,
+	}
+}
+
+// InitializeConnection returns the path of volume file, which can only be
+// attached to the host of the dock.
+func (d *Driver) InitializeConnection(opt *pb.CreateVolumeAttachmentOpts) (*model.ConnectionInfo, error) {
+	p, ok := opt.GetMetadata()[KFilePath]
+	if !ok {
+		err := errors.New("can't find 'filePath' in volume metadata")
+		log.Error(err)
+		return nil, err
+	}
+	return &model.ConnectionInfo{
+		DriverVolumeType: connector.LocalDriver,
+		ConnectionData:   d.connectionData(p),
+	}, nil
+}
+
+func (d *Driver) TerminateConnection(opt *pb.DeleteVolumeAttachmentOpts) error { return nil }
+
+func (d *Driver) CreateSnapshot(opt *pb.CreateVolumeSnapshotOpts) (*model.VolumeSnapshotSpec, error) {
+	volPath := d.filePath(opt.GetMetadata(), KFilePath, volumePrefix+opt.GetVolumeId()+fileSuffix)
+	if volPath == "" {
+		return nil, fmt.Errorf("volume %s does not exist", opt.GetVolumeId())
+	}
+
+	p := filepath.Join(filepath.Dir(volPath), snapshotPrefix+opt.GetId()+fileSuffix)
+	if err := d.copyFile(volPath, p); err != nil {
+		log.Error("Failed to create snapshot file:", err)
+		return nil, err
+	}
+	metadata := map[string]string{KSnapshotFilePath: p}
+
+	if bucket, ok := opt.GetMetadata()["bucket"]; ok {
+		log.Info("update load snapshot to :", bucket)
+		drvName := opt.GetMetadata()[model.BackupDriverKey]
+		backupId, err := d.uploadSnapshot(drvName, p, bucket)
+		if err != nil {
+			os.Remove(p)
+			return nil, err
+		}
+		metadata["backupId"] = backupId
+		metadata["bucket"] = bucket
+		if drvName != "" {
+			metadata[model.BackupDriverKey] = drvName
+		}
+	}
+
+	return &model.VolumeSnapshotSpec{
+		BaseModel: &model.BaseModel{
+			Id: opt.GetId(),
+		},
+		Name:        opt.GetName(),
+		Size:        opt.GetSize(),
+		Description: opt.GetDescription(),
+		VolumeId:    opt.GetVolumeId(),
+		Metadata:    metadata,
+	}, nil
+}
+
+// PullSnapshot reports the size of the snapshot file.
+func (d *Driver) PullSnapshot(snapIdentifier string) (*model.VolumeSnapshotSpec, error) {
+	p := d.findFile(snapshotPrefix + snapIdentifier + fileSuffix)
+	if p == "" {
+		return nil, fmt.Errorf("snapshot %s does not exist", snapIdentifier)
+	}
+	size, err := fileSize(p)
+	if err != nil {
+		return nil, err
+	}
+	return &model.VolumeSnapshotSpec{
+		BaseModel: &model.BaseModel{
+			Id: snapIdentifier,
+		},
+		Size:     size,
+		Metadata: map[string]string{KSnapshotFilePath: p},
+	}, nil
+}
+
+func (d *Driver) DeleteSnapshot(opt *pb.DeleteVolumeSnapshotOpts) error {
+	if bucket, ok := opt.GetMetadata()["bucket"]; ok {
+		log.Info("remove snapshot in multi-cloud :", bucket)
+		if err := d.deleteUploadedSnapshot(opt.GetMetadata()[model.BackupDriverKey], opt.GetMetadata()["backupId"], bucket); err != nil {
+			return err
+		}
+	}
+
+	p := d.filePath(opt.GetMetadata(), KSnapshotFilePath, snapshotPrefix+opt.GetId()+fileSuffix)
+	if p == "" {
+		log.Warningf("Snapshot(%s) does not exist, nothing to remove", opt.GetId())
+		return nil
+	}
+	if err := os.Remove(p); err != nil && !os.IsNotExist(err) {
+		log.Error("Failed to remove snapshot file:", err)
+		return err
+	}
+	return nil
+}
+
+// VerifySnapshotBackup checks the backup of the snapshot in multi-cloud
+// without restoring it.
+func (d *Driver) VerifySnapshotBackup(opt *pb.VerifyVolumeSnapshotOpts) error {
+	bucket, ok := opt.GetMetadata()["bucket"]
+	if !ok {
+		return fmt.Errorf("snapshot %s is not backed up into the cloud", opt.GetId())
+	}
+	backupId, ok := opt.GetMetadata()["backupId"]
+	if !ok {
+		return errors.New("can't find backupId in metadata")
+	}
+
+	mc, err := d.newBackupDriver(opt.GetMetadata()[model.BackupDriverKey])
+	if err != nil {
+		log.Errorf("get backup driver failed, err: %v", err)
+		return err
+	}
+	if err := mc.SetUp(); err != nil {
+		return err
+	}
+	defer mc.CleanUp()
+
+	b := &backup.BackupSpec{Metadata: map[string]string{"bucket": bucket}}
+	return backup.Verify(mc, b, backupId)
+}
+
+// InitializeSnapshotConnection returns the path of snapshot file, which is
+// attached read-only.
+func (d *Driver) InitializeSnapshotConnection(opt *pb.CreateSnapshotAttachmentOpts) (*model.ConnectionInfo, error) {
+	p, ok := opt.GetMetadata()[KSnapshotFilePath]
+	if !ok {
+		err := errors.New("can't find 'snapshotFilePath' in snapshot metadata")
+		log.Error(err)
+		return nil, err
+	}
+	data := d.connectionData(p)
+	data[connector.ReadOnly] = true
+	return &model.ConnectionInfo{
+		DriverVolumeType: connector.LocalDriver,
+		ConnectionData:   data,
+	}, nil
+}
+
+func (d *Driver) TerminateSnapshotConnection(opt *pb.DeleteSnapshotAttachmentOpts) error {
+	return nil
+}
+
+func (d *Driver) CreateVolumeGroup(opt *pb.CreateVolumeGroupOpts) (*model.VolumeGroupSpec, error) {
+	return nil, &model.NotImplementError{S: "method CreateVolumeGroup has not been implemented yet"}
+}
+
+func (d *Driver) UpdateVolumeGroup(opt *pb.UpdateVolumeGroupOpts) (*model.VolumeGroupSpec, error) {
+	return nil, &model.NotImplementError{S: "method UpdateVolumeGroup has not been implemented yet"}
+}
+
+func (d *Driver) DeleteVolumeGroup(opt *pb.DeleteVolumeGroupOpts) error {
+	return &model.NotImplementError{S: "method DeleteVolumeGroup has not been implemented yet"}
+}
+
+// ListPools reports the capacity of the file systems which the directories of
+// pools are in.
+func (d *Driver) ListPools() ([]*model.StoragePoolSpec, error) {
+	var names []string
+	for name := range d.conf.Pool {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var pols []*model.StoragePoolSpec
+	for _, name := range names {
+		c, dir := d.conf.Pool[name], d.poolDir(name)
+		var fs syscall.Statfs_t
+		if err := syscall.Statfs(dir, &fs); err != nil {
+			log.Errorf("Failed to get capacity of pool %s: %v", name, err)
+			return nil, err
+		}
+
+		host, _ := os.Hostname()
+		pol := &model.StoragePoolSpec{
+			BaseModel: &model.BaseModel{
+				Id: uuid.NewV5(uuid.NamespaceOID, host+":"+dir).String(),
+			},
+			Name:             name,
+			TotalCapacity:    int64(fs.Blocks) * int64(fs.Bsize) >> sizeShiftBit,
+			FreeCapacity:     int64(fs.Bavail) * int64(fs.Bsize) >> sizeShiftBit,
+			StorageType:      c.StorageType,
+			Extras:           c.Extras,
+			AvailabilityZone: c.AvailabilityZone,
+		}
+		if pol.AvailabilityZone == "" {
+			pol.AvailabilityZone = defaultAZ
+		}
+		pol.Extras.DataStorage.ProvisioningPolicy = "Thin"
+		pols = append(pols, pol)
+	}
+	return pols, nil
+}
+
+// Capabilities declares the operations supported by file driver.
+func (d *Driver) Capabilities() model.DriverCapabilitiesSpec {
+	return model.DriverCapabilitiesSpec{
+		SnapshotAttachment: true,
+		Clone:              true,
+		OnlineExtend:       true,
+	}
+}
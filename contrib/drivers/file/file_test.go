@@ -0,0 +1,153 @@
+// Copyright (c) 2018 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+//    Licensed under the Apache License, Version 2.0 (the "License"); you may
+//    not use this file except in compliance with the License. You may obtain
+//    a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//    WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//    License for the specific language governing permissions and limitations
+//    under the License.
+
+package file
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/opensds/opensds/contrib/connector"
+	pb "github.com/opensds/opensds/pkg/model/proto"
+	"github.com/opensds/opensds/testutils/driver/conformance"
+)
+
+const testConfig = `
+directory: %s
+pool:
+  pool001:
+    storageType: block
+    extras:
+      ioConnectivity:
+        accessProtocol: local
+`
+
+// testDriver removes the directory of its pools when it's unset.
+type testDriver struct {
+	*Driver
+	dir string
+}
+
+func (d *testDriver) Unset() error {
+	defer os.RemoveAll(d.dir)
+	return d.Driver.Unset()
+}
+
+func newTestDriver(t *testing.T) *testDriver {
+	dir, err := ioutil.TempDir("", "file-driver-test-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	conf := filepath.Join(dir, "file.yaml")
+	content := []byte(fmt.Sprintf(testConfig, filepath.Join(dir, "pools")))
+	if err := ioutil.WriteFile(conf, content, 0644); err != nil {
+		os.RemoveAll(dir)
+		t.Fatal(err)
+	}
+
+	d := &Driver{ConfigPath: conf}
+	if err := d.Setup(); err != nil {
+		os.RemoveAll(dir)
+		t.Fatal("Setup file driver failed:", err)
+	}
+	return &testDriver{Driver: d, dir: dir}
+}
+
+func TestConformance(t *testing.T) {
+	conformance.Run(t, func(t *testing.T) conformance.VolumeDriver {
+		return newTestDriver(t)
+	})
+}
+
+func TestCreateVolumeFromSnapshot(t *testing.T) {
+	d := newTestDriver(t)
+	defer d.Unset()
+
+	vol, err := d.CreateVolume(&pb.CreateVolumeOpts{Id: "0001", Size: 1, PoolName: "pool001"})
+	if err != nil {
+		t.Fatal("Create volume failed:", err)
+	}
+	var data = []byte("data of volume")
+	if err := ioutil.WriteFile(vol.Metadata[KFilePath], data, 0644); err != nil {
+		t.Fatal(err)
+	}
+	snap, err := d.CreateSnapshot(&pb.CreateVolumeSnapshotOpts{
+		Id: "0002", VolumeId: vol.Id, Size: 1, Metadata: vol.Metadata,
+	})
+	if err != nil {
+		t.Fatal("Create snapshot failed:", err)
+	}
+
+	newVol, err := d.CreateVolume(&pb.CreateVolumeOpts{
+		Id:           "0003",
+		Size:         2,
+		PoolName:     "pool001",
+		SnapshotId:   snap.Id,
+		SnapshotSize: 1,
+		Metadata:     snap.Metadata,
+	})
+	if err != nil {
+		t.Fatal("Create volume from snapshot failed:", err)
+	}
+	p := newVol.Metadata[KFilePath]
+	f, err := os.Open(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	var got = make([]byte, len(data))
+	if _, err := f.Read(got); err != nil || string(got) != string(data) {
+		t.Errorf("Expected data %q of snapshot, got %q, %v", data, got, err)
+	}
+	if fi, _ := f.Stat(); fi.Size() != 2<<30 {
+		t.Errorf("Expected size of 2GB, got %d", fi.Size())
+	}
+}
+
+func TestInitializeConnection(t *testing.T) {
+	d := newTestDriver(t)
+	defer d.Unset()
+
+	vol, err := d.CreateVolume(&pb.CreateVolumeOpts{Id: "0001", Size: 1, PoolName: "pool001"})
+	if err != nil {
+		t.Fatal("Create volume failed:", err)
+	}
+	info, err := d.InitializeConnection(&pb.CreateVolumeAttachmentOpts{
+		VolumeId: vol.Id,
+		HostInfo: &pb.HostInfo{},
+		Metadata: vol.Metadata,
+	})
+	if err != nil {
+		t.Fatal("Initialize connection failed:", err)
+	}
+	if info.DriverVolumeType != connector.LocalDriver {
+		t.Errorf("Expected local connection, got %s", info.DriverVolumeType)
+	}
+	if p := info.ConnectionData[connector.Path]; p != vol.Metadata[KFilePath] {
+		t.Errorf("Expected path %s, got %v", vol.Metadata[KFilePath], p)
+	}
+}
+
+func TestVerifySnapshotBackupWithoutBackup(t *testing.T) {
+	d := newTestDriver(t)
+	defer d.Unset()
+
+	err := d.VerifySnapshotBackup(&pb.VerifyVolumeSnapshotOpts{Id: "0001"})
+	if err == nil {
+		t.Error("Expected error of verifying snapshot which is not backed up, got nil")
+	}
+}
@@ -201,7 +201,10 @@ func (c *DoradoClient) logout() error {
 	return c.request("DELETE", "/sessions", nil, nil)
 }
 
-func (c *DoradoClient) CreateVolume(name string, size int64, desc string, poolId string) (*Lun, error) {
+// CreateVolume creates a thin lun, whose data is compressed or deduplicated
+// inline by the array if asked, otherwise the defaults of the array apply.
+func (c *DoradoClient) CreateVolume(name string, size int64, desc string, poolId string,
+	compression, dedup bool) (*Lun, error) {
 	data := map[string]interface{}{
 		"NAME":        name,
 		"CAPACITY":    Gb2Sector(size),
@@ -210,6 +213,12 @@ func (c *DoradoClient) CreateVolume(name string, size int64, desc string, poolId
 		"PARENTID":    poolId,
 		"WRITEPOLICY": 1,
 	}
+	if compression {
+		data["ENABLECOMPRESSION"] = "true"
+	}
+	if dedup {
+		data["ENABLESMARTDEDUP"] = "true"
+	}
 	lun := &LunResp{}
 	err := c.request("POST", "/lun", data, lun)
 	return &lun.Data, err
@@ -280,12 +289,18 @@ func (c *DoradoClient) GetVolume(id string) (*Lun, error) {
 }
 
 func (c *DoradoClient) GetVolumeByName(name string) (*Lun, error) {
-	lun := &LunResp{}
-	err := c.request("GET", "/lun?filter=NAME::"+name, nil, lun)
+	luns := &LunsResp{}
+	err := c.request("GET", "/lun?filter=NAME::"+name, nil, luns)
 	if err != nil {
 		return nil, err
 	}
-	return &lun.Data, err
+	if len(luns.Data) == 0 {
+		return nil, &ArrayInnerError{Err: Error{
+			Code:        LunNotExist,
+			Description: fmt.Sprintf("lun %s not found", name),
+		}}
+	}
+	return &luns.Data[0], nil
 }
 func (c *DoradoClient) DeleteVolume(id string) error {
 	err := c.request("DELETE", "/lun/"+id, nil, nil)
@@ -337,7 +352,13 @@ func (c *DoradoClient) GetSnapshot(id string) (*Snapshot, error) {
 func (c *DoradoClient) GetSnapshotByName(name string) (*Snapshot, error) {
 	snap := &SnapshotsResp{}
 	err := c.request("GET", "/snapshot?filter=NAME::"+name, nil, snap)
-	return &snap.Data[0], err
+	if err != nil {
+		return nil, err
+	}
+	if len(snap.Data) == 0 {
+		return nil, fmt.Errorf("snapshot %s not found", name)
+	}
+	return &snap.Data[0], nil
 }
 
 func (c *DoradoClient) DeleteSnapshot(id string) error {
@@ -1131,6 +1152,180 @@ func (c *DoradoClient) CheckPairExist(id string) bool {
 	return err == nil
 }
 
+func (c *DoradoClient) FindHyperMetroDomain(name string) (*HyperMetroDomain, error) {
+	resp := &HyperMetroDomainsResp{}
+	if err := c.request("GET", "/HyperMetroDomain?range=[0-32]", nil, resp); err != nil {
+		log.Errorf("List HyperMetro domains failed, %v", err)
+		return nil, err
+	}
+	for _, d := range resp.Data {
+		if d.Name == name {
+			return &d, nil
+		}
+	}
+	return nil, fmt.Errorf("No HyperMetro domain with name %s was found.", name)
+}
+
+func (c *DoradoClient) CreateHyperMetroDomain(name string, rmtDev *RemoteDevice) (*HyperMetroDomain, error) {
+	data := map[string]interface{}{
+		"NAME":       name,
+		"DOMAINTYPE": HyperMetroDomainTypeAA,
+		"REMOTEDEVICES": []map[string]string{
+			{"devId": rmtDev.Id, "devESN": rmtDev.Sn, "devName": rmtDev.Name},
+		},
+	}
+	resp := &HyperMetroDomainResp{}
+	if err := c.request("POST", "/HyperMetroDomain", data, resp); err != nil {
+		log.Errorf("Create HyperMetro domain failed, %v", err)
+		return nil, err
+	}
+	return &resp.Data, nil
+}
+
+func (c *DoradoClient) CreateHyperMetroPair(domainId, localLunId, rmtLunId string) (*HyperMetroPair, error) {
+	data := map[string]interface{}{
+		"DOMAINID":       domainId,
+		"HCRESOURCETYPE": HyperMetroResourceLun,
+		"ISFIRSTSYNC":    false,
+		"LOCALOBJID":     localLunId,
+		"REMOTEOBJID":    rmtLunId,
+		"SPEED":          HyperMetroSpeed,
+	}
+	resp := &HyperMetroPairResp{}
+	if err := c.request("POST", "/HyperMetroPair", data, resp); err != nil {
+		log.Errorf("Create HyperMetro pair failed, %v", err)
+		return nil, err
+	}
+	return &resp.Data, nil
+}
+
+func (c *DoradoClient) GetHyperMetroPair(id string) (*HyperMetroPair, error) {
+	resp := &HyperMetroPairResp{}
+	if err := c.request("GET", "/HyperMetroPair/"+id, nil, resp); err != nil {
+		log.Errorf("Get HyperMetro pair failed, %v", err)
+		return nil, err
+	}
+	return &resp.Data, nil
+}
+
+// FindHyperMetroPairByLun returns the HyperMetro pair which the lun of array
+// belongs to, or nil if the lun is not in any pair.
+func (c *DoradoClient) FindHyperMetroPairByLun(lunId string) (*HyperMetroPair, error) {
+	resp := &HyperMetroPairsResp{}
+	if err := c.request("GET", "/HyperMetroPair?filter=LOCALOBJID::"+lunId, nil, resp); err != nil {
+		log.Errorf("Get HyperMetro pair by lun %s failed, %v", lunId, err)
+		return nil, err
+	}
+	for _, p := range resp.Data {
+		if p.LocalObjId == lunId {
+			return &p, nil
+		}
+	}
+	return nil, nil
+}
+
+func (c *DoradoClient) SyncHyperMetroPair(id string) error {
+	data := map[string]interface{}{"ID": id, "TYPE": HyperMetroPairType}
+	err := c.request("PUT", "/HyperMetroPair/synchronize_hcpair", data, nil)
+	if err != nil {
+		log.Errorf("Sync HyperMetro pair failed, %v", err)
+	}
+	return err
+}
+
+// StopHyperMetroPair pauses the HyperMetro pair, the lun of the array which
+// the request is sent to keeps serving the hosts if isPrimary is true,
+// otherwise the one of the remote array does.
+func (c *DoradoClient) StopHyperMetroPair(id string, isPrimary bool) error {
+	data := map[string]interface{}{"ID": id, "TYPE": HyperMetroPairType, "ISPRIMARY": isPrimary}
+	err := c.request("PUT", "/HyperMetroPair/disable_hcpair", data, nil)
+	if err != nil {
+		log.Errorf("Stop HyperMetro pair failed, %v", err)
+	}
+	return err
+}
+
+func (c *DoradoClient) DeleteHyperMetroPair(id string) error {
+	return c.request("DELETE", "/HyperMetroPair/"+id, nil, nil)
+}
+
+func (c *DoradoClient) CheckHyperMetroPairExist(id string) bool {
+	resp := &SimpleResp{}
+	err := c.request("GET", "/HyperMetroPair/"+id, nil, resp)
+	return err == nil
+}
+
+// CreateQosPolicy creates a SmartQoS policy which limits the luns in the
+// list, the bandwidth is in MB/s and zero means no limit.
+func (c *DoradoClient) CreateQosPolicy(name string, lunIds []string, maxIOPS, maxBWS int64) (*QosPolicy, error) {
+	data := map[string]interface{}{
+		"NAME":              name,
+		"LUNLIST":           lunIds,
+		"CLASSTYPE":         QosClassTypeNormal,
+		"IOTYPE":            QosIOTypeReadWrite,
+		"SCHEDULEPOLICY":    QosSchedulePolicyAlways,
+		"SCHEDULESTARTTIME": "1410969600",
+		"STARTTIME":         "00:00",
+		"DURATION":          "86400",
+	}
+	if maxIOPS > 0 {
+		data["MAXIOPS"] = maxIOPS
+	}
+	if maxBWS > 0 {
+		data["MAXBANDWIDTH"] = maxBWS
+	}
+	resp := &QosPolicyResp{}
+	if err := c.request("POST", "/ioclass", data, resp); err != nil {
+		log.Errorf("Create qos policy %s failed, %v", name, err)
+		return nil, err
+	}
+	return &resp.Data, nil
+}
+
+func (c *DoradoClient) GetQosPolicy(id string) (*QosPolicy, error) {
+	resp := &QosPolicyResp{}
+	if err := c.request("GET", "/ioclass/"+id, nil, resp); err != nil {
+		log.Errorf("Get qos policy %s failed, %v", id, err)
+		return nil, err
+	}
+	return &resp.Data, nil
+}
+
+// UpdateQosPolicy changes the limits of SmartQoS policy, the limit which is
+// zero is removed from the policy.
+func (c *DoradoClient) UpdateQosPolicy(id string, maxIOPS, maxBWS int64) error {
+	data := map[string]interface{}{
+		"ID":           id,
+		"MAXIOPS":      maxIOPS,
+		"MAXBANDWIDTH": maxBWS,
+	}
+	err := c.request("PUT", "/ioclass/"+id, data, nil)
+	if err != nil {
+		log.Errorf("Update qos policy %s failed, %v", id, err)
+	}
+	return err
+}
+
+func (c *DoradoClient) ActivateQosPolicy(id string, enable bool) error {
+	status := QosDisable
+	if enable {
+		status = QosEnable
+	}
+	data := map[string]interface{}{
+		"ID":           id,
+		"ENABLESTATUS": status,
+	}
+	err := c.request("PUT", "/ioclass/active/"+id, data, nil)
+	if err != nil {
+		log.Errorf("Set enable status of qos policy %s to %s failed, %v", id, status, err)
+	}
+	return err
+}
+
+func (c *DoradoClient) DeleteQosPolicy(id string) error {
+	return c.request("DELETE", "/ioclass/"+id, nil, nil)
+}
+
 const FC_INIT_ONLINE = "27"
 
 func (c *DoradoClient) GetHostOnlineFCInitiators(hostId string) ([]string, error) {
@@ -1355,8 +1550,17 @@ var (
 	SnapshotType      = "27"
 	ObjectUnavailable = 1077948996
 	HostGroupNotExist = 1077937500
+	LunNotExist       = 1077936859
+	SnapshotNotExist  = 1077937880
 )
 
+// isArrayError returns whether the error is reported by the array with the
+// error code.
+func isArrayError(err error, code int) bool {
+	inErr, ok := err.(*ArrayInnerError)
+	return ok && inErr.Err.Code == code
+}
+
 func (c *DoradoClient) getObjectCountFromLungroup(lunGrpId string) (int, error) {
 	lunCount, err := c.getObjCountFromLungroupByType(lunGrpId, LunType)
 	if err != nil {
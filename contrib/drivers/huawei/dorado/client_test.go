@@ -0,0 +1,56 @@
+// Copyright (c) 2018 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+//    Licensed under the Apache License, Version 2.0 (the "License"); you may
+//    not use this file except in compliance with the License. You may obtain
+//    a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//    WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//    License for the specific language governing permissions and limitations
+//    under the License.
+
+package dorado
+
+import (
+	"net/http/httptest"
+	"testing"
+)
+
+// newFakeClient returns the client logged in to the fake array.
+func newFakeClient(t *testing.T, array *fakeArray) (*DoradoClient, func()) {
+	srv := httptest.NewServer(array)
+	client, err := NewClient(&AuthOptions{Endpoints: srv.URL})
+	if err != nil {
+		srv.Close()
+		t.Fatal("Login to fake array failed:", err)
+	}
+	return client, srv.Close
+}
+
+func TestCreateVolumeDataReduction(t *testing.T) {
+	array := newFakeArray()
+	client, clean := newFakeClient(t, array)
+	defer clean()
+
+	// The defaults of the array apply unless the data reduction is asked.
+	if _, err := client.CreateVolume("vol1", 1, "", "0", false, false); err != nil {
+		t.Fatal("Create volume failed:", err)
+	}
+	for _, key := range []string{"ENABLECOMPRESSION", "ENABLESMARTDEDUP"} {
+		if v, ok := array.lunData[key]; ok {
+			t.Errorf("Expected %s not to be sent, got %v", key, v)
+		}
+	}
+
+	if _, err := client.CreateVolume("vol2", 1, "", "0", true, true); err != nil {
+		t.Fatal("Create volume failed:", err)
+	}
+	for _, key := range []string{"ENABLECOMPRESSION", "ENABLESMARTDEDUP"} {
+		if v := array.lunData[key]; v != "true" {
+			t.Errorf("Expected %s to be true, got %v", key, v)
+		}
+	}
+}
@@ -22,6 +22,12 @@ type AuthOptions struct {
 
 type Replication struct {
 	RemoteAuthOpt AuthOptions `yaml:"remoteAuthOptions"`
+	// RemoteTargetIp is the iscsi target ip of the remote array, through
+	// which the luns of HyperMetro pairs on the remote array are attached.
+	RemoteTargetIp string `yaml:"remoteTargetIp,omitempty"`
+	// HyperMetroDomain is the name of HyperMetro domain which the HyperMetro
+	// pairs are created in, it's created if not exist.
+	HyperMetroDomain string `yaml:"hyperMetroDomain,omitempty"`
 }
 
 type DoradoConfig struct {
@@ -72,3 +78,18 @@ func Sector2Gb(sec string) int64 {
 func Gb2Sector(gb int64) int64 {
 	return gb * UnitGi / 512
 }
+
+// ParseRatio converts the ratio reported by array, such as "2.5:1" or "2.5",
+// to number, zero is returned if it's not reported.
+func ParseRatio(ratio string) float64 {
+	ratio = strings.TrimSuffix(strings.TrimSpace(ratio), ":1")
+	if ratio == "" {
+		return 0
+	}
+	r, err := strconv.ParseFloat(ratio, 64)
+	if err != nil {
+		log.Error("Convert ratio from string to number failed, error:", err)
+		return 0
+	}
+	return r
+}
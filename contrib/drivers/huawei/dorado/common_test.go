@@ -143,3 +143,20 @@ func TestWaitForCondition(t *testing.T) {
 		t.Errorf("Test WaitForCondition failed, %v", err)
 	}
 }
+
+func TestParseRatio(t *testing.T) {
+	testCases := []struct {
+		ratio    string
+		expected float64
+	}{
+		{"2.5:1", 2.5},
+		{"3", 3},
+		{"", 0},
+		{"invalid", 0},
+	}
+	for _, tc := range testCases {
+		if r := ParseRatio(tc.ratio); r != tc.expected {
+			t.Errorf("Expected ratio %v of %q, got %v", tc.expected, tc.ratio, r)
+		}
+	}
+}
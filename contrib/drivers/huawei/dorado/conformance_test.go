@@ -0,0 +1,191 @@
+// Copyright (c) 2018 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+//    Licensed under the Apache License, Version 2.0 (the "License"); you may
+//    not use this file except in compliance with the License. You may obtain
+//    a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//    WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//    License for the specific language governing permissions and limitations
+//    under the License.
+
+package dorado
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	. "github.com/opensds/opensds/contrib/drivers/utils/config"
+	"github.com/opensds/opensds/testutils/driver/conformance"
+)
+
+const fakeDeviceId = "fake-device"
+
+// fakeArray emulates the rest api of the array which the driver requests for
+// managing luns and snapshots, the capacities are in sectors as the real one.
+type fakeArray struct {
+	sync.Mutex
+	seq   int
+	luns  map[string]*Lun
+	snaps map[string]*Snapshot
+	// lunData is the data of the last lun creation request.
+	lunData map[string]interface{}
+	// errs are the error codes returned to the requests of "METHOD path".
+	errs map[string]int
+}
+
+func newFakeArray() *fakeArray {
+	return &fakeArray{luns: map[string]*Lun{}, snaps: map[string]*Snapshot{}, errs: map[string]int{}}
+}
+
+func (a *fakeArray) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	a.Lock()
+	defer a.Unlock()
+
+	var in map[string]interface{}
+	json.NewDecoder(r.Body).Decode(&in)
+	data, code := a.handle(r.Method, r.URL.Path, r.URL.Query().Get("filter"), in)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"data":  data,
+		"error": Error{Code: code},
+	})
+}
+
+func (a *fakeArray) handle(method, path, filter string, in map[string]interface{}) (interface{}, int) {
+	if path == "/xxxxx/sessions" {
+		return Auth{DeviceId: fakeDeviceId, IBaseToken: "fake-token"}, 0
+	}
+	path = strings.TrimPrefix(path, "/"+fakeDeviceId)
+	if code, ok := a.errs[method+" "+path]; ok {
+		return nil, code
+	}
+	name := strings.TrimPrefix(filter, "NAME::")
+	switch {
+	case path == "/sessions":
+		return nil, 0
+	case path == "/storagepool":
+		return []StoragePool{{
+			Id:                "0",
+			Name:              "StoragePool001",
+			UserTotalCapacity: fmt.Sprint(Gb2Sector(100)),
+			UserFreeCapacity:  fmt.Sprint(Gb2Sector(100) - a.usedSectors()),
+		}}, 0
+	case path == "/lun" && method == "POST":
+		a.lunData = in
+		a.seq++
+		lun := &Lun{
+			Id:            strconv.Itoa(a.seq),
+			Name:          in["NAME"].(string),
+			Capacity:      fmt.Sprint(int64(in["CAPACITY"].(float64))),
+			HealthStatus:  StatusHealth,
+			RunningStatus: StatusVolumeReady,
+		}
+		a.luns[lun.Id] = lun
+		return lun, 0
+	case path == "/lun" && method == "GET":
+		var luns = []Lun{}
+		for _, lun := range a.luns {
+			if lun.Name == name {
+				luns = append(luns, *lun)
+			}
+		}
+		return luns, 0
+	case path == "/lun/expand":
+		lun, ok := a.luns[in["ID"].(string)]
+		if !ok {
+			return nil, LunNotExist
+		}
+		lun.Capacity = fmt.Sprint(int64(in["CAPACITY"].(float64)))
+		return nil, 0
+	case strings.HasPrefix(path, "/lun/"):
+		id := strings.TrimPrefix(path, "/lun/")
+		lun, ok := a.luns[id]
+		if !ok {
+			return nil, LunNotExist
+		}
+		if method == "DELETE" {
+			delete(a.luns, id)
+		}
+		return lun, 0
+	case path == "/snapshot" && method == "POST":
+		lun, ok := a.luns[in["PARENTID"].(string)]
+		if !ok {
+			return nil, LunNotExist
+		}
+		a.seq++
+		snap := &Snapshot{
+			Id:           strconv.Itoa(a.seq),
+			Name:         in["NAME"].(string),
+			ParentId:     lun.Id,
+			UserCapacity: lun.Capacity,
+		}
+		a.snaps[snap.Id] = snap
+		return snap, 0
+	case path == "/snapshot" && method == "GET":
+		var snaps = []Snapshot{}
+		for _, snap := range a.snaps {
+			if snap.Name == name {
+				snaps = append(snaps, *snap)
+			}
+		}
+		return snaps, 0
+	case strings.HasPrefix(path, "/snapshot/"):
+		id := strings.TrimPrefix(path, "/snapshot/")
+		snap, ok := a.snaps[id]
+		if !ok {
+			return nil, SnapshotNotExist
+		}
+		if method == "DELETE" {
+			delete(a.snaps, id)
+		}
+		return snap, 0
+	}
+	return nil, ObjectUnavailable
+}
+
+func (a *fakeArray) usedSectors() int64 {
+	var used int64
+	for _, lun := range a.luns {
+		c, _ := strconv.ParseInt(lun.Capacity, 10, 64)
+		used += c
+	}
+	return used
+}
+
+// fakeArrayDriver shuts down the fake array after the driver logs out of it.
+type fakeArrayDriver struct {
+	*Driver
+	srv *httptest.Server
+}
+
+func (d *fakeArrayDriver) Unset() error {
+	defer d.srv.Close()
+	return d.Driver.Unset()
+}
+
+func TestConformance(t *testing.T) {
+	conformance.Run(t, func(t *testing.T) conformance.VolumeDriver {
+		srv := httptest.NewServer(newFakeArray())
+		conf := &DoradoConfig{}
+		if _, err := Parse(conf, "testdata/dorado.yaml"); err != nil {
+			srv.Close()
+			t.Fatal("Parse dorado config failed:", err)
+		}
+		conf.Endpoints = srv.URL
+		client, err := NewClient(&conf.AuthOptions)
+		if err != nil {
+			srv.Close()
+			t.Fatal("Login to fake array failed:", err)
+		}
+		return &fakeArrayDriver{Driver: &Driver{conf: conf, client: client}, srv: srv}
+	})
+}
@@ -102,6 +102,7 @@ const (
 	MappingViewPrefix = "OpenSDS_MappingView_"
 	LunGroupPrefix    = "OpenSDS_LunGroup_"
 	HostGroupPrefix   = "OpenSDS_HostGroup_"
+	QosPolicyPrefix   = "OpenSDS_QoS_"
 )
 
 const (
@@ -134,3 +135,37 @@ const (
 	ReplicaDataStatusComplete   = "2"
 	ReplicaDataStatusIncomplete = "3"
 )
+
+const (
+	KHyperMetroPairId        = "huaweiHyperMetroPairId"        // HyperMetro pair
+	KHyperMetroDomainId      = "huaweiHyperMetroDomainId"      // HyperMetro domain
+	KHyperMetroHealthStatus  = "huaweiHyperMetroHealthStatus"  // health status of HyperMetro pair
+	KHyperMetroRunningStatus = "huaweiHyperMetroRunningStatus" // running status of HyperMetro pair
+)
+
+const (
+	DefaultHyperMetroDomain = "OpenSDS_HyperMetroDomain"
+	HyperMetroPairType      = "15361"
+	HyperMetroResourceLun   = "1"
+	HyperMetroDomainTypeAA  = "1"
+	HyperMetroSpeed         = "2"
+
+	HyperMetroRunningStatusNormal   = "1"
+	HyperMetroRunningStatusSync     = "23"
+	HyperMetroRunningStatusInvalid  = "35"
+	HyperMetroRunningStatusPause    = "41"
+	HyperMetroRunningStatusError    = "94"
+	HyperMetroRunningStatusToBeSync = "100"
+
+	HyperMetroHealthStatusNormal = "1"
+	HyperMetroHealthStatusFault  = "2"
+)
+
+// SmartQoS policy
+const (
+	QosClassTypeNormal      = "1"
+	QosIOTypeReadWrite      = "2"
+	QosSchedulePolicyAlways = "1"
+	QosEnable               = "true"
+	QosDisable              = "false"
+)
@@ -21,24 +21,30 @@ import (
 	"strings"
 
 	log "github.com/golang/glog"
+	"github.com/opensds/opensds/contrib/connector"
 	. "github.com/opensds/opensds/contrib/drivers/utils/config"
 	"github.com/opensds/opensds/pkg/model"
 	pb "github.com/opensds/opensds/pkg/model/proto"
 	"github.com/opensds/opensds/pkg/utils"
-	"github.com/opensds/opensds/pkg/utils/config"
 	"github.com/satori/go.uuid"
 )
 
 type Driver struct {
+	// ConfigPath is the path of driver configuration file of the backend.
+	ConfigPath string
+
 	conf   *DoradoConfig
 	client *DoradoClient
+	// rmtClient is the client of remote array, which is used to attach the
+	// luns of HyperMetro pairs on the remote array.
+	rmtClient *DoradoClient
 }
 
 func (d *Driver) Setup() (err error) {
 	// Read huawei dorado config file
 	conf := &DoradoConfig{}
 	d.conf = conf
-	path := config.CONF.OsdsDock.Backends.HuaweiDorado.ConfigPath
+	path := d.ConfigPath
 
 	if "" == path {
 		path = defaultConfPath
@@ -54,9 +60,45 @@ func (d *Driver) Setup() (err error) {
 
 func (d *Driver) Unset() error {
 	d.client.logout()
+	if d.rmtClient != nil {
+		d.rmtClient.logout()
+	}
 	return nil
 }
 
+// remoteClient returns the client of remote array, it's created when it's
+// used for the first time since only HyperMetro needs it.
+func (d *Driver) remoteClient() (*DoradoClient, error) {
+	if d.rmtClient != nil {
+		return d.rmtClient, nil
+	}
+	if d.conf.RemoteAuthOpt.Endpoints == "" {
+		return nil, errors.New("remote array is not configured")
+	}
+	client, err := NewClient(&d.conf.RemoteAuthOpt)
+	if err != nil {
+		log.Errorf("Get new remote client failed, %v", err)
+		return nil, err
+	}
+	d.rmtClient = client
+	return d.rmtClient, nil
+}
+
+// getHyperMetroPair returns the HyperMetro pair which the lun belongs to, or
+// nil if the lun is not in any pair which is serving the hosts.
+func (d *Driver) getHyperMetroPair(lunId string) *HyperMetroPair {
+	pair, err := d.client.FindHyperMetroPairByLun(lunId)
+	if err != nil || pair == nil {
+		return nil
+	}
+	if HyperMetroPairStatus(pair) != model.ReplicationEnabled {
+		log.Warningf("HyperMetro pair %s of lun %s is not serving, health status:%s, running status:%s",
+			pair.Id, lunId, pair.HealthStatus, pair.RunningStatus)
+		return nil
+	}
+	return pair
+}
+
 func (d *Driver) createVolumeFromSnapshot(opt *pb.CreateVolumeOpts) (*model.VolumeSpec, error) {
 	metadata := opt.GetMetadata()
 	if metadata["hypermetro"] == "true" && metadata["replication_enabled"] == "true" {
@@ -76,7 +118,7 @@ func (d *Driver) createVolumeFromSnapshot(opt *pb.CreateVolumeOpts) (*model.Volu
 	}
 
 	lun, err := d.client.CreateVolume(EncodeName(opt.GetId()), opt.GetSize(),
-		volumeDesc, poolId)
+		volumeDesc, poolId, opt.GetIsCompressed(), opt.GetIsDeduplicated())
 	if err != nil {
 		log.Error("Create Volume Failed:", err)
 		return nil, err
@@ -107,6 +149,11 @@ func (d *Driver) createVolumeFromSnapshot(opt *pb.CreateVolumeOpts) (*model.Volu
 		d.client.DeleteVolume(lun.Id)
 		return nil, err
 	}
+	if err = d.setLunQos(lun.Id, opt.GetQos()); err != nil {
+		log.Error("Set qos of volume failed:", err)
+		d.client.DeleteVolume(lun.Id)
+		return nil, err
+	}
 	return &model.VolumeSpec{
 		BaseModel: &model.BaseModel{
 			Id: opt.GetId(),
@@ -167,11 +214,17 @@ func (d *Driver) CreateVolume(opt *pb.CreateVolumeOpts) (*model.VolumeSpec, erro
 	if err != nil {
 		return nil, err
 	}
-	lun, err := d.client.CreateVolume(name, opt.GetSize(), desc, poolId)
+	lun, err := d.client.CreateVolume(name, opt.GetSize(), desc, poolId,
+		opt.GetIsCompressed(), opt.GetIsDeduplicated())
 	if err != nil {
 		log.Error("Create Volume Failed:", err)
 		return nil, err
 	}
+	if err := d.setLunQos(lun.Id, opt.GetQos()); err != nil {
+		log.Error("Set qos of volume failed:", err)
+		d.client.DeleteVolume(lun.Id)
+		return nil, err
+	}
 	log.Infof("Create volume %s (%s) success.", opt.GetName(), lun.Id)
 	return &model.VolumeSpec{
 		BaseModel: &model.BaseModel{
@@ -205,8 +258,29 @@ func (d *Driver) PullVolume(volID string) (*model.VolumeSpec, error) {
 }
 
 func (d *Driver) DeleteVolume(opt *pb.DeleteVolumeOpts) error {
-	lunId := opt.GetMetadata()[KLunId]
+	lunId, ok := opt.GetMetadata()[KLunId]
+	if !ok {
+		// The volume may fail before the lun id is recorded, look it up by
+		// the name derived from the volume id.
+		lun, err := d.client.GetVolumeByName(EncodeName(opt.GetId()))
+		if isArrayError(err, LunNotExist) {
+			log.Warningf("Volume %s does not exist, nothing to remove", opt.GetId())
+			return nil
+		}
+		if err != nil {
+			log.Errorf("Get lun of volume %s failed: %v", opt.GetId(), err)
+			return err
+		}
+		lunId = lun.Id
+	}
+	if err := d.setLunQos(lunId, nil); err != nil {
+		log.Warningf("Remove qos policy of volume %s failed, %v", opt.GetId(), err)
+	}
 	err := d.client.DeleteVolume(lunId)
+	if isArrayError(err, LunNotExist) {
+		log.Warningf("Lun %s of volume %s does not exist, nothing to remove", lunId, opt.GetId())
+		return nil
+	}
 	if err != nil {
 		log.Errorf("Delete volume failed, volume id =%s , Error:%s", opt.GetId(), err)
 		return err
@@ -236,9 +310,25 @@ func (d *Driver) ExtendVolume(opt *pb.ExtendVolumeOpts) (*model.VolumeSpec, erro
 	}, nil
 }
 
-func (d *Driver) getTargetInfo() (string, string, error) {
-	tgtIp := d.conf.TargetIp
-	resp, err := d.client.ListTgtPort()
+// UpdateVolumeQos changes the SmartQoS limits of the lun, it takes effect
+// immediately even if the lun is mapped to hosts.
+func (d *Driver) UpdateVolumeQos(opt *pb.UpdateVolumeQosOpts) (*model.VolumeSpec, error) {
+	lunId := opt.GetMetadata()[KLunId]
+	if err := d.setLunQos(lunId, opt.GetQos()); err != nil {
+		log.Errorf("Update qos of volume %s failed, %v", opt.GetId(), err)
+		return nil, err
+	}
+
+	log.Infof("Update qos of volume %s success.", opt.GetId())
+	return &model.VolumeSpec{
+		BaseModel: &model.BaseModel{
+			Id: opt.GetId(),
+		},
+	}, nil
+}
+
+func (d *Driver) getTargetInfo(client *DoradoClient, tgtIp string) (string, string, error) {
+	resp, err := client.ListTgtPort()
 	if err != nil {
 		return "", "", err
 	}
@@ -269,54 +359,93 @@ func (d *Driver) InitializeConnectionIscsi(opt *pb.CreateVolumeAttachmentOpts) (
 
 	lunId := opt.GetMetadata()[KLunId]
 	hostInfo := opt.GetHostInfo()
+	tgtIqn, tgtIp, tgtLun, err := d.mapLunIscsi(d.client, d.conf.TargetIp, lunId, hostInfo)
+	if err != nil {
+		return nil, err
+	}
+	connInfo := &model.ConnectionInfo{
+		DriverVolumeType: ISCSIProtocol,
+		ConnectionData: map[string]interface{}{
+			"targetDiscovered": true,
+			"targetIQN":        []string{tgtIqn},
+			"targetPortal":     []string{tgtIp + ":3260"},
+			"discard":          false,
+			"targetLun":        tgtLun,
+		},
+	}
+
+	// The luns of HyperMetro pair share the same identity, so the lun on the
+	// remote array is attached as another path of the same multipath device.
+	if pair := d.getHyperMetroPair(lunId); pair != nil {
+		rmtIqn, rmtIp, rmtLun, err := d.mapHyperMetroRemoteLun(pair, hostInfo)
+		if err != nil {
+			log.Warningf("Attach the lun of HyperMetro pair %s on remote array failed, "+
+				"only the local one is attached: %v", pair.Id, err)
+			return connInfo, nil
+		}
+		connInfo.ConnectionData["targetIQN"] = []string{tgtIqn, rmtIqn}
+		connInfo.ConnectionData["targetPortal"] = []string{tgtIp + ":3260", rmtIp + ":3260"}
+		connInfo.ConnectionData["targetLuns"] = []int{tgtLun, rmtLun}
+		connInfo.ConnectionData[connector.MultiPath] = true
+	}
+	return connInfo, nil
+}
+
+// mapLunIscsi maps the lun on the array of client to the host, and returns
+// the target iqn, target ip and host lun id through which the lun is
+// attached.
+func (d *Driver) mapLunIscsi(client *DoradoClient, targetIp, lunId string, hostInfo *pb.HostInfo) (string, string, int, error) {
 	// Create host if not exist.
-	hostId, err := d.client.AddHostWithCheck(hostInfo)
+	hostId, err := client.AddHostWithCheck(hostInfo)
 	if err != nil {
 		log.Errorf("Add host failed, host name =%s, error: %v", hostInfo.Host, err)
-		return nil, err
+		return "", "", -1, err
 	}
 
 	// Add initiator to the host.
-	if err = d.client.AddInitiatorToHostWithCheck(hostId, hostInfo.Initiator); err != nil {
+	if err = client.AddInitiatorToHostWithCheck(hostId, hostInfo.Initiator); err != nil {
 		log.Errorf("Add initiator to host failed, host id=%s, initiator=%s, error: %v", hostId, hostInfo.Initiator, err)
-		return nil, err
+		return "", "", -1, err
 	}
 
 	// Add host to hostgroup.
-	hostGrpId, err := d.client.AddHostToHostGroup(hostId)
+	hostGrpId, err := client.AddHostToHostGroup(hostId)
 	if err != nil {
 		log.Errorf("Add host to group failed, host id=%s, error: %v", hostId, err)
-		return nil, err
+		return "", "", -1, err
 	}
 
 	// Mapping lungroup and hostgroup to view.
-	if err = d.client.DoMapping(lunId, hostGrpId, hostId); err != nil {
+	if err = client.DoMapping(lunId, hostGrpId, hostId); err != nil {
 		log.Errorf("Do mapping failed, lun id=%s, hostGrpId=%s, hostId=%s, error: %v",
 			lunId, hostGrpId, hostId, err)
-		return nil, err
+		return "", "", -1, err
 	}
 
-	tgtIqn, tgtIp, err := d.getTargetInfo()
+	tgtIqn, tgtIp, err := d.getTargetInfo(client, targetIp)
 	if err != nil {
 		log.Error("Get the target info failed,", err)
-		return nil, err
+		return "", "", -1, err
 	}
-	tgtLun, err := d.client.GetHostLunId(hostId, lunId)
+	tgtLun, err := client.GetHostLunId(hostId, lunId)
 	if err != nil {
 		log.Error("Get the get host lun id failed,", err)
-		return nil, err
+		return "", "", -1, err
 	}
-	connInfo := &model.ConnectionInfo{
-		DriverVolumeType: ISCSIProtocol,
-		ConnectionData: map[string]interface{}{
-			"targetDiscovered": true,
-			"targetIQN":        []string{tgtIqn},
-			"targetPortal":     []string{tgtIp + ":3260"},
-			"discard":          false,
-			"targetLun":        tgtLun,
-		},
+	return tgtIqn, tgtIp, tgtLun, nil
+}
+
+// mapHyperMetroRemoteLun maps the lun of HyperMetro pair on the remote array
+// to the host.
+func (d *Driver) mapHyperMetroRemoteLun(pair *HyperMetroPair, hostInfo *pb.HostInfo) (string, string, int, error) {
+	if d.conf.RemoteTargetIp == "" {
+		return "", "", -1, errors.New("remote target ip is not configured")
 	}
-	return connInfo, nil
+	client, err := d.remoteClient()
+	if err != nil {
+		return "", "", -1, err
+	}
+	return d.mapLunIscsi(client, d.conf.RemoteTargetIp, pair.RemoteObjId, hostInfo)
 }
 
 func (d *Driver) TerminateConnection(opt *pb.DeleteVolumeAttachmentOpts) error {
@@ -331,28 +460,49 @@ func (d *Driver) TerminateConnection(opt *pb.DeleteVolumeAttachmentOpts) error {
 
 func (d *Driver) TerminateConnectionIscsi(opt *pb.DeleteVolumeAttachmentOpts) error {
 	lunId := opt.GetMetadata()[KLunId]
-	hostId, err := d.client.GetHostIdByName(opt.GetHostInfo().GetHost())
+	// The pair is looked up before unmapping the local lun, since the lun on
+	// the remote array is mapped to the host as well when it's attached.
+	pair, _ := d.client.FindHyperMetroPairByLun(lunId)
+	if err := d.unmapLunIscsi(d.client, lunId, opt.GetHostInfo()); err != nil {
+		return err
+	}
+	if pair != nil && d.conf.RemoteAuthOpt.Endpoints != "" {
+		client, err := d.remoteClient()
+		if err != nil {
+			return err
+		}
+		if err := d.unmapLunIscsi(client, pair.RemoteObjId, opt.GetHostInfo()); err != nil {
+			log.Warningf("Detach the lun of HyperMetro pair %s on remote array failed: %v", pair.Id, err)
+		}
+	}
+	return nil
+}
+
+// unmapLunIscsi removes the mapping of the lun on the array of client and
+// the host.
+func (d *Driver) unmapLunIscsi(client *DoradoClient, lunId string, hostInfo *pb.HostInfo) error {
+	hostId, err := client.GetHostIdByName(hostInfo.GetHost())
 	if err != nil {
 		return err
 	}
-	lunGrpId, _ := d.client.FindLunGroup(LunGroupPrefix + hostId)
-	hostGrpId, _ := d.client.FindHostGroup(HostGroupPrefix + hostId)
-	viewId, _ := d.client.FindMappingView(MappingViewPrefix + hostId)
+	lunGrpId, _ := client.FindLunGroup(LunGroupPrefix + hostId)
+	hostGrpId, _ := client.FindHostGroup(HostGroupPrefix + hostId)
+	viewId, _ := client.FindMappingView(MappingViewPrefix + hostId)
 	if viewId != "" {
-		d.client.RemoveLunGroupFromMappingView(viewId, lunGrpId)
-		d.client.RemoveHostGroupFromMappingView(viewId, hostGrpId)
-		d.client.DeleteMappingView(viewId)
+		client.RemoveLunGroupFromMappingView(viewId, lunGrpId)
+		client.RemoveHostGroupFromMappingView(viewId, hostGrpId)
+		client.DeleteMappingView(viewId)
 	}
 	if hostGrpId != "" {
-		d.client.RemoveHostFromHostGroup(hostGrpId, hostId)
-		d.client.DeleteHostGroup(hostGrpId)
+		client.RemoveHostFromHostGroup(hostGrpId, hostId)
+		client.DeleteHostGroup(hostGrpId)
 	}
 	if lunGrpId != "" {
-		d.client.RemoveLunFromLunGroup(lunGrpId, lunId)
-		d.client.DeleteLunGroup(lunGrpId)
+		client.RemoveLunFromLunGroup(lunGrpId, lunId)
+		client.DeleteLunGroup(lunGrpId)
 	}
-	d.client.RemoveIscsiFromHost(opt.GetHostInfo().GetInitiator())
-	d.client.DeleteHost(hostId)
+	client.RemoveIscsiFromHost(hostInfo.GetInitiator())
+	client.DeleteHost(hostId)
 	return nil
 }
 
@@ -371,7 +521,7 @@ func (d *Driver) CreateSnapshot(opt *pb.CreateVolumeSnapshotOpts) (*model.Volume
 		Name:        opt.GetName(),
 		Description: opt.GetDescription(),
 		VolumeId:    opt.GetVolumeId(),
-		Size:        0,
+		Size:        opt.GetSize(),
 		Metadata: map[string]string{
 			KSnapId: snap.Id,
 		},
@@ -386,11 +536,11 @@ func (d *Driver) PullSnapshot(id string) (*model.VolumeSnapshotSpec, error) {
 	}
 	return &model.VolumeSnapshotSpec{
 		BaseModel: &model.BaseModel{
-			Id: snap.Id,
+			Id: id,
 		},
 		Name:        snap.Name,
 		Description: snap.Description,
-		Size:        0,
+		Size:        Sector2Gb(snap.UserCapacity),
 		VolumeId:    snap.ParentId,
 	}, nil
 }
@@ -398,6 +548,10 @@ func (d *Driver) PullSnapshot(id string) (*model.VolumeSnapshotSpec, error) {
 func (d *Driver) DeleteSnapshot(opt *pb.DeleteVolumeSnapshotOpts) error {
 	id := opt.GetMetadata()[KSnapId]
 	err := d.client.DeleteSnapshot(id)
+	if isArrayError(err, SnapshotNotExist) {
+		log.Warningf("Snapshot %s does not exist, nothing to remove", opt.GetId())
+		return nil
+	}
 	if err != nil {
 		log.Errorf("Delete volume snapshot failed, volume snapshot id = %s , error: %v", opt.GetId(), err)
 		return err
@@ -430,6 +584,9 @@ func (d *Driver) ListPools() ([]*model.StoragePoolSpec, error) {
 			Extras:           c.Pool[p.Name].Extras,
 			AvailabilityZone: c.Pool[p.Name].AvailabilityZone,
 		}
+		if ds := pol.Extras.DataStorage; ds.IsCompressed || ds.IsDeduplicated {
+			pol.DataReductionRatio = ParseRatio(p.DataReductionRatio)
+		}
 		if pol.AvailabilityZone == "" {
 			pol.AvailabilityZone = defaultAZ
 		}
@@ -438,69 +595,129 @@ func (d *Driver) ListPools() ([]*model.StoragePoolSpec, error) {
 	return pols, nil
 }
 
+// Capabilities declares the operations supported by dorado driver.
+func (d *Driver) Capabilities() model.DriverCapabilitiesSpec {
+	return model.DriverCapabilitiesSpec{
+		Clone:        true,
+		Qos:          true,
+		OnlineExtend: true,
+		Replication:  true,
+	}
+}
+
 func (d *Driver) InitializeConnectionFC(opt *pb.CreateVolumeAttachmentOpts) (*model.ConnectionInfo, error) {
 	lunId := opt.GetMetadata()[KLunId]
 	hostInfo := opt.GetHostInfo()
+	tgtPortWWNs, initTargMap, tgtLun, err := d.mapLunFC(d.client, lunId, hostInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	fcInfo := &model.ConnectionInfo{
+		DriverVolumeType: FCProtocol,
+		ConnectionData: map[string]interface{}{
+			"targetDiscovered":     true,
+			"targetWWN":            tgtPortWWNs,
+			"volume_id":            opt.GetVolumeId(),
+			"initiator_target_map": initTargMap,
+			"description":          "huawei",
+			"host_name":            hostInfo.Host,
+			"targetLun":            tgtLun,
+		},
+	}
+
+	// The lun on the remote array of HyperMetro pair is attached as another
+	// path of the same multipath device, the same as through iSCSI.
+	if pair := d.getHyperMetroPair(lunId); pair != nil {
+		rmtWWNs, rmtTargMap, rmtLun, err := d.mapHyperMetroRemoteLunFC(pair, hostInfo)
+		if err != nil {
+			log.Warningf("Attach the lun of HyperMetro pair %s on remote array failed, "+
+				"only the local one is attached: %v", pair.Id, err)
+			return fcInfo, nil
+		}
+		var wwns []string
+		var luns []int
+		for _, wwn := range tgtPortWWNs {
+			wwns, luns = append(wwns, wwn), append(luns, tgtLun)
+		}
+		for _, wwn := range rmtWWNs {
+			wwns, luns = append(wwns, wwn), append(luns, rmtLun)
+		}
+		targMap := map[string][]string{}
+		for _, m := range []map[string][]string{initTargMap, rmtTargMap} {
+			for ini, tgts := range m {
+				targMap[ini] = append(targMap[ini], tgts...)
+			}
+		}
+		fcInfo.ConnectionData["targetWWN"] = wwns
+		fcInfo.ConnectionData["targetLuns"] = luns
+		fcInfo.ConnectionData["initiator_target_map"] = targMap
+		fcInfo.ConnectionData[connector.MultiPath] = true
+	}
+	return fcInfo, nil
+}
+
+// mapLunFC maps the lun on the array of client to the host, and returns the
+// target port wwns, the initiator target map and host lun id through which
+// the lun is attached.
+func (d *Driver) mapLunFC(client *DoradoClient, lunId string, hostInfo *pb.HostInfo) ([]string, map[string][]string, int, error) {
 	// Create host if not exist.
-	hostId, err := d.client.AddHostWithCheck(hostInfo)
+	hostId, err := client.AddHostWithCheck(hostInfo)
 	if err != nil {
 		log.Errorf("Add host failed, host name =%s, error: %v", hostInfo.Host, err)
-		return nil, err
+		return nil, nil, -1, err
 	}
 
 	// Add host to hostgroup.
-	hostGrpId, err := d.client.AddHostToHostGroup(hostId)
+	hostGrpId, err := client.AddHostToHostGroup(hostId)
 	if err != nil {
 		log.Errorf("Add host to group failed, host id=%s, error: %v", hostId, err)
-		return nil, err
+		return nil, nil, -1, err
 	}
 
 	// Not use FC switch
-	tgtPortWWNs, initTargMap, err := d.connectFCUseNoSwitch(opt, opt.GetHostInfo().GetInitiator(), hostId)
+	tgtPortWWNs, initTargMap, err := d.connectFCUseNoSwitch(client, hostInfo.GetInitiator(), hostId)
 	if err != nil {
-		return nil, err
+		return nil, nil, -1, err
 	}
 
 	// Mapping lungroup and hostgroup to view.
-	if err = d.client.DoMapping(lunId, hostGrpId, hostId); err != nil {
+	if err = client.DoMapping(lunId, hostGrpId, hostId); err != nil {
 		log.Errorf("Do mapping failed, lun id=%s, hostGrpId=%s, hostId=%s, error: %v",
 			lunId, hostGrpId, hostId, err)
-		return nil, err
+		return nil, nil, -1, err
 	}
 
-	tgtLun, err := d.client.GetHostLunId(hostId, lunId)
+	tgtLun, err := client.GetHostLunId(hostId, lunId)
 	if err != nil {
 		log.Error("Get the get host lun id failed,", err)
-		return nil, err
+		return nil, nil, -1, err
 	}
+	return tgtPortWWNs, initTargMap, tgtLun, nil
+}
 
-	fcInfo := &model.ConnectionInfo{
-		DriverVolumeType: FCProtocol,
-		ConnectionData: map[string]interface{}{
-			"targetDiscovered":     true,
-			"target_wwn":           tgtPortWWNs,
-			"volume_id":            opt.GetVolumeId(),
-			"initiator_target_map": initTargMap,
-			"description":          "huawei",
-			"host_name":            opt.GetHostInfo().Host,
-			"target_lun":           tgtLun,
-		},
+// mapHyperMetroRemoteLunFC maps the lun of HyperMetro pair on the remote
+// array to the host through fibre channel.
+func (d *Driver) mapHyperMetroRemoteLunFC(pair *HyperMetroPair, hostInfo *pb.HostInfo) ([]string, map[string][]string, int, error) {
+	client, err := d.remoteClient()
+	if err != nil {
+		return nil, nil, -1, err
 	}
-	return fcInfo, nil
+	return d.mapLunFC(client, pair.RemoteObjId, hostInfo)
 }
 
-func (d *Driver) connectFCUseNoSwitch(opt *pb.CreateVolumeAttachmentOpts, wwpns string, hostId string) ([]string, map[string][]string, error) {
+func (d *Driver) connectFCUseNoSwitch(client *DoradoClient, wwpns string, hostId string) ([]string, map[string][]string, error) {
 	wwns := strings.Split(wwpns, ",")
 
-	onlineWWNsInHost, err := d.client.GetHostOnlineFCInitiators(hostId)
+	onlineWWNsInHost, err := client.GetHostOnlineFCInitiators(hostId)
 	if err != nil {
 		return nil, nil, err
 	}
-	onlineFreeWWNs, err := d.client.GetOnlineFreeWWNs()
+	onlineFreeWWNs, err := client.GetOnlineFreeWWNs()
 	if err != nil {
 		return nil, nil, err
 	}
-	onlineFCInitiators, err := d.client.GetOnlineFCInitiatorOnArray()
+	onlineFCInitiators, err := client.GetOnlineFCInitiatorOnArray()
 	if err != nil {
 		return nil, nil, err
 	}
@@ -519,21 +736,21 @@ func (d *Driver) connectFCUseNoSwitch(opt *pb.CreateVolumeAttachmentOpts, wwpns
 
 	for _, wwn := range wwnsNew {
 		if !d.isInStringArray(wwn, onlineWWNsInHost) && !d.isInStringArray(wwn, onlineFreeWWNs) {
-			wwnsInHost, err := d.client.GetHostFCInitiators(hostId)
+			wwnsInHost, err := client.GetHostFCInitiators(hostId)
 			if err != nil {
 				return nil, nil, err
 			}
-			iqnsInHost, err := d.client.GetHostIscsiInitiators(hostId)
+			iqnsInHost, err := client.GetHostIscsiInitiators(hostId)
 			if err != nil {
 				return nil, nil, err
 			}
-			flag, err := d.client.IsHostAssociatedToHostgroup(hostId)
+			flag, err := client.IsHostAssociatedToHostgroup(hostId)
 			if err != nil {
 				return nil, nil, err
 			}
 
 			if wwnsInHost == nil && iqnsInHost == nil && flag == false {
-				if err = d.client.RemoveHost(hostId); err != nil {
+				if err = client.RemoveHost(hostId); err != nil {
 					return nil, nil, err
 				}
 			}
@@ -546,13 +763,13 @@ func (d *Driver) connectFCUseNoSwitch(opt *pb.CreateVolumeAttachmentOpts, wwpns
 
 	for _, wwn := range wwnsNew {
 		if d.isInStringArray(wwn, onlineFreeWWNs) {
-			if err = d.client.AddFCPortTohost(hostId, wwn); err != nil {
+			if err = client.AddFCPortTohost(hostId, wwn); err != nil {
 				return nil, nil, err
 			}
 		}
 	}
 
-	tgtPortWWNs, initTargMap, err := d.client.GetIniTargMap(wwnsNew)
+	tgtPortWWNs, initTargMap, err := client.GetIniTargMap(wwnsNew)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -571,35 +788,50 @@ func (d *Driver) isInStringArray(s string, source []string) bool {
 }
 
 func (d *Driver) TerminateConnectionFC(opt *pb.DeleteVolumeAttachmentOpts) error {
+	lunId := opt.GetMetadata()[KLunId]
+	// The pair is looked up before detaching the local lun, since the lun on
+	// the remote array is mapped to the host as well when it's attached.
+	pair, _ := d.client.FindHyperMetroPairByLun(lunId)
 	// Detach lun
-	fcInfo, err := d.detachVolumeFC(opt)
+	fcInfo, err := d.detachVolumeFC(d.client, lunId, opt.GetHostInfo())
 	if err != nil {
 		return err
 	}
 	log.Info(fmt.Sprintf("terminate connection fc, return data is: %s", fcInfo))
+
+	if pair != nil && d.conf.RemoteAuthOpt.Endpoints != "" {
+		client, err := d.remoteClient()
+		if err != nil {
+			return err
+		}
+		if _, err := d.detachVolumeFC(client, pair.RemoteObjId, opt.GetHostInfo()); err != nil {
+			log.Warningf("Detach the lun of HyperMetro pair %s on remote array failed: %v", pair.Id, err)
+		}
+	}
 	return nil
 }
 
-func (d *Driver) detachVolumeFC(opt *pb.DeleteVolumeAttachmentOpts) (string, error) {
-	wwns := strings.Split(opt.GetHostInfo().GetInitiator(), ",")
-	lunId := opt.GetMetadata()[KLunId]
+// detachVolumeFC removes the mapping of the lun on the array of client and
+// the host.
+func (d *Driver) detachVolumeFC(client *DoradoClient, lunId string, hostInfo *pb.HostInfo) (string, error) {
+	wwns := strings.Split(hostInfo.GetInitiator(), ",")
 
 	log.Infof("terminate connection, wwpns: %s,lun id: %s", wwns, lunId)
 
-	hostId, lunGrpId, hostGrpId, viewId, err := d.getMappedInfo(opt.GetHostInfo().GetHost())
+	hostId, lunGrpId, hostGrpId, viewId, err := d.getMappedInfo(client, hostInfo.GetHost())
 	if err != nil {
 		return "", err
 	}
 
 	if lunId != "" && lunGrpId != "" {
-		if err := d.client.RemoveLunFromLunGroup(lunGrpId, lunId); err != nil {
+		if err := client.RemoveLunFromLunGroup(lunGrpId, lunId); err != nil {
 			return "", err
 		}
 	}
 
 	var leftObjectCount = -1
 	if lunGrpId != "" {
-		if leftObjectCount, err = d.client.getObjectCountFromLungroup(lunGrpId); err != nil {
+		if leftObjectCount, err = client.getObjectCountFromLungroup(lunGrpId); err != nil {
 			return "", err
 		}
 	}
@@ -608,11 +840,11 @@ func (d *Driver) detachVolumeFC(opt *pb.DeleteVolumeAttachmentOpts) (string, err
 	if leftObjectCount > 0 {
 		fcInfo = "driver_volume_type: fibre_channel, data: {}"
 	} else {
-		if fcInfo, err = d.deleteZoneAndRemoveFCInitiators(wwns, hostId, hostGrpId, viewId); err != nil {
+		if fcInfo, err = d.deleteZoneAndRemoveFCInitiators(client, wwns, hostId, hostGrpId, viewId); err != nil {
 			return "", err
 		}
 
-		if err := d.clearHostRelatedResource(lunGrpId, viewId, hostId, hostGrpId); err != nil {
+		if err := d.clearHostRelatedResource(client, lunGrpId, viewId, hostId, hostGrpId); err != nil {
 			return "", err
 		}
 	}
@@ -621,25 +853,25 @@ func (d *Driver) detachVolumeFC(opt *pb.DeleteVolumeAttachmentOpts) (string, err
 	return fcInfo, nil
 }
 
-func (d *Driver) deleteZoneAndRemoveFCInitiators(wwns []string, hostId, hostGrpId, viewId string) (string, error) {
-	tgtPortWWNs, initTargMap, err := d.client.GetIniTargMap(wwns)
+func (d *Driver) deleteZoneAndRemoveFCInitiators(client *DoradoClient, wwns []string, hostId, hostGrpId, viewId string) (string, error) {
+	tgtPortWWNs, initTargMap, err := client.GetIniTargMap(wwns)
 	if err != nil {
 		return "", err
 	}
 
 	// Remove the initiators from host if need.
-	hostGroupNum, err := d.client.getHostGroupNumFromHost(hostId)
+	hostGroupNum, err := client.getHostGroupNumFromHost(hostId)
 	if err != nil {
 		return "", err
 	}
 	if hostGrpId != "" && hostGroupNum <= 1 || (hostGrpId == "" && hostGroupNum <= 0) {
-		fcInitiators, err := d.client.GetHostFCInitiators(hostId)
+		fcInitiators, err := client.GetHostFCInitiators(hostId)
 		if err != nil {
 			return "", err
 		}
 		for _, wwn := range wwns {
 			if d.isInStringArray(wwn, fcInitiators) {
-				if err := d.client.removeFCFromHost(wwn); err != nil {
+				if err := client.removeFCFromHost(wwn); err != nil {
 					return "", err
 				}
 			}
@@ -649,21 +881,21 @@ func (d *Driver) deleteZoneAndRemoveFCInitiators(wwns []string, hostId, hostGrpI
 	return fmt.Sprintf("driver_volume_type: fibre_channel, target_wwn: %s, initiator_target_map: %s", tgtPortWWNs, initTargMap), nil
 }
 
-func (d *Driver) getMappedInfo(hostName string) (string, string, string, string, error) {
-	hostId, err := d.client.GetHostIdByName(hostName)
+func (d *Driver) getMappedInfo(client *DoradoClient, hostName string) (string, string, string, string, error) {
+	hostId, err := client.GetHostIdByName(hostName)
 	if err != nil {
 		return "", "", "", "", err
 	}
 
-	lunGrpId, err := d.client.FindLunGroup(LunGroupPrefix + hostId)
+	lunGrpId, err := client.FindLunGroup(LunGroupPrefix + hostId)
 	if err != nil {
 		return "", "", "", "", err
 	}
-	hostGrpId, err := d.client.FindHostGroup(HostGroupPrefix + hostId)
+	hostGrpId, err := client.FindHostGroup(HostGroupPrefix + hostId)
 	if err != nil {
 		return "", "", "", "", err
 	}
-	viewId, err := d.client.FindMappingView(MappingViewPrefix + hostId)
+	viewId, err := client.FindMappingView(MappingViewPrefix + hostId)
 	if err != nil {
 		return "", "", "", "", err
 	}
@@ -671,55 +903,55 @@ func (d *Driver) getMappedInfo(hostName string) (string, string, string, string,
 	return hostId, lunGrpId, hostGrpId, viewId, nil
 }
 
-func (d *Driver) clearHostRelatedResource(lunGrpId, viewId, hostId, hostGrpId string) error {
+func (d *Driver) clearHostRelatedResource(client *DoradoClient, lunGrpId, viewId, hostId, hostGrpId string) error {
 	if lunGrpId != "" {
 		if viewId != "" {
-			d.client.RemoveLunGroupFromMappingView(viewId, lunGrpId)
+			client.RemoveLunGroupFromMappingView(viewId, lunGrpId)
 		}
-		d.client.DeleteLunGroup(lunGrpId)
+		client.DeleteLunGroup(lunGrpId)
 	}
 	if hostId != "" {
 		if hostGrpId != "" {
 
 			if viewId != "" {
-				d.client.RemoveHostGroupFromMappingView(viewId, hostGrpId)
+				client.RemoveHostGroupFromMappingView(viewId, hostGrpId)
 			}
 
-			views, err := d.client.getHostgroupAssociatedViews(hostGrpId)
+			views, err := client.getHostgroupAssociatedViews(hostGrpId)
 			if err != nil {
 				return err
 			}
 
 			if len(views) <= 0 {
-				if err := d.client.RemoveHostFromHostGroup(hostGrpId, hostId); err != nil {
+				if err := client.RemoveHostFromHostGroup(hostGrpId, hostId); err != nil {
 					return err
 				}
-				hosts, err := d.client.getHostsInHostgroup(hostGrpId)
+				hosts, err := client.getHostsInHostgroup(hostGrpId)
 				if err != nil {
 					return err
 				}
 
 				if len(hosts) <= 0 {
-					if err := d.client.DeleteHostGroup(hostGrpId); err != nil {
+					if err := client.DeleteHostGroup(hostGrpId); err != nil {
 						return err
 					}
 				}
 			}
 		}
 
-		flag, err := d.client.checkFCInitiatorsExistInHost(hostId)
+		flag, err := client.checkFCInitiatorsExistInHost(hostId)
 		if err != nil {
 			return err
 		}
 		if !flag {
-			if err := d.client.RemoveHost(hostId); err != nil {
+			if err := client.RemoveHost(hostId); err != nil {
 				return err
 			}
 		}
 	}
 
 	if viewId != "" {
-		if err := d.client.DeleteMappingView(viewId); err != nil {
+		if err := client.DeleteMappingView(viewId); err != nil {
 			return err
 		}
 	}
@@ -0,0 +1,61 @@
+// Copyright (c) 2018 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+//    Licensed under the Apache License, Version 2.0 (the "License"); you may
+//    not use this file except in compliance with the License. You may obtain
+//    a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//    WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//    License for the specific language governing permissions and limitations
+//    under the License.
+
+package dorado
+
+import (
+	"testing"
+
+	. "github.com/opensds/opensds/contrib/drivers/utils/config"
+	pb "github.com/opensds/opensds/pkg/model/proto"
+)
+
+// newFakeDriver returns the driver managing the fake array.
+func newFakeDriver(t *testing.T, array *fakeArray) (*Driver, func()) {
+	client, clean := newFakeClient(t, array)
+	conf := &DoradoConfig{}
+	if _, err := Parse(conf, "testdata/dorado.yaml"); err != nil {
+		clean()
+		t.Fatal("Parse dorado config failed:", err)
+	}
+	return &Driver{conf: conf, client: client}, clean
+}
+
+func TestDeleteVolumeWithoutLunId(t *testing.T) {
+	array := newFakeArray()
+	d, clean := newFakeDriver(t, array)
+	defer clean()
+
+	opt := &pb.DeleteVolumeOpts{Id: "bd5b12a8-a101-11e7-941e-d77981b584d8"}
+	if err := d.DeleteVolume(opt); err != nil {
+		t.Error("Expected no error of missing lun, got:", err)
+	}
+
+	// The lun may still exist if it can't be looked up.
+	array.errs["GET /lun"] = ObjectUnavailable
+	if err := d.DeleteVolume(opt); err == nil {
+		t.Error("Expected error of failed lun lookup, got nil")
+	}
+
+	delete(array.errs, "GET /lun")
+	if _, err := d.client.CreateVolume(EncodeName(opt.Id), 1, "", "0", false, false); err != nil {
+		t.Fatal("Create volume failed:", err)
+	}
+	if err := d.DeleteVolume(opt); err != nil {
+		t.Error("Delete volume failed:", err)
+	}
+	if len(array.luns) != 0 {
+		t.Errorf("Expected the lun to be deleted, got %d luns", len(array.luns))
+	}
+}
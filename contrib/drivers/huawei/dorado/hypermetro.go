@@ -0,0 +1,188 @@
+// Copyright (c) 2018 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+//    Licensed under the Apache License, Version 2.0 (the "License"); you may
+//    not use this file except in compliance with the License. You may obtain
+//    a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//    WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//    License for the specific language governing permissions and limitations
+//    under the License.
+
+package dorado
+
+import (
+	"fmt"
+
+	log "github.com/golang/glog"
+	"github.com/opensds/opensds/pkg/model"
+	"github.com/opensds/opensds/pkg/utils"
+)
+
+// HyperMetro keeps the luns of local and remote arrays in active-active
+// synchronous mirroring, both luns of the pair share the same identity and
+// can be written by the hosts at the same time.
+
+// HyperMetroDomainWithCheck returns the id of HyperMetro domain configured,
+// and creates it with the remote device if not exist.
+func (r *ReplicaPairMgr) HyperMetroDomainWithCheck() (string, error) {
+	name := r.conf.HyperMetroDomain
+	if name == "" {
+		name = DefaultHyperMetroDomain
+	}
+	if domain, err := r.localClient.FindHyperMetroDomain(name); err == nil {
+		return domain.Id, nil
+	}
+
+	wwn := r.TryGetRemoteWwn()
+	if wwn == "" {
+		return "", fmt.Errorf("get remote device wwn failed")
+	}
+	dev := r.TryGetRemoteDevByWwn(wwn)
+	if dev == nil {
+		return "", fmt.Errorf("get remote device info failed")
+	}
+	domain, err := r.localClient.CreateHyperMetroDomain(name, dev)
+	if err != nil {
+		return "", err
+	}
+	log.Infof("Create HyperMetro domain %s (%s) success", name, domain.Id)
+	return domain.Id, nil
+}
+
+func (r *ReplicaPairMgr) CreateHyperMetro(localLunId, rmtLunId string) (map[string]string, error) {
+	interval := DefaultReplicaWaitInterval
+	timeout := DefaultReplicaWaitTimeout
+
+	localLun, err := r.localClient.GetVolume(localLunId)
+	if err != nil {
+		return nil, err
+	}
+	if err = r.WaitVolumeOnline(r.localClient, localLun, interval, timeout); err != nil {
+		return nil, err
+	}
+	rmtLun, err := r.remoteClient.GetVolume(rmtLunId)
+	if err != nil {
+		return nil, err
+	}
+	if err = r.WaitVolumeOnline(r.remoteClient, rmtLun, interval, timeout); err != nil {
+		return nil, err
+	}
+
+	domainId, err := r.HyperMetroDomainWithCheck()
+	if err != nil {
+		log.Error("Get HyperMetro domain failed,", err)
+		return nil, err
+	}
+	pair, err := r.localClient.CreateHyperMetroPair(domainId, localLun.Id, rmtLun.Id)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.SyncHyperMetro(pair.Id); err != nil {
+		r.DeleteHyperMetro(pair.Id)
+		return nil, err
+	}
+
+	resp := map[string]string{
+		KHyperMetroPairId:   pair.Id,
+		KHyperMetroDomainId: domainId,
+	}
+	if pair, err = r.localClient.GetHyperMetroPair(pair.Id); err == nil {
+		resp[KHyperMetroHealthStatus] = pair.HealthStatus
+		resp[KHyperMetroRunningStatus] = pair.RunningStatus
+	}
+	log.Infof("Create HyperMetro pair %s of lun %s and %s success", resp[KHyperMetroPairId], localLunId, rmtLunId)
+	return resp, nil
+}
+
+func (r *ReplicaPairMgr) DeleteHyperMetro(pairId string) error {
+	if !r.localClient.CheckHyperMetroPairExist(pairId) {
+		log.Warningf("Specified HyperMetro pair (%s) does not exist, ignore it", pairId)
+		return nil
+	}
+	if err := r.StopHyperMetro(r.localClient, pairId, true); err != nil {
+		return err
+	}
+	return r.localClient.DeleteHyperMetroPair(pairId)
+}
+
+// SyncHyperMetro synchronizes the data of the pair, the luns on both arrays
+// serve the hosts again once the synchronization completes.
+func (r *ReplicaPairMgr) SyncHyperMetro(pairId string) error {
+	pair, err := r.localClient.GetHyperMetroPair(pairId)
+	if err != nil {
+		return err
+	}
+	if pair.RunningStatus != HyperMetroRunningStatusNormal &&
+		pair.RunningStatus != HyperMetroRunningStatusSync {
+		if err := r.localClient.SyncHyperMetroPair(pairId); err != nil {
+			return err
+		}
+	}
+	return r.WaitHyperMetroReady(pairId)
+}
+
+// StopHyperMetro pauses the pair, the lun on the array of the client keeps
+// serving the hosts if isPrimary is true, otherwise the one on the other
+// array does.
+func (r *ReplicaPairMgr) StopHyperMetro(client *DoradoClient, pairId string, isPrimary bool) error {
+	pair, err := client.GetHyperMetroPair(pairId)
+	if err != nil {
+		return err
+	}
+	if pair.RunningStatus == HyperMetroRunningStatusPause ||
+		pair.RunningStatus == HyperMetroRunningStatusInvalid {
+		return nil
+	}
+	return client.StopHyperMetroPair(pairId, isPrimary)
+}
+
+// FailoverHyperMetro pauses the pair on the remote array, so that only the
+// lun on the remote array serves the hosts.
+func (r *ReplicaPairMgr) FailoverHyperMetro(pairId string) error {
+	return r.StopHyperMetro(r.remoteClient, pairId, true)
+}
+
+// FailbackHyperMetro resynchronizes the data of the lun on the remote array
+// back to the local one and resumes the active-active mirroring.
+func (r *ReplicaPairMgr) FailbackHyperMetro(pairId string) error {
+	return r.SyncHyperMetro(pairId)
+}
+
+func (r *ReplicaPairMgr) WaitHyperMetroReady(pairId string) error {
+	log.Info("Wait HyperMetro synchronize complete.")
+	interval := DefaultReplicaWaitInterval
+	timeout := DefaultReplicaWaitTimeout
+	return utils.WaitForCondition(func() (bool, error) {
+		pair, err := r.localClient.GetHyperMetroPair(pairId)
+		if err != nil {
+			return false, nil
+		}
+		switch HyperMetroPairStatus(pair) {
+		case model.ReplicationEnabled:
+			return pair.RunningStatus == HyperMetroRunningStatusNormal, nil
+		case model.ReplicationError:
+			return false, fmt.Errorf("wait HyperMetro synchronize failed, health status:%s, running status:%s",
+				pair.HealthStatus, pair.RunningStatus)
+		}
+		return false, nil
+	}, interval, timeout)
+}
+
+// HyperMetroPairStatus reports the health of HyperMetro pair as the status
+// of replication.
+func HyperMetroPairStatus(pair *HyperMetroPair) string {
+	if pair.HealthStatus != HyperMetroHealthStatusNormal {
+		return model.ReplicationError
+	}
+	switch pair.RunningStatus {
+	case HyperMetroRunningStatusNormal, HyperMetroRunningStatusSync, HyperMetroRunningStatusToBeSync:
+		return model.ReplicationEnabled
+	case HyperMetroRunningStatusPause:
+		return model.ReplicationDisabled
+	}
+	return model.ReplicationError
+}
@@ -43,11 +43,12 @@ type AuthResp struct {
 }
 
 type StoragePool struct {
-	Description       string `json:"DESCRIPTION"`
-	Id                string `json:"ID"`
-	Name              string `json:"NAME"`
-	UserFreeCapacity  string `json:"USERFREECAPACITY"`
-	UserTotalCapacity string `json:"USERTOTALCAPACITY"`
+	Description        string `json:"DESCRIPTION"`
+	Id                 string `json:"ID"`
+	Name               string `json:"NAME"`
+	UserFreeCapacity   string `json:"USERFREECAPACITY"`
+	UserTotalCapacity  string `json:"USERTOTALCAPACITY"`
+	DataReductionRatio string `json:"DATAREDUCTIONRATIO"`
 }
 type StoragePoolsResp struct {
 	Data  []StoragePool `json:"data"`
@@ -69,6 +70,7 @@ type Lun struct {
 	ExtendIfSwitch              string `json:"EXTENDIFSWITCH"`
 	HealthStatus                string `json:"HEALTHSTATUS"`
 	Id                          string `json:"ID"`
+	IOClassId                   string `json:"IOCLASSID"`
 	IsAdd2LunGroup              string `json:"ISADD2LUNGROUP"`
 	IsCheckZeroPage             string `json:"ISCHECKZEROPAGE"`
 	IscsiThinLunThreshold       string `json:"ISCSITHINLUNTHRESHOLD"`
@@ -279,6 +281,7 @@ type RemoteDevice struct {
 	HealthStatus  string `json:"HEALTHSTATUS"`
 	RunningStatus string `json:"RUNNINGSTATUS"`
 	Wwn           string `json:"WWN"`
+	Sn            string `json:"SN"`
 }
 
 type RemoteDevicesResp struct {
@@ -324,6 +327,80 @@ type ReplicationPairResp struct {
 	Error Error           `json:"error"`
 }
 
+type HyperMetroDomain struct {
+	Id            string `json:"ID"`
+	Name          string `json:"NAME"`
+	DomainType    string `json:"DOMAINTYPE"`
+	HealthStatus  string `json:"HEALTHSTATUS"`
+	RunningStatus string `json:"RUNNINGSTATUS"`
+}
+
+type HyperMetroDomainResp struct {
+	Data  HyperMetroDomain `json:"data"`
+	Error Error            `json:"error"`
+}
+
+type HyperMetroDomainsResp struct {
+	Data  []HyperMetroDomain `json:"data"`
+	Error Error              `json:"error"`
+}
+
+type HyperMetroPair struct {
+	Id                string `json:"ID"`
+	DomainId          string `json:"DOMAINID"`
+	DomainName        string `json:"DOMAINNAME"`
+	HealthStatus      string `json:"HEALTHSTATUS"`
+	RunningStatus     string `json:"RUNNINGSTATUS"`
+	LocalObjId        string `json:"LOCALOBJID"`
+	RemoteObjId       string `json:"REMOTEOBJID"`
+	LocalDataState    string `json:"LOCALDATASTATE"`
+	RemoteDataState   string `json:"REMOTEDATASTATE"`
+	IsPrimary         string `json:"ISPRIMARY"`
+	IsInCg            string `json:"ISINCG"`
+	SyncProgress      string `json:"SYNCPROGRESS"`
+	SyncLeftTime      string `json:"SYNCLEFTTIME"`
+	Speed             string `json:"SPEED"`
+	RecoveryPolicy    string `json:"RECOVERYPOLICY"`
+	ResourceWwn       string `json:"RESOURCEWWN"`
+	HcResourceType    string `json:"HCRESOURCETYPE"`
+	Type              string `json:"TYPE"`
+	LinkStatus        string `json:"LINKSTATUS"`
+	CapacityByte      string `json:"CAPACITYBYTE"`
+	RemoteDeviceName  string `json:"REMOTEDEVICENAME"`
+	LocalHostAccess   string `json:"LOCALHOSTACCESSSTATE"`
+	RemoteHostAccess  string `json:"REMOTEHOSTACCESSSTATE"`
+	SynchronizeStatus string `json:"SYNCHRONIZESTATUS"`
+}
+
+type HyperMetroPairResp struct {
+	Data  HyperMetroPair `json:"data"`
+	Error Error          `json:"error"`
+}
+
+type HyperMetroPairsResp struct {
+	Data  []HyperMetroPair `json:"data"`
+	Error Error            `json:"error"`
+}
+
+type QosPolicy struct {
+	Id             string `json:"ID"`
+	Name           string `json:"NAME"`
+	LunList        string `json:"LUNLIST"`
+	MaxIOPS        string `json:"MAXIOPS"`
+	MaxBandWidth   string `json:"MAXBANDWIDTH"`
+	EnableStatus   string `json:"ENABLESTATUS"`
+	RunningStatus  string `json:"RUNNINGSTATUS"`
+	HealthStatus   string `json:"HEALTHSTATUS"`
+	ClassType      string `json:"CLASSTYPE"`
+	IOType         string `json:"IOTYPE"`
+	SchedulePolicy string `json:"SCHEDULEPOLICY"`
+}
+
+type QosPolicyResp struct {
+	Data  QosPolicy `json:"data"`
+	Error Error     `json:"error"`
+}
+
 type SimpleStruct struct {
 	Id   string `json:"ID"`
 	Name string `json:"NAME"`
@@ -0,0 +1,87 @@
+// Copyright (c) 2018 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+//    Licensed under the Apache License, Version 2.0 (the "License"); you may
+//    not use this file except in compliance with the License. You may obtain
+//    a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//    WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//    License for the specific language governing permissions and limitations
+//    under the License.
+
+package dorado
+
+import (
+	"fmt"
+
+	log "github.com/golang/glog"
+	pb "github.com/opensds/opensds/pkg/model/proto"
+)
+
+// Each lun limited by OpenSDS owns a SmartQoS policy named after the lun id,
+// so that the limits of a volume can be changed without touching others.
+
+// setLunQos makes the SmartQoS policy of the lun match the qos, the policy is
+// created when the lun is limited for the first time and removed when all
+// limits of the lun are cleared.
+func (d *Driver) setLunQos(lunId string, qos *pb.Qos) error {
+	lun, err := d.client.GetVolume(lunId)
+	if err != nil {
+		return err
+	}
+	maxIOPS, maxBWS := qos.GetMaxIOPS(), qos.GetMaxBWS()
+	limited := maxIOPS > 0 || maxBWS > 0
+
+	if lun.IOClassId == "" {
+		if !limited {
+			return nil
+		}
+		policy, err := d.client.CreateQosPolicy(QosPolicyPrefix+lunId, []string{lunId}, maxIOPS, maxBWS)
+		if err != nil {
+			return err
+		}
+		if err := d.client.ActivateQosPolicy(policy.Id, true); err != nil {
+			d.client.DeleteQosPolicy(policy.Id)
+			return err
+		}
+		log.Infof("Create qos policy %s of lun %s success, maxIOPS:%d, maxBWS:%d",
+			policy.Id, lunId, maxIOPS, maxBWS)
+		return nil
+	}
+
+	policy, err := d.client.GetQosPolicy(lun.IOClassId)
+	if err != nil {
+		return err
+	}
+	if policy.Name != QosPolicyPrefix+lunId {
+		return fmt.Errorf("lun %s is limited by qos policy %s which is not managed by OpenSDS", lunId, policy.Name)
+	}
+	if !limited {
+		return d.deleteLunQos(policy)
+	}
+	if err := d.client.UpdateQosPolicy(policy.Id, maxIOPS, maxBWS); err != nil {
+		return err
+	}
+	log.Infof("Update qos policy %s of lun %s success, maxIOPS:%d, maxBWS:%d",
+		policy.Id, lunId, maxIOPS, maxBWS)
+	return nil
+}
+
+// deleteLunQos deactivates and removes the SmartQoS policy, the array
+// refuses to delete an active policy.
+func (d *Driver) deleteLunQos(policy *QosPolicy) error {
+	if policy.RunningStatus == StatusQosActive {
+		if err := d.client.ActivateQosPolicy(policy.Id, false); err != nil {
+			return err
+		}
+	}
+	if err := d.client.DeleteQosPolicy(policy.Id); err != nil {
+		log.Errorf("Delete qos policy %s failed, %v", policy.Id, err)
+		return err
+	}
+	log.Infof("Delete qos policy %s success", policy.Id)
+	return nil
+}
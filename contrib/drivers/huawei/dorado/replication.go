@@ -25,11 +25,13 @@ import (
 	"github.com/opensds/opensds/pkg/model"
 	pb "github.com/opensds/opensds/pkg/model/proto"
 	"github.com/opensds/opensds/pkg/utils"
-	"github.com/opensds/opensds/pkg/utils/config"
 )
 
 // ReplicationDriver
 type ReplicationDriver struct {
+	// ConfigPath is the path of driver configuration file of the backend.
+	ConfigPath string
+
 	conf *DoradoConfig
 	mgr  *ReplicaPairMgr
 }
@@ -39,7 +41,7 @@ func (r *ReplicationDriver) Setup() (err error) {
 	// Read huawei dorado config file
 	conf := &DoradoConfig{}
 	r.conf = conf
-	path := config.CONF.OsdsDock.Backends.HuaweiDorado.ConfigPath
+	path := r.ConfigPath
 
 	if "" == path {
 		path = defaultConfPath
@@ -64,6 +66,20 @@ func (r *ReplicationDriver) CreateReplication(opt *pb.CreateReplicationOpts) (*m
 	}
 	pLunId := opt.PrimaryReplicationDriverData[KLunId]
 	sLunId := opt.SecondaryReplicationDriverData[KLunId]
+	// Active-active replication is provided by HyperMetro pair.
+	if opt.ReplicationMode == model.ReplicationModeActive {
+		resp, err := r.mgr.CreateHyperMetro(pLunId, sLunId)
+		if err != nil {
+			return nil, err
+		}
+		return &model.ReplicationSpec{
+			BaseModel: &model.BaseModel{
+				Id: opt.GetId(),
+			},
+			Metadata: resp,
+		}, nil
+	}
+
 	replicationPeriod := strconv.FormatInt(opt.ReplicationPeriod*60, 10)
 
 	replicationMode := ReplicaAsyncMode
@@ -88,6 +104,9 @@ func (r *ReplicationDriver) DeleteReplication(opt *pb.DeleteReplicationOpts) err
 	if !opt.GetIsPrimary() {
 		return nil
 	}
+	if metroId, ok := opt.GetMetadata()[KHyperMetroPairId]; ok {
+		return r.mgr.DeleteHyperMetro(metroId)
+	}
 	pairId, ok := opt.GetMetadata()[KPairId]
 	var sLunId string
 	if opt.SecondaryVolumeId == "" {
@@ -105,6 +124,9 @@ func (r *ReplicationDriver) EnableReplication(opt *pb.EnableReplicationOpts) err
 	if !opt.GetIsPrimary() {
 		return nil
 	}
+	if metroId, ok := opt.GetMetadata()[KHyperMetroPairId]; ok {
+		return r.mgr.SyncHyperMetro(metroId)
+	}
 	pairId, ok := opt.GetMetadata()[KPairId]
 	if !ok {
 		msg := fmt.Sprintf("Can find pair id in metadata")
@@ -118,6 +140,9 @@ func (r *ReplicationDriver) DisableReplication(opt *pb.DisableReplicationOpts) e
 	if !opt.GetIsPrimary() {
 		return nil
 	}
+	if metroId, ok := opt.GetMetadata()[KHyperMetroPairId]; ok {
+		return r.mgr.StopHyperMetro(r.mgr.localClient, metroId, true)
+	}
 	pairId, ok := opt.GetMetadata()[KPairId]
 	if !ok {
 		msg := fmt.Sprintf("Can find pair id in metadata")
@@ -131,6 +156,12 @@ func (r *ReplicationDriver) FailoverReplication(opt *pb.FailoverReplicationOpts)
 	if !opt.GetIsPrimary() {
 		return nil
 	}
+	if metroId, ok := opt.GetMetadata()[KHyperMetroPairId]; ok {
+		if opt.SecondaryBackendId == model.ReplicationDefaultBackendId {
+			return r.mgr.FailoverHyperMetro(metroId)
+		}
+		return r.mgr.FailbackHyperMetro(metroId)
+	}
 	pairId, ok := opt.GetMetadata()[KPairId]
 	if !ok {
 		msg := fmt.Sprintf("Can find pair id in metadata")
@@ -16,6 +16,8 @@ package dorado
 
 import (
 	"testing"
+
+	"github.com/opensds/opensds/pkg/model"
 )
 
 func TestLoadConf(t *testing.T) {
@@ -38,3 +40,23 @@ func TestFailoverReplication(t *testing.T) {
 func TestDeleteReplication(t *testing.T) {
 
 }
+
+func TestHyperMetroPairStatus(t *testing.T) {
+	testCases := []struct {
+		health, running, expected string
+	}{
+		{HyperMetroHealthStatusNormal, HyperMetroRunningStatusNormal, model.ReplicationEnabled},
+		{HyperMetroHealthStatusNormal, HyperMetroRunningStatusSync, model.ReplicationEnabled},
+		{HyperMetroHealthStatusNormal, HyperMetroRunningStatusToBeSync, model.ReplicationEnabled},
+		{HyperMetroHealthStatusNormal, HyperMetroRunningStatusPause, model.ReplicationDisabled},
+		{HyperMetroHealthStatusNormal, HyperMetroRunningStatusInvalid, model.ReplicationError},
+		{HyperMetroHealthStatusFault, HyperMetroRunningStatusNormal, model.ReplicationError},
+	}
+	for _, tc := range testCases {
+		pair := &HyperMetroPair{HealthStatus: tc.health, RunningStatus: tc.running}
+		if status := HyperMetroPairStatus(pair); status != tc.expected {
+			t.Errorf("Expected status %s of pair(health:%s, running:%s), got %s",
+				tc.expected, tc.health, tc.running, status)
+		}
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright (c) 2018 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+//    Licensed under the Apache License, Version 2.0 (the "License"); you may
+//    not use this file except in compliance with the License. You may obtain
+//    a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//    WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//    License for the specific language governing permissions and limitations
+//    under the License.
+
+package fusionstorage
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	. "github.com/opensds/opensds/contrib/drivers/utils/config"
+	"github.com/opensds/opensds/testutils/driver/conformance"
+)
+
+const fakeVersion = "v1.3"
+
+// fakeFusionStorage emulates the rest api of FusionStorage which the driver
+// requests for managing volumes and snapshots, the sizes are in MB as the
+// real one.
+type fakeFusionStorage struct {
+	sync.Mutex
+	vols  map[string]int64
+	snaps map[string]string
+}
+
+func newFakeFusionStorage() *fakeFusionStorage {
+	return &fakeFusionStorage{vols: map[string]int64{}, snaps: map[string]string{}}
+}
+
+func (f *fakeFusionStorage) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.Lock()
+	defer f.Unlock()
+
+	var in map[string]interface{}
+	json.NewDecoder(r.Body).Decode(&in)
+	path := strings.TrimPrefix(r.URL.Path, BasicURI)
+	out, code := f.handle(strings.TrimPrefix(path, fakeVersion), in)
+	if code != "" {
+		errCode, _ := strconv.Atoi(code)
+		out = map[string]interface{}{
+			"result": 1,
+			"detail": []detail{{ErrorCode: errCode}},
+		}
+	}
+	json.NewEncoder(w).Encode(out)
+}
+
+func (f *fakeFusionStorage) handle(path string, in map[string]interface{}) (interface{}, string) {
+	switch path {
+	case "rest/version":
+		return version{CurrentVersion: fakeVersion}, ""
+	case "/sec/login":
+		return responseResult{}, ""
+	case "/storagePool":
+		var used int64
+		for _, size := range f.vols {
+			used += size
+		}
+		return poolResp{Pools: []pool{{PoolId: 0, TotalCapacity: 100 << UnitGiShiftBit, UsedCapacity: used}}}, ""
+	case "/volume/create":
+		f.vols[in["volName"].(string)] = int64(in["volSize"].(float64))
+		return responseResult{}, ""
+	case "/volume/delete":
+		name := in["volNames"].([]interface{})[0].(string)
+		if _, ok := f.vols[name]; !ok {
+			return nil, VolumeNotExistErrorCode
+		}
+		delete(f.vols, name)
+		return responseResult{}, ""
+	case "/volume/expand":
+		name := in["volName"].(string)
+		if _, ok := f.vols[name]; !ok {
+			return nil, VolumeNotExistErrorCode
+		}
+		f.vols[name] = int64(in["newVolSize"].(float64))
+		return responseResult{}, ""
+	case "/snapshot/create":
+		name := in["volName"].(string)
+		if _, ok := f.vols[name]; !ok {
+			return nil, VolumeNotExistErrorCode
+		}
+		f.snaps[in["snapshotName"].(string)] = name
+		return responseResult{}, ""
+	case "/snapshot/delete":
+		name := in["snapshotName"].(string)
+		if _, ok := f.snaps[name]; !ok {
+			return nil, SnapshotNotExistErrorCode
+		}
+		delete(f.snaps, name)
+		return responseResult{}, ""
+	}
+	return nil, "50150002"
+}
+
+// fakeFusionStorageDriver shuts down the fake FusionStorage when the driver
+// is unset.
+type fakeFusionStorageDriver struct {
+	*Driver
+	srv *httptest.Server
+}
+
+func (d *fakeFusionStorageDriver) Unset() error {
+	defer d.srv.Close()
+	return d.Driver.Unset()
+}
+
+func TestConformance(t *testing.T) {
+	conformance.Run(t, func(t *testing.T) conformance.VolumeDriver {
+		srv := httptest.NewServer(newFakeFusionStorage())
+		conf := &Config{}
+		if _, err := Parse(conf, "testdata/fusionstorage.yaml"); err != nil {
+			srv.Close()
+			t.Fatal("Parse fusionstorage config failed:", err)
+		}
+		conf.Url = srv.URL
+		// The fsc_cli server which Setup starts is only used for attaching
+		// volumes, so the client logs in to the fake one directly.
+		cli := &FsCli{
+			addess:   conf.Url,
+			username: conf.Username,
+			password: conf.Password,
+			fmIp:     conf.FmIp,
+			fsaIp:    conf.FsaIp,
+			headers:  map[string]string{"Content-Type": "application/json;charset=UTF-8"},
+		}
+		if err := cli.login(); err != nil {
+			srv.Close()
+			t.Fatal("Login to fake fusionstorage failed:", err)
+		}
+		return &fakeFusionStorageDriver{Driver: &Driver{conf: conf, cli: cli}, srv: srv}
+	})
+}
@@ -26,6 +26,7 @@ const (
 	FusionstorageIscsi         = "fusionstorage_iscsi"
 	InitiatorNotExistErrorCode = "32155103"
 	VolumeNotExistErrorCode    = "32150005"
+	SnapshotNotExistErrorCode  = "32150006"
 	CmdBin                     = "fsc_cli"
 	MaxRetryNode               = 3
 	DefaultConfPath            = "/etc/opensds/driver/fusionstorage.yaml"
@@ -24,11 +24,13 @@ import (
 	. "github.com/opensds/opensds/contrib/drivers/utils/config"
 	. "github.com/opensds/opensds/pkg/model"
 	pb "github.com/opensds/opensds/pkg/model/proto"
-	"github.com/opensds/opensds/pkg/utils/config"
 	"github.com/satori/go.uuid"
 )
 
 type Driver struct {
+	// ConfigPath is the path of driver configuration file of the backend.
+	ConfigPath string
+
 	cli  *FsCli
 	conf *Config
 }
@@ -53,7 +55,7 @@ func (d *Driver) Setup() error {
 
 	d.conf = conf
 
-	path := config.CONF.OsdsDock.Backends.HuaweiFusionStorage.ConfigPath
+	path := d.ConfigPath
 	if path == "" {
 		path = DefaultConfPath
 	}
@@ -112,6 +114,10 @@ func (d *Driver) CreateVolume(opt *pb.CreateVolumeOpts) (*VolumeSpec, error) {
 func (d *Driver) DeleteVolume(opt *pb.DeleteVolumeOpts) error {
 	name := EncodeName(opt.GetId())
 	err := d.cli.deleteVolume(name)
+	if err != nil && strings.Contains(err.Error(), VolumeNotExistErrorCode) {
+		log.Warningf("Volume (%s) does not exist, nothing to remove", opt.GetId())
+		return nil
+	}
 	if err != nil {
 		log.Errorf("Delete volume (%s) failed: %v", opt.GetId(), err)
 		return err
@@ -158,6 +164,14 @@ func (d *Driver) ListPools() ([]*StoragePoolSpec, error) {
 	return pols, nil
 }
 
+// Capabilities declares the operations supported by fusionstorage driver.
+func (d *Driver) Capabilities() DriverCapabilitiesSpec {
+	return DriverCapabilitiesSpec{
+		SnapshotAttachment: true,
+		OnlineExtend:       true,
+	}
+}
+
 func (d *Driver) InitializeConnection(opt *pb.CreateVolumeAttachmentOpts) (*ConnectionInfo, error) {
 	lunId := opt.GetMetadata()[LunId]
 	if lunId == "" {
@@ -166,8 +180,12 @@ func (d *Driver) InitializeConnection(opt *pb.CreateVolumeAttachmentOpts) (*Conn
 		return nil, fmt.Errorf(msg)
 	}
 
-	hostInfo := opt.GetHostInfo()
+	return d.initializeLunConnection(lunId, opt.GetVolumeId(), opt.GetHostInfo())
+}
 
+// initializeLunConnection maps the lun, which is either a volume or a
+// snapshot, to the host and returns the iscsi connection info of it.
+func (d *Driver) initializeLunConnection(lunId, resourceId string, hostInfo *pb.HostInfo) (*ConnectionInfo, error) {
 	initiator := hostInfo.GetInitiator()
 	hostName := hostInfo.GetHost()
 
@@ -270,7 +288,7 @@ func (d *Driver) InitializeConnection(opt *pb.CreateVolumeAttachmentOpts) (*Conn
 		DriverVolumeType: ISCSIProtocol,
 		ConnectionData: map[string]interface{}{
 			"target_discovered": true,
-			"volume_id":         opt.GetVolumeId(),
+			"volume_id":         resourceId,
 			"description":       "huawei",
 			"host_name":         hostName,
 			"targetLun":         targetLunId,
@@ -294,8 +312,12 @@ func (d *Driver) TerminateConnection(opt *pb.DeleteVolumeAttachmentOpts) error {
 		return fmt.Errorf(msg)
 	}
 
-	hostInfo := opt.GetHostInfo()
+	return d.terminateLunConnection(lunId, opt.GetHostInfo())
+}
 
+// terminateLunConnection unmaps the lun from the host, and removes the host
+// if there is no lun mapped to it any more.
+func (d *Driver) terminateLunConnection(lunId string, hostInfo *pb.HostInfo) error {
 	initiator := hostInfo.GetInitiator()
 	hostName := hostInfo.GetHost()
 
@@ -366,7 +388,7 @@ func (d *Driver) TerminateConnection(opt *pb.DeleteVolumeAttachmentOpts) error {
 
 func (d *Driver) PullVolume(volIdentifier string) (*VolumeSpec, error) {
 	// Not used , do nothing
-	return nil, nil
+	return nil, &NotImplementError{"method PullVolume has not been implemented yet"}
 }
 
 func (d *Driver) ExtendVolume(opt *pb.ExtendVolumeOpts) (*VolumeSpec, error) {
@@ -409,11 +431,15 @@ func (d *Driver) CreateSnapshot(opt *pb.CreateVolumeSnapshotOpts) (*VolumeSnapsh
 }
 
 func (d *Driver) PullSnapshot(snapIdentifier string) (*VolumeSnapshotSpec, error) {
-	return nil, nil
+	return nil, &NotImplementError{"method PullSnapshot has not been implemented yet"}
 }
 
 func (d *Driver) DeleteSnapshot(opt *pb.DeleteVolumeSnapshotOpts) error {
 	err := d.cli.deleteSnapshot(EncodeName(opt.GetId()))
+	if err != nil && strings.Contains(err.Error(), SnapshotNotExistErrorCode) {
+		log.Warningf("Volume snapshot (%s) does not exist, nothing to remove", opt.GetId())
+		return nil
+	}
 	if err != nil {
 		log.Errorf("Delete volume snapshot (%s) failed: %v", opt.GetId(), err)
 		return err
@@ -422,12 +448,23 @@ func (d *Driver) DeleteSnapshot(opt *pb.DeleteVolumeSnapshotOpts) error {
 	return nil
 }
 
+// InitializeSnapshotConnection maps the snapshot to the host, which can only
+// be read by the host.
 func (d *Driver) InitializeSnapshotConnection(opt *pb.CreateSnapshotAttachmentOpts) (*ConnectionInfo, error) {
-	return nil, &NotImplementError{S: "method InitializeSnapshotConnection has not been implemented yet."}
+	connInfo, err := d.initializeLunConnection(EncodeName(opt.GetSnapshotId()), opt.GetSnapshotId(), opt.GetHostInfo())
+	if err != nil {
+		log.Errorf("Initialize snapshot (%s) connection failed: %v", opt.GetSnapshotId(), err)
+		return nil, err
+	}
+	return connInfo, nil
 }
 
 func (d *Driver) TerminateSnapshotConnection(opt *pb.DeleteSnapshotAttachmentOpts) error {
-	return &NotImplementError{S: "method TerminateSnapshotConnection has not been implemented yet."}
+	if err := d.terminateLunConnection(EncodeName(opt.GetSnapshotId()), opt.GetHostInfo()); err != nil {
+		log.Errorf("Terminate snapshot (%s) connection failed: %v", opt.GetSnapshotId(), err)
+		return err
+	}
+	return nil
 }
 
 func (d *Driver) CreateVolumeGroup(opt *pb.CreateVolumeGroupOpts) (*VolumeGroupSpec, error) {
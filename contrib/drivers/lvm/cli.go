@@ -61,6 +61,42 @@ func (c *Cli) CreateVolume(name string, vg string, size int64) error {
 	return err
 }
 
+// thinPoolName returns the name of the thin pool which the thin volumes of
+// the volume group are provisioned from.
+func thinPoolName(vg string) string {
+	return vg + "-pool"
+}
+
+// CreateThinPool creates the thin pool of the volume group with the extents
+// of size, such as "80%FREE", the rest of the free space is kept for the
+// metadata of the pool to grow and the thick volumes.
+func (c *Cli) CreateThinPool(vg string, size string) error {
+	cmd := []string{
+		"env", "LC_ALL=C",
+		"lvcreate",
+		"-T",
+		"-l", size,
+		path.Join(vg, thinPoolName(vg)),
+	}
+	_, err := c.execute(cmd...)
+	return err
+}
+
+// CreateThinVolume creates a thin volume from the thin pool of the volume
+// group, whose space is allocated on write.
+func (c *Cli) CreateThinVolume(name string, vg string, size int64) error {
+	cmd := []string{
+		"env", "LC_ALL=C",
+		"lvcreate",
+		"-T",
+		"-n", name,
+		"-V", sizeStr(size),
+		path.Join(vg, thinPoolName(vg)),
+	}
+	_, err := c.execute(cmd...)
+	return err
+}
+
 func (c *Cli) Exists(name string) bool {
 	cmd := []string{
 		"env", "LC_ALL=C",
@@ -152,6 +188,24 @@ func (c *Cli) LvIsActivate(name, vg string) bool {
 	return out[4] == 'a'
 }
 
+// LvIsThin returns whether the logic volume is a thin volume.
+func (c *Cli) LvIsThin(name, vg string) bool {
+	cmd := []string{
+		"env", "LC_ALL=C",
+		"lvdisplay",
+		"--noheading",
+		"-C", "-o",
+		"Attr", path.Join(vg, name),
+	}
+	out, err := c.execute(cmd...)
+	if err != nil {
+		glog.Error("Failed to display logic volume:", err)
+		return false
+	}
+	out = strings.TrimSpace(out)
+	return len(out) > 0 && out[0] == 'V'
+}
+
 func (c *Cli) DeactivateLv(name, vg string) error {
 	cmd := []string{
 		"env", "LC_ALL=C",
@@ -219,6 +273,94 @@ func (c *Cli) CreateLvSnapshot(name, sourceLvName, vg string, size int64) error
 	return nil
 }
 
+// CreateThinLvSnapshot creates the snapshot of thin volume, which shares
+// the blocks with the source in the thin pool so that no size is required.
+func (c *Cli) CreateThinLvSnapshot(name, sourceLvName, vg string) error {
+	cmd := []string{
+		"env", "LC_ALL=C",
+		"lvcreate",
+		"-n", name,
+		// Thin snapshots are skipped on activation by default.
+		"-k", "n",
+		"-p", "r",
+		"-s", path.Join(vg, sourceLvName),
+	}
+	if _, err := c.execute(cmd...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// AddLvTag tags the logic volume, by which the volume group that the volume
+// belongs to is tracked.
+func (c *Cli) AddLvTag(name, vg, tag string) error {
+	cmd := []string{
+		"env", "LC_ALL=C",
+		"lvchange",
+		"--addtag", tag,
+		path.Join(vg, name),
+	}
+	_, err := c.execute(cmd...)
+	return err
+}
+
+func (c *Cli) DeleteLvTag(name, vg, tag string) error {
+	cmd := []string{
+		"env", "LC_ALL=C",
+		"lvchange",
+		"--deltag", tag,
+		path.Join(vg, name),
+	}
+	_, err := c.execute(cmd...)
+	return err
+}
+
+type LogicVolume struct {
+	Name string
+	VG   string
+}
+
+// ListLvs lists the logic volumes, only the ones with the tag are listed if
+// it is given.
+func (c *Cli) ListLvs(tag string) ([]LogicVolume, error) {
+	cmd := []string{
+		"env", "LC_ALL=C",
+		"lvs",
+		"--noheadings",
+		"-o", "vg_name,lv_name",
+	}
+	if tag != "" {
+		cmd = append(cmd, "@"+tag)
+	}
+	out, err := c.execute(cmd...)
+	if err != nil {
+		return nil, err
+	}
+	var lvs []LogicVolume
+	for _, line := range strings.Split(out, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+		lvs = append(lvs, LogicVolume{Name: fields[1], VG: fields[0]})
+	}
+	return lvs, nil
+}
+
+// LvVg returns the volume group which the logic volume belongs to.
+func (c *Cli) LvVg(name string) (string, error) {
+	lvs, err := c.ListLvs("")
+	if err != nil {
+		return "", err
+	}
+	for _, lv := range lvs {
+		if lv.Name == name {
+			return lv.VG, nil
+		}
+	}
+	return "", fmt.Errorf("logic volume %s not found", name)
+}
+
 type VolumeGroup struct {
 	Name          string
 	TotalCapacity int64
@@ -259,6 +401,63 @@ func (c *Cli) ListVgs() (*[]VolumeGroup, error) {
 	return &vgs, nil
 }
 
+type ThinPool struct {
+	Name          string
+	VG            string
+	TotalCapacity int64
+	// The capacity of the thin volumes provisioned from the pool, which can
+	// exceed the total capacity of it.
+	ProvisionedCapacity int64
+	// The usage of the data and metadata of the pool in percent.
+	DataUsage     float64
+	MetadataUsage float64
+}
+
+func (c *Cli) ListThinPools() (*[]ThinPool, error) {
+	cmd := []string{
+		"env", "LC_ALL=C",
+		"lvs",
+		"--noheadings",
+		"--nosuffix",
+		"--unit=g",
+		"--separator", "|",
+		"-o", "vg_name,lv_name,lv_size,pool_lv,data_percent,metadata_percent,lv_attr",
+	}
+	out, err := c.execute(cmd...)
+	if err != nil {
+		return nil, err
+	}
+	var pools []ThinPool
+	var provisioned = make(map[string]float64)
+	for _, line := range strings.Split(out, "\n") {
+		fields := strings.Split(strings.TrimSpace(line), "|")
+		if len(fields) < 7 {
+			continue
+		}
+		size, _ := strconv.ParseFloat(fields[2], 64)
+		// The thin volumes are summed up by the pool they belong to.
+		if fields[3] != "" {
+			provisioned[path.Join(fields[0], fields[3])] += size
+		}
+		if !strings.HasPrefix(fields[6], "t") {
+			continue
+		}
+		data, _ := strconv.ParseFloat(fields[4], 64)
+		meta, _ := strconv.ParseFloat(fields[5], 64)
+		pools = append(pools, ThinPool{
+			Name:          fields[1],
+			VG:            fields[0],
+			TotalCapacity: int64(size),
+			DataUsage:     data,
+			MetadataUsage: meta,
+		})
+	}
+	for i := range pools {
+		pools[i].ProvisionedCapacity = int64(provisioned[path.Join(pools[i].VG, pools[i].Name)])
+	}
+	return &pools, nil
+}
+
 func (c *Cli) CopyVolume(src, dest string, size int64) error {
 	var count = (size << sizeShiftBit) / blocksize
 	_, err := c.execute("dd",
@@ -0,0 +1,179 @@
+// Copyright (c) 2018 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+//    Licensed under the Apache License, Version 2.0 (the "License"); you may
+//    not use this file except in compliance with the License. You may obtain
+//    a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//    WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//    License for the specific language governing permissions and limitations
+//    under the License.
+
+package lvm
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/opensds/opensds/testutils/driver/conformance"
+)
+
+// fakeLvm emulates the lvm commands run by the driver against a single
+// volume group, so that the logic volumes created by one command are seen by
+// the following ones.
+type fakeLvm struct {
+	vg    string
+	total int64
+	lvs   map[string]*fakeLv
+}
+
+type fakeLv struct {
+	size   int64
+	attr   []byte
+	origin string
+}
+
+func newFakeLvm(vg string, total int64) *fakeLvm {
+	return &fakeLvm{vg: vg, total: total, lvs: map[string]*fakeLv{}}
+}
+
+func (f *fakeLvm) Run(name string, args ...string) (string, error) {
+	if name == "env" {
+		name, args = args[1], args[2:]
+	}
+	switch name {
+	case "vgs":
+		var used int64
+		for _, lv := range f.lvs {
+			used += lv.size
+		}
+		return fmt.Sprintf("  %s %d.00 %d.00 fake-vg-uuid\n", f.vg, f.total, f.total-used), nil
+	case "lvs":
+		var names []string
+		for n := range f.lvs {
+			names = append(names, "  "+n)
+		}
+		sort.Strings(names)
+		return strings.Join(names, "\n"), nil
+	case "lvdisplay":
+		lv, err := f.lookup(args[len(args)-1])
+		if err != nil {
+			return "", err
+		}
+		return "  " + string(lv.attr), nil
+	case "lvcreate":
+		return "", f.create(args)
+	case "lvremove":
+		return "", f.remove(args[len(args)-1])
+	case "lvextend":
+		lv, err := f.lookup(args[len(args)-1])
+		if err != nil {
+			return "", err
+		}
+		lv.size, err = parseSize(args[1])
+		return "", err
+	case "lvchange":
+		lv, err := f.lookup(args[len(args)-1])
+		if err != nil {
+			return "", err
+		}
+		if args[0] == "-a" {
+			lv.attr[4] = map[string]byte{"y": 'a', "n": '-'}[args[1]]
+		}
+		return "", nil
+	case "udevadm", "dd":
+		return "", nil
+	}
+	return "", fmt.Errorf("command %s is not supported", name)
+}
+
+func (f *fakeLvm) create(args []string) error {
+	var name, src string
+	var size int64
+	for i := 0; i < len(args)-1; i++ {
+		switch args[i] {
+		case "-n":
+			name = args[i+1]
+		case "-L":
+			s, err := parseSize(args[i+1])
+			if err != nil {
+				return err
+			}
+			size = s
+		case "-s":
+			src = args[i+1]
+		default:
+			continue
+		}
+		i++
+	}
+	if _, ok := f.lvs[name]; ok {
+		return fmt.Errorf("logical volume %q already exists in volume group %q", name, f.vg)
+	}
+	if src == "" {
+		if args[len(args)-1] != f.vg {
+			return fmt.Errorf("volume group %q not found", args[len(args)-1])
+		}
+		f.lvs[name] = &fakeLv{size: size, attr: []byte("-wi-a-----")}
+		return nil
+	}
+	origin, err := f.lookup(src)
+	if err != nil {
+		return err
+	}
+	origin.attr[0] = 'o'
+	f.lvs[name] = &fakeLv{size: size, attr: []byte("swi-a-s---"), origin: src[strings.Index(src, "/")+1:]}
+	return nil
+}
+
+func (f *fakeLvm) remove(p string) error {
+	lv, err := f.lookup(p)
+	if err != nil {
+		return err
+	}
+	delete(f.lvs, p[strings.Index(p, "/")+1:])
+	if origin, ok := f.lvs[lv.origin]; ok {
+		origin.attr[0] = '-'
+		for _, other := range f.lvs {
+			if other.origin == lv.origin {
+				origin.attr[0] = 'o'
+			}
+		}
+	}
+	return nil
+}
+
+func (f *fakeLvm) lookup(p string) (*fakeLv, error) {
+	fields := strings.Split(p, "/")
+	if len(fields) != 2 || fields[0] != f.vg {
+		return nil, fmt.Errorf("volume group %q not found", fields[0])
+	}
+	lv, ok := f.lvs[fields[1]]
+	if !ok {
+		return nil, fmt.Errorf("failed to find logical volume %q", p)
+	}
+	return lv, nil
+}
+
+func parseSize(s string) (int64, error) {
+	return strconv.ParseInt(strings.TrimSuffix(s, "g"), 10, 64)
+}
+
+func TestConformance(t *testing.T) {
+	conformance.Run(t, func(t *testing.T) conformance.VolumeDriver {
+		d := &Driver{ConfigPath: "testdata/lvm.yaml"}
+		if err := d.Setup(); err != nil {
+			t.Fatal("Setup lvm driver failed:", err)
+		}
+		f := newFakeLvm("vg001", 20)
+		d.cli.RootExecuter = f
+		d.cli.BaseExecuter = f
+		return d
+	})
+}
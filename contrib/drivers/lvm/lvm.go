@@ -30,13 +30,15 @@ import (
 	"github.com/opensds/opensds/pkg/model"
 	pb "github.com/opensds/opensds/pkg/model/proto"
 	"github.com/opensds/opensds/pkg/utils"
-	"github.com/opensds/opensds/pkg/utils/config"
 	"github.com/satori/go.uuid"
 )
 
 const (
 	defaultTgtConfDir = "/etc/tgt/conf.d"
 	defaultTgtBindIp  = "127.0.0.1"
+	defaultNvmeofPort = "4420"
+	defaultOverRatio  = 20.0
+	defaultThinPool   = "80%FREE"
 	defaultConfPath   = "/etc/opensds/driver/lvm.yaml"
 	volumePrefix      = "volume-"
 	snapshotPrefix    = "_snapshot-"
@@ -53,40 +55,100 @@ const (
 )
 
 type LVMConfig struct {
-	TgtBindIp      string                    `yaml:"tgtBindIp"`
-	TgtConfDir     string                    `yaml:"tgtConfDir"`
-	EnableChapAuth bool                      `yaml:"enableChapAuth"`
-	Pool           map[string]PoolProperties `yaml:"pool,flow"`
+	TgtBindIp       string                    `yaml:"tgtBindIp"`
+	TgtConfDir      string                    `yaml:"tgtConfDir"`
+	EnableChapAuth  bool                      `yaml:"enableChapAuth"`
+	ISCSITarget     string                    `yaml:"iscsiTarget"`
+	NvmeofTransport string                    `yaml:"nvmeofTransport"`
+	NvmeofPort      string                    `yaml:"nvmeofPort"`
+	ThinProvision   bool                      `yaml:"thinProvision"`
+	ThinPoolSize    string                    `yaml:"thinPoolSize"`
+	OversubRatio    float64                   `yaml:"oversubRatio"`
+	Pool            map[string]PoolProperties `yaml:"pool,flow"`
+	BackupPipeline  *backup.PipelineConfig    `yaml:"backupPipeline,omitempty"`
 }
 
 type Driver struct {
+	// ConfigPath is the path of driver configuration file of the backend.
+	ConfigPath string
+
 	conf *LVMConfig
 	cli  *Cli
 }
 
 func (d *Driver) Setup() error {
 	// Read lvm config file
-	d.conf = &LVMConfig{TgtBindIp: defaultTgtBindIp, TgtConfDir: defaultTgtConfDir}
-	p := config.CONF.OsdsDock.Backends.LVM.ConfigPath
+	d.conf = &LVMConfig{
+		TgtBindIp:       defaultTgtBindIp,
+		TgtConfDir:      defaultTgtConfDir,
+		ISCSITarget:     targets.ISCSITgt,
+		NvmeofTransport: targets.NvmeofRDMA,
+		NvmeofPort:      defaultNvmeofPort,
+		ThinPoolSize:    defaultThinPool,
+		OversubRatio:    defaultOverRatio,
+	}
+	p := d.ConfigPath
 	if "" == p {
 		p = defaultConfPath
 	}
 	if _, err := Parse(d.conf, p); err != nil {
 		return err
 	}
+	if d.conf.ISCSITarget != targets.ISCSITgt && d.conf.ISCSITarget != targets.ISCSILio {
+		return fmt.Errorf("iscsi target %s is not supported", d.conf.ISCSITarget)
+	}
 	cli, err := NewCli()
 	if err != nil {
 		return err
 	}
 	d.cli = cli
 
+	if d.conf.ThinProvision {
+		return d.setupThinPools()
+	}
+	return nil
+}
+
+// setupThinPools creates the thin pools of the volume groups which don't
+// have one yet.
+func (d *Driver) setupThinPools() error {
+	for vg := range d.conf.Pool {
+		if d.cli.Exists(thinPoolName(vg)) {
+			continue
+		}
+		if err := d.cli.CreateThinPool(vg, d.conf.ThinPoolSize); err != nil {
+			log.Errorf("Failed to create thin pool of volume group %s: %v", vg, err)
+			return err
+		}
+	}
 	return nil
 }
 
 func (*Driver) Unset() error { return nil }
 
-func (d *Driver) downloadSnapshot(bucket, backupId, dest string) error {
-	mc, err := backup.NewBackup("multi-cloud")
+// newBackupDriver returns the backup driver named by the backup policy, which
+// is multi-cloud by default, wrapped into the checksum/compression/encryption
+// pipeline unless it is disabled.
+func (d *Driver) newBackupDriver(name string) (backup.BackupDriver, error) {
+	if name == "" {
+		name = backup.DefaultDriver
+	}
+	mc, err := backup.NewBackup(name)
+	if err != nil {
+		return nil, err
+	}
+	if !d.conf.BackupPipeline.Enabled() {
+		return mc, nil
+	}
+	p, err := backup.NewPipeline(mc, d.conf.BackupPipeline)
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (d *Driver) downloadSnapshot(drvName, bucket, backupId, dest string) error {
+	mc, err := d.newBackupDriver(drvName)
 	if err != nil {
 		log.Errorf("get backup driver, err: %v", err)
 		return err
@@ -121,7 +183,12 @@ func (d *Driver) downloadSnapshot(bucket, backupId, dest string) error {
 func (d *Driver) CreateVolume(opt *pb.CreateVolumeOpts) (vol *model.VolumeSpec, err error) {
 	var name = volumePrefix + opt.GetId()
 	var vg = opt.GetPoolName()
-	if err = d.cli.CreateVolume(name, vg, opt.GetSize()); err != nil {
+	if d.conf.ThinProvision {
+		err = d.cli.CreateThinVolume(name, vg, opt.GetSize())
+	} else {
+		err = d.cli.CreateVolume(name, vg, opt.GetSize())
+	}
+	if err != nil {
 		return
 	}
 
@@ -149,7 +216,7 @@ func (d *Driver) CreateVolume(opt *pb.CreateVolumeOpts) (vol *model.VolumeSpec,
 			if !ok {
 				return nil, errors.New("can't find bucket name in metadata")
 			}
-			err := d.downloadSnapshot(bucket, backupId, lvPath)
+			err := d.downloadSnapshot(data[model.BackupDriverKey], bucket, backupId, lvPath)
 			if err != nil {
 				log.Errorf("Download snapshot failed, %v", err)
 				return nil, err
@@ -179,7 +246,7 @@ func (d *Driver) CreateVolume(opt *pb.CreateVolumeOpts) (vol *model.VolumeSpec,
 
 func (d *Driver) PullVolume(volIdentifier string) (*model.VolumeSpec, error) {
 	// Not used , do nothing
-	return nil, nil
+	return nil, &model.NotImplementError{S: "method PullVolume has not been implemented yet"}
 }
 
 func (d *Driver) DeleteVolume(opt *pb.DeleteVolumeOpts) error {
@@ -256,13 +323,16 @@ func (d *Driver) InitializeConnection(opt *pb.CreateVolumeAttachmentOpts) (*mode
 	// create target according to the pool's access protocol
 	accPro := opt.AccessProtocol
 	log.Info("accpro:", accPro)
-	t := targets.NewTarget(d.conf.TgtBindIp, d.conf.TgtConfDir, accPro)
+	transport := opt.GetTransport()
+	if transport == "" {
+		transport = d.conf.NvmeofTransport
+	}
+	t := targets.NewTarget(d.conf.TgtBindIp, d.conf.TgtConfDir, accPro, d.conf.ISCSITarget, transport, d.conf.NvmeofPort)
 	expt, err := t.CreateExport(opt.GetVolumeId(), lvPath, hostIP, initiator, chapAuth)
 	if err != nil {
 		log.Error("Failed to initialize connection of logic volume:", err)
 		return nil, err
 	}
-
 	return &model.ConnectionInfo{
 		DriverVolumeType: accPro,
 		ConnectionData:   expt,
@@ -271,7 +341,7 @@ func (d *Driver) InitializeConnection(opt *pb.CreateVolumeAttachmentOpts) (*mode
 
 func (d *Driver) TerminateConnection(opt *pb.DeleteVolumeAttachmentOpts) error {
 	accPro := opt.AccessProtocol
-	t := targets.NewTarget(d.conf.TgtBindIp, d.conf.TgtConfDir, accPro)
+	t := targets.NewTarget(d.conf.TgtBindIp, d.conf.TgtConfDir, accPro, d.conf.ISCSITarget, d.conf.NvmeofTransport, d.conf.NvmeofPort)
 	if err := t.RemoveExport(opt.GetVolumeId()); err != nil {
 		log.Error("failed to terminate connection of logic volume:", err)
 		return err
@@ -332,8 +402,8 @@ func (d *Driver) DetachSnapshot(snapshotId string, info *model.ConnectionInfo) e
 	return d.TerminateSnapshotConnection(attach)
 }
 
-func (d *Driver) uploadSnapshot(lvsPath string, bucket string) (string, error) {
-	mc, err := backup.NewBackup("multi-cloud")
+func (d *Driver) uploadSnapshot(drvName, lvsPath, bucket string) (string, error) {
+	mc, err := d.newBackupDriver(drvName)
 	if err != nil {
 		log.Errorf("get backup driver, err: %v", err)
 		return "", err
@@ -366,8 +436,8 @@ func (d *Driver) uploadSnapshot(lvsPath string, bucket string) (string, error) {
 	return b.Id, nil
 }
 
-func (d *Driver) deleteUploadedSnapshot(backupId string, bucket string) error {
-	mc, err := backup.NewBackup("multi-cloud")
+func (d *Driver) deleteUploadedSnapshot(drvName, backupId, bucket string) error {
+	mc, err := d.newBackupDriver(drvName)
 	if err != nil {
 		log.Errorf("get backup driver failed, err: %v", err)
 		return err
@@ -405,7 +475,7 @@ func (d *Driver) CreateSnapshot(opt *pb.CreateVolumeSnapshotOpts) (snap *model.V
 
 	fields := strings.Split(lvPath, "/")
 	vg, sourceLvName := fields[2], fields[3]
-	if err := d.cli.CreateLvSnapshot(snapName, sourceLvName, vg, opt.GetSize()); err != nil {
+	if err := d.createLvSnapshot(snapName, sourceLvName, vg, opt.GetSize(), d.cli.LvIsThin(sourceLvName, vg)); err != nil {
 		log.Error("Failed to create logic volume snapshot:", err)
 		return nil, err
 	}
@@ -427,13 +497,17 @@ func (d *Driver) CreateSnapshot(opt *pb.CreateVolumeSnapshotOpts) (snap *model.V
 		defer d.DetachSnapshot(opt.GetId(), info)
 
 		log.Info("update load snapshot to :", bucket)
-		backupId, err := d.uploadSnapshot(mountPoint, bucket)
+		drvName := opt.GetMetadata()[model.BackupDriverKey]
+		backupId, err := d.uploadSnapshot(drvName, mountPoint, bucket)
 		if err != nil {
 			d.cli.Delete(snapName, vg)
 			return nil, err
 		}
 		metadata["backupId"] = backupId
 		metadata["bucket"] = bucket
+		if drvName != "" {
+			metadata[model.BackupDriverKey] = drvName
+		}
 	}
 
 	return &model.VolumeSnapshotSpec{
@@ -448,16 +522,25 @@ func (d *Driver) CreateSnapshot(opt *pb.CreateVolumeSnapshotOpts) (snap *model.V
 	}, nil
 }
 
+// createLvSnapshot creates the snapshot of logic volume, the snapshot of thin
+// volume takes no size.
+func (d *Driver) createLvSnapshot(name, sourceLvName, vg string, size int64, thin bool) error {
+	if thin {
+		return d.cli.CreateThinLvSnapshot(name, sourceLvName, vg)
+	}
+	return d.cli.CreateLvSnapshot(name, sourceLvName, vg, size)
+}
+
 func (d *Driver) PullSnapshot(snapIdentifier string) (*model.VolumeSnapshotSpec, error) {
 	// not used, do nothing
-	return nil, nil
+	return nil, &model.NotImplementError{S: "method PullSnapshot has not been implemented yet"}
 }
 
 func (d *Driver) DeleteSnapshot(opt *pb.DeleteVolumeSnapshotOpts) error {
 
 	if bucket, ok := opt.Metadata["bucket"]; ok {
 		log.Info("remove snapshot in multi-cloud :", bucket)
-		if err := d.deleteUploadedSnapshot(opt.Metadata["backupId"], bucket); err != nil {
+		if err := d.deleteUploadedSnapshot(opt.Metadata[model.BackupDriverKey], opt.Metadata["backupId"], bucket); err != nil {
 			return err
 		}
 	}
@@ -483,12 +566,50 @@ func (d *Driver) DeleteSnapshot(opt *pb.DeleteVolumeSnapshotOpts) error {
 	return nil
 }
 
+// VerifySnapshotBackup checks the backup of the snapshot in multi-cloud
+// without restoring it.
+func (d *Driver) VerifySnapshotBackup(opt *pb.VerifyVolumeSnapshotOpts) error {
+	bucket, ok := opt.GetMetadata()["bucket"]
+	if !ok {
+		return fmt.Errorf("snapshot %s is not backed up into the cloud", opt.GetId())
+	}
+	backupId, ok := opt.GetMetadata()["backupId"]
+	if !ok {
+		return errors.New("can't find backupId in metadata")
+	}
+
+	mc, err := d.newBackupDriver(opt.GetMetadata()[model.BackupDriverKey])
+	if err != nil {
+		log.Errorf("get backup driver failed, err: %v", err)
+		return err
+	}
+	if err := mc.SetUp(); err != nil {
+		return err
+	}
+	defer mc.CleanUp()
+
+	b := &backup.BackupSpec{Metadata: map[string]string{"bucket": bucket}}
+	return backup.Verify(mc, b, backupId)
+}
+
 func (d *Driver) ListPools() ([]*model.StoragePoolSpec, error) {
 
 	vgs, err := d.cli.ListVgs()
 	if err != nil {
 		return nil, err
 	}
+	var thinPools = make(map[string]ThinPool)
+	if d.conf.ThinProvision {
+		tps, err := d.cli.ListThinPools()
+		if err != nil {
+			return nil, err
+		}
+		for _, tp := range *tps {
+			if tp.Name == thinPoolName(tp.VG) {
+				thinPools[tp.VG] = tp
+			}
+		}
+	}
 
 	var pols []*model.StoragePoolSpec
 	for _, vg := range *vgs {
@@ -510,11 +631,57 @@ func (d *Driver) ListPools() ([]*model.StoragePoolSpec, error) {
 		if pol.AvailabilityZone == "" {
 			pol.AvailabilityZone = "default"
 		}
+		pol.Extras.DataStorage.ProvisioningPolicy = "Fixed"
+		pol.Extras.DataStorage.IsSpaceEfficient = false
+		if tp, ok := thinPools[vg.Name]; ok {
+			d.setThinPoolCapacity(pol, &tp)
+		}
 		pols = append(pols, pol)
 	}
 	return pols, nil
 }
 
+// Capabilities declares the operations supported by lvm driver, the
+// volumes are replicated by the host-based replication driver of the dock if
+// any. The I/O of logic volumes can't be limited, since neither the volume
+// group nor the targets exporting them enforce any limit.
+func (d *Driver) Capabilities() model.DriverCapabilitiesSpec {
+	return model.DriverCapabilitiesSpec{
+		VolumeGroup:        true,
+		SnapshotAttachment: true,
+		Clone:              true,
+		OnlineExtend:       true,
+	}
+}
+
+// setThinPoolCapacity reports the capacity of the pool by its thin pool, the
+// free capacity is what can still be provisioned under the over subscription
+// ratio, which is capped by the size of the thin pool so that it never
+// exceeds the total capacity and no single volume is larger than the pool.
+// The usage of the thin pool is reported in the advanced extras.
+func (d *Driver) setThinPoolCapacity(pol *model.StoragePoolSpec, tp *ThinPool) {
+	ratio := d.conf.OversubRatio
+	free := int64(float64(tp.TotalCapacity)*ratio) - tp.ProvisionedCapacity
+	if free > tp.TotalCapacity {
+		free = tp.TotalCapacity
+	}
+	if free < 0 {
+		free = 0
+	}
+	pol.TotalCapacity = tp.TotalCapacity
+	pol.FreeCapacity = free
+
+	pol.Extras.DataStorage.ProvisioningPolicy = "Thin"
+	pol.Extras.DataStorage.IsSpaceEfficient = true
+	// The advanced extras of config are shared by all the pools reported.
+	pol.Extras.Advanced = utils.MergeGeneralMaps(pol.Extras.Advanced, map[string]interface{}{
+		"thinPoolDataUsage":        tp.DataUsage,
+		"thinPoolMetadataUsage":    tp.MetadataUsage,
+		"provisionedCapacity":      tp.ProvisionedCapacity,
+		"maxOverSubscriptionRatio": ratio,
+	})
+}
+
 func (d *Driver) InitializeSnapshotConnection(opt *pb.CreateSnapshotAttachmentOpts) (*model.ConnectionInfo, error) {
 	initiator := opt.HostInfo.GetInitiator()
 	if initiator == "" {
@@ -543,7 +710,7 @@ func (d *Driver) InitializeSnapshotConnection(opt *pb.CreateSnapshotAttachmentOp
 		log.Infof("still create snapshot connection by iscsi")
 		accPro = iscsiAccess
 	}
-	t := targets.NewTarget(d.conf.TgtBindIp, d.conf.TgtConfDir, accPro)
+	t := targets.NewTarget(d.conf.TgtBindIp, d.conf.TgtConfDir, accPro, d.conf.ISCSITarget, "", "")
 	data, err := t.CreateExport(opt.GetSnapshotId(), lvsPath, hostIP, initiator, chapAuth)
 	if err != nil {
 		log.Error("Failed to initialize snapshot connection of logic volume:", err)
@@ -558,13 +725,13 @@ func (d *Driver) InitializeSnapshotConnection(opt *pb.CreateSnapshotAttachmentOp
 
 func (d *Driver) TerminateSnapshotConnection(opt *pb.DeleteSnapshotAttachmentOpts) error {
 	accPro := opt.AccessProtocol
-	if accPro == nvmeofAccess{
+	if accPro == nvmeofAccess {
 		log.Infof("nvmet right now can not support snap volume serve as nvme target")
 		log.Infof("still create snapshot connection by iscsi")
 		accPro = iscsiAccess
 	}
 	log.Info("terminate snapshot conn")
-	t := targets.NewTarget(d.conf.TgtBindIp, d.conf.TgtConfDir, accPro)
+	t := targets.NewTarget(d.conf.TgtBindIp, d.conf.TgtConfDir, accPro, d.conf.ISCSITarget, "", "")
 	if err := t.RemoveExport(opt.GetSnapshotId()); err != nil {
 		log.Error("Failed to terminate snapshot connection of logic volume:", err)
 		return err
@@ -573,14 +740,142 @@ func (d *Driver) TerminateSnapshotConnection(opt *pb.DeleteSnapshotAttachmentOpt
 
 }
 
+// groupTag returns the tag of logic volumes by which the membership of the
+// volume group is tracked.
+func groupTag(groupId string) string {
+	return "opensds-group-" + groupId
+}
+
 func (d *Driver) CreateVolumeGroup(opt *pb.CreateVolumeGroupOpts) (*model.VolumeGroupSpec, error) {
-	return nil, &model.NotImplementError{"method CreateVolumeGroup has not been implemented yet"}
+	if err := d.tagGroupVolumes(opt.GetId(), opt.GetAddVolumes(), true); err != nil {
+		return nil, err
+	}
+	return &model.VolumeGroupSpec{
+		BaseModel: &model.BaseModel{
+			Id: opt.GetId(),
+		},
+		Name:             opt.GetName(),
+		Description:      opt.GetDescription(),
+		AvailabilityZone: opt.GetAvailabilityZone(),
+		PoolId:           opt.GetPoolId(),
+	}, nil
 }
 
 func (d *Driver) UpdateVolumeGroup(opt *pb.UpdateVolumeGroupOpts) (*model.VolumeGroupSpec, error) {
-	return nil, &model.NotImplementError{"method UpdateVolumeGroup has not been implemented yet"}
+	if err := d.tagGroupVolumes(opt.GetId(), opt.GetAddVolumes(), true); err != nil {
+		return nil, err
+	}
+	if err := d.tagGroupVolumes(opt.GetId(), opt.GetRemoveVolumes(), false); err != nil {
+		return nil, err
+	}
+	return &model.VolumeGroupSpec{
+		BaseModel: &model.BaseModel{
+			Id: opt.GetId(),
+		},
+		PoolId: opt.GetPoolId(),
+	}, nil
 }
 
+// DeleteVolumeGroup removes the tag of the group from its volumes, which are
+// deleted one by one afterwards.
 func (d *Driver) DeleteVolumeGroup(opt *pb.DeleteVolumeGroupOpts) error {
-	return &model.NotImplementError{"method DeleteVolumeGroup has not been implemented yet"}
+	tag := groupTag(opt.GetId())
+	lvs, err := d.cli.ListLvs(tag)
+	if err != nil {
+		log.Errorf("Failed to list logic volumes of group %s: %v", opt.GetId(), err)
+		return err
+	}
+	for _, lv := range lvs {
+		if err := d.cli.DeleteLvTag(lv.Name, lv.VG, tag); err != nil {
+			log.Errorf("Failed to remove logic volume %s from group %s: %v", lv.Name, opt.GetId(), err)
+			return err
+		}
+	}
+	return nil
+}
+
+// tagGroupVolumes adds the tag of the group to the volumes, or removes it from
+// them if add is false.
+func (d *Driver) tagGroupVolumes(groupId string, volIds []string, add bool) error {
+	tag := groupTag(groupId)
+	for _, volId := range volIds {
+		name := volumePrefix + volId
+		vg, err := d.cli.LvVg(name)
+		if err != nil {
+			log.Error("Failed to find logic volume:", err)
+			return err
+		}
+		if add {
+			err = d.cli.AddLvTag(name, vg, tag)
+		} else {
+			err = d.cli.DeleteLvTag(name, vg, tag)
+		}
+		if err != nil {
+			log.Errorf("Failed to update logic volume %s of group %s: %v", name, groupId, err)
+			return err
+		}
+	}
+	return nil
+}
+
+// CreateGroupSnapshot takes the snapshots of the volumes in the group. LVM
+// can't snapshot several logic volumes at once, the file systems on them are
+// frozen by the controller while the snapshots are taken instead, so that
+// they are consistent with each other. The controller rejects the group
+// snapshot if any volume is attached without being mounted.
+func (d *Driver) CreateGroupSnapshot(opt *pb.CreateVolumeGroupSnapshotOpts) ([]*model.VolumeSnapshotSpec, error) {
+	type member struct {
+		lv, vg string
+		thin   bool
+		opt    *pb.CreateVolumeSnapshotOpts
+	}
+	var members []member
+	for _, snapOpt := range opt.GetSnapshots() {
+		lvPath, ok := snapOpt.GetMetadata()[KLvPath]
+		if !ok {
+			err := fmt.Errorf("can't find 'lvPath' in metadata of volume %s", snapOpt.GetVolumeId())
+			log.Error(err)
+			return nil, err
+		}
+		fields := strings.Split(lvPath, "/")
+		vg, lv := fields[2], fields[3]
+		members = append(members, member{lv: lv, vg: vg, thin: d.cli.LvIsThin(lv, vg), opt: snapOpt})
+	}
+
+	var snaps []*model.VolumeSnapshotSpec
+	var created []LogicVolume
+	for _, m := range members {
+		snapName := snapshotPrefix + m.opt.GetId()
+		if err := d.createLvSnapshot(snapName, m.lv, m.vg, m.opt.GetSize(), m.thin); err != nil {
+			log.Errorf("Failed to create snapshot of logic volume %s: %v", m.lv, err)
+			for _, lv := range created {
+				d.cli.Delete(lv.Name, lv.VG)
+			}
+			return nil, err
+		}
+		created = append(created, LogicVolume{Name: snapName, VG: m.vg})
+		snaps = append(snaps, &model.VolumeSnapshotSpec{
+			BaseModel: &model.BaseModel{
+				Id: m.opt.GetId(),
+			},
+			Name:            m.opt.GetName(),
+			Size:            m.opt.GetSize(),
+			Description:     m.opt.GetDescription(),
+			VolumeId:        m.opt.GetVolumeId(),
+			GroupSnapshotId: opt.GetId(),
+			Metadata: map[string]string{
+				KLvsPath: path.Join("/dev", m.vg, snapName),
+			},
+		})
+	}
+	return snaps, nil
+}
+
+func (d *Driver) DeleteGroupSnapshot(opt *pb.DeleteVolumeGroupSnapshotOpts) error {
+	for _, snapOpt := range opt.GetSnapshots() {
+		if err := d.DeleteSnapshot(snapOpt); err != nil {
+			return err
+		}
+	}
+	return nil
 }
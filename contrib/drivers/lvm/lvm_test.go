@@ -22,7 +22,6 @@ import (
 	. "github.com/opensds/opensds/contrib/drivers/utils/config"
 	"github.com/opensds/opensds/pkg/model"
 	pb "github.com/opensds/opensds/pkg/model/proto"
-	"github.com/opensds/opensds/pkg/utils/config"
 	"github.com/opensds/opensds/pkg/utils/exec"
 )
 
@@ -49,14 +48,18 @@ var fp = map[string]PoolProperties{
 }
 
 func TestSetup(t *testing.T) {
-	var d = &Driver{}
-	config.CONF.OsdsDock.Backends.LVM.ConfigPath = "testdata/lvm.yaml"
+	var d = &Driver{ConfigPath: "testdata/lvm.yaml"}
 	var expectedDriver = &Driver{
 		conf: &LVMConfig{
-			Pool:           fp,
-			TgtBindIp:      "192.168.56.105",
-			TgtConfDir:     "/etc/tgt/conf.d",
-			EnableChapAuth: true,
+			Pool:            fp,
+			TgtBindIp:       "192.168.56.105",
+			TgtConfDir:      "/etc/tgt/conf.d",
+			EnableChapAuth:  true,
+			ISCSITarget:     "lio",
+			NvmeofTransport: "tcp",
+			NvmeofPort:      "4420",
+			ThinPoolSize:    "80%FREE",
+			OversubRatio:    20.0,
 		},
 	}
 
@@ -94,8 +97,7 @@ func (f *FakeExecuter) Run(name string, args ...string) (string, error) {
 }
 
 func TestCreateVolume(t *testing.T) {
-	var fd = &Driver{}
-	config.CONF.OsdsDock.Backends.LVM.ConfigPath = "testdata/lvm.yaml"
+	var fd = &Driver{ConfigPath: "testdata/lvm.yaml"}
 	fd.Setup()
 
 	respMap := map[string]*FakeResp{
@@ -131,8 +133,7 @@ func TestCreateVolume(t *testing.T) {
 }
 
 func TestCreateVolumeFromSnapshot(t *testing.T) {
-	var fd = &Driver{}
-	config.CONF.OsdsDock.Backends.LVM.ConfigPath = "testdata/lvm.yaml"
+	var fd = &Driver{ConfigPath: "testdata/lvm.yaml"}
 	fd.Setup()
 
 	respMap := map[string]*FakeResp{
@@ -171,8 +172,7 @@ func TestCreateVolumeFromSnapshot(t *testing.T) {
 }
 
 func TestDeleteVolume(t *testing.T) {
-	var fd = &Driver{}
-	config.CONF.OsdsDock.Backends.LVM.ConfigPath = "testdata/lvm.yaml"
+	var fd = &Driver{ConfigPath: "testdata/lvm.yaml"}
 	fd.Setup()
 
 	respMap := map[string]*FakeResp{
@@ -193,8 +193,7 @@ func TestDeleteVolume(t *testing.T) {
 }
 
 func TestExtendVolume(t *testing.T) {
-	var fd = &Driver{}
-	config.CONF.OsdsDock.Backends.LVM.ConfigPath = "testdata/lvm.yaml"
+	var fd = &Driver{ConfigPath: "testdata/lvm.yaml"}
 	fd.Setup()
 
 	respMap := map[string]*FakeResp{
@@ -224,8 +223,7 @@ func TestExtendVolume(t *testing.T) {
 }
 
 func TestCreateSnapshot(t *testing.T) {
-	var fd = &Driver{}
-	config.CONF.OsdsDock.Backends.LVM.ConfigPath = "testdata/lvm.yaml"
+	var fd = &Driver{ConfigPath: "testdata/lvm.yaml"}
 	fd.Setup()
 
 	respMap := map[string]*FakeResp{
@@ -266,8 +264,7 @@ func TestCreateSnapshot(t *testing.T) {
 }
 
 func TestDeleteSnapshot(t *testing.T) {
-	var fd = &Driver{}
-	config.CONF.OsdsDock.Backends.LVM.ConfigPath = "testdata/lvm.yaml"
+	var fd = &Driver{ConfigPath: "testdata/lvm.yaml"}
 	fd.Setup()
 
 	lvsResp := `  _snapshot-f0594d2b-ffdf-4947-8380-089f0bc17389
@@ -295,9 +292,64 @@ func TestDeleteSnapshot(t *testing.T) {
 	}
 }
 
+func TestCreateGroupSnapshot(t *testing.T) {
+	var fd = &Driver{ConfigPath: "testdata/lvm.yaml"}
+	fd.Setup()
+
+	respMap := map[string]*FakeResp{
+		"lvdisplay": {"-wi-a-----", nil},
+		"lvcreate":  {"", nil},
+	}
+	fd.cli.RootExecuter = NewFakeExecuter(respMap)
+	fd.cli.BaseExecuter = NewFakeExecuter(respMap)
+
+	opt := &pb.CreateVolumeGroupSnapshotOpts{
+		Id:      "3769855c-a102-11e7-b772-17b880d2f560",
+		GroupId: "3769855c-a102-11e7-b772-17b880d2f555",
+		Snapshots: []*pb.CreateVolumeSnapshotOpts{
+			{
+				Id:       "d1916c49-3088-4a40-b6fb-0fda18d074c3",
+				Size:     int64(1),
+				VolumeId: "bd5b12a8-a101-11e7-941e-d77981b584d8",
+				Metadata: map[string]string{
+					"lvPath": "/dev/vg001/volume-bd5b12a8-a101-11e7-941e-d77981b584d8",
+				},
+			},
+			{
+				Id:       "d1916c49-3088-4a40-b6fb-0fda18d074c4",
+				Size:     int64(1),
+				VolumeId: "bd5b12a8-a101-11e7-941e-d77981b584d9",
+				Metadata: map[string]string{
+					"lvPath": "/dev/vg001/volume-bd5b12a8-a101-11e7-941e-d77981b584d9",
+				},
+			},
+		},
+	}
+	snps, err := fd.CreateGroupSnapshot(opt)
+	if err != nil {
+		t.Fatal("Failed to create volume group snapshot:", err)
+	}
+	if len(snps) != 2 {
+		t.Fatalf("Expected 2 snapshots, got %d", len(snps))
+	}
+	for i, snp := range snps {
+		if snp.GroupSnapshotId != opt.Id {
+			t.Errorf("Expected group snapshot id %s, got %s", opt.Id, snp.GroupSnapshotId)
+		}
+		expectedPath := "/dev/vg001/_snapshot-" + opt.Snapshots[i].Id
+		if snp.Metadata["lvsPath"] != expectedPath {
+			t.Errorf("Expected lvsPath %s, got %s", expectedPath, snp.Metadata["lvsPath"])
+		}
+	}
+
+	respMap["lvcreate"] = &FakeResp{"", fmt.Errorf("insufficient free space")}
+	if _, err = fd.CreateGroupSnapshot(opt); err == nil {
+		t.Error("Expected error of creating snapshot, got nil")
+	}
+}
+
 func TestListPools(t *testing.T) {
-	var fd = &Driver{}
-	config.CONF.OsdsDock.Backends.LVM.ConfigPath = "testdata/lvm.yaml"
+	var fd = &Driver{ConfigPath: "testdata/lvm.yaml"}
 	fd.Setup()
 
 	var vgsResp = `  vg001  18.00 18.00 ahF6kS-QNOH-X63K-avat-6Kag-XLTo-c9ghQ6
@@ -319,7 +371,7 @@ func TestListPools(t *testing.T) {
 			StorageType:      "block",
 			Extras: model.StoragePoolExtraSpec{
 				DataStorage: model.DataStorageLoS{
-					ProvisioningPolicy: "Thin",
+					ProvisioningPolicy: "Fixed",
 					IsSpaceEfficient:   false,
 				},
 				IOConnectivity: model.IOConnectivityLoS{
@@ -346,3 +398,88 @@ func TestListPools(t *testing.T) {
 		t.Errorf("Expected %+v, got %+v\n", expected[0], pols[0])
 	}
 }
+
+func TestListThinPools(t *testing.T) {
+	var fd = &Driver{ConfigPath: "testdata/lvm.yaml"}
+	fd.Setup()
+	fd.conf.ThinProvision = true
+
+	var vgsResp = `  vg001  18.00 0.90 ahF6kS-QNOH-X63K-avat-6Kag-XLTo-c9ghQ6
+`
+	var lvsResp = `  vg001|vg001-pool|17.00||25.00|3.50|twi-aotz--
+  vg001|volume-0e2f4a9e-4a94-4d27-b1b4-83464811605c|100.00|vg001-pool|4.00||Vwi-a-tz--
+  vg001|volume-591c43e6-1156-42f5-9fbc-161153da185c|200.00|vg001-pool|0.50||Vwi-a-tz--
+  ubuntu-vg|root|120.00||||-wi-ao----
+`
+	respMap := map[string]*FakeResp{
+		"vgs": {vgsResp, nil},
+		"lvs": {lvsResp, nil},
+	}
+	fd.cli.RootExecuter = NewFakeExecuter(respMap)
+	fd.cli.BaseExecuter = NewFakeExecuter(respMap)
+
+	pols, err := fd.ListPools()
+	if err != nil {
+		t.Fatal("Failed to list pools:", err)
+	}
+	if len(pols) != 1 {
+		t.Fatalf("Expected 1 pool, got %d", len(pols))
+	}
+	// 17g * 20 - 300g provisioned is 40g, which is capped by the size of
+	// the thin pool.
+	if pols[0].TotalCapacity != 17 || pols[0].FreeCapacity != 17 {
+		t.Errorf("Expected total 17 and free 17, got total %d and free %d",
+			pols[0].TotalCapacity, pols[0].FreeCapacity)
+	}
+	var expectedDataStorage = model.DataStorageLoS{
+		ProvisioningPolicy: "Thin",
+		IsSpaceEfficient:   true,
+	}
+	if !reflect.DeepEqual(pols[0].Extras.DataStorage, expectedDataStorage) {
+		t.Errorf("Expected %+v, got %+v", expectedDataStorage, pols[0].Extras.DataStorage)
+	}
+	var expectedAdvanced = map[string]interface{}{
+		"diskType":                 "SSD",
+		"latency":                  "5ms",
+		"thinPoolDataUsage":        25.0,
+		"thinPoolMetadataUsage":    3.5,
+		"provisionedCapacity":      int64(300),
+		"maxOverSubscriptionRatio": 20.0,
+	}
+	if !reflect.DeepEqual(pols[0].Extras.Advanced, expectedAdvanced) {
+		t.Errorf("Expected %+v, got %+v", expectedAdvanced, pols[0].Extras.Advanced)
+	}
+	// The advanced extras of config are kept as they are.
+	if len(fd.conf.Pool["vg001"].Extras.Advanced) != 2 {
+		t.Errorf("Expected advanced extras of config unchanged, got %+v", fd.conf.Pool["vg001"].Extras.Advanced)
+	}
+
+	// 17g * 18 - 300g provisioned
+	fd.conf.OversubRatio = 18
+	if pols, err = fd.ListPools(); err != nil {
+		t.Fatal("Failed to list pools:", err)
+	}
+	if pols[0].TotalCapacity != 17 || pols[0].FreeCapacity != 6 {
+		t.Errorf("Expected total 17 and free 6, got total %d and free %d",
+			pols[0].TotalCapacity, pols[0].FreeCapacity)
+	}
+}
+
+func TestSetupThinPools(t *testing.T) {
+	var fd = &Driver{ConfigPath: "testdata/lvm.yaml"}
+	fd.Setup()
+	fd.conf.ThinPoolSize = "60%VG"
+
+	e := exec.NewReplayExecuter(
+		exec.Record{Name: "env", Args: []string{"LC_ALL=C", "lvs", "--noheadings", "-o", "name"}},
+		exec.Record{Name: "env", Args: []string{"LC_ALL=C", "lvcreate", "-T", "-l", "60%VG", "vg001/vg001-pool"}},
+	)
+	fd.cli.RootExecuter = e
+	fd.cli.BaseExecuter = e
+	if err := fd.setupThinPools(); err != nil {
+		t.Fatal("Failed to set up thin pools:", err)
+	}
+	if recs := e.Unplayed(); len(recs) != 0 {
+		t.Errorf("Expected commands not run: %+v", recs)
+	}
+}
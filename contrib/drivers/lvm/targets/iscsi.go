@@ -17,7 +17,6 @@ package targets
 import (
 	"fmt"
 	"os"
-	"os/exec"
 	"strconv"
 	"strings"
 
@@ -28,6 +27,10 @@ import (
 const (
 	opensdsPrefix = "opensds-"
 	tgtAdminCmd   = "tgt-admin"
+
+	// ISCSITgt and ISCSILio are the implementations of iscsi target.
+	ISCSITgt = "tgt"
+	ISCSILio = "lio"
 )
 
 type ISCSITarget interface {
@@ -37,7 +40,12 @@ type ISCSITarget interface {
 	GetLun(path string) int
 }
 
-func NewISCSITarget(bip, tgtConfDir string) ISCSITarget {
+// NewISCSITarget method creates an iscsi target implemented by the helper,
+// which is either tgt or lio, tgt by default.
+func NewISCSITarget(bip, tgtConfDir, helper string) ISCSITarget {
+	if helper == ISCSILio {
+		return &lioTarget{BindIp: bip}
+	}
 	return &tgtTarget{
 		TgtConfDir: tgtConfDir,
 		BindIp:     bip,
@@ -173,11 +181,5 @@ func (t *tgtTarget) RemoveISCSITarget(volId, iqn string) error {
 }
 
 func (*tgtTarget) execCmd(name string, cmd ...string) (string, error) {
-	ret, err := exec.Command(name, cmd...).Output()
-	log.Infoln("Command:", cmd, strings.Join(cmd, " "))
-	log.V(8).Infof("result:%s", string(ret))
-	if err != nil {
-		log.Error("error info:", err)
-	}
-	return string(ret), err
+	return Executer.Run(name, cmd...)
 }
@@ -0,0 +1,59 @@
+// Copyright (c) 2018 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+//    Licensed under the Apache License, Version 2.0 (the "License"); you may
+//    not use this file except in compliance with the License. You may obtain
+//    a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//    WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//    License for the specific language governing permissions and limitations
+//    under the License.
+
+package targets
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/opensds/opensds/pkg/utils/exec"
+)
+
+// TestTgtTarget replays the commands of tgt recorded on a host exporting the
+// volume, so that tgt is not required to run it.
+func TestTgtTarget(t *testing.T) {
+	replayer, err := exec.NewReplayExecuterFromFile("testdata/tgt.record")
+	if err != nil {
+		t.Fatal(err)
+	}
+	oldExecuter := Executer
+	Executer = replayer
+	defer func() { Executer = oldExecuter }()
+
+	dir, err := ioutil.TempDir("", "tgt-test-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	var volId, iqn, path = "0001", iscsiTgtPrefix + "0001", "/dev/vg001/volume-0001"
+	tgt := NewISCSITarget("192.168.0.10", dir, ISCSITgt)
+	if err := tgt.CreateISCSITarget(volId, iqn, path, "192.168.0.20", "ALL", nil); err != nil {
+		t.Fatal("Create iscsi target failed:", err)
+	}
+	if lun := tgt.GetLun(path); lun != 1 {
+		t.Errorf("Expected lun 1, got %d", lun)
+	}
+	if err := tgt.RemoveISCSITarget(volId, iqn); err != nil {
+		t.Error("Remove iscsi target failed:", err)
+	}
+	if _, err := os.Stat(dir + "/" + opensdsPrefix + volId + ".conf"); !os.IsNotExist(err) {
+		t.Error("Expected config of target to be removed, got", err)
+	}
+	if recs := replayer.Unplayed(); len(recs) != 0 {
+		t.Errorf("Expected all commands to be run, %d are not: %v", len(recs), recs)
+	}
+}
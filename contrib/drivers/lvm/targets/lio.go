@@ -0,0 +1,286 @@
+// Copyright (c) 2018 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+//    Licensed under the Apache License, Version 2.0 (the "License"); you may
+//    not use this file except in compliance with the License. You may obtain
+//    a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//    WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//    License for the specific language governing permissions and limitations
+//    under the License.
+
+package targets
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	log "github.com/golang/glog"
+	"github.com/opensds/opensds/pkg/utils"
+)
+
+const (
+	lioTpgt   = "tpgt_1"
+	lioLun    = "lun_0"
+	lioHba    = "iblock_0"
+	iscsiPort = "3260"
+)
+
+// lioConfigfsPath is where the LIO target is configured through configfs.
+var lioConfigfsPath = "/sys/kernel/config/target"
+
+// lioTarget manages the LIO iSCSI target through configfs directly, so that
+// neither targetcli nor tgt is required on the host. Every volume is exported
+// by its own target with a single lun, and the initiators allowed to access
+// it are listed in the ACLs of the target.
+type lioTarget struct {
+	BindIp string
+}
+
+func (t *lioTarget) backstorePath(volId string) string {
+	return filepath.Join(lioConfigfsPath, "core", lioHba, opensdsPrefix+volId)
+}
+
+func (t *lioTarget) tpgPath(iqn string) string {
+	return filepath.Join(lioConfigfsPath, "iscsi", iqn, lioTpgt)
+}
+
+func (t *lioTarget) GetLun(path string) int {
+	links, _ := filepath.Glob(filepath.Join(lioConfigfsPath, "iscsi", "*", "tpgt_*", "lun", "lun_*", "*"))
+	for _, link := range links {
+		if fi, err := os.Lstat(link); err != nil || fi.Mode()&os.ModeSymlink == 0 {
+			continue
+		}
+		// The link points to the backstore whose udev_path is the device.
+		dev, err := ioutil.ReadFile(filepath.Join(link, "udev_path"))
+		if err != nil || strings.TrimSpace(string(dev)) != path {
+			continue
+		}
+		lun, err := strconv.Atoi(strings.TrimPrefix(filepath.Base(filepath.Dir(link)), "lun_"))
+		if err != nil {
+			return -1
+		}
+		log.Info("Got lun id:", lun)
+		return lun
+	}
+	return -1
+}
+
+func (t *lioTarget) CreateISCSITarget(volId, tgtIqn, path, hostIp, initiator string, chapAuth []string) error {
+	if exist, _ := utils.PathExists(filepath.Join(lioConfigfsPath, "iscsi")); !exist {
+		t.execCmd("modprobe", "iscsi_target_mod")
+	}
+
+	backstore := t.backstorePath(volId)
+	if exist, _ := utils.PathExists(backstore); !exist {
+		if err := os.MkdirAll(backstore, 0755); err != nil {
+			log.Errorf("Fail to create lio backstore of volume %s: %v", volId, err)
+			return err
+		}
+		if err := t.writeAttrs(backstore, [][2]string{
+			{"control", "udev_path=" + path},
+			{"udev_path", path},
+			{"enable", "1"},
+		}); err != nil {
+			return err
+		}
+	}
+
+	tpg := t.tpgPath(tgtIqn)
+	lun := filepath.Join(tpg, "lun", lioLun)
+	if exist, _ := utils.PathExists(lun); !exist {
+		if err := os.MkdirAll(lun, 0755); err != nil {
+			log.Errorf("Fail to create lun of lio target %s: %v", tgtIqn, err)
+			return err
+		}
+		if err := os.Symlink(backstore, filepath.Join(lun, opensdsPrefix+volId)); err != nil {
+			log.Errorf("Fail to link lun of lio target %s to backstore: %v", tgtIqn, err)
+			return err
+		}
+	}
+
+	bindIp := t.BindIp
+	if bindIp == "" {
+		bindIp = "0.0.0.0"
+	}
+	portal := filepath.Join(tpg, "np", bindIp+":"+iscsiPort)
+	if exist, _ := utils.PathExists(portal); !exist {
+		if err := os.MkdirAll(portal, 0755); err != nil {
+			log.Errorf("Fail to create portal of lio target %s: %v", tgtIqn, err)
+			return err
+		}
+	}
+
+	// LIO has no ACL of initiator address like tgt, so hostIp is ignored and
+	// the access is only controlled by the initiator name and CHAP.
+	var err error
+	if initiator == "ALL" {
+		err = t.allowAll(tpg, chapAuth)
+	} else {
+		err = t.allowInitiator(tpg, lun, initiator, chapAuth)
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := t.writeAttrs(tpg, [][2]string{{"enable", "1"}}); err != nil {
+		return err
+	}
+	if t.GetISCSITarget(tgtIqn) == -1 {
+		return fmt.Errorf("failed to create volume(%s) attachment", volId)
+	}
+	return nil
+}
+
+// allowAll lets any initiator access the target, which is called demo mode
+// in LIO.
+func (t *lioTarget) allowAll(tpg string, chapAuth []string) error {
+	var authentication = "0"
+	if len(chapAuth) == 2 {
+		if err := t.writeAttrs(tpg, [][2]string{
+			{"auth/userid", chapAuth[0]},
+			{"auth/password", chapAuth[1]},
+		}); err != nil {
+			return err
+		}
+		authentication = "1"
+	}
+	return t.writeAttrs(tpg, [][2]string{
+		{"attrib/generate_node_acls", "1"},
+		{"attrib/demo_mode_write_protect", "0"},
+		{"attrib/cache_dynamic_acls", "1"},
+		{"attrib/authentication", authentication},
+	})
+}
+
+// allowInitiator adds the ACL of the initiator to the target, in which the lun
+// is mapped and CHAP of the initiator is set.
+func (t *lioTarget) allowInitiator(tpg, lun, initiator string, chapAuth []string) error {
+	acl := filepath.Join(tpg, "acls", initiator)
+	mappedLun := filepath.Join(acl, lioLun)
+	if exist, _ := utils.PathExists(mappedLun); !exist {
+		if err := os.MkdirAll(mappedLun, 0755); err != nil {
+			log.Errorf("Fail to create acl of initiator %s: %v", initiator, err)
+			return err
+		}
+		if err := os.Symlink(lun, filepath.Join(mappedLun, lioLun)); err != nil {
+			log.Errorf("Fail to map lun to initiator %s: %v", initiator, err)
+			return err
+		}
+	}
+
+	var authentication = "0"
+	if len(chapAuth) == 2 {
+		if err := t.writeAttrs(acl, [][2]string{
+			{"auth/userid", chapAuth[0]},
+			{"auth/password", chapAuth[1]},
+		}); err != nil {
+			return err
+		}
+		authentication = "1"
+	}
+	return t.writeAttrs(tpg, [][2]string{
+		{"attrib/generate_node_acls", "0"},
+		{"attrib/authentication", authentication},
+	})
+}
+
+func (t *lioTarget) GetISCSITarget(iqn string) int {
+	tpg := t.tpgPath(iqn)
+	if exist, _ := utils.PathExists(tpg); !exist {
+		return -1
+	}
+	tid, err := strconv.Atoi(strings.TrimPrefix(lioTpgt, "tpgt_"))
+	if err != nil {
+		return -1
+	}
+	return tid
+}
+
+func (t *lioTarget) RemoveISCSITarget(volId, iqn string) error {
+	tpg := t.tpgPath(iqn)
+	if exist, _ := utils.PathExists(tpg); !exist {
+		log.Warningf("Lio target %s does not exist, nothing to remove.", iqn)
+		return t.removeBackstore(volId)
+	}
+	t.writeAttrs(tpg, [][2]string{{"enable", "0"}})
+
+	// The objects in configfs have to be removed one by one in the reverse
+	// order of creation, the attribute files go with their directories.
+	var paths []string
+	acls, _ := filepath.Glob(filepath.Join(tpg, "acls", "*"))
+	for _, acl := range acls {
+		mappedLuns, _ := filepath.Glob(filepath.Join(acl, "lun_*"))
+		for _, mappedLun := range mappedLuns {
+			links, _ := filepath.Glob(filepath.Join(mappedLun, "*"))
+			paths = append(paths, t.symlinks(links)...)
+			paths = append(paths, mappedLun)
+		}
+		paths = append(paths, acl)
+	}
+	luns, _ := filepath.Glob(filepath.Join(tpg, "lun", "lun_*"))
+	for _, lun := range luns {
+		links, _ := filepath.Glob(filepath.Join(lun, "*"))
+		paths = append(paths, t.symlinks(links)...)
+		paths = append(paths, lun)
+	}
+	portals, _ := filepath.Glob(filepath.Join(tpg, "np", "*"))
+	paths = append(paths, portals...)
+	paths = append(paths, tpg, filepath.Dir(tpg))
+
+	for _, p := range paths {
+		if err := os.Remove(p); err != nil {
+			log.Errorf("Fail to remove %s of lio target %s: %v", p, iqn, err)
+			return err
+		}
+	}
+	return t.removeBackstore(volId)
+}
+
+func (t *lioTarget) removeBackstore(volId string) error {
+	backstore := t.backstorePath(volId)
+	if exist, _ := utils.PathExists(backstore); !exist {
+		return nil
+	}
+	if err := os.Remove(backstore); err != nil {
+		log.Errorf("Fail to remove lio backstore of volume %s: %v", volId, err)
+		return err
+	}
+	return nil
+}
+
+// symlinks returns the symbolic links among the paths.
+func (*lioTarget) symlinks(paths []string) []string {
+	var links []string
+	for _, p := range paths {
+		if fi, err := os.Lstat(p); err == nil && fi.Mode()&os.ModeSymlink != 0 {
+			links = append(links, p)
+		}
+	}
+	return links
+}
+
+// writeAttrs writes the values to the attribute files under dir in order.
+func (*lioTarget) writeAttrs(dir string, attrs [][2]string) error {
+	for _, attr := range attrs {
+		// The groups of attributes are created by configfs along with their
+		// parent, so this only takes effect out of configfs.
+		os.MkdirAll(filepath.Dir(filepath.Join(dir, attr[0])), 0755)
+		if err := ioutil.WriteFile(filepath.Join(dir, attr[0]), []byte(attr[1]), 0644); err != nil {
+			log.Errorf("Fail to write %s to %s: %v", attr[1], attr[0], err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (*lioTarget) execCmd(name string, cmd ...string) (string, error) {
+	return Executer.Run(name, cmd...)
+}
@@ -0,0 +1,109 @@
+// Copyright (c) 2018 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+//    Licensed under the Apache License, Version 2.0 (the "License"); you may
+//    not use this file except in compliance with the License. You may obtain
+//    a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//    WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//    License for the specific language governing permissions and limitations
+//    under the License.
+
+package targets
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func newTestLioTarget(t *testing.T) (*lioTarget, func()) {
+	dir, err := ioutil.TempDir("", "lio-test-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The iscsi group exists once the module of lio is loaded.
+	os.MkdirAll(filepath.Join(dir, "iscsi"), 0755)
+
+	oldPath := lioConfigfsPath
+	lioConfigfsPath = dir
+	return &lioTarget{BindIp: "192.168.0.10"}, func() {
+		lioConfigfsPath = oldPath
+		os.RemoveAll(dir)
+	}
+}
+
+func readAttr(t *testing.T, path string) string {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(b)
+}
+
+func TestLioCreateISCSITarget(t *testing.T) {
+	tgt, cleanup := newTestLioTarget(t)
+	defer cleanup()
+
+	var volId, iqn, path = "0001", iscsiTgtPrefix + "0001", "/dev/vg001/volume-0001"
+	var initiator = "iqn.1993-08.org.debian:01:host1"
+	if err := tgt.CreateISCSITarget(volId, iqn, path, "", initiator, []string{"user", "password"}); err != nil {
+		t.Fatal(err)
+	}
+
+	tpg := tgt.tpgPath(iqn)
+	var expectedAttrs = map[string]string{
+		filepath.Join(tgt.backstorePath(volId), "udev_path"):      path,
+		filepath.Join(tpg, "enable"):                              "1",
+		filepath.Join(tpg, "attrib", "generate_node_acls"):        "0",
+		filepath.Join(tpg, "attrib", "authentication"):            "1",
+		filepath.Join(tpg, "acls", initiator, "auth", "userid"):   "user",
+		filepath.Join(tpg, "acls", initiator, "auth", "password"): "password",
+	}
+	for attr, expected := range expectedAttrs {
+		if got := readAttr(t, attr); got != expected {
+			t.Errorf("Expected %s of %s, got %s", expected, attr, got)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(tpg, "np", "192.168.0.10:3260")); err != nil {
+		t.Error("Expected portal created:", err)
+	}
+	if _, err := os.Lstat(filepath.Join(tpg, "acls", initiator, lioLun, lioLun)); err != nil {
+		t.Error("Expected lun mapped to initiator:", err)
+	}
+
+	if tid := tgt.GetISCSITarget(iqn); tid != 1 {
+		t.Errorf("Expected target id 1, got %d", tid)
+	}
+	if lun := tgt.GetLun(path); lun != 0 {
+		t.Errorf("Expected lun 0, got %d", lun)
+	}
+	if lun := tgt.GetLun("/dev/vg001/volume-0002"); lun != -1 {
+		t.Errorf("Expected lun -1 of volume not exported, got %d", lun)
+	}
+}
+
+func TestLioCreateISCSITargetForAll(t *testing.T) {
+	tgt, cleanup := newTestLioTarget(t)
+	defer cleanup()
+
+	var volId, iqn = "0001", iscsiTgtPrefix + "0001"
+	if err := tgt.CreateISCSITarget(volId, iqn, "/dev/vg001/volume-0001", "", "ALL", nil); err != nil {
+		t.Fatal(err)
+	}
+
+	tpg := tgt.tpgPath(iqn)
+	if got := readAttr(t, filepath.Join(tpg, "attrib", "generate_node_acls")); got != "1" {
+		t.Errorf("Expected demo mode enabled, got generate_node_acls %s", got)
+	}
+	if got := readAttr(t, filepath.Join(tpg, "attrib", "authentication")); got != "0" {
+		t.Errorf("Expected authentication disabled, got %s", got)
+	}
+	if acls, _ := filepath.Glob(filepath.Join(tpg, "acls", "*")); len(acls) != 0 {
+		t.Errorf("Expected no acl, got %v", acls)
+	}
+}
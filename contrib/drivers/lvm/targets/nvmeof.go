@@ -17,10 +17,11 @@ package targets
 import (
 	"bytes"
 	"errors"
+	"fmt"
 	"io"
+	"io/ioutil"
 	"os"
-	"os/exec"
-	"strconv"
+	"path/filepath"
 	"strings"
 
 	log "github.com/golang/glog"
@@ -30,29 +31,54 @@ import (
 const (
 	opensdsNvmeofPrefix = "opensds-Nvmeof"
 	NvmetDir            = "/sys/kernel/config/nvmet"
+
+	NvmeofTCP  = "tcp"
+	NvmeofRDMA = "rdma"
+	NvmeofLoop = "loop"
 )
 
+// nvmetPorts are the ids of ports through which the target serves, the
+// subsystems served through the same transport share one port.
+var nvmetPorts = map[string]string{
+	NvmeofRDMA: "1",
+	NvmeofTCP:  "2",
+	NvmeofLoop: "3",
+}
+
+// nvmetModules are the kernel modules of target transports.
+var nvmetModules = map[string]string{
+	NvmeofRDMA: "nvmet-rdma",
+	NvmeofTCP:  "nvmet-tcp",
+	NvmeofLoop: "nvme-loop",
+}
+
 type NvmeofTarget interface {
 	CreateNvmeofTarget(volId, tgtIqn, path, hostIp, initiator string, chapAuth []string) error
 	GetNvmeofTarget(iqn string) int
 	RemoveNvmeofTarget(volId, iqn string) error
 }
 
-func NewNvmeofTarget(bip, tgtConfDir string) NvmeofTarget {
+// NewNvmeofTarget method creates a nvmeof target which serves through the
+// transport, one of tcp, rdma and loop, on the port.
+func NewNvmeofTarget(bip, tgtConfDir, transport, port string) NvmeofTarget {
 	return &NvmeoftgtTarget{
 		TgtConfDir: tgtConfDir,
 		BindIp:     bip,
+		Transport:  transport,
+		Port:       port,
 	}
 }
 
 type NvmeoftgtTarget struct {
 	BindIp     string
 	TgtConfDir string
+	Transport  string
+	Port       string
 }
 
 func (t *NvmeoftgtTarget) init() {
 	t.execCmd("modprobe", "nvmet")
-	t.execCmd("modprobe", "nvmet-rdma")
+	t.execCmd("modprobe", nvmetModules[t.Transport])
 }
 
 func (t *NvmeoftgtTarget) getTgtConfPath(volId string) string {
@@ -60,6 +86,10 @@ func (t *NvmeoftgtTarget) getTgtConfPath(volId string) string {
 }
 
 func (t *NvmeoftgtTarget) CreateNvmeofTarget(volId, tgtNqn, path, hostIp, initiator string, chapAuth []string) error {
+	if _, ok := nvmetPorts[t.Transport]; !ok {
+		return fmt.Errorf("nvmeof transport %s is not supported", t.Transport)
+	}
+	t.init()
 
 	if exist, _ := utils.PathExists(NvmetDir); !exist {
 		os.MkdirAll(NvmetDir, 0755)
@@ -127,66 +157,118 @@ func (t *NvmeoftgtTarget) CreateNvmeofTarget(volId, tgtNqn, path, hostIp, initia
 	}
 
 	//create port
-	portid := 1
-	portspath := NvmetDir + "/ports/" + strconv.Itoa(portid)
-	if exist, _ := utils.PathExists(portspath); !exist {
-		//log.Errorf(portspath)
-		os.MkdirAll(portspath, 0755)
-	}
-
-	// get target ip
-	ippath := portspath + "/addr_traddr"
-	ip, err := t.execCmd("hostname", "-I")
+	portspath, err := t.createPort()
 	if err != nil {
-		log.Errorf("fail to get target ipv4 address")
 		return err
 	}
-	// Set nvmeof parameters, rightnow only supports rdma
-	// if built on virtual machine the return string ip may contain several ip addresses
-	ip = strings.Split(ip, " ")[0]
-	err = t.WriteWithIo(ippath, ip)
+
+	// create a soft link
+	portssub := portspath + "/subsystems/" + tgtNqn
+	_, err = t.execCmd("ln", "-s", sysdir, portssub)
 	if err != nil {
-		log.Errorf("Fail to set target ip")
+		log.Errorf("Fail to create link")
 		return err
 	}
 
-	trtypepath := portspath + "/addr_trtype"
-	err = t.WriteWithIo(trtypepath, "rdma")
-	if err != nil {
-		log.Errorf("Fail to set rdma type")
-		return err
+	// check
+	if exist, _ := utils.PathExists(portssub); !exist {
+		log.Errorf("nvme target is not served on the port")
+		return errors.New("nvme target is not served on the port")
 	}
+	log.Info("create nvme target")
+	return nil
+}
 
-	trsvcidpath := portspath + "/addr_trsvcid"
-	err = t.WriteWithIo(trsvcidpath, "4420")
-	if err != nil {
-		log.Errorf("Fail to set ip port")
-		return err
+// portAttr is an attribute of the port of target, which is a file in the
+// directory of port.
+type portAttr struct {
+	name  string
+	value string
+}
+
+// createPort creates the port of target transport if it doesn't exist, and
+// rewrites it if any of its attributes differs from the ones wanted, such as
+// the address of host has changed.
+func (t *NvmeoftgtTarget) createPort() (string, error) {
+	portspath := NvmetDir + "/ports/" + nvmetPorts[t.Transport]
+	if exist, _ := utils.PathExists(portspath); !exist {
+		os.MkdirAll(portspath, 0755)
 	}
 
-	adrfampath := portspath + "/addr_adrfam"
-	err = t.WriteWithIo(adrfampath, "ipv4")
+	attrs, err := t.portAttrs()
 	if err != nil {
-		log.Errorf("Fail to set ip family")
-		return err
+		return "", err
+	}
+	if portMatches(portspath, attrs) {
+		return portspath, nil
 	}
 
-	// create a soft link
-	portssub := portspath + "/subsystems/" + tgtNqn
-	_, err = t.execCmd("ln", "-s", sysdir, portssub)
-	if err != nil {
-		log.Errorf("Fail to create link")
-		return err
+	// The attributes of port can't be changed while any subsystem is linked
+	// to it, so the links are removed and restored after it's rewritten.
+	links, _ := filepath.Glob(portspath + "/subsystems/*")
+	var sysdirs = make(map[string]string)
+	for _, link := range links {
+		sysdir, err := os.Readlink(link)
+		if err != nil {
+			continue
+		}
+		if err = os.Remove(link); err != nil {
+			log.Errorf("Fail to unlink subsystem %s from port: %v", sysdir, err)
+			return "", err
+		}
+		sysdirs[link] = sysdir
+	}
+	for _, attr := range attrs {
+		if err := t.WriteWithIo(portspath+"/"+attr.name, attr.value); err != nil {
+			log.Errorf("Fail to set %s of port", attr.name)
+			return "", err
+		}
 	}
+	for link, sysdir := range sysdirs {
+		if _, err := t.execCmd("ln", "-s", sysdir, link); err != nil {
+			log.Errorf("Fail to link subsystem %s to port", sysdir)
+			return "", err
+		}
+	}
+	return portspath, nil
+}
 
-	// check
-	info, err := t.execCmd("dmesg", `|grep "enabling port"`)
-	if err != nil || info == "" {
-		log.Errorf("nvme target is not listening on the port")
-		return err
+// portAttrs returns the attributes of the port of target transport, the
+// transport type is the last one so that it's written after the address.
+func (t *NvmeoftgtTarget) portAttrs() ([]portAttr, error) {
+	var attrs []portAttr
+	// The loop port has no address, it's only reachable from the local host.
+	if t.Transport != NvmeofLoop {
+		// get target ip
+		ip, err := t.execCmd("hostname", "-I")
+		if err != nil {
+			log.Errorf("fail to get target ipv4 address")
+			return nil, err
+		}
+		// if built on virtual machine the return string ip may contain several ip addresses
+		ips := strings.Fields(ip)
+		if len(ips) == 0 {
+			return nil, errors.New("no ipv4 address found for nvme target")
+		}
+		attrs = append(attrs,
+			portAttr{"addr_traddr", ips[0]},
+			portAttr{"addr_trsvcid", t.Port},
+			portAttr{"addr_adrfam", "ipv4"},
+		)
 	}
-	log.Info("create nvme target")
-	return nil
+	return append(attrs, portAttr{"addr_trtype", t.Transport}), nil
+}
+
+// portMatches returns whether all the attributes of the port in portspath
+// are the same as attrs.
+func portMatches(portspath string, attrs []portAttr) bool {
+	for _, attr := range attrs {
+		value, _ := ioutil.ReadFile(portspath + "/" + attr.name)
+		if strings.TrimSpace(string(value)) != attr.value {
+			return false
+		}
+	}
+	return true
 }
 
 func (t *NvmeoftgtTarget) GetNvmeofTarget(nqn string) int {
@@ -211,9 +293,10 @@ func (t *NvmeoftgtTarget) RemoveNvmeofTarget(volId, nqn string) error {
 		return nil
 	}
 
-	//  port's link has to be removed first or the subsystem cannot be removed
-	portpath := NvmetDir + "/ports/1/subsystems/" + nqn
-	info, err := t.execCmd("rm", "-f", portpath)
+	//  port's link has to be removed first or the subsystem cannot be removed,
+	//  the subsystem may be linked to the port of any transport
+	portpath := NvmetDir + "/ports/*/subsystems/" + nqn
+	info, err := t.execBash("rm -f " + portpath)
 	if err != nil {
 		log.Errorf("can not rm port")
 		log.Errorf(info)
@@ -254,19 +337,11 @@ func (t *NvmeoftgtTarget) RemoveNvmeofTarget(volId, nqn string) error {
 }
 
 func (*NvmeoftgtTarget) execCmd(name string, cmd ...string) (string, error) {
-	ret, err := exec.Command(name, cmd...).Output()
-	if err != nil {
-		log.Errorf("error info: %v", err)
-	}
-	return string(ret), err
+	return Executer.Run(name, cmd...)
 }
 
 func (*NvmeoftgtTarget) execBash(name string) (string, error) {
-	ret, err := exec.Command("/bin/sh", "-c", name).Output()
-	if err != nil {
-		log.Errorf("error info in sh %v ", err)
-	}
-	return string(ret), err
+	return Executer.Run("/bin/sh", "-c", name)
 }
 
 func (*NvmeoftgtTarget) WriteWithIo(name, content string) error {
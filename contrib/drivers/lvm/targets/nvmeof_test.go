@@ -0,0 +1,89 @@
+// Copyright (c) 2019 Intel Corporation, Ltd. All Rights Reserved.
+//
+//    Licensed under the Apache License, Version 2.0 (the "License"); you may
+//    not use this file except in compliance with the License. You may obtain
+//    a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//    WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//    License for the specific language governing permissions and limitations
+//    under the License.
+
+package targets
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/opensds/opensds/pkg/utils/exec"
+)
+
+func TestPortAttrs(t *testing.T) {
+	defer func(e exec.Executer) { Executer = e }(Executer)
+	Executer = exec.NewReplayExecuter(
+		exec.Record{Name: "hostname", Args: []string{"-I"}, Output: "192.168.0.10 172.17.0.1 \n"},
+	)
+
+	tgt := &NvmeoftgtTarget{Transport: NvmeofTCP, Port: "4420"}
+	attrs, err := tgt.portAttrs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var expected = []portAttr{
+		{"addr_traddr", "192.168.0.10"},
+		{"addr_trsvcid", "4420"},
+		{"addr_adrfam", "ipv4"},
+		{"addr_trtype", NvmeofTCP},
+	}
+	if len(attrs) != len(expected) {
+		t.Fatalf("Expected %v, got %v", expected, attrs)
+	}
+	for i := range expected {
+		if attrs[i] != expected[i] {
+			t.Errorf("Expected %v, got %v", expected[i], attrs[i])
+		}
+	}
+
+	// The loop port has no address.
+	tgt = &NvmeoftgtTarget{Transport: NvmeofLoop}
+	if attrs, _ = tgt.portAttrs(); len(attrs) != 1 || attrs[0].name != "addr_trtype" {
+		t.Errorf("Expected only transport type of loop port, got %v", attrs)
+	}
+}
+
+func TestPortMatches(t *testing.T) {
+	dir, err := ioutil.TempDir("", "nvmeof-test-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	for name, value := range map[string]string{
+		"addr_traddr":  "192.168.0.10\n",
+		"addr_trsvcid": "4420\n",
+		"addr_adrfam":  "ipv4\n",
+		"addr_trtype":  "tcp\n",
+	} {
+		ioutil.WriteFile(filepath.Join(dir, name), []byte(value), 0644)
+	}
+	var attrs = []portAttr{
+		{"addr_traddr", "192.168.0.10"},
+		{"addr_trsvcid", "4420"},
+		{"addr_adrfam", "ipv4"},
+		{"addr_trtype", "tcp"},
+	}
+	if !portMatches(dir, attrs) {
+		t.Error("Expected port matched")
+	}
+
+	// The port is rewritten if only its address differs.
+	attrs[0].value = "192.168.0.11"
+	if portMatches(dir, attrs) {
+		t.Error("Expected port with another address not matched")
+	}
+}
@@ -14,6 +14,37 @@
 
 package targets
 
+import (
+	osexec "os/exec"
+	"strings"
+
+	log "github.com/golang/glog"
+	"github.com/opensds/opensds/pkg/utils/exec"
+)
+
+// Executer runs the commands of targets, it can be replaced by tests with an
+// executer replaying the recorded commands.
+var Executer = exec.Recordable(&outputExecuter{})
+
+// outputExecuter runs the command and returns its standard output only, which
+// is parsed by the targets, the standard error is logged if it fails.
+type outputExecuter struct{}
+
+func (*outputExecuter) Run(name string, arg ...string) (string, error) {
+	log.Infoln("Command:", name, strings.Join(arg, " "))
+	ret, err := osexec.Command(name, arg...).Output()
+	if err != nil {
+		if e, ok := err.(*osexec.ExitError); ok {
+			log.Errorf("error info: %v, stderr: %s", err, e.Stderr)
+		} else {
+			log.Error("error info:", err)
+		}
+		return string(ret), err
+	}
+	log.V(8).Infof("result:%s", string(ret))
+	return string(ret), nil
+}
+
 const (
 	iscsiTgtPrefix  = "iqn.2017-10.io.opensds:"
 	nvmeofTgtPrefix = "nqn.2019-01.com.opensds:nvme:"
@@ -29,16 +60,19 @@ type Target interface {
 	RemoveExport(volId string) error
 }
 
-// NewTarget method creates a new target based on its type.
-func NewTarget(bip string, tgtConfDir string, access string) Target {
+// NewTarget method creates a new target based on its type, the iscsi target
+// is implemented by the helper, and the nvmeof target serves through the
+// transport on the port, which are ignored by others.
+func NewTarget(bip, tgtConfDir, access, helper, transport, port string) Target {
 	switch  access{
 	case iscsiAccess:
 		return &iscsiTarget{
-			ISCSITarget: NewISCSITarget(bip, tgtConfDir),
+			ISCSITarget: NewISCSITarget(bip, tgtConfDir, helper),
+			BindIp:      bip,
 		}
 	case  nvmeofAccess :
 		return &nvmeofTarget{
-			NvmeofTarget: NewNvmeofTarget(bip, tgtConfDir),
+			NvmeofTarget: NewNvmeofTarget(bip, tgtConfDir, transport, port),
 		}
 	default:
 		return nil
@@ -47,6 +81,7 @@ func NewTarget(bip string, tgtConfDir string, access string) Target {
 
 type iscsiTarget struct {
 	ISCSITarget
+	BindIp string
 }
 
 func (t *iscsiTarget) CreateExport(volId, path, hostIp, initiator string, chapAuth []string) (map[string]interface{}, error) {
@@ -58,7 +93,7 @@ func (t *iscsiTarget) CreateExport(volId, path, hostIp, initiator string, chapAu
 	conn := map[string]interface{}{
 		"targetDiscovered": true,
 		"targetIQN":        []string{tgtIqn},
-		"targetPortal":     []string{t.ISCSITarget.(*tgtTarget).BindIp + ":3260"},
+		"targetPortal":     []string{t.BindIp + ":" + iscsiPort},
 		"discard":          false,
 		"targetLun":        lunId,
 	}
@@ -84,11 +119,13 @@ func (t *nvmeofTarget) CreateExport(volId, path, hostIp, initiator string, chapA
 	if err := t.CreateNvmeofTarget(volId, tgtNqn, path, hostIp, initiator, chapAuth); err != nil {
 		return nil, err
 	}
+	tgt := t.NvmeofTarget.(*NvmeoftgtTarget)
 	conn := map[string]interface{}{
 		"targetDiscovered": true,
 		"targetNQN":        tgtNqn,
-		"targetIP":     t.NvmeofTarget.(*NvmeoftgtTarget).BindIp ,
-		"targetPort":	"4420",
+		"targetIP":         tgt.BindIp,
+		"targetPort":       tgt.Port,
+		"transportType":    tgt.Transport,
 		"discard":          false,
 	}
 	if len(chapAuth) == 2 {
@@ -0,0 +1,35 @@
+// Copyright (c) 2018 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+//    Licensed under the Apache License, Version 2.0 (the "License"); you may
+//    not use this file except in compliance with the License. You may obtain
+//    a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//    WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//    License for the specific language governing permissions and limitations
+//    under the License.
+
+package targets
+
+import (
+	"testing"
+)
+
+func TestOutputExecuter(t *testing.T) {
+	// The warnings written to standard error are not mixed into the output
+	// to be parsed.
+	out, err := (&outputExecuter{}).Run("/bin/sh", "-c", "echo out; echo warning >&2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out != "out\n" {
+		t.Errorf("Expected %q, got %q", "out\n", out)
+	}
+
+	if _, err := (&outputExecuter{}).Run("/bin/sh", "-c", "exit 3"); err == nil {
+		t.Error("Expected error of failed command, got nil")
+	}
+}
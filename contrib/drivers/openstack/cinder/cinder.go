@@ -36,7 +36,6 @@ import (
 	. "github.com/opensds/opensds/contrib/drivers/utils/config"
 	"github.com/opensds/opensds/pkg/model"
 	pb "github.com/opensds/opensds/pkg/model/proto"
-	"github.com/opensds/opensds/pkg/utils/config"
 	"github.com/opensds/opensds/pkg/utils/pwd"
 	"github.com/satori/go.uuid"
 )
@@ -45,11 +44,17 @@ const (
 	defaultConfPath = "/etc/opensds/driver/cinder.yaml"
 	KCinderVolumeId = "cinderVolumeId"
 	KCinderSnapId   = "cinderSnapId"
+
+	snapshotAttachmentPrefix  = "opensds-snapshot-attachment-"
+	snapshotAttachmentTimeout = 60
 )
 
 // Driver is a struct of Cinder backend, which can be called to manage block
 // storage service defined in gophercloud.
 type Driver struct {
+	// ConfigPath is the path of driver configuration file of the backend.
+	ConfigPath string
+
 	// Current block storage version
 	blockStoragev2 *gophercloud.ServiceClient
 	blockStoragev3 *gophercloud.ServiceClient
@@ -112,7 +117,7 @@ func (opts ListPoolOpts) ToStoragePoolsListQuery() (string, error) {
 func (d *Driver) Setup() error {
 	// Read cinder config file
 	d.conf = &CinderConfig{}
-	p := config.CONF.OsdsDock.Backends.Cinder.ConfigPath
+	p := d.ConfigPath
 	if "" == p {
 		p = defaultConfPath
 	}
@@ -303,8 +308,13 @@ func (d *Driver) InitializeConnection(req *pb.CreateVolumeAttachmentOpts) (*mode
 	}
 
 	log.Error(conn)
+	return convertConnectionInfo(conn), nil
+}
+
+// convertConnectionInfo converts the connection info returned by cinder into
+// the one used by opensds connectors.
+func convertConnectionInfo(conn map[string]interface{}) *model.ConnectionInfo {
 	data := conn["data"].(map[string]interface{})
-	log.Error(data)
 	connData := map[string]interface{}{
 		"accessMode":       data["access_mode"],
 		"targetDiscovered": data["target_discovered"],
@@ -322,7 +332,7 @@ func (d *Driver) InitializeConnection(req *pb.CreateVolumeAttachmentOpts) (*mode
 	return &model.ConnectionInfo{
 		DriverVolumeType: conn["driver_volume_type"].(string),
 		ConnectionData:   connData,
-	}, nil
+	}
 }
 
 // TerminateConnection
@@ -469,12 +479,119 @@ func (d *Driver) ListPools() ([]*model.StoragePoolSpec, error) {
 	return pols, nil
 }
 
+// Capabilities declares the operations supported by cinder driver.
+func (d *Driver) Capabilities() model.DriverCapabilitiesSpec {
+	return model.DriverCapabilitiesSpec{
+		SnapshotAttachment: true,
+	}
+}
+
+// snapshotAttachmentVolumeName returns the name of the temporary volume
+// through which the snapshot is exposed to the host.
+func snapshotAttachmentVolumeName(attachmentId string) string {
+	return snapshotAttachmentPrefix + attachmentId
+}
+
+// InitializeSnapshotConnection exposes the snapshot to the host through a
+// temporary read-only volume created from it, since cinder can not attach
+// snapshot directly.
 func (d *Driver) InitializeSnapshotConnection(opt *pb.CreateSnapshotAttachmentOpts) (*model.ConnectionInfo, error) {
-	return nil, &model.NotImplementError{S: "method InitializeSnapshotConnection has not been implemented yet"}
+	cinderSnapId := opt.Metadata[KCinderSnapId]
+	snp, err := snapshotsv2.Get(d.blockStoragev2, cinderSnapId).Extract()
+	if err != nil {
+		log.Error("Cannot get snapshot:", err)
+		return nil, err
+	}
+
+	vol, err := volumesv2.Create(d.blockStoragev2, &volumesv2.CreateOpts{
+		Name:       snapshotAttachmentVolumeName(opt.GetId()),
+		Size:       snp.Size,
+		SnapshotID: cinderSnapId,
+	}).Extract()
+	if err != nil {
+		log.Error("Cannot create volume from snapshot:", err)
+		return nil, err
+	}
+	if err := volumesv2.WaitForStatus(d.blockStoragev2, vol.ID, "available", snapshotAttachmentTimeout); err != nil {
+		log.Error("Volume created from snapshot is not available:", err)
+		d.deleteSnapshotAttachmentVolume(vol.ID)
+		return nil, err
+	}
+
+	// Set the temporary volume read-only, so that the data of snapshot can
+	// not be modified by the host.
+	body := map[string]interface{}{
+		"os-update_readonly_flag": map[string]interface{}{"readonly": true},
+	}
+	_, err = d.blockStoragev2.Post(d.blockStoragev2.ServiceURL("volumes", vol.ID, "action"),
+		body, nil, &gophercloud.RequestOpts{OkCodes: []int{202}})
+	if err != nil {
+		log.Error("Cannot set volume created from snapshot read-only:", err)
+		d.deleteSnapshotAttachmentVolume(vol.ID)
+		return nil, err
+	}
+
+	opts := &volumeactions.InitializeConnectionOpts{
+		IP:        opt.HostInfo.GetIp(),
+		Host:      opt.HostInfo.GetHost(),
+		Initiator: opt.HostInfo.GetInitiator(),
+		Platform:  opt.HostInfo.GetPlatform(),
+		OSType:    opt.HostInfo.GetOsType(),
+		Multipath: &opt.MultiPath,
+	}
+	conn, err := volumeactions.InitializeConnection(d.blockStoragev2, vol.ID, opts).Extract()
+	if err != nil {
+		log.Error("Cannot initialize snapshot connection:", err)
+		d.deleteSnapshotAttachmentVolume(vol.ID)
+		return nil, err
+	}
+	return convertConnectionInfo(conn), nil
 }
 
+// TerminateSnapshotConnection terminates the connection of the temporary
+// volume created from the snapshot and deletes it.
 func (d *Driver) TerminateSnapshotConnection(opt *pb.DeleteSnapshotAttachmentOpts) error {
-	return &model.NotImplementError{S: "method TerminateSnapshotConnection has not been implemented yet"}
+	listOpts := volumesv2.ListOpts{Name: snapshotAttachmentVolumeName(opt.GetId())}
+	pages, err := volumesv2.List(d.blockStoragev2, listOpts).AllPages()
+	if err != nil {
+		log.Error("Cannot list volumes:", err)
+		return err
+	}
+	vols, err := volumesv2.ExtractVolumes(pages)
+	if err != nil {
+		log.Error("Cannot extract volumes:", err)
+		return err
+	}
+	if len(vols) == 0 {
+		log.Warningf("Volume of snapshot attachment (%s) does not exist, ignore it", opt.GetId())
+		return nil
+	}
+
+	opts := volumeactions.TerminateConnectionOpts{
+		IP:        opt.HostInfo.GetIp(),
+		Host:      opt.HostInfo.GetHost(),
+		Initiator: opt.HostInfo.GetInitiator(),
+		Platform:  opt.HostInfo.GetPlatform(),
+		OSType:    opt.HostInfo.GetOsType(),
+	}
+	for _, vol := range vols {
+		if err := volumeactions.TerminateConnection(d.blockStoragev2, vol.ID, opts).ExtractErr(); err != nil {
+			log.Error("Cannot terminate snapshot connection:", err)
+			return err
+		}
+		if err := d.deleteSnapshotAttachmentVolume(vol.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *Driver) deleteSnapshotAttachmentVolume(volId string) error {
+	if err := volumesv2.Delete(d.blockStoragev2, volId).ExtractErr(); err != nil {
+		log.Error("Cannot delete volume created from snapshot:", err)
+		return err
+	}
+	return nil
 }
 
 func (d *Driver) CreateVolumeGroup(opt *pb.CreateVolumeGroupOpts) (*model.VolumeGroupSpec, error) {
@@ -0,0 +1,268 @@
+// Copyright (c) 2018 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+//    Licensed under the Apache License, Version 2.0 (the "License"); you may
+//    not use this file except in compliance with the License. You may obtain
+//    a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//    WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//    License for the specific language governing permissions and limitations
+//    under the License.
+
+package plugin
+
+import (
+	"encoding/json"
+	"errors"
+
+	log "github.com/golang/glog"
+	"github.com/opensds/opensds/pkg/model"
+	pb "github.com/opensds/opensds/pkg/model/proto"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+// parseResponse turns the generic response of plugin into the result or the
+// error of driver.
+func parseResponse(resp *pb.GenericResponse, err error, out interface{}) error {
+	if err != nil {
+		log.Error("when call driver plugin:", err)
+		return err
+	}
+	if errorMsg := resp.GetError(); errorMsg != nil {
+		return errors.New(errorMsg.GetDescription())
+	}
+	if out == nil {
+		return nil
+	}
+	return json.Unmarshal([]byte(resp.GetResult().GetMessage()), out)
+}
+
+// VolumeDriverClient implements the VolumeDriver interface of contrib/drivers
+// by calling the driver plugin at Endpoint.
+type VolumeDriverClient struct {
+	Endpoint string
+
+	conn   *grpc.ClientConn
+	client pb.VolumeDriverPluginClient
+}
+
+// NewVolumeDriverClient method creates a volume driver which calls the plugin
+// at edp.
+func NewVolumeDriverClient(edp string) *VolumeDriverClient {
+	return &VolumeDriverClient{Endpoint: edp}
+}
+
+// Setup
+func (d *VolumeDriverClient) Setup() error {
+	conn, err := Dial(d.Endpoint)
+	if err != nil {
+		log.Errorf("when connect driver plugin %s: %v", d.Endpoint, err)
+		return err
+	}
+	d.conn = conn
+	d.client = pb.NewVolumeDriverPluginClient(conn)
+	return nil
+}
+
+// Unset
+func (d *VolumeDriverClient) Unset() error {
+	if d.conn == nil {
+		return nil
+	}
+	return d.conn.Close()
+}
+
+func (d *VolumeDriverClient) CreateVolume(opt *pb.CreateVolumeOpts) (*model.VolumeSpec, error) {
+	var vol = &model.VolumeSpec{}
+	resp, err := d.client.CreateVolume(context.Background(), opt)
+	if err = parseResponse(resp, err, vol); err != nil {
+		return nil, err
+	}
+	return vol, nil
+}
+
+func (d *VolumeDriverClient) PullVolume(volIdentifier string) (*model.VolumeSpec, error) {
+	var vol = &model.VolumeSpec{}
+	resp, err := d.client.PullVolume(context.Background(), &pb.PullVolumeOpts{Id: volIdentifier})
+	if err = parseResponse(resp, err, vol); err != nil {
+		return nil, err
+	}
+	return vol, nil
+}
+
+func (d *VolumeDriverClient) DeleteVolume(opt *pb.DeleteVolumeOpts) error {
+	resp, err := d.client.DeleteVolume(context.Background(), opt)
+	return parseResponse(resp, err, nil)
+}
+
+func (d *VolumeDriverClient) ExtendVolume(opt *pb.ExtendVolumeOpts) (*model.VolumeSpec, error) {
+	var vol = &model.VolumeSpec{}
+	resp, err := d.client.ExtendVolume(context.Background(), opt)
+	if err = parseResponse(resp, err, vol); err != nil {
+		return nil, err
+	}
+	return vol, nil
+}
+
+func (d *VolumeDriverClient) InitializeConnection(opt *pb.CreateVolumeAttachmentOpts) (*model.ConnectionInfo, error) {
+	var connInfo = &model.ConnectionInfo{}
+	resp, err := d.client.InitializeConnection(context.Background(), opt)
+	if err = parseResponse(resp, err, connInfo); err != nil {
+		return nil, err
+	}
+	return connInfo, nil
+}
+
+func (d *VolumeDriverClient) TerminateConnection(opt *pb.DeleteVolumeAttachmentOpts) error {
+	resp, err := d.client.TerminateConnection(context.Background(), opt)
+	return parseResponse(resp, err, nil)
+}
+
+func (d *VolumeDriverClient) CreateSnapshot(opt *pb.CreateVolumeSnapshotOpts) (*model.VolumeSnapshotSpec, error) {
+	var snp = &model.VolumeSnapshotSpec{}
+	resp, err := d.client.CreateSnapshot(context.Background(), opt)
+	if err = parseResponse(resp, err, snp); err != nil {
+		return nil, err
+	}
+	return snp, nil
+}
+
+func (d *VolumeDriverClient) PullSnapshot(snapIdentifier string) (*model.VolumeSnapshotSpec, error) {
+	var snp = &model.VolumeSnapshotSpec{}
+	resp, err := d.client.PullSnapshot(context.Background(), &pb.PullVolumeSnapshotOpts{Id: snapIdentifier})
+	if err = parseResponse(resp, err, snp); err != nil {
+		return nil, err
+	}
+	return snp, nil
+}
+
+func (d *VolumeDriverClient) DeleteSnapshot(opt *pb.DeleteVolumeSnapshotOpts) error {
+	resp, err := d.client.DeleteSnapshot(context.Background(), opt)
+	return parseResponse(resp, err, nil)
+}
+
+func (d *VolumeDriverClient) InitializeSnapshotConnection(opt *pb.CreateSnapshotAttachmentOpts) (*model.ConnectionInfo, error) {
+	var connInfo = &model.ConnectionInfo{}
+	resp, err := d.client.InitializeSnapshotConnection(context.Background(), opt)
+	if err = parseResponse(resp, err, connInfo); err != nil {
+		return nil, err
+	}
+	return connInfo, nil
+}
+
+func (d *VolumeDriverClient) TerminateSnapshotConnection(opt *pb.DeleteSnapshotAttachmentOpts) error {
+	resp, err := d.client.TerminateSnapshotConnection(context.Background(), opt)
+	return parseResponse(resp, err, nil)
+}
+
+func (d *VolumeDriverClient) CreateVolumeGroup(opt *pb.CreateVolumeGroupOpts) (*model.VolumeGroupSpec, error) {
+	var vg = &model.VolumeGroupSpec{}
+	resp, err := d.client.CreateVolumeGroup(context.Background(), opt)
+	if err = parseResponse(resp, err, vg); err != nil {
+		return nil, err
+	}
+	return vg, nil
+}
+
+func (d *VolumeDriverClient) UpdateVolumeGroup(opt *pb.UpdateVolumeGroupOpts) (*model.VolumeGroupSpec, error) {
+	var vg = &model.VolumeGroupSpec{}
+	resp, err := d.client.UpdateVolumeGroup(context.Background(), opt)
+	if err = parseResponse(resp, err, vg); err != nil {
+		return nil, err
+	}
+	return vg, nil
+}
+
+func (d *VolumeDriverClient) DeleteVolumeGroup(opt *pb.DeleteVolumeGroupOpts) error {
+	resp, err := d.client.DeleteVolumeGroup(context.Background(), opt)
+	return parseResponse(resp, err, nil)
+}
+
+func (d *VolumeDriverClient) ListPools() ([]*model.StoragePoolSpec, error) {
+	var pols []*model.StoragePoolSpec
+	resp, err := d.client.ListPools(context.Background(), &pb.ListPoolsOpts{})
+	if err = parseResponse(resp, err, &pols); err != nil {
+		return nil, err
+	}
+	return pols, nil
+}
+
+// Capabilities asks the plugin for the capabilities of its driver, nothing
+// is declared supported if the plugin can't be reached.
+func (d *VolumeDriverClient) Capabilities() model.DriverCapabilitiesSpec {
+	var caps model.DriverCapabilitiesSpec
+	resp, err := d.client.GetCapabilities(context.Background(), &pb.GetCapabilitiesOpts{})
+	if err = parseResponse(resp, err, &caps); err != nil {
+		log.Errorf("when get capabilities of driver plugin %s: %v", d.Endpoint, err)
+		return model.DriverCapabilitiesSpec{}
+	}
+	return caps
+}
+
+// ReplicationDriverClient implements the ReplicationDriver interface of
+// contrib/drivers by calling the driver plugin at Endpoint.
+type ReplicationDriverClient struct {
+	Endpoint string
+
+	conn   *grpc.ClientConn
+	client pb.ReplicationDriverPluginClient
+}
+
+// NewReplicationDriverClient method creates a replication driver which calls
+// the plugin at edp.
+func NewReplicationDriverClient(edp string) *ReplicationDriverClient {
+	return &ReplicationDriverClient{Endpoint: edp}
+}
+
+// Setup
+func (r *ReplicationDriverClient) Setup() error {
+	conn, err := Dial(r.Endpoint)
+	if err != nil {
+		log.Errorf("when connect driver plugin %s: %v", r.Endpoint, err)
+		return err
+	}
+	r.conn = conn
+	r.client = pb.NewReplicationDriverPluginClient(conn)
+	return nil
+}
+
+// Unset
+func (r *ReplicationDriverClient) Unset() error {
+	if r.conn == nil {
+		return nil
+	}
+	return r.conn.Close()
+}
+
+func (r *ReplicationDriverClient) CreateReplication(opt *pb.CreateReplicationOpts) (*model.ReplicationSpec, error) {
+	var replica = &model.ReplicationSpec{}
+	resp, err := r.client.CreateReplication(context.Background(), opt)
+	if err = parseResponse(resp, err, replica); err != nil {
+		return nil, err
+	}
+	return replica, nil
+}
+
+func (r *ReplicationDriverClient) DeleteReplication(opt *pb.DeleteReplicationOpts) error {
+	resp, err := r.client.DeleteReplication(context.Background(), opt)
+	return parseResponse(resp, err, nil)
+}
+
+func (r *ReplicationDriverClient) EnableReplication(opt *pb.EnableReplicationOpts) error {
+	resp, err := r.client.EnableReplication(context.Background(), opt)
+	return parseResponse(resp, err, nil)
+}
+
+func (r *ReplicationDriverClient) DisableReplication(opt *pb.DisableReplicationOpts) error {
+	resp, err := r.client.DisableReplication(context.Background(), opt)
+	return parseResponse(resp, err, nil)
+}
+
+func (r *ReplicationDriverClient) FailoverReplication(opt *pb.FailoverReplicationOpts) error {
+	resp, err := r.client.FailoverReplication(context.Background(), opt)
+	return parseResponse(resp, err, nil)
+}
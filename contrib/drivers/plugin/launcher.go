@@ -0,0 +1,137 @@
+// Copyright (c) 2018 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+//    Licensed under the Apache License, Version 2.0 (the "License"); you may
+//    not use this file except in compliance with the License. You may obtain
+//    a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//    WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//    License for the specific language governing permissions and limitations
+//    under the License.
+
+package plugin
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/golang/glog"
+)
+
+// restartDelay is how long the launcher waits before restarting a plugin
+// process which exited.
+var restartDelay = 5 * time.Second
+
+// Launcher runs the command of a driver plugin and restarts it whenever it
+// exits, until the launcher is stopped.
+type Launcher struct {
+	Name     string
+	Command  string
+	Endpoint string
+
+	lock   sync.Mutex
+	cmd    *exec.Cmd
+	stopCh chan struct{}
+	doneCh chan struct{}
+}
+
+// NewLauncher method creates a launcher of the plugin named name. The
+// endpoint is passed to the plugin in the OPENSDS_PLUGIN_ENDPOINT
+// environment variable.
+func NewLauncher(name, command, edp string) *Launcher {
+	return &Launcher{
+		Name:     name,
+		Command:  command,
+		Endpoint: edp,
+	}
+}
+
+// Start method launches the plugin process and supervises it in background.
+func (l *Launcher) Start() error {
+	args := strings.Fields(l.Command)
+	if len(args) == 0 {
+		return errors.New("plugin command is empty")
+	}
+
+	l.stopCh = make(chan struct{})
+	l.doneCh = make(chan struct{})
+	if err := l.run(args); err != nil {
+		l.stopCh = nil
+		return err
+	}
+	go l.supervise(args)
+	return nil
+}
+
+func (l *Launcher) run(args []string) error {
+	// The lock makes sure that no process is started once the launcher is
+	// stopped.
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	select {
+	case <-l.stopCh:
+		return errors.New("launcher is stopped")
+	default:
+	}
+
+	cmd := exec.Command(args[0], args[1:]...)
+	cmd.Env = append(os.Environ(), EndpointEnv+"="+l.Endpoint)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Start(); err != nil {
+		log.Errorf("when launch driver plugin %s: %v", l.Name, err)
+		l.cmd = nil
+		return err
+	}
+	log.Infof("Driver plugin %s launched, pid: %d", l.Name, cmd.Process.Pid)
+	l.cmd = cmd
+	return nil
+}
+
+func (l *Launcher) supervise(args []string) {
+	defer close(l.doneCh)
+	for {
+		l.lock.Lock()
+		cmd := l.cmd
+		l.lock.Unlock()
+
+		if cmd != nil {
+			err := cmd.Wait()
+			select {
+			case <-l.stopCh:
+				return
+			default:
+			}
+			log.Errorf("driver plugin %s exited: %v, restart it in %v", l.Name, err, restartDelay)
+		}
+
+		select {
+		case <-l.stopCh:
+			return
+		case <-time.After(restartDelay):
+		}
+		l.run(args)
+	}
+}
+
+// Stop method kills the plugin process and stops supervising it.
+func (l *Launcher) Stop() {
+	if l.stopCh == nil {
+		return
+	}
+	l.lock.Lock()
+	close(l.stopCh)
+	if l.cmd != nil && l.cmd.Process != nil {
+		l.cmd.Process.Kill()
+	}
+	l.lock.Unlock()
+
+	<-l.doneCh
+}
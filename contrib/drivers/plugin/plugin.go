@@ -0,0 +1,141 @@
+// Copyright (c) 2018 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+//    Licensed under the Apache License, Version 2.0 (the "License"); you may
+//    not use this file except in compliance with the License. You may obtain
+//    a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//    WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//    License for the specific language governing permissions and limitations
+//    under the License.
+
+/*
+This module implements the out-of-tree driver plugin protocol. A driver plugin
+is a separate process which serves the VolumeDriverPlugin and, optionally, the
+ReplicationDriverPlugin gRPC services, osdsdock calls it through the
+VolumeDriverClient and ReplicationDriverClient of this package just like an
+in-tree driver.
+
+*/
+
+package plugin
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/opensds/opensds/pkg/model"
+	pb "github.com/opensds/opensds/pkg/model/proto"
+	"google.golang.org/grpc"
+)
+
+// EndpointEnv is the environment variable which tells a plugin launched by
+// osdsdock where it should listen.
+const EndpointEnv = "OPENSDS_PLUGIN_ENDPOINT"
+
+const unixPrefix = "unix://"
+
+// VolumeDriver has the same methods as the VolumeDriver interface of
+// contrib/drivers, it's redefined here so that plugins don't need to import
+// the in-tree drivers.
+type VolumeDriver interface {
+	//Any initialization the volume driver does while starting.
+	Setup() error
+	//Any operation the volume driver does while stopping.
+	Unset() error
+
+	CreateVolume(opt *pb.CreateVolumeOpts) (*model.VolumeSpec, error)
+
+	PullVolume(volIdentifier string) (*model.VolumeSpec, error)
+
+	DeleteVolume(opt *pb.DeleteVolumeOpts) error
+
+	ExtendVolume(opt *pb.ExtendVolumeOpts) (*model.VolumeSpec, error)
+
+	InitializeConnection(opt *pb.CreateVolumeAttachmentOpts) (*model.ConnectionInfo, error)
+
+	TerminateConnection(opt *pb.DeleteVolumeAttachmentOpts) error
+
+	CreateSnapshot(opt *pb.CreateVolumeSnapshotOpts) (*model.VolumeSnapshotSpec, error)
+
+	PullSnapshot(snapIdentifier string) (*model.VolumeSnapshotSpec, error)
+
+	DeleteSnapshot(opt *pb.DeleteVolumeSnapshotOpts) error
+
+	InitializeSnapshotConnection(opt *pb.CreateSnapshotAttachmentOpts) (*model.ConnectionInfo, error)
+
+	TerminateSnapshotConnection(opt *pb.DeleteSnapshotAttachmentOpts) error
+
+	CreateVolumeGroup(opt *pb.CreateVolumeGroupOpts) (*model.VolumeGroupSpec, error)
+
+	UpdateVolumeGroup(opt *pb.UpdateVolumeGroupOpts) (*model.VolumeGroupSpec, error)
+
+	DeleteVolumeGroup(opt *pb.DeleteVolumeGroupOpts) error
+
+	ListPools() ([]*model.StoragePoolSpec, error)
+
+	// Capabilities declares the optional operations which the driver
+	// supports, it's published in the extras of the pools of the driver.
+	Capabilities() model.DriverCapabilitiesSpec
+}
+
+// ReplicationDriver has the same methods as the ReplicationDriver interface
+// of contrib/drivers.
+type ReplicationDriver interface {
+	// Any initialization the replication driver does while starting.
+	Setup() error
+	// Any operation the replication driver does while stopping.
+	Unset() error
+
+	CreateReplication(opt *pb.CreateReplicationOpts) (*model.ReplicationSpec, error)
+	DeleteReplication(opt *pb.DeleteReplicationOpts) error
+	EnableReplication(opt *pb.EnableReplicationOpts) error
+	DisableReplication(opt *pb.DisableReplicationOpts) error
+	FailoverReplication(opt *pb.FailoverReplicationOpts) error
+}
+
+// parseEndpoint splits the endpoint into network and address, the endpoint
+// is either unix:///path/to/socket or host:port.
+func parseEndpoint(edp string) (string, string, error) {
+	if edp == "" {
+		return "", "", errors.New("plugin endpoint is empty")
+	}
+	if strings.HasPrefix(edp, unixPrefix) {
+		return "unix", strings.TrimPrefix(edp, unixPrefix), nil
+	}
+	return "tcp", edp, nil
+}
+
+// Listen announces on the plugin endpoint, the stale unix socket left by a
+// previous run is removed first.
+func Listen(edp string) (net.Listener, error) {
+	network, addr, err := parseEndpoint(edp)
+	if err != nil {
+		return nil, err
+	}
+	if network == "unix" {
+		if err := os.Remove(addr); err != nil && !os.IsNotExist(err) {
+			return nil, fmt.Errorf("remove stale socket %s failed: %v", addr, err)
+		}
+	}
+	return net.Listen(network, addr)
+}
+
+// Dial sets up the gRPC connection to the plugin endpoint.
+func Dial(edp string) (*grpc.ClientConn, error) {
+	network, addr, err := parseEndpoint(edp)
+	if err != nil {
+		return nil, err
+	}
+	return grpc.Dial(addr, grpc.WithInsecure(),
+		grpc.WithDialer(func(addr string, timeout time.Duration) (net.Conn, error) {
+			return net.DialTimeout(network, addr, timeout)
+		}))
+}
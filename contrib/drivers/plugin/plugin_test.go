@@ -0,0 +1,142 @@
+// Copyright (c) 2018 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+//    Licensed under the Apache License, Version 2.0 (the "License"); you may
+//    not use this file except in compliance with the License. You may obtain
+//    a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//    WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//    License for the specific language governing permissions and limitations
+//    under the License.
+
+package plugin
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/opensds/opensds/pkg/model"
+	pb "github.com/opensds/opensds/pkg/model/proto"
+	. "github.com/opensds/opensds/testutils/collection"
+	sample "github.com/opensds/opensds/testutils/driver"
+)
+
+func startTestPlugin(t *testing.T) (string, func()) {
+	dir, err := ioutil.TempDir("", "plugin-test-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	edp := "unix://" + filepath.Join(dir, "sample.sock")
+	lis, err := Listen(edp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := NewServer(&sample.Driver{}, &sample.ReplicationDriver{})
+	go s.Serve(lis)
+
+	return edp, func() {
+		s.Stop()
+		os.RemoveAll(dir)
+	}
+}
+
+func TestVolumeDriverClient(t *testing.T) {
+	edp, stop := startTestPlugin(t)
+	defer stop()
+
+	d := NewVolumeDriverClient(edp)
+	if err := d.Setup(); err != nil {
+		t.Fatal(err)
+	}
+	defer d.Unset()
+
+	vol, err := d.CreateVolume(&pb.CreateVolumeOpts{
+		Id:          SampleVolumes[0].Id,
+		Name:        SampleVolumes[0].Name,
+		Description: SampleVolumes[0].Description,
+		Size:        SampleVolumes[0].Size,
+		PoolId:      SampleVolumes[0].PoolId,
+		ProfileId:   SampleVolumes[0].ProfileId,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(vol, &SampleVolumes[0]) {
+		t.Errorf("Expected %+v, got %+v\n", &SampleVolumes[0], vol)
+	}
+
+	if err = d.DeleteVolume(&pb.DeleteVolumeOpts{}); err != nil {
+		t.Error(err)
+	}
+
+	connInfo, err := d.InitializeConnection(&pb.CreateVolumeAttachmentOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if connInfo.DriverVolumeType != "iscsi" {
+		t.Errorf("Expected %+v, got %+v\n", "iscsi", connInfo.DriverVolumeType)
+	}
+
+	pols, err := d.ListPools()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var expected []*model.StoragePoolSpec
+	for i := range SamplePools {
+		expected = append(expected, &SamplePools[i])
+	}
+	if !reflect.DeepEqual(pols, expected) {
+		t.Errorf("Expected %+v, got %+v\n", expected, pols)
+	}
+
+	if caps := d.Capabilities(); !reflect.DeepEqual(caps, (&sample.Driver{}).Capabilities()) {
+		t.Errorf("Expected capabilities of sample driver, got %+v\n", caps)
+	}
+
+	// The error of driver should reach the caller unchanged.
+	if _, err = d.PullVolume("not-exist"); err == nil || err.Error() != "Can't find volume not-exist" {
+		t.Errorf("Expected error of driver, got %v", err)
+	}
+}
+
+func TestReplicationDriverClient(t *testing.T) {
+	edp, stop := startTestPlugin(t)
+	defer stop()
+
+	r := NewReplicationDriverClient(edp)
+	if err := r.Setup(); err != nil {
+		t.Fatal(err)
+	}
+	defer r.Unset()
+
+	if _, err := r.CreateReplication(&pb.CreateReplicationOpts{}); err != nil {
+		t.Error(err)
+	}
+	if err := r.FailoverReplication(&pb.FailoverReplicationOpts{}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestParseEndpoint(t *testing.T) {
+	for edp, expected := range map[string][2]string{
+		"unix:///var/run/opensds/sample.sock": {"unix", "/var/run/opensds/sample.sock"},
+		"127.0.0.1:50060":                     {"tcp", "127.0.0.1:50060"},
+	} {
+		network, addr, err := parseEndpoint(edp)
+		if err != nil {
+			t.Error(err)
+		}
+		if network != expected[0] || addr != expected[1] {
+			t.Errorf("Expected %v, got %s %s", expected, network, addr)
+		}
+	}
+	if _, _, err := parseEndpoint(""); err == nil {
+		t.Error("Expected error with empty endpoint")
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright (c) 2018 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+//    Licensed under the Apache License, Version 2.0 (the "License"); you may
+//    not use this file except in compliance with the License. You may obtain
+//    a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//    WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//    License for the specific language governing permissions and limitations
+//    under the License.
+
+/*
+This module implements the reference driver plugin which serves the sample
+driver over gRPC. Vendors can follow it to ship their drivers as plugins
+without forking OpenSDS.
+
+*/
+
+package main
+
+import (
+	"flag"
+	"os"
+
+	log "github.com/golang/glog"
+	"github.com/opensds/opensds/contrib/drivers/plugin"
+	sample "github.com/opensds/opensds/testutils/driver"
+)
+
+func main() {
+	var endpoint string
+	// The endpoint is passed by osdsdock in the environment variable if the
+	// plugin is launched by it.
+	flag.StringVar(&endpoint, "endpoint", os.Getenv(plugin.EndpointEnv), "Listen endpoint of driver plugin")
+	flag.Parse()
+	defer log.Flush()
+
+	if err := plugin.Serve(endpoint, &sample.Driver{}, &sample.ReplicationDriver{}); err != nil {
+		log.Fatalf("driver plugin stopped: %v", err)
+	}
+}
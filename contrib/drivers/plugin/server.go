@@ -0,0 +1,165 @@
+// Copyright (c) 2018 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+//    Licensed under the Apache License, Version 2.0 (the "License"); you may
+//    not use this file except in compliance with the License. You may obtain
+//    a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//    WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//    License for the specific language governing permissions and limitations
+//    under the License.
+
+package plugin
+
+import (
+	log "github.com/golang/glog"
+	pb "github.com/opensds/opensds/pkg/model/proto"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+// response wraps the result of driver into a generic response. The error of
+// driver is carried in the response instead of the gRPC status, so that it
+// reaches osdsdock unchanged.
+func response(result interface{}, err error) (*pb.GenericResponse, error) {
+	if err != nil {
+		return pb.GenericResponseError(err), nil
+	}
+	return pb.GenericResponseResult(result), nil
+}
+
+// volumeDriverServer is used to implement pb.VolumeDriverPluginServer.
+type volumeDriverServer struct {
+	d VolumeDriver
+}
+
+func (s *volumeDriverServer) CreateVolume(ctx context.Context, opt *pb.CreateVolumeOpts) (*pb.GenericResponse, error) {
+	return response(s.d.CreateVolume(opt))
+}
+
+func (s *volumeDriverServer) PullVolume(ctx context.Context, opt *pb.PullVolumeOpts) (*pb.GenericResponse, error) {
+	return response(s.d.PullVolume(opt.GetId()))
+}
+
+func (s *volumeDriverServer) DeleteVolume(ctx context.Context, opt *pb.DeleteVolumeOpts) (*pb.GenericResponse, error) {
+	return response(nil, s.d.DeleteVolume(opt))
+}
+
+func (s *volumeDriverServer) ExtendVolume(ctx context.Context, opt *pb.ExtendVolumeOpts) (*pb.GenericResponse, error) {
+	return response(s.d.ExtendVolume(opt))
+}
+
+func (s *volumeDriverServer) InitializeConnection(ctx context.Context, opt *pb.CreateVolumeAttachmentOpts) (*pb.GenericResponse, error) {
+	return response(s.d.InitializeConnection(opt))
+}
+
+func (s *volumeDriverServer) TerminateConnection(ctx context.Context, opt *pb.DeleteVolumeAttachmentOpts) (*pb.GenericResponse, error) {
+	return response(nil, s.d.TerminateConnection(opt))
+}
+
+func (s *volumeDriverServer) CreateSnapshot(ctx context.Context, opt *pb.CreateVolumeSnapshotOpts) (*pb.GenericResponse, error) {
+	return response(s.d.CreateSnapshot(opt))
+}
+
+func (s *volumeDriverServer) PullSnapshot(ctx context.Context, opt *pb.PullVolumeSnapshotOpts) (*pb.GenericResponse, error) {
+	return response(s.d.PullSnapshot(opt.GetId()))
+}
+
+func (s *volumeDriverServer) DeleteSnapshot(ctx context.Context, opt *pb.DeleteVolumeSnapshotOpts) (*pb.GenericResponse, error) {
+	return response(nil, s.d.DeleteSnapshot(opt))
+}
+
+func (s *volumeDriverServer) InitializeSnapshotConnection(ctx context.Context, opt *pb.CreateSnapshotAttachmentOpts) (*pb.GenericResponse, error) {
+	return response(s.d.InitializeSnapshotConnection(opt))
+}
+
+func (s *volumeDriverServer) TerminateSnapshotConnection(ctx context.Context, opt *pb.DeleteSnapshotAttachmentOpts) (*pb.GenericResponse, error) {
+	return response(nil, s.d.TerminateSnapshotConnection(opt))
+}
+
+func (s *volumeDriverServer) CreateVolumeGroup(ctx context.Context, opt *pb.CreateVolumeGroupOpts) (*pb.GenericResponse, error) {
+	return response(s.d.CreateVolumeGroup(opt))
+}
+
+func (s *volumeDriverServer) UpdateVolumeGroup(ctx context.Context, opt *pb.UpdateVolumeGroupOpts) (*pb.GenericResponse, error) {
+	return response(s.d.UpdateVolumeGroup(opt))
+}
+
+func (s *volumeDriverServer) DeleteVolumeGroup(ctx context.Context, opt *pb.DeleteVolumeGroupOpts) (*pb.GenericResponse, error) {
+	return response(nil, s.d.DeleteVolumeGroup(opt))
+}
+
+func (s *volumeDriverServer) ListPools(ctx context.Context, opt *pb.ListPoolsOpts) (*pb.GenericResponse, error) {
+	return response(s.d.ListPools())
+}
+
+func (s *volumeDriverServer) GetCapabilities(ctx context.Context, opt *pb.GetCapabilitiesOpts) (*pb.GenericResponse, error) {
+	return response(s.d.Capabilities(), nil)
+}
+
+// replicationDriverServer is used to implement
+// pb.ReplicationDriverPluginServer.
+type replicationDriverServer struct {
+	r ReplicationDriver
+}
+
+func (s *replicationDriverServer) CreateReplication(ctx context.Context, opt *pb.CreateReplicationOpts) (*pb.GenericResponse, error) {
+	return response(s.r.CreateReplication(opt))
+}
+
+func (s *replicationDriverServer) DeleteReplication(ctx context.Context, opt *pb.DeleteReplicationOpts) (*pb.GenericResponse, error) {
+	return response(nil, s.r.DeleteReplication(opt))
+}
+
+func (s *replicationDriverServer) EnableReplication(ctx context.Context, opt *pb.EnableReplicationOpts) (*pb.GenericResponse, error) {
+	return response(nil, s.r.EnableReplication(opt))
+}
+
+func (s *replicationDriverServer) DisableReplication(ctx context.Context, opt *pb.DisableReplicationOpts) (*pb.GenericResponse, error) {
+	return response(nil, s.r.DisableReplication(opt))
+}
+
+func (s *replicationDriverServer) FailoverReplication(ctx context.Context, opt *pb.FailoverReplicationOpts) (*pb.GenericResponse, error) {
+	return response(nil, s.r.FailoverReplication(opt))
+}
+
+// NewServer method creates the gRPC server of a driver plugin. The drivers
+// are set up by the caller, rd is optional if the plugin doesn't support
+// replication.
+func NewServer(vd VolumeDriver, rd ReplicationDriver) *grpc.Server {
+	s := grpc.NewServer()
+	pb.RegisterVolumeDriverPluginServer(s, &volumeDriverServer{d: vd})
+	if rd != nil {
+		pb.RegisterReplicationDriverPluginServer(s, &replicationDriverServer{r: rd})
+	}
+	return s
+}
+
+// Serve method sets up the drivers and serves them at the plugin endpoint
+// until the listener fails. It's the entry of a driver plugin process.
+func Serve(edp string, vd VolumeDriver, rd ReplicationDriver) error {
+	if err := vd.Setup(); err != nil {
+		return err
+	}
+	defer vd.Unset()
+	if rd != nil {
+		if err := rd.Setup(); err != nil {
+			return err
+		}
+		defer rd.Unset()
+	}
+
+	lis, err := Listen(edp)
+	if err != nil {
+		log.Errorf("failed to listen %s: %v", edp, err)
+		return err
+	}
+	log.Info("Driver plugin initialized! Start listening on:", lis.Addr())
+
+	s := NewServer(vd, rd)
+	defer s.Stop()
+	return s.Serve(lis)
+}
@@ -22,19 +22,20 @@ plugin, just modify Init() and Clean() method.
 package drivers
 
 import (
-	"reflect"
-
+	"github.com/opensds/opensds/contrib/drivers/ceph"
 	"github.com/opensds/opensds/contrib/drivers/drbd"
 	"github.com/opensds/opensds/contrib/drivers/huawei/dorado"
+	"github.com/opensds/opensds/contrib/drivers/plugin"
 	driversConfig "github.com/opensds/opensds/contrib/drivers/utils/config"
 	"github.com/opensds/opensds/pkg/model"
 	pb "github.com/opensds/opensds/pkg/model/proto"
 	"github.com/opensds/opensds/pkg/utils/config"
 	replication_sample "github.com/opensds/opensds/testutils/driver"
+	"github.com/opensds/opensds/testutils/driver/faulty"
 )
 
 // ReplicationDriver is an interface for exposing some operations of different
-// replication drivers, currently supporting DRBD.
+// replication drivers, currently supporting DRBD, Dorado and Ceph.
 type ReplicationDriver interface {
 	// Any initialization the replication driver does while starting.
 	Setup() error
@@ -48,30 +49,53 @@ type ReplicationDriver interface {
 	FailoverReplication(opt *pb.FailoverReplicationOpts) error
 }
 
-func IsSupportHostBasedReplication(resourceType string) bool {
-	v := reflect.ValueOf(config.CONF.Backends)
-	t := reflect.TypeOf(config.CONF.Backends)
-	for i := 0; i < t.NumField(); i++ {
-		field := v.Field(i)
-		tag := t.Field(i).Tag.Get("conf")
-		if resourceType == tag && field.Interface().(config.BackendProperties).SupportReplication {
-			return true
-		}
+// ReplicationStateDriver is implemented by the replication drivers which
+// record the state of replication in its metadata, the state is refreshed
+// whenever the replication is synced or failed over.
+type ReplicationStateDriver interface {
+	// GetReplicationState returns the metadata of replication to be updated
+	// with the current state.
+	GetReplicationState(metadata map[string]string) (map[string]string, error)
+}
+
+// IsSupportHostBasedReplication returns whether the backend supports
+// replicating its volumes by itself.
+func IsSupportHostBasedReplication(backendName string) bool {
+	if b, ok := config.GetBackend(backendName); ok {
+		return b.SupportReplication
 	}
 	return false
 }
 
-// Init
-func InitReplicationDriver(resourceType string) (ReplicationDriver, error) {
+// InitReplicationDriver method creates the replication driver and sets it
+// up. When the driver is the one of backend, the replication driver is
+// configured by the driver configuration file of backend, otherwise it's a
+// host-based replication driver such as DRBD.
+func InitReplicationDriver(driverName, backendName string) (ReplicationDriver, error) {
+	b, ok := config.GetBackend(backendName)
+	if !ok || b.DriverName != driverName {
+		b = config.BackendProperties{DriverName: driverName}
+	}
+
 	var d ReplicationDriver
-	switch resourceType {
+	switch b.DriverName {
 	case driversConfig.DRBDDriverType:
 		d = &drbd.ReplicationDriver{}
 		break
 	case driversConfig.HuaweiDoradoDriverType:
-		d = &dorado.ReplicationDriver{}
+		d = &dorado.ReplicationDriver{ConfigPath: b.ConfigPath}
+		break
+	case driversConfig.CephDriverType:
+		d = &ceph.ReplicationDriver{ConfigPath: b.ConfigPath}
+		break
+	case driversConfig.FaultyDriverType:
+		d = &faulty.ReplicationDriver{ConfigPath: b.ConfigPath}
 		break
 	default:
+		if b.PluginEndpoint != "" {
+			d = plugin.NewReplicationDriverClient(b.PluginEndpoint)
+			break
+		}
 		d = &replication_sample.ReplicationDriver{}
 		break
 	}
@@ -87,6 +111,10 @@ func CleanReplicationDriver(d ReplicationDriver) ReplicationDriver {
 		break
 	case *dorado.ReplicationDriver:
 		d = &dorado.ReplicationDriver{}
+	case *ceph.ReplicationDriver:
+		d = &ceph.ReplicationDriver{}
+	case *plugin.ReplicationDriverClient:
+		break
 	default:
 		break
 	}
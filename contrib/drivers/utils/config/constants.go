@@ -25,10 +25,16 @@ const (
 	CinderDriverType              = "cinder"
 	CephDriverType                = "ceph"
 	LVMDriverType                 = "lvm"
+	ZFSDriverType                 = "zfs"
+	FileDriverType                = "file"
 	HuaweiDoradoDriverType        = "huawei_dorado"
 	HuaweiFusionStorageDriverType = "huawei_fusionstorage"
 
 	DRBDDriverType = "drbd"
+
+	// FaultyDriverType is the sample driver which injects faults into its
+	// operations, it's used for testing only.
+	FaultyDriverType = "faulty"
 )
 
 // These constants below represent the access protocol type of all storage
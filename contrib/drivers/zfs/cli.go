@@ -0,0 +1,203 @@
+// Copyright (c) 2018 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+//    Licensed under the Apache License, Version 2.0 (the "License"); you may
+//    not use this file except in compliance with the License. You may obtain
+//    a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//    WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//    License for the specific language governing permissions and limitations
+//    under the License.
+
+package zfs
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/golang/glog"
+	"github.com/opensds/opensds/pkg/utils/exec"
+)
+
+type Cli struct {
+	// Command Root executer
+	RootExecuter exec.Executer
+}
+
+func NewCli() (*Cli, error) {
+	return &Cli{
+		RootExecuter: exec.NewRootExecuter(),
+	}, nil
+}
+
+func (c *Cli) execute(cmd ...string) (string, error) {
+	return c.RootExecuter.Run(cmd[0], cmd[1:]...)
+}
+
+func sizeStr(size int64) string {
+	return fmt.Sprintf("%dG", size)
+}
+
+// propertyArgs returns the "-o property=value" arguments of the properties in
+// the order of their names, so that the commands are always the same.
+func propertyArgs(props map[string]string) []string {
+	var names []string
+	for name := range props {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var args []string
+	for _, name := range names {
+		args = append(args, "-o", name+"="+props[name])
+	}
+	return args
+}
+
+// CreateVolume creates the zvol of the size in GB, the space of a sparse zvol
+// is not reserved.
+func (c *Cli) CreateVolume(name string, size int64, sparse bool, props map[string]string) error {
+	cmd := []string{"zfs", "create"}
+	if sparse {
+		cmd = append(cmd, "-s")
+	}
+	cmd = append(cmd, "-V", sizeStr(size))
+	cmd = append(cmd, propertyArgs(props)...)
+	cmd = append(cmd, name)
+	_, err := c.execute(cmd...)
+	return err
+}
+
+// ExtendVolume grows the zvol to the size in GB.
+func (c *Cli) ExtendVolume(name string, size int64) error {
+	_, err := c.execute("zfs", "set", "volsize="+sizeStr(size), name)
+	return err
+}
+
+// Exists returns whether the dataset, which is a zvol or a snapshot, exists.
+func (c *Cli) Exists(name string) bool {
+	_, err := c.execute("zfs", "list", "-H", "-o", "name", name)
+	return err == nil
+}
+
+// Destroy destroys the dataset, the snapshot which has clones is destroyed
+// once its last clone is destroyed.
+func (c *Cli) Destroy(name string) error {
+	cmd := []string{"zfs", "destroy"}
+	if strings.Contains(name, "@") {
+		cmd = append(cmd, "-d")
+	}
+	_, err := c.execute(append(cmd, name)...)
+	return err
+}
+
+// HasSnapshots returns whether the zvol has any snapshot, including those
+// whose destruction is deferred by their clones.
+func (c *Cli) HasSnapshots(name string) bool {
+	out, err := c.execute("zfs", "list", "-H", "-t", "snapshot", "-o", "name", "-d", "1", name)
+	if err != nil {
+		glog.Errorf("Failed to list snapshots of %s: %v", name, err)
+		// Assume there are snapshots so that the zvol is not destroyed.
+		return true
+	}
+	return strings.TrimSpace(out) != ""
+}
+
+func (c *Cli) CreateSnapshot(name string) error {
+	_, err := c.execute("zfs", "snapshot", name)
+	return err
+}
+
+// Clone creates the zvol from the snapshot, which shares the blocks with it.
+func (c *Cli) Clone(snapshot, name string, props map[string]string) error {
+	cmd := []string{"zfs", "clone"}
+	cmd = append(cmd, propertyArgs(props)...)
+	cmd = append(cmd, snapshot, name)
+	_, err := c.execute(cmd...)
+	return err
+}
+
+// Copy creates the zvol from the snapshot by sending the snapshot stream to
+// it, which can be in a different pool. The snapshot received with the zvol
+// is destroyed, so that the zvol doesn't depend on the snapshot anyhow.
+func (c *Cli) Copy(snapshot, name string, props map[string]string) error {
+	receive := append([]string{"zfs", "receive"}, propertyArgs(props)...)
+	receive = append(receive, name)
+	pipeline := fmt.Sprintf("zfs send %s | %s", snapshot, strings.Join(receive, " "))
+	if _, err := c.execute("/bin/sh", "-c", pipeline); err != nil {
+		return err
+	}
+	received := name + snapshot[strings.Index(snapshot, "@"):]
+	_, err := c.execute("zfs", "destroy", received)
+	return err
+}
+
+type Pool struct {
+	Name string
+	GUID string
+	// Capacities are in GB.
+	TotalCapacity int64
+	FreeCapacity  int64
+	DedupRatio    float64
+}
+
+// ListPools returns the zpools named, which report their capacities and the
+// ratio of deduplication.
+func (c *Cli) ListPools(names ...string) ([]Pool, error) {
+	cmd := []string{"zpool", "list", "-H", "-p", "-o", "name,size,free,dedupratio,guid"}
+	out, err := c.execute(append(cmd, names...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	var pools []Pool
+	for _, line := range strings.Split(strings.TrimSpace(out), "\n") {
+		fields := strings.Split(line, "\t")
+		if len(fields) != 5 {
+			continue
+		}
+		pools = append(pools, Pool{
+			Name:          fields[0],
+			TotalCapacity: parseBytes(fields[1]) >> sizeShiftBit,
+			FreeCapacity:  parseBytes(fields[2]) >> sizeShiftBit,
+			DedupRatio:    parseRatio(fields[3]),
+			GUID:          fields[4],
+		})
+	}
+	return pools, nil
+}
+
+// CompressRatio returns the ratio of compression achieved by the datasets of
+// the zpool.
+func (c *Cli) CompressRatio(pool string) (float64, error) {
+	out, err := c.execute("zfs", "get", "-H", "-p", "-o", "value", "compressratio", pool)
+	if err != nil {
+		return 0, err
+	}
+	return parseRatio(out), nil
+}
+
+func parseBytes(s string) int64 {
+	n, err := strconv.ParseInt(strings.TrimSpace(s), 10, 64)
+	if err != nil {
+		glog.Errorf("Failed to parse size %q: %v", s, err)
+		return 0
+	}
+	return n
+}
+
+// parseRatio parses the ratio reported by zfs, which is in the form of
+// "1.52x" or "1.52".
+func parseRatio(s string) float64 {
+	r, err := strconv.ParseFloat(strings.TrimSuffix(strings.TrimSpace(s), "x"), 64)
+	if err != nil {
+		glog.Errorf("Failed to parse ratio %q: %v", s, err)
+		return 0
+	}
+	return r
+}
@@ -0,0 +1,429 @@
+// Copyright (c) 2018 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+//    Licensed under the Apache License, Version 2.0 (the "License"); you may
+//    not use this file except in compliance with the License. You may obtain
+//    a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//    WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//    License for the specific language governing permissions and limitations
+//    under the License.
+
+/*
+This module implements the zfs driver for OpenSDS. The volumes are zvols of
+the zpools configured, which are exported through the iSCSI or NVMe-oF
+targets of the lvm driver. The snapshots are native snapshots of zfs, and the
+volumes created from them are clones if they're in the same zpool, or copies
+sent from them otherwise.
+
+*/
+
+package zfs
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+
+	log "github.com/golang/glog"
+	"github.com/opensds/opensds/contrib/drivers/lvm/targets"
+	. "github.com/opensds/opensds/contrib/drivers/utils/config"
+	"github.com/opensds/opensds/pkg/model"
+	pb "github.com/opensds/opensds/pkg/model/proto"
+	"github.com/opensds/opensds/pkg/utils"
+	"github.com/satori/go.uuid"
+)
+
+const (
+	defaultTgtConfDir  = "/etc/tgt/conf.d"
+	defaultTgtBindIp   = "127.0.0.1"
+	defaultNvmeofPort  = "4420"
+	defaultCompression = "lz4"
+	defaultConfPath    = "/etc/opensds/driver/zfs.yaml"
+	zvolDevicePath     = "/dev/zvol"
+	volumePrefix       = "volume-"
+	snapshotPrefix     = "snapshot-"
+	sizeShiftBit       = 30
+	defaultAZ          = "default"
+	allInitiators      = "ALL"
+)
+
+const (
+	KZvolPath    = "zvolPath"
+	KZfsSnapshot = "zfsSnapshot"
+)
+
+type ZFSConfig struct {
+	TgtBindIp       string                    `yaml:"tgtBindIp"`
+	TgtConfDir      string                    `yaml:"tgtConfDir"`
+	EnableChapAuth  bool                      `yaml:"enableChapAuth"`
+	ISCSITarget     string                    `yaml:"iscsiTarget"`
+	NvmeofTransport string                    `yaml:"nvmeofTransport"`
+	NvmeofPort      string                    `yaml:"nvmeofPort"`
+	ThinProvision   bool                      `yaml:"thinProvision"`
+	Compression     string                    `yaml:"compression"`
+	Pool            map[string]PoolProperties `yaml:"pool,flow"`
+}
+
+type Driver struct {
+	// ConfigPath is the path of driver configuration file of the backend.
+	ConfigPath string
+
+	conf *ZFSConfig
+	cli  *Cli
+}
+
+func (d *Driver) Setup() error {
+	// Read zfs config file
+	d.conf = &ZFSConfig{
+		TgtBindIp:       defaultTgtBindIp,
+		TgtConfDir:      defaultTgtConfDir,
+		ISCSITarget:     targets.ISCSITgt,
+		NvmeofTransport: targets.NvmeofRDMA,
+		NvmeofPort:      defaultNvmeofPort,
+		Compression:     defaultCompression,
+	}
+	p := d.ConfigPath
+	if "" == p {
+		p = defaultConfPath
+	}
+	if _, err := Parse(d.conf, p); err != nil {
+		return err
+	}
+	if d.conf.ISCSITarget != targets.ISCSITgt && d.conf.ISCSITarget != targets.ISCSILio {
+		return fmt.Errorf("iscsi target %s is not supported", d.conf.ISCSITarget)
+	}
+	cli, err := NewCli()
+	if err != nil {
+		return err
+	}
+	d.cli = cli
+	return nil
+}
+
+func (*Driver) Unset() error { return nil }
+
+// zvolName returns the name of the zvol of volume in the zpool.
+func zvolName(pool, volId string) string {
+	return path.Join(pool, volumePrefix+volId)
+}
+
+// zvolPath returns the path of the device of the zvol.
+func zvolPath(name string) string {
+	return path.Join(zvolDevicePath, name)
+}
+
+// zvolNameOf returns the name of zvol of the volume by its metadata.
+func zvolNameOf(metadata map[string]string) (string, error) {
+	p, ok := metadata[KZvolPath]
+	if !ok {
+		err := errors.New("can't find 'zvolPath' in volume metadata")
+		log.Error(err)
+		return "", err
+	}
+	return strings.TrimPrefix(p, zvolDevicePath+"/"), nil
+}
+
+// properties returns the properties of zvol by which its data is reduced as
+// the profile asks.
+func (d *Driver) properties(opt *pb.CreateVolumeOpts) map[string]string {
+	props := map[string]string{}
+	if opt.GetIsCompressed() {
+		props["compression"] = d.conf.Compression
+	}
+	if opt.GetIsDeduplicated() {
+		props["dedup"] = "on"
+	}
+	return props
+}
+
+func (d *Driver) CreateVolume(opt *pb.CreateVolumeOpts) (vol *model.VolumeSpec, err error) {
+	var name = zvolName(opt.GetPoolName(), opt.GetId())
+	if opt.GetSnapshotId() == "" {
+		err = d.cli.CreateVolume(name, opt.GetSize(), d.conf.ThinProvision, d.properties(opt))
+	} else {
+		err = d.createVolumeFromSnapshot(name, opt)
+	}
+	if err != nil {
+		log.Errorf("Failed to create zvol %s: %v", name, err)
+		return nil, err
+	}
+
+	return &model.VolumeSpec{
+		BaseModel: &model.BaseModel{
+			Id: opt.GetId(),
+		},
+		Name:        opt.GetName(),
+		Size:        opt.GetSize(),
+		Description: opt.GetDescription(),
+		Metadata: map[string]string{
+			KZvolPath: zvolPath(name),
+		},
+	}, nil
+}
+
+// createVolumeFromSnapshot clones the snapshot if it's in the zpool of the
+// volume, or copies it otherwise, and then grows the zvol to the size of
+// volume.
+func (d *Driver) createVolumeFromSnapshot(name string, opt *pb.CreateVolumeOpts) (err error) {
+	if opt.SnapshotFromCloud {
+		return errors.New("creating volume from snapshot in cloud is not supported by zfs driver")
+	}
+	snapshot, ok := opt.GetMetadata()[KZfsSnapshot]
+	if !ok {
+		return errors.New("can't find 'zfsSnapshot' in snapshot metadata")
+	}
+
+	if strings.SplitN(snapshot, "/", 2)[0] == opt.GetPoolName() {
+		err = d.cli.Clone(snapshot, name, d.properties(opt))
+	} else {
+		err = d.cli.Copy(snapshot, name, d.properties(opt))
+	}
+	if err != nil {
+		return err
+	}
+
+	if opt.GetSize() > opt.GetSnapshotSize() {
+		if err := d.cli.ExtendVolume(name, opt.GetSize()); err != nil {
+			// remove created zvol if got error
+			if err := d.cli.Destroy(name); err != nil {
+				log.Error("Failed to remove zvol:", err)
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *Driver) PullVolume(volIdentifier string) (*model.VolumeSpec, error) {
+	// Not used , do nothing
+	return nil, &model.NotImplementError{S: "method PullVolume has not been implemented yet"}
+}
+
+func (d *Driver) DeleteVolume(opt *pb.DeleteVolumeOpts) error {
+	name, err := zvolNameOf(opt.GetMetadata())
+	if err != nil {
+		return err
+	}
+	if !d.cli.Exists(name) {
+		log.Warningf("Volume(%s) does not exist, nothing to remove", name)
+		return nil
+	}
+
+	// The snapshots whose clones still exist are kept after being deleted,
+	// so the zvol can't be destroyed until the clones are deleted.
+	if d.cli.HasSnapshots(name) {
+		err := fmt.Errorf("unable to delete due to existing snapshot for volume: %s", name)
+		log.Error(err)
+		return err
+	}
+
+	if err := d.cli.Destroy(name); err != nil {
+		log.Error("Failed to remove zvol:", err)
+		return err
+	}
+	return nil
+}
+
+// ExtendVolume ...
+func (d *Driver) ExtendVolume(opt *pb.ExtendVolumeOpts) (*model.VolumeSpec, error) {
+	var name = zvolName(opt.GetPoolName(), opt.GetId())
+	if err := d.cli.ExtendVolume(name, opt.GetSize()); err != nil {
+		log.Errorf("extend volume(%s) failed, error: %v", name, err)
+		return nil, err
+	}
+	return &model.VolumeSpec{
+		BaseModel: &model.BaseModel{
+			Id: opt.GetId(),
+		},
+		Name:        opt.GetName(),
+		Size:        opt.GetSize(),
+		Description: opt.GetDescription(),
+		Metadata:    opt.GetMetadata(),
+	}, nil
+}
+
+func (d *Driver) InitializeConnection(opt *pb.CreateVolumeAttachmentOpts) (*model.ConnectionInfo, error) {
+	initiator := opt.HostInfo.GetInitiator()
+	if initiator == "" {
+		initiator = allInitiators
+	}
+
+	hostIP := opt.HostInfo.GetIp()
+	if hostIP == "" {
+		hostIP = allInitiators
+	}
+
+	name, err := zvolNameOf(opt.GetMetadata())
+	if err != nil {
+		return nil, err
+	}
+	var chapAuth []string
+	if d.conf.EnableChapAuth {
+		chapAuth = []string{utils.RandSeqWithAlnum(20), utils.RandSeqWithAlnum(16)}
+	}
+
+	// create target according to the pool's access protocol
+	accPro := opt.AccessProtocol
+	transport := opt.GetTransport()
+	if transport == "" {
+		transport = d.conf.NvmeofTransport
+	}
+	t := targets.NewTarget(d.conf.TgtBindIp, d.conf.TgtConfDir, accPro, d.conf.ISCSITarget, transport, d.conf.NvmeofPort)
+	expt, err := t.CreateExport(opt.GetVolumeId(), zvolPath(name), hostIP, initiator, chapAuth)
+	if err != nil {
+		log.Error("Failed to initialize connection of zvol:", err)
+		return nil, err
+	}
+	return &model.ConnectionInfo{
+		DriverVolumeType: accPro,
+		ConnectionData:   expt,
+	}, nil
+}
+
+func (d *Driver) TerminateConnection(opt *pb.DeleteVolumeAttachmentOpts) error {
+	accPro := opt.AccessProtocol
+	t := targets.NewTarget(d.conf.TgtBindIp, d.conf.TgtConfDir, accPro, d.conf.ISCSITarget, d.conf.NvmeofTransport, d.conf.NvmeofPort)
+	if err := t.RemoveExport(opt.GetVolumeId()); err != nil {
+		log.Error("failed to terminate connection of zvol:", err)
+		return err
+	}
+	return nil
+}
+
+func (d *Driver) CreateSnapshot(opt *pb.CreateVolumeSnapshotOpts) (*model.VolumeSnapshotSpec, error) {
+	name, err := zvolNameOf(opt.GetMetadata())
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := name + "@" + snapshotPrefix + opt.GetId()
+	if err := d.cli.CreateSnapshot(snapshot); err != nil {
+		log.Error("Failed to create zfs snapshot:", err)
+		return nil, err
+	}
+
+	return &model.VolumeSnapshotSpec{
+		BaseModel: &model.BaseModel{
+			Id: opt.GetId(),
+		},
+		Name:        opt.GetName(),
+		Size:        opt.GetSize(),
+		Description: opt.GetDescription(),
+		VolumeId:    opt.GetVolumeId(),
+		Metadata: map[string]string{
+			KZfsSnapshot: snapshot,
+		},
+	}, nil
+}
+
+func (d *Driver) PullSnapshot(snapIdentifier string) (*model.VolumeSnapshotSpec, error) {
+	// not used, do nothing
+	return nil, &model.NotImplementError{S: "method PullSnapshot has not been implemented yet"}
+}
+
+// DeleteSnapshot destroys the snapshot, or defers it until the volumes cloned
+// from the snapshot are deleted.
+func (d *Driver) DeleteSnapshot(opt *pb.DeleteVolumeSnapshotOpts) error {
+	snapshot, ok := opt.GetMetadata()[KZfsSnapshot]
+	if !ok {
+		err := errors.New("can't find 'zfsSnapshot' in snapshot metadata, ingnore it!")
+		log.Error(err)
+		return nil
+	}
+	if !d.cli.Exists(snapshot) {
+		log.Warningf("Snapshot(%s) does not exist, nothing to remove", snapshot)
+		return nil
+	}
+
+	if err := d.cli.Destroy(snapshot); err != nil {
+		log.Error("Failed to remove zfs snapshot:", err)
+		return err
+	}
+	return nil
+}
+
+func (d *Driver) InitializeSnapshotConnection(opt *pb.CreateSnapshotAttachmentOpts) (*model.ConnectionInfo, error) {
+	return nil, &model.NotImplementError{S: "method InitializeSnapshotConnection has not been implemented yet"}
+}
+
+func (d *Driver) TerminateSnapshotConnection(opt *pb.DeleteSnapshotAttachmentOpts) error {
+	return &model.NotImplementError{S: "method TerminateSnapshotConnection has not been implemented yet"}
+}
+
+func (d *Driver) CreateVolumeGroup(opt *pb.CreateVolumeGroupOpts) (*model.VolumeGroupSpec, error) {
+	return nil, &model.NotImplementError{S: "method CreateVolumeGroup has not been implemented yet"}
+}
+
+func (d *Driver) UpdateVolumeGroup(opt *pb.UpdateVolumeGroupOpts) (*model.VolumeGroupSpec, error) {
+	return nil, &model.NotImplementError{S: "method UpdateVolumeGroup has not been implemented yet"}
+}
+
+func (d *Driver) DeleteVolumeGroup(opt *pb.DeleteVolumeGroupOpts) error {
+	return &model.NotImplementError{S: "method DeleteVolumeGroup has not been implemented yet"}
+}
+
+func (d *Driver) ListPools() ([]*model.StoragePoolSpec, error) {
+	var names []string
+	for name := range d.conf.Pool {
+		names = append(names, name)
+	}
+	zpools, err := d.cli.ListPools(names...)
+	if err != nil {
+		return nil, err
+	}
+
+	var pols []*model.StoragePoolSpec
+	for _, zp := range zpools {
+		c, ok := d.conf.Pool[zp.Name]
+		if !ok {
+			continue
+		}
+
+		pol := &model.StoragePoolSpec{
+			BaseModel: &model.BaseModel{
+				Id: uuid.NewV5(uuid.NamespaceOID, zp.GUID).String(),
+			},
+			Name:             zp.Name,
+			TotalCapacity:    zp.TotalCapacity,
+			FreeCapacity:     zp.FreeCapacity,
+			StorageType:      c.StorageType,
+			Extras:           c.Extras,
+			AvailabilityZone: c.AvailabilityZone,
+		}
+		if pol.AvailabilityZone == "" {
+			pol.AvailabilityZone = defaultAZ
+		}
+		if d.conf.ThinProvision {
+			pol.Extras.DataStorage.ProvisioningPolicy = "Thin"
+			pol.Extras.DataStorage.IsSpaceEfficient = true
+		} else {
+			pol.Extras.DataStorage.ProvisioningPolicy = "Fixed"
+		}
+		// The data reduced by compression and deduplication is reported
+		// together.
+		if ds := pol.Extras.DataStorage; ds.IsCompressed || ds.IsDeduplicated {
+			ratio, err := d.cli.CompressRatio(zp.Name)
+			if err != nil {
+				log.Errorf("Failed to get compression ratio of zpool %s: %v", zp.Name, err)
+				return nil, err
+			}
+			pol.DataReductionRatio = ratio * zp.DedupRatio
+		}
+		pols = append(pols, pol)
+	}
+	return pols, nil
+}
+
+// Capabilities declares the operations supported by zfs driver, the I/O of
+// zvols can't be limited as the one of lvm driver.
+func (d *Driver) Capabilities() model.DriverCapabilitiesSpec {
+	return model.DriverCapabilitiesSpec{
+		Clone:        true,
+		OnlineExtend: true,
+	}
+}
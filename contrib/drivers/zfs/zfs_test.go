@@ -0,0 +1,265 @@
+// Copyright (c) 2018 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+//    Licensed under the Apache License, Version 2.0 (the "License"); you may
+//    not use this file except in compliance with the License. You may obtain
+//    a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//    WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//    License for the specific language governing permissions and limitations
+//    under the License.
+
+package zfs
+
+import (
+	"reflect"
+	"testing"
+
+	. "github.com/opensds/opensds/contrib/drivers/utils/config"
+	"github.com/opensds/opensds/pkg/model"
+	pb "github.com/opensds/opensds/pkg/model/proto"
+	"github.com/opensds/opensds/pkg/utils/exec"
+)
+
+const (
+	volId  = "e1bb066c-5ce7-46eb-9336-25508cee9f71"
+	snapId = "d1916c49-3088-4a40-b6fb-0fda18d074c3"
+	zvol   = "tank/volume-" + volId
+	zsnap  = zvol + "@snapshot-" + snapId
+)
+
+var fp = map[string]PoolProperties{
+	"tank": {
+		StorageType:      "block",
+		AvailabilityZone: "default",
+		Extras: model.StoragePoolExtraSpec{
+			DataStorage: model.DataStorageLoS{
+				ProvisioningPolicy: "Thin",
+				IsSpaceEfficient:   true,
+				IsCompressed:       true,
+			},
+			IOConnectivity: model.IOConnectivityLoS{
+				AccessProtocol: "iscsi",
+				MaxIOPS:        7000000,
+				MaxBWS:         600,
+			},
+			Advanced: map[string]interface{}{
+				"diskType": "SSD",
+				"latency":  "5ms",
+			},
+		},
+	},
+}
+
+func TestSetup(t *testing.T) {
+	var d = &Driver{ConfigPath: "testdata/zfs.yaml"}
+	var expected = &ZFSConfig{
+		Pool:            fp,
+		TgtBindIp:       "192.168.56.105",
+		TgtConfDir:      "/etc/tgt/conf.d",
+		EnableChapAuth:  true,
+		ISCSITarget:     "lio",
+		NvmeofTransport: "tcp",
+		NvmeofPort:      "4420",
+		ThinProvision:   true,
+		Compression:     "lz4",
+	}
+
+	if err := d.Setup(); err != nil {
+		t.Errorf("Setup zfs driver failed: %+v\n", err)
+	}
+	if !reflect.DeepEqual(d.conf, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, d.conf)
+	}
+}
+
+// newTestDriver returns the driver which replays the records instead of
+// running the commands of zfs.
+func newTestDriver(t *testing.T, recs ...exec.Record) (*Driver, *exec.ReplayExecuter) {
+	var d = &Driver{ConfigPath: "testdata/zfs.yaml"}
+	if err := d.Setup(); err != nil {
+		t.Fatal("Setup zfs driver failed:", err)
+	}
+	replayer := exec.NewReplayExecuter(recs...)
+	d.cli.RootExecuter = replayer
+	return d, replayer
+}
+
+func checkUnplayed(t *testing.T, replayer *exec.ReplayExecuter) {
+	if recs := replayer.Unplayed(); len(recs) != 0 {
+		t.Errorf("Expected all commands to be run, %d are not: %v", len(recs), recs)
+	}
+}
+
+func TestCreateVolume(t *testing.T) {
+	d, replayer := newTestDriver(t, exec.Record{
+		Name: "zfs",
+		Args: []string{"create", "-s", "-V", "1G", "-o", "compression=lz4", "-o", "dedup=on", zvol},
+	})
+
+	opt := &pb.CreateVolumeOpts{
+		Id:             volId,
+		Name:           "test001",
+		Description:    "volume for testing",
+		Size:           1,
+		PoolName:       "tank",
+		IsCompressed:   true,
+		IsDeduplicated: true,
+	}
+	var expected = &model.VolumeSpec{
+		BaseModel:   &model.BaseModel{Id: volId},
+		Name:        "test001",
+		Description: "volume for testing",
+		Size:        1,
+		Metadata: map[string]string{
+			KZvolPath: "/dev/zvol/" + zvol,
+		},
+	}
+	vol, err := d.CreateVolume(opt)
+	if err != nil {
+		t.Fatal("Failed to create volume:", err)
+	}
+	if !reflect.DeepEqual(vol, expected) {
+		t.Errorf("Expected %+v, got %+v\n", expected, vol)
+	}
+	checkUnplayed(t, replayer)
+}
+
+func TestCreateVolumeFromSnapshot(t *testing.T) {
+	var newVol = "volume-3769855c-a102-11e7-b772-17b880d2f537"
+	for _, c := range []struct {
+		pool    string
+		records []exec.Record
+	}{
+		// The snapshot in the same zpool is cloned.
+		{"tank", []exec.Record{
+			{Name: "zfs", Args: []string{"clone", zsnap, "tank/" + newVol}},
+			{Name: "zfs", Args: []string{"set", "volsize=2G", "tank/" + newVol}},
+		}},
+		// The snapshot in other zpool is copied.
+		{"backup", []exec.Record{
+			{Name: "/bin/sh", Args: []string{"-c", "zfs send " + zsnap + " | zfs receive backup/" + newVol}},
+			{Name: "zfs", Args: []string{"destroy", "backup/" + newVol + "@snapshot-" + snapId}},
+			{Name: "zfs", Args: []string{"set", "volsize=2G", "backup/" + newVol}},
+		}},
+	} {
+		d, replayer := newTestDriver(t, c.records...)
+		opt := &pb.CreateVolumeOpts{
+			Id:           "3769855c-a102-11e7-b772-17b880d2f537",
+			Size:         2,
+			PoolName:     c.pool,
+			SnapshotId:   snapId,
+			SnapshotSize: 1,
+			Metadata:     map[string]string{KZfsSnapshot: zsnap},
+		}
+		vol, err := d.CreateVolume(opt)
+		if err != nil {
+			t.Errorf("Failed to create volume in %s from snapshot: %v", c.pool, err)
+			continue
+		}
+		if p := vol.Metadata[KZvolPath]; p != "/dev/zvol/"+c.pool+"/"+newVol {
+			t.Errorf("Expected zvol in %s, got %s", c.pool, p)
+		}
+		checkUnplayed(t, replayer)
+	}
+}
+
+func TestDeleteVolume(t *testing.T) {
+	var exists = exec.Record{Name: "zfs", Args: []string{"list", "-H", "-o", "name", zvol}, Output: zvol + "\n"}
+	var listSnapshots = []string{"list", "-H", "-t", "snapshot", "-o", "name", "-d", "1", zvol}
+	opt := &pb.DeleteVolumeOpts{
+		Id:       volId,
+		Metadata: map[string]string{KZvolPath: "/dev/zvol/" + zvol},
+	}
+
+	d, replayer := newTestDriver(t,
+		exists,
+		exec.Record{Name: "zfs", Args: listSnapshots, Output: zsnap + "\n"},
+	)
+	if err := d.DeleteVolume(opt); err == nil {
+		t.Error("Expected error of deleting volume with snapshots, got nil")
+	}
+	checkUnplayed(t, replayer)
+
+	d, replayer = newTestDriver(t,
+		exists,
+		exec.Record{Name: "zfs", Args: listSnapshots},
+		exec.Record{Name: "zfs", Args: []string{"destroy", zvol}},
+	)
+	if err := d.DeleteVolume(opt); err != nil {
+		t.Error("Failed to delete volume:", err)
+	}
+	checkUnplayed(t, replayer)
+}
+
+func TestExtendVolume(t *testing.T) {
+	d, replayer := newTestDriver(t, exec.Record{
+		Name: "zfs", Args: []string{"set", "volsize=2G", zvol},
+	})
+	opt := &pb.ExtendVolumeOpts{Id: volId, Size: 2, PoolName: "tank"}
+	vol, err := d.ExtendVolume(opt)
+	if err != nil {
+		t.Fatal("Failed to extend volume:", err)
+	}
+	if vol.Size != 2 {
+		t.Errorf("Expected size 2, got %d", vol.Size)
+	}
+	checkUnplayed(t, replayer)
+}
+
+func TestCreateAndDeleteSnapshot(t *testing.T) {
+	d, replayer := newTestDriver(t,
+		exec.Record{Name: "zfs", Args: []string{"snapshot", zsnap}},
+		exec.Record{Name: "zfs", Args: []string{"list", "-H", "-o", "name", zsnap}, Output: zsnap + "\n"},
+		exec.Record{Name: "zfs", Args: []string{"destroy", "-d", zsnap}},
+	)
+	snap, err := d.CreateSnapshot(&pb.CreateVolumeSnapshotOpts{
+		Id:       snapId,
+		VolumeId: volId,
+		Size:     1,
+		Metadata: map[string]string{KZvolPath: "/dev/zvol/" + zvol},
+	})
+	if err != nil {
+		t.Fatal("Failed to create snapshot:", err)
+	}
+	if snap.Metadata[KZfsSnapshot] != zsnap {
+		t.Errorf("Expected snapshot %s, got %s", zsnap, snap.Metadata[KZfsSnapshot])
+	}
+
+	if err := d.DeleteSnapshot(&pb.DeleteVolumeSnapshotOpts{Id: snapId, Metadata: snap.Metadata}); err != nil {
+		t.Error("Failed to delete snapshot:", err)
+	}
+	checkUnplayed(t, replayer)
+}
+
+func TestListPools(t *testing.T) {
+	d, _ := newTestDriver(t)
+	replayer, err := exec.NewReplayExecuterFromFile("testdata/list_pools.record")
+	if err != nil {
+		t.Fatal(err)
+	}
+	d.cli.RootExecuter = replayer
+
+	pols, err := d.ListPools()
+	if err != nil {
+		t.Fatal("Failed to list pools:", err)
+	}
+	if len(pols) != 1 {
+		t.Fatalf("Expected 1 pool, got %d", len(pols))
+	}
+	pol := pols[0]
+	if pol.Name != "tank" || pol.TotalCapacity != 100 || pol.FreeCapacity != 60 {
+		t.Errorf("Expected pool tank of 100GB with 60GB free, got %s of %dGB with %dGB free",
+			pol.Name, pol.TotalCapacity, pol.FreeCapacity)
+	}
+	if pol.DataReductionRatio != 2 {
+		t.Errorf("Expected data reduction ratio 2, got %v", pol.DataReductionRatio)
+	}
+	if pol.Extras.DataStorage.ProvisioningPolicy != "Thin" {
+		t.Errorf("Expected thin pool, got %s", pol.Extras.DataStorage.ProvisioningPolicy)
+	}
+	checkUnplayed(t, replayer)
+}
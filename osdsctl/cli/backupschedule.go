@@ -0,0 +1,75 @@
+// Copyright (c) 2018 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+This module implements a entry into the OpenSDS service.
+
+*/
+
+package cli
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+var volumeBackupScheduleCommand = &cobra.Command{
+	Use:   "backupschedule",
+	Short: "show volume backup schedules in the cluster",
+	Run:   volumeBackupScheduleAction,
+}
+
+var volumeBackupScheduleShowCommand = &cobra.Command{
+	Use:   "show <volume id>",
+	Short: "show the backup schedule of specified volume in the cluster",
+	Run:   volumeBackupScheduleShowAction,
+}
+
+var volumeBackupScheduleListCommand = &cobra.Command{
+	Use:   "list",
+	Short: "list all volume backup schedules in the cluster",
+	Run:   volumeBackupScheduleListAction,
+}
+
+func init() {
+	volumeBackupScheduleCommand.AddCommand(volumeBackupScheduleShowCommand)
+	volumeBackupScheduleCommand.AddCommand(volumeBackupScheduleListCommand)
+}
+
+func volumeBackupScheduleAction(cmd *cobra.Command, args []string) {
+	cmd.Usage()
+	os.Exit(1)
+}
+
+func volumeBackupScheduleShowAction(cmd *cobra.Command, args []string) {
+	ArgsNumCheck(cmd, args, 1)
+	resp, err := client.GetBackupSchedule(args[0])
+	if err != nil {
+		Fatalln(HttpErrStrip(err))
+	}
+	keys := KeyList{"Id", "CreatedAt", "UpdatedAt", "TenantId", "VolumeId", "ProfileId", "NextRunAt",
+		"LastRunAt", "LastResult", "LastError", "LastBackupId", "LastBackupMode", "LastFullBackupId", "RunCount"}
+	PrintDict(resp, keys, FormatterList{})
+}
+
+func volumeBackupScheduleListAction(cmd *cobra.Command, args []string) {
+	ArgsNumCheck(cmd, args, 0)
+	resp, err := client.ListBackupSchedules()
+	if err != nil {
+		Fatalln(HttpErrStrip(err))
+	}
+	keys := KeyList{"Id", "VolumeId", "ProfileId", "NextRunAt", "LastRunAt", "LastResult", "LastBackupMode"}
+	PrintList(resp, keys, FormatterList{})
+}
@@ -100,6 +100,11 @@ func Run() error {
 		if err != nil {
 			return err
 		}
+	case c.Oidc:
+		authOptions, err = c.LoadTokenAuthOptionsFromEnv()
+		if err != nil {
+			return err
+		}
 	case c.Noauth:
 		authOptions = c.LoadNoAuthOptionsFromEnv()
 	default:
@@ -124,7 +124,7 @@ func init() {
 	flags.StringVarP(&replicationDesp, "description", "d", "", "the description of created replication")
 	flags.StringVarP(&primaryReplicationDriverData, "primary_driver_data", "p", "", "the primary replication driver data of created replication")
 	flags.StringVarP(&secondaryReplicationDriverData, "secondary_driver_data", "s", "", "the secondary replication driver data of created replication")
-	flags.StringVarP(&replicationMode, "replication_mode", "m", model.ReplicationModeSync, "the replication mode of created replication, value can be sync/async")
+	flags.StringVarP(&replicationMode, "replication_mode", "m", model.ReplicationModeSync, "the replication mode of created replication, value can be sync/async/active")
 	flags.Int64VarP(&replicationPeriod, "replication_period", "t", 0, "the replication period(minute) of created replication, the value must greater than 0, only in sync replication mode should set this value (default 60)")
 	replicationUpdateCommand.Flags().StringVarP(&replicationName, "name", "n", "", "the name of updated replication")
 	replicationUpdateCommand.Flags().StringVarP(&replicationDesp, "description", "d", "", "the description of updated replication")
@@ -150,7 +150,7 @@ var replicationFormatters = FormatterList{"PrimaryReplicationDriverData": JsonFo
 
 func replicationCreateAction(cmd *cobra.Command, args []string) {
 	ArgsNumCheck(cmd, args, 2)
-	validMode := []string{model.ReplicationModeSync, model.ReplicationModeAsync}
+	validMode := []string{model.ReplicationModeSync, model.ReplicationModeAsync, model.ReplicationModeActive}
 	var mode = strings.ToLower(replicationMode)
 	if !utils.Contained(mode, validMode) {
 		Fatalf("invalid replication mode '%s'\n", replicationMode)
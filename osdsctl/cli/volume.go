@@ -69,12 +69,20 @@ var volumeExtendCommand = &cobra.Command{
 	Run:   volumeExtendAction,
 }
 
+var volumeQosCommand = &cobra.Command{
+	Use:   "qos <id>",
+	Short: "update the qos of a volume in the cluster, which can be in use",
+	Run:   volumeQosAction,
+}
+
 var (
 	profileId string
 	volName   string
 	volDesp   string
 	volAz     string
 	volSnap   string
+	volIOPS   int64
+	volBWS    int64
 )
 
 var (
@@ -124,10 +132,14 @@ func init() {
 	volumeUpdateCommand.Flags().StringVarP(&volName, "name", "n", "", "the name of updated volume")
 	volumeUpdateCommand.Flags().StringVarP(&volDesp, "description", "d", "", "the description of updated volume")
 	volumeCommand.AddCommand(volumeExtendCommand)
+	volumeCommand.AddCommand(volumeQosCommand)
+	volumeQosCommand.Flags().Int64VarP(&volIOPS, "maxIOPS", "", 0, "the max IOPS of volume, 0 means no limit")
+	volumeQosCommand.Flags().Int64VarP(&volBWS, "maxBWS", "", 0, "the max bandwidth of volume in MB/s, 0 means no limit")
 
 	volumeCommand.AddCommand(volumeSnapshotCommand)
 	volumeCommand.AddCommand(volumeAttachmentCommand)
 	volumeCommand.AddCommand(volumeGroupCommand)
+	volumeCommand.AddCommand(volumeBackupScheduleCommand)
 }
 
 func volumeAction(cmd *cobra.Command, args []string) {
@@ -236,3 +248,19 @@ func volumeExtendAction(cmd *cobra.Command, args []string) {
 		"AvailabilityZone", "Status", "PoolId", "ProfileId", "Metadata", "GroupId"}
 	PrintDict(resp, keys, FormatterList{})
 }
+
+func volumeQosAction(cmd *cobra.Command, args []string) {
+	ArgsNumCheck(cmd, args, 1)
+	body := &model.QosSpec{
+		MaxIOPS: volIOPS,
+		MaxBWS:  volBWS,
+	}
+
+	resp, err := client.UpdateVolumeQos(args[0], body)
+	if err != nil {
+		Fatalln(HttpErrStrip(err))
+	}
+	keys := KeyList{"Id", "CreatedAt", "UpdatedAt", "Name", "Description", "Size",
+		"AvailabilityZone", "Status", "PoolId", "ProfileId", "Metadata", "GroupId", "Qos"}
+	PrintDict(resp, keys, FormatterList{"Qos": JsonFormatter})
+}
@@ -82,3 +82,9 @@ func TestVolumeExtendAction(t *testing.T) {
 	args = append(args, "5")
 	volumeExtendAction(volumeExtendCommand, args)
 }
+
+func TestVolumeQosAction(t *testing.T) {
+	var args []string
+	args = append(args, "bd5b12a8-a101-11e7-941e-d77981b584d8")
+	volumeQosAction(volumeQosCommand, args)
+}
@@ -62,6 +62,12 @@ var volumeSnapshotUpdateCommand = &cobra.Command{
 	Run:   volumeSnapshotUpdateAction,
 }
 
+var volumeSnapshotVerifyCommand = &cobra.Command{
+	Use:   "verify <snapshot id>",
+	Short: "verify the backup of a volume snapshot in the cloud without restoring it",
+	Run:   volumeSnapshotVerifyAction,
+}
+
 var (
 	volSnapshotName string
 	volSnapshotDesp string
@@ -100,6 +106,7 @@ func init() {
 	volumeSnapshotCommand.AddCommand(volumeSnapshotListCommand)
 	volumeSnapshotCommand.AddCommand(volumeSnapshotDeleteCommand)
 	volumeSnapshotCommand.AddCommand(volumeSnapshotUpdateCommand)
+	volumeSnapshotCommand.AddCommand(volumeSnapshotVerifyCommand)
 	volumeSnapshotUpdateCommand.Flags().StringVarP(&volSnapshotName, "name", "n", "", "the name of updated volume snapshot")
 	volumeSnapshotUpdateCommand.Flags().StringVarP(&volSnapshotDesp, "description", "d", "", "the description of updated volume snapshot")
 }
@@ -175,3 +182,13 @@ func volumeSnapshotUpdateAction(cmd *cobra.Command, args []string) {
 	keys := KeyList{"Id", "CreatedAt", "UpdatedAt", "Name", "Description", "Size", "Status", "VolumeId"}
 	PrintDict(resp, keys, FormatterList{})
 }
+
+func volumeSnapshotVerifyAction(cmd *cobra.Command, args []string) {
+	ArgsNumCheck(cmd, args, 1)
+	resp, err := client.VerifyVolumeSnapshot(args[0])
+	if err != nil {
+		Fatalln(HttpErrStrip(err))
+	}
+	keys := KeyList{"Id", "CreatedAt", "UpdatedAt", "Name", "Description", "Size", "Status", "VolumeId"}
+	PrintDict(resp, keys, FormatterList{})
+}
@@ -76,3 +76,9 @@ func TestVolumeSnapshotUpdateAction(t *testing.T) {
 	args = append(args, "3769855c-a102-11e7-b772-17b880d2f537")
 	volumeSnapshotUpdateAction(volumeSnapshotDeleteCommand, args)
 }
+
+func TestVolumeSnapshotVerifyAction(t *testing.T) {
+	var args []string
+	args = append(args, "3769855c-a102-11e7-b772-17b880d2f537")
+	volumeSnapshotVerifyAction(volumeSnapshotVerifyCommand, args)
+}
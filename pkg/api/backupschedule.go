@@ -0,0 +1,91 @@
+// Copyright (c) 2018 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+This module implements a entry into the OpenSDS northbound service.
+
+*/
+
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/opensds/opensds/pkg/api/policy"
+	c "github.com/opensds/opensds/pkg/context"
+	"github.com/opensds/opensds/pkg/db"
+	"github.com/opensds/opensds/pkg/model"
+)
+
+// BackupSchedulePortal shows the state of the backup policies carried out by
+// osdslet, including the next run and the last result of every volume.
+type BackupSchedulePortal struct {
+	BasePortal
+}
+
+func (b *BackupSchedulePortal) ListBackupSchedules() {
+	if !policy.Authorize(b.Ctx, "backup_schedule:list") {
+		return
+	}
+
+	result, err := db.C.ListBackupSchedules(c.GetContext(b.Ctx))
+	if err != nil {
+		errMsg := fmt.Sprintf("list backup schedules failed: %s", err.Error())
+		b.ErrorHandle(model.ErrorInternalServer, errMsg)
+		return
+	}
+
+	// Marshal the result.
+	body, err := json.Marshal(result)
+	if err != nil {
+		errMsg := fmt.Sprintf("marshal backup schedules listed result failed: %s", err.Error())
+		b.ErrorHandle(model.ErrorInternalServer, errMsg)
+		return
+	}
+
+	b.SuccessHandle(StatusOK, body)
+	return
+}
+
+func (b *BackupSchedulePortal) GetBackupSchedule() {
+	if !policy.Authorize(b.Ctx, "backup_schedule:get") {
+		return
+	}
+	ctx := c.GetContext(b.Ctx)
+	id := b.Ctx.Input.Param(":volumeId")
+
+	if _, err := db.C.GetVolume(ctx, id); err != nil {
+		errMsg := fmt.Sprintf("volume %s not found: %s", id, err.Error())
+		b.ErrorHandle(model.ErrorNotFound, errMsg)
+		return
+	}
+	result, err := db.C.GetBackupSchedule(ctx, id)
+	if err != nil {
+		errMsg := fmt.Sprintf("backup schedule of volume %s not found: %s", id, err.Error())
+		b.ErrorHandle(model.ErrorNotFound, errMsg)
+		return
+	}
+
+	// Marshal the result.
+	body, err := json.Marshal(result)
+	if err != nil {
+		errMsg := fmt.Sprintf("marshal backup schedule showed result failed: %s", err.Error())
+		b.ErrorHandle(model.ErrorInternalServer, errMsg)
+		return
+	}
+
+	b.SuccessHandle(StatusOK, body)
+	return
+}
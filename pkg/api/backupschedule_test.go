@@ -0,0 +1,103 @@
+// Copyright (c) 2018 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/astaxie/beego"
+	"github.com/astaxie/beego/context"
+	c "github.com/opensds/opensds/pkg/context"
+	"github.com/opensds/opensds/pkg/db"
+	"github.com/opensds/opensds/pkg/model"
+	. "github.com/opensds/opensds/testutils/collection"
+	dbtest "github.com/opensds/opensds/testutils/db/testing"
+)
+
+func init() {
+	beego.Router("/v1beta/block/backupSchedules", &BackupSchedulePortal{}, "get:ListBackupSchedules")
+	beego.Router("/v1beta/block/volumes/:volumeId/backupSchedule", &BackupSchedulePortal{}, "get:GetBackupSchedule")
+}
+
+func TestListBackupSchedules(t *testing.T) {
+	var sampleBackupSchedules = []*model.BackupScheduleSpec{&SampleBackupSchedules[0]}
+	mockClient := new(dbtest.Client)
+	mockClient.On("ListBackupSchedules", c.NewAdminContext()).Return(sampleBackupSchedules, nil)
+	db.C = mockClient
+
+	r, _ := http.NewRequest("GET", "/v1beta/block/backupSchedules", nil)
+	w := httptest.NewRecorder()
+	beego.InsertFilter("*", beego.BeforeExec, func(httpCtx *context.Context) {
+		httpCtx.Input.SetData("context", c.NewAdminContext())
+	})
+	beego.BeeApp.Handlers.ServeHTTP(w, r)
+
+	var output []*model.BackupScheduleSpec
+	json.Unmarshal(w.Body.Bytes(), &output)
+
+	if w.Code != 200 {
+		t.Errorf("Expected 200, actual %v", w.Code)
+	}
+	if !reflect.DeepEqual(sampleBackupSchedules, output) {
+		t.Errorf("Expected %v, actual %v", sampleBackupSchedules, output)
+	}
+}
+
+func TestGetBackupSchedule(t *testing.T) {
+	mockClient := new(dbtest.Client)
+	mockClient.On("GetVolume", c.NewAdminContext(), "bd5b12a8-a101-11e7-941e-d77981b584d8").Return(&SampleVolumes[0], nil)
+	mockClient.On("GetBackupSchedule", c.NewAdminContext(), "bd5b12a8-a101-11e7-941e-d77981b584d8").Return(&SampleBackupSchedules[0], nil)
+	db.C = mockClient
+
+	r, _ := http.NewRequest("GET", "/v1beta/block/volumes/bd5b12a8-a101-11e7-941e-d77981b584d8/backupSchedule", nil)
+	w := httptest.NewRecorder()
+	beego.InsertFilter("*", beego.BeforeExec, func(httpCtx *context.Context) {
+		httpCtx.Input.SetData("context", c.NewAdminContext())
+	})
+	beego.BeeApp.Handlers.ServeHTTP(w, r)
+
+	var output model.BackupScheduleSpec
+	json.Unmarshal(w.Body.Bytes(), &output)
+
+	if w.Code != 200 {
+		t.Errorf("Expected 200, actual %v", w.Code)
+	}
+	if !reflect.DeepEqual(SampleBackupSchedules[0], output) {
+		t.Errorf("Expected %v, actual %v", SampleBackupSchedules[0], output)
+	}
+}
+
+func TestGetBackupScheduleWithoutPolicy(t *testing.T) {
+	mockClient := new(dbtest.Client)
+	mockClient.On("GetVolume", c.NewAdminContext(), "bd5b12a8-a101-11e7-941e-d77981b584d8").Return(&SampleVolumes[0], nil)
+	mockClient.On("GetBackupSchedule", c.NewAdminContext(), "bd5b12a8-a101-11e7-941e-d77981b584d8").Return(nil, errors.New("db error"))
+	db.C = mockClient
+
+	r, _ := http.NewRequest("GET", "/v1beta/block/volumes/bd5b12a8-a101-11e7-941e-d77981b584d8/backupSchedule", nil)
+	w := httptest.NewRecorder()
+	beego.InsertFilter("*", beego.BeforeExec, func(httpCtx *context.Context) {
+		httpCtx.Input.SetData("context", c.NewAdminContext())
+	})
+	beego.BeeApp.Handlers.ServeHTTP(w, r)
+
+	if w.Code != 404 {
+		t.Errorf("Expected 404, actual %v", w.Code)
+	}
+}
@@ -21,6 +21,7 @@ package api
 import (
 	"errors"
 	"fmt"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -58,6 +59,14 @@ func CreateVolumeDBEntry(ctx *c.Context, in *model.VolumeSpec) (*model.VolumeSpe
 			log.Error(errMsg)
 			return nil, errors.New(errMsg)
 		}
+		snapVol, err := db.C.GetVolume(ctx, snap.VolumeId)
+		if err != nil {
+			log.Error("get volume of snapshot failed in create volume method: ", err)
+			return nil, err
+		}
+		if err = checkPoolCapability(ctx, snapVol.PoolId, model.CapabilityClone); err != nil {
+			return nil, err
+		}
 	}
 	if in.AvailabilityZone == "" {
 		log.Warning("Use default availability zone when user doesn't specify availabilityZone.")
@@ -73,6 +82,49 @@ func CreateVolumeDBEntry(ctx *c.Context, in *model.VolumeSpec) (*model.VolumeSpe
 	return db.C.CreateVolume(ctx, in)
 }
 
+// checkPoolCapability returns an error if the driver of the pool has declared
+// that it doesn't support the capability, so that the request is rejected
+// before it's sent to the dock.
+func checkPoolCapability(ctx *c.Context, poolId, capability string) error {
+	pol, err := db.C.GetPool(ctx, poolId)
+	if err != nil {
+		log.Error("get pool failed in check capability method: ", err)
+		return err
+	}
+	if !pol.Extras.Capabilities.Supports(capability) {
+		errMsg := fmt.Sprintf("%s is not supported by the driver of pool %s", capability, pol.Name)
+		log.Error(errMsg)
+		return errors.New(errMsg)
+	}
+	return nil
+}
+
+// CheckBackupPolicy rejects the backup policy which can't be honored, so that
+// the backups are never taken in another way than the policy asks.
+func CheckBackupPolicy(ctx *c.Context, policy *model.BackupPolicySpec) error {
+	if policy.Topology.Path != "" {
+		errMsg := "backup topology path is not supported, the backups can only be uploaded to a bucket"
+		log.Error(errMsg)
+		return errors.New(errMsg)
+	}
+	if policy.FullBackupInterval <= 1 {
+		return nil
+	}
+	pools, err := db.C.ListPools(ctx)
+	if err != nil {
+		log.Error("list pools failed in check backup policy method: ", err)
+		return err
+	}
+	for _, pol := range pools {
+		if pol.Extras.Capabilities.Supports(model.CapabilityIncrementalBackup) {
+			return nil
+		}
+	}
+	errMsg := "incremental backups are not supported by the driver of any pool, full backup interval must not be bigger than 1"
+	log.Error(errMsg)
+	return errors.New(errMsg)
+}
+
 func CreateVolumeError(ctx *c.Context, in *model.VolumeSpec) error {
 	var errMsg = "size of volume must be equal to or bigger than size of the snapshot"
 	log.Error(errMsg)
@@ -137,8 +189,10 @@ func ExtendVolumeDBEntry(ctx *c.Context, volID string, in *model.ExtendVolumeSpe
 		return nil, err
 	}
 
-	if volume.Status != model.VolumeAvailable {
-		errMsg := "the status of the volume to be extended must be available!"
+	// The volume in use is extended online, and the hosts which it is
+	// attached to are expanded after that.
+	if volume.Status != model.VolumeAvailable && volume.Status != model.VolumeInUse {
+		errMsg := "the status of the volume to be extended must be available or in-use!"
 		log.Error(errMsg)
 		return nil, errors.New(errMsg)
 	}
@@ -148,12 +202,44 @@ func ExtendVolumeDBEntry(ctx *c.Context, volID string, in *model.ExtendVolumeSpe
 		log.Error(errMsg)
 		return nil, errors.New(errMsg)
 	}
+	if volume.Status == model.VolumeInUse {
+		if err = checkPoolCapability(ctx, volume.PoolId, model.CapabilityOnlineExtend); err != nil {
+			return nil, err
+		}
+	}
 
 	volume.Status = model.VolumeExtending
 	// Store the volume data into database.
 	return db.C.ExtendVolume(ctx, volume)
 }
 
+// UpdateVolumeQosDBEntry checks whether the qos of the volume can be changed,
+// the real operation would be executed in another new thread, and the new
+// qos would be updated in controller module.
+func UpdateVolumeQosDBEntry(ctx *c.Context, volID string, in *model.QosSpec) (*model.VolumeSpec, error) {
+	volume, err := db.C.GetVolume(ctx, volID)
+	if err != nil {
+		log.Error("get volume failed in update volume qos method: ", err)
+		return nil, err
+	}
+
+	// The qos of volume in use is changed online.
+	if volume.Status != model.VolumeAvailable && volume.Status != model.VolumeInUse {
+		errMsg := "the status of the volume to be updated qos must be available or in-use!"
+		log.Error(errMsg)
+		return nil, errors.New(errMsg)
+	}
+	if in.MaxIOPS < 0 || in.MaxBWS < 0 {
+		errMsg := fmt.Sprintf("invalid volume qos, maxIOPS: %d, maxBWS: %d", in.MaxIOPS, in.MaxBWS)
+		log.Error(errMsg)
+		return nil, errors.New(errMsg)
+	}
+	if err = checkPoolCapability(ctx, volume.PoolId, model.CapabilityQos); err != nil {
+		return nil, err
+	}
+	return volume, nil
+}
+
 func CreateVolumeAttachmentDBEntry(ctx *c.Context, in *model.VolumeAttachmentSpec) (*model.VolumeAttachmentSpec, error) {
 	vol, err := db.C.GetVolume(ctx, in.VolumeId)
 	if err != nil {
@@ -165,6 +251,21 @@ func CreateVolumeAttachmentDBEntry(ctx *c.Context, in *model.VolumeAttachmentSpe
 		log.Error(errMsg)
 		return nil, errors.New(errMsg)
 	}
+	if in.Mountpoint == "" && (in.FsType != "" || len(in.MountOptions) > 0) {
+		errMsg := "file system type and mount options are only valid when mountpoint is specified"
+		log.Error(errMsg)
+		return nil, errors.New(errMsg)
+	}
+	if in.Mountpoint != "" && !filepath.IsAbs(in.Mountpoint) {
+		errMsg := fmt.Sprintf("mountpoint %s is not an absolute path", in.Mountpoint)
+		log.Error(errMsg)
+		return nil, errors.New(errMsg)
+	}
+	if in.Transport != "" && !utils.Contained(in.Transport, []string{"tcp", "rdma", "loop"}) {
+		errMsg := fmt.Sprintf("nvmeof transport %s is not supported", in.Transport)
+		log.Error(errMsg)
+		return nil, errors.New(errMsg)
+	}
 	if in.Id == "" {
 		in.Id = uuid.NewV4().String()
 	}
@@ -179,6 +280,12 @@ func CreateVolumeAttachmentDBEntry(ctx *c.Context, in *model.VolumeAttachmentSpe
 	}
 
 	in.Status = model.VolumeAttachCreating
+	// The volume is mounted by the attacher dock after the attachment is
+	// created.
+	in.MountStatus = ""
+	if in.Mountpoint != "" {
+		in.MountStatus = model.VolumeAttachUnmounted
+	}
 	in.Metadata = utils.MergeStringMaps(in.Metadata, vol.Metadata)
 	return db.C.CreateVolumeAttachment(ctx, in)
 }
@@ -209,18 +316,64 @@ func CreateVolumeSnapshotDBEntry(ctx *c.Context, in *model.VolumeSnapshotSpec) (
 // DeleteVolumeSnapshotDBEntry just modifies the state of the volume snapshot to
 // be deleting in the DB, the real deletion operation would be executed in
 // another new thread.
+func CreateSnapshotAttachmentDBEntry(ctx *c.Context, in *model.SnapshotAttachmentSpec) (*model.SnapshotAttachmentSpec, error) {
+	snap, err := db.C.GetVolumeSnapshot(ctx, in.SnapshotId)
+	if err != nil {
+		log.Error("get volume snapshot failed in create snapshot attachment method: ", err)
+		return nil, err
+	}
+	if snap.Status != model.VolumeSnapAvailable {
+		errMsg := "only the status of volume snapshot is available, attachment can be created"
+		log.Error(errMsg)
+		return nil, errors.New(errMsg)
+	}
+	vol, err := db.C.GetVolume(ctx, snap.VolumeId)
+	if err != nil {
+		log.Error("get volume of snapshot failed in create snapshot attachment method: ", err)
+		return nil, err
+	}
+	if err = checkPoolCapability(ctx, vol.PoolId, model.CapabilitySnapshotAttachment); err != nil {
+		return nil, err
+	}
+	if in.Id == "" {
+		in.Id = uuid.NewV4().String()
+	}
+	if in.CreatedAt == "" {
+		in.CreatedAt = time.Now().Format(constants.TimeFormat)
+	}
+
+	in.Status = model.VolumeAttachCreating
+	in.Metadata = utils.MergeStringMaps(in.Metadata, snap.Metadata)
+	return db.C.CreateSnapshotAttachment(ctx, in)
+}
+
 func DeleteVolumeSnapshotDBEntry(ctx *c.Context, in *model.VolumeSnapshotSpec) error {
+	if in.GroupSnapshotId != "" {
+		errMsg := fmt.Sprintf("the volume snapshot is taken along with group snapshot %s, it can only be deleted with the group snapshot", in.GroupSnapshotId)
+		log.Error(errMsg)
+		return errors.New(errMsg)
+	}
 	validStatus := []string{model.VolumeSnapAvailable, model.VolumeSnapError,
-		model.VolumeSnapErrorDeleting}
+		model.VolumeSnapErrorDeleting, model.VolumeSnapErrorVerifying}
 	if !utils.Contained(in.Status, validStatus) {
-		errMsg := fmt.Sprintf("only the volume snapshot with the status available, error, error_deleting can be deleted, the volume status is %s", in.Status)
+		errMsg := fmt.Sprintf("only the volume snapshot with the status available, error, error_deleting, error_verifying can be deleted, the volume status is %s", in.Status)
+		log.Error(errMsg)
+		return errors.New(errMsg)
+	}
+	atcs, err := db.C.ListSnapshotAttachments(ctx, in.Id)
+	if err != nil {
+		log.Error("list snapshot attachments failed in delete volume snapshot method: ", err)
+		return err
+	}
+	if len(atcs) > 0 {
+		errMsg := fmt.Sprintf("the volume snapshot is attached to %d hosts, detach it before deletion", len(atcs))
 		log.Error(errMsg)
 		return errors.New(errMsg)
 	}
 
 	// If volume id is invalid, it would mean that volume snapshot creation failed before the create method
 	// in storage driver was called, and delete its db entry directly.
-	_, err := db.C.GetVolume(ctx, in.VolumeId)
+	_, err = db.C.GetVolume(ctx, in.VolumeId)
 	if err != nil {
 		if err := db.C.DeleteVolumeSnapshot(ctx, in.Id); err != nil {
 			log.Error("when delete volume snapshot in db:", err)
@@ -237,6 +390,26 @@ func DeleteVolumeSnapshotDBEntry(ctx *c.Context, in *model.VolumeSnapshotSpec) e
 	return nil
 }
 
+// VerifyVolumeSnapshotDBEntry just modifies the state of the volume snapshot
+// to be verifying in the DB, the backup of the snapshot would be checked in
+// another new thread.
+func VerifyVolumeSnapshotDBEntry(ctx *c.Context, in *model.VolumeSnapshotSpec) (*model.VolumeSnapshotSpec, error) {
+	validStatus := []string{model.VolumeSnapAvailable, model.VolumeSnapErrorVerifying}
+	if !utils.Contained(in.Status, validStatus) {
+		errMsg := fmt.Sprintf("only the volume snapshot with the status available, error_verifying can be verified, the volume snapshot status is %s", in.Status)
+		log.Error(errMsg)
+		return nil, errors.New(errMsg)
+	}
+	if _, ok := in.Metadata["backupId"]; !ok {
+		errMsg := "only the volume snapshot backed up into the cloud can be verified"
+		log.Error(errMsg)
+		return nil, errors.New(errMsg)
+	}
+
+	in.Status = model.VolumeSnapVerifying
+	return db.C.UpdateVolumeSnapshot(ctx, in.Id, in)
+}
+
 func CreateReplicationDBEntry(ctx *c.Context, in *model.ReplicationSpec) (*model.ReplicationSpec, error) {
 	pVol, err := db.C.GetVolume(ctx, in.PrimaryVolumeId)
 	if err != nil {
@@ -258,6 +431,11 @@ func CreateReplicationDBEntry(ctx *c.Context, in *model.ReplicationSpec) (*model
 		log.Error(errMsg)
 		return nil, errMsg
 	}
+	for _, vol := range []*model.VolumeSpec{pVol, sVol} {
+		if err = checkPoolCapability(ctx, vol.PoolId, model.CapabilityReplication); err != nil {
+			return nil, err
+		}
+	}
 
 	// Check if specified volume has already been used in other replication.
 	v, err := db.C.GetReplicationByVolumeId(ctx, in.PrimaryVolumeId)
@@ -289,6 +467,23 @@ func CreateReplicationDBEntry(ctx *c.Context, in *model.ReplicationSpec) (*model
 		return nil, errMsg
 	}
 
+	// The replication mode is decided by the replica update mode of profile
+	// if it's not specified.
+	if in.ReplicationMode == "" {
+		profileId := in.ProfileId
+		if profileId == "" {
+			profileId = pVol.ProfileId
+		}
+		if profileId != "" {
+			prf, err := db.C.GetProfile(ctx, profileId)
+			if err != nil {
+				log.Error("get profile failed in create volume replication method: ", err)
+				return nil, err
+			}
+			in.ReplicationMode = replicationModeOf(prf)
+		}
+	}
+
 	if in.Id == "" {
 		in.Id = uuid.NewV4().String()
 	}
@@ -300,6 +495,20 @@ func CreateReplicationDBEntry(ctx *c.Context, in *model.ReplicationSpec) (*model
 	return db.C.CreateReplication(ctx, in)
 }
 
+// replicationModeOf returns the replication mode asked by the replica update
+// mode of profile, the adaptive mode is left to the replication driver.
+func replicationModeOf(prf *model.ProfileSpec) string {
+	switch prf.ReplicationProperties.ReplicaInfos.ReplicaUpdateMode {
+	case "Active":
+		return model.ReplicationModeActive
+	case "Synchronous":
+		return model.ReplicationModeSync
+	case "Asynchronous":
+		return model.ReplicationModeAsync
+	}
+	return ""
+}
+
 // DeleteReplicationDBEntry just modifies the state of the volume replication to
 // be deleting in the DB, the real deletion operation would be executed in
 // another new thread.
@@ -402,6 +611,12 @@ func CreateVolumeGroupDBEntry(ctx *c.Context, in *model.VolumeGroupSpec) (*model
 		log.Warning("Use default availability zone when user doesn't specify availabilityZone.")
 		in.AvailabilityZone = "default"
 	}
+	// The pool is selected by volume group capability if it's not specified.
+	if in.PoolId != "" {
+		if err := checkPoolCapability(ctx, in.PoolId, model.CapabilityVolumeGroup); err != nil {
+			return nil, err
+		}
+	}
 
 	in.Status = model.VolumeGroupCreating
 	return db.C.CreateVolumeGroup(ctx, in)
@@ -442,6 +657,14 @@ func UpdateVolumeGroupDBEntry(ctx *c.Context, vgUpdate *model.VolumeGroupSpec) (
 		return nil, errors.New(msg)
 	}
 
+	// Only the driver of the pool which supports volume group can change the
+	// volumes of the group.
+	if len(vgUpdate.AddVolumes) > 0 || len(vgUpdate.RemoveVolumes) > 0 {
+		if err = checkPoolCapability(ctx, vg.PoolId, model.CapabilityVolumeGroup); err != nil {
+			return nil, err
+		}
+	}
+
 	volumes, err := db.C.ListVolumesByGroupId(ctx, vgUpdate.Id)
 	if err != nil {
 		return nil, err
@@ -639,3 +862,98 @@ func DeleteVolumeGroupDBEntry(ctx *c.Context, volumeGroupId string) error {
 
 	return nil
 }
+
+// CreateVolumeGroupSnapshotDBEntry creates the snapshot entries of all the
+// volumes in the group, which share the same group snapshot id.
+func CreateVolumeGroupSnapshotDBEntry(ctx *c.Context, in *model.VolumeGroupSnapshotSpec) (*model.VolumeGroupSnapshotSpec, error) {
+	vg, err := db.C.GetVolumeGroup(ctx, in.GroupId)
+	if err != nil {
+		log.Error("get volume group failed in create volume group snapshot method: ", err)
+		return nil, err
+	}
+	if vg.Status != model.VolumeGroupAvailable {
+		var errMsg = "only the status of volume group is available, the group snapshot can be created"
+		log.Error(errMsg)
+		return nil, errors.New(errMsg)
+	}
+
+	volumes, err := db.C.ListVolumesByGroupId(ctx, in.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	if len(volumes) == 0 {
+		var errMsg = fmt.Sprintf("group %s contains no volume to take snapshot of", in.GroupId)
+		log.Error(errMsg)
+		return nil, errors.New(errMsg)
+	}
+
+	if in.Id == "" {
+		in.Id = uuid.NewV4().String()
+	}
+	if in.CreatedAt == "" {
+		in.CreatedAt = time.Now().Format(constants.TimeFormat)
+	}
+
+	in.Snapshots = nil
+	for _, vol := range volumes {
+		snp, err := CreateVolumeSnapshotDBEntry(ctx, &model.VolumeSnapshotSpec{
+			BaseModel: &model.BaseModel{
+				CreatedAt: in.CreatedAt,
+			},
+			Name:            in.Name,
+			Description:     in.Description,
+			VolumeId:        vol.Id,
+			GroupSnapshotId: in.Id,
+		})
+		if err != nil {
+			// Roll back the snapshot entries created already.
+			for _, created := range in.Snapshots {
+				db.C.DeleteVolumeSnapshot(ctx, created.Id)
+			}
+			return nil, err
+		}
+		in.Snapshots = append(in.Snapshots, snp)
+	}
+	return in, nil
+}
+
+// DeleteVolumeGroupSnapshotDBEntry modifies the state of all the snapshots in
+// the group snapshot to be deleting in the DB and returns them.
+func DeleteVolumeGroupSnapshotDBEntry(ctx *c.Context, groupId, groupSnapshotId string) ([]*model.VolumeSnapshotSpec, error) {
+	vg, err := db.C.GetVolumeGroup(ctx, groupId)
+	if err != nil {
+		return nil, err
+	}
+	if !utils.Contained(groupSnapshotId, vg.GroupSnapshots) {
+		errMsg := fmt.Sprintf("group snapshot %s not found in group %s", groupSnapshotId, groupId)
+		log.Error(errMsg)
+		return nil, errors.New(errMsg)
+	}
+
+	snapshots, err := db.C.ListVolumeSnapshots(ctx)
+	if err != nil {
+		return nil, err
+	}
+	validStatus := []string{model.VolumeSnapAvailable, model.VolumeSnapError,
+		model.VolumeSnapErrorDeleting}
+	var snps []*model.VolumeSnapshotSpec
+	for _, snp := range snapshots {
+		if snp.GroupSnapshotId != groupSnapshotId {
+			continue
+		}
+		if !utils.Contained(snp.Status, validStatus) {
+			errMsg := fmt.Sprintf("only the volume snapshot with the status available, error, error_deleting can be deleted, the snapshot %s status is %s", snp.Id, snp.Status)
+			log.Error(errMsg)
+			return nil, errors.New(errMsg)
+		}
+		snps = append(snps, snp)
+	}
+
+	for _, snp := range snps {
+		snp.Status = model.VolumeSnapDeleting
+		if _, err = db.C.UpdateVolumeSnapshot(ctx, snp.Id, snp); err != nil {
+			return nil, err
+		}
+	}
+	return snps, nil
+}
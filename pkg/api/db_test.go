@@ -24,6 +24,7 @@ import (
 	"github.com/opensds/opensds/pkg/model"
 	. "github.com/opensds/opensds/testutils/collection"
 	dbtest "github.com/opensds/opensds/testutils/db/testing"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestCreateVolumeDBEntry(t *testing.T) {
@@ -78,14 +79,17 @@ func TestCreateVolumeFromSnapshotDBEntry(t *testing.T) {
 		BaseModel: &model.BaseModel{
 			Id: "3769855c-a102-11e7-b772-17b880d2f537",
 		},
-		Size:   int64(1),
-		Status: model.VolumeSnapAvailable,
+		Size:     int64(1),
+		Status:   model.VolumeSnapAvailable,
+		VolumeId: SampleVolumes[0].Id,
 	}
 
 	// Test case 1: Everything should work well.
 	mockClient := new(dbtest.Client)
 	mockClient.On("CreateVolume", context.NewAdminContext(), in).Return(&SampleVolumes[1], nil)
 	mockClient.On("GetVolumeSnapshot", context.NewAdminContext(), "3769855c-a102-11e7-b772-17b880d2f537").Return(snap, nil)
+	mockClient.On("GetVolume", context.NewAdminContext(), SampleVolumes[0].Id).Return(&SampleVolumes[0], nil)
+	mockClient.On("GetPool", context.NewAdminContext(), SampleVolumes[0].PoolId).Return(&SamplePools[0], nil)
 	db.C = mockClient
 
 	var expected = &SampleVolumes[1]
@@ -131,6 +135,22 @@ func TestCreateVolumeFromSnapshotDBEntry(t *testing.T) {
 			t.Errorf("Expected Non-%v, got %v\n", expectedError, err.Error())
 		}
 	}
+
+	// Test case 4: The driver of the pool should support cloning volume.
+	snap.Size = 1
+	var pol = SamplePools[0]
+	pol.Extras.Capabilities.Clone = false
+	mockClient = new(dbtest.Client)
+	mockClient.On("GetVolumeSnapshot", context.NewAdminContext(), "3769855c-a102-11e7-b772-17b880d2f537").Return(snap, nil)
+	mockClient.On("GetVolume", context.NewAdminContext(), SampleVolumes[0].Id).Return(&SampleVolumes[0], nil)
+	mockClient.On("GetPool", context.NewAdminContext(), SampleVolumes[0].PoolId).Return(&pol, nil)
+	db.C = mockClient
+
+	_, err = CreateVolumeDBEntry(context.NewAdminContext(), in)
+	expectedError = "clone is not supported by the driver of pool sample-pool-01"
+	if err == nil || err.Error() != expectedError {
+		t.Errorf("Expected %v, got %v\n", expectedError, err)
+	}
 }
 
 func TestDeleteVolumeDBEntry(t *testing.T) {
@@ -216,6 +236,7 @@ func TestExtendVolumeDBEntry(t *testing.T) {
 		},
 		Status: model.VolumeAvailable,
 		Size:   2,
+		PoolId: SamplePools[0].Id,
 	}
 	var in = &model.VolumeSpec{
 		BaseModel: &model.BaseModel{
@@ -223,6 +244,7 @@ func TestExtendVolumeDBEntry(t *testing.T) {
 		},
 		Status: model.VolumeExtending,
 		Size:   2,
+		PoolId: SamplePools[0].Id,
 	}
 
 	// Test case 1: Everything should work well.
@@ -235,14 +257,14 @@ func TestExtendVolumeDBEntry(t *testing.T) {
 		t.Errorf("Failed to extend volume: %v\n", err)
 	}
 
-	// Test case 2: The status of volume should always be available.
+	// Test case 2: The status of volume should always be available or in-use.
 	vol.Status = model.VolumeCreating
 	mockClient = new(dbtest.Client)
 	mockClient.On("GetVolume", context.NewAdminContext(), "bd5b12a8-a101-11e7-941e-d77981b584d8").Return(vol, nil)
 	mockClient.On("ExtendVolume", context.NewAdminContext(), in).Return(nil, nil)
 	db.C = mockClient
 	_, err = ExtendVolumeDBEntry(context.NewAdminContext(), vol.Id, &model.ExtendVolumeSpec{NewSize: 20})
-	expectedError := "the status of the volume to be extended must be available!"
+	expectedError := "the status of the volume to be extended must be available or in-use!"
 	if err == nil {
 		t.Errorf("Expected Non-%v, got %v\n", nil, err)
 	} else {
@@ -268,6 +290,68 @@ func TestExtendVolumeDBEntry(t *testing.T) {
 			t.Errorf("Expected Non-%v, got %v\n", expectedError, err.Error())
 		}
 	}
+
+	// Test case 4: The volume in use can only be extended if the driver of
+	// the pool supports online extending.
+	vol.Status = model.VolumeInUse
+	var pol = SamplePools[0]
+	pol.Extras.Capabilities.OnlineExtend = false
+	mockClient = new(dbtest.Client)
+	mockClient.On("GetVolume", context.NewAdminContext(), "bd5b12a8-a101-11e7-941e-d77981b584d8").Return(vol, nil)
+	mockClient.On("GetPool", context.NewAdminContext(), pol.Id).Return(&pol, nil)
+	db.C = mockClient
+	_, err = ExtendVolumeDBEntry(context.NewAdminContext(), vol.Id, &model.ExtendVolumeSpec{NewSize: 40})
+	expectedError = "onlineExtend is not supported by the driver of pool sample-pool-01"
+	if err == nil || err.Error() != expectedError {
+		t.Errorf("Expected %v, got %v\n", expectedError, err)
+	}
+}
+
+func TestUpdateVolumeQosDBEntry(t *testing.T) {
+	var vol = &model.VolumeSpec{
+		BaseModel: &model.BaseModel{
+			Id: "bd5b12a8-a101-11e7-941e-d77981b584d8",
+		},
+		Status: model.VolumeInUse,
+		PoolId: SamplePools[0].Id,
+	}
+
+	// Test case 1: The qos of volume in use can be changed.
+	mockClient := new(dbtest.Client)
+	mockClient.On("GetVolume", context.NewAdminContext(), vol.Id).Return(vol, nil)
+	mockClient.On("GetPool", context.NewAdminContext(), vol.PoolId).Return(&SamplePools[0], nil)
+	db.C = mockClient
+	if _, err := UpdateVolumeQosDBEntry(context.NewAdminContext(), vol.Id, &model.QosSpec{MaxIOPS: 1000}); err != nil {
+		t.Errorf("Failed to update volume qos: %v\n", err)
+	}
+
+	// Test case 2: The limits of qos should never be negative.
+	_, err := UpdateVolumeQosDBEntry(context.NewAdminContext(), vol.Id, &model.QosSpec{MaxBWS: -1})
+	expectedError := "invalid volume qos, maxIOPS: 0, maxBWS: -1"
+	if err == nil || err.Error() != expectedError {
+		t.Errorf("Expected %v, got %v\n", expectedError, err)
+	}
+
+	// Test case 3: The status of volume should always be available or in-use.
+	vol.Status = model.VolumeExtending
+	_, err = UpdateVolumeQosDBEntry(context.NewAdminContext(), vol.Id, &model.QosSpec{MaxIOPS: 1000})
+	expectedError = "the status of the volume to be updated qos must be available or in-use!"
+	if err == nil || err.Error() != expectedError {
+		t.Errorf("Expected %v, got %v\n", expectedError, err)
+	}
+
+	// Test case 4: The driver of the pool should support qos.
+	vol.Status = model.VolumeAvailable
+	var pol = SamplePools[1]
+	mockClient = new(dbtest.Client)
+	mockClient.On("GetVolume", context.NewAdminContext(), vol.Id).Return(vol, nil)
+	mockClient.On("GetPool", context.NewAdminContext(), vol.PoolId).Return(&pol, nil)
+	db.C = mockClient
+	_, err = UpdateVolumeQosDBEntry(context.NewAdminContext(), vol.Id, &model.QosSpec{MaxIOPS: 1000})
+	expectedError = "qos is not supported by the driver of pool sample-pool-02"
+	if err == nil || err.Error() != expectedError {
+		t.Errorf("Expected %v, got %v\n", expectedError, err)
+	}
 }
 
 func TestCreateVolumeAttachmentDBEntry(t *testing.T) {
@@ -302,6 +386,35 @@ func TestCreateVolumeAttachmentDBEntry(t *testing.T) {
 	}
 }
 
+func TestCreateVolumeAttachmentDBEntryWithInvalidMountpoint(t *testing.T) {
+	var vol = &model.VolumeSpec{
+		BaseModel: &model.BaseModel{
+			Id: "bd5b12a8-a101-11e7-941e-d77981b584d8",
+		},
+		Status: "available",
+	}
+	mockClient := new(dbtest.Client)
+	mockClient.On("GetVolume", context.NewAdminContext(), "bd5b12a8-a101-11e7-941e-d77981b584d8").Return(vol, nil)
+	db.C = mockClient
+
+	for _, req := range []*model.VolumeAttachmentSpec{
+		{
+			BaseModel: &model.BaseModel{},
+			VolumeId:  "bd5b12a8-a101-11e7-941e-d77981b584d8",
+			FsType:    "xfs",
+		},
+		{
+			BaseModel:  &model.BaseModel{},
+			VolumeId:   "bd5b12a8-a101-11e7-941e-d77981b584d8",
+			Mountpoint: "mnt/vol01",
+		},
+	} {
+		if _, err := CreateVolumeAttachmentDBEntry(context.NewAdminContext(), req); err == nil {
+			t.Errorf("Expected error when create volume attachment %+v\n", req)
+		}
+	}
+}
+
 func TestCreateVolumeSnapshotDBEntry(t *testing.T) {
 	var m = map[string]string{"a": "a"}
 	var vol = &model.VolumeSpec{
@@ -349,6 +462,7 @@ func TestDeleteVolumeSnapshotDBEntry(t *testing.T) {
 	mockClient := new(dbtest.Client)
 	mockClient.On("UpdateVolumeSnapshot", context.NewAdminContext(), "3769855c-a102-11e7-b772-17b880d2f537", req).Return(nil, nil)
 	mockClient.On("GetVolume", context.NewAdminContext(), req.VolumeId).Return(nil, nil)
+	mockClient.On("ListSnapshotAttachments", context.NewAdminContext(), req.Id).Return(nil, nil)
 	db.C = mockClient
 
 	err := DeleteVolumeSnapshotDBEntry(context.NewAdminContext(), req)
@@ -357,3 +471,320 @@ func TestDeleteVolumeSnapshotDBEntry(t *testing.T) {
 		t.Errorf("Failed to delete volume snapshot, err is %v\n", err)
 	}
 }
+
+func TestVerifyVolumeSnapshotDBEntry(t *testing.T) {
+	var req = &model.VolumeSnapshotSpec{
+		BaseModel: &model.BaseModel{
+			Id: "3769855c-a102-11e7-b772-17b880d2f537",
+		},
+		VolumeId: "bd5b12a8-a101-11e7-941e-d77981b584d8",
+		Status:   "available",
+		Metadata: map[string]string{"bucket": "backup", "backupId": "f5c6a5f8-2a1b-4bb6-8c3f-4a6f9b3b9e1d"},
+	}
+
+	mockClient := new(dbtest.Client)
+	mockClient.On("UpdateVolumeSnapshot", context.NewAdminContext(), req.Id, req).Return(req, nil)
+	db.C = mockClient
+
+	result, err := VerifyVolumeSnapshotDBEntry(context.NewAdminContext(), req)
+	if err != nil {
+		t.Errorf("Failed to verify volume snapshot, err is %v\n", err)
+	}
+	if result.Status != model.VolumeSnapVerifying {
+		t.Errorf("Expected status %s, got %s\n", model.VolumeSnapVerifying, result.Status)
+	}
+}
+
+func TestVerifyVolumeSnapshotDBEntryWithoutBackup(t *testing.T) {
+	var req = &model.VolumeSnapshotSpec{
+		BaseModel: &model.BaseModel{
+			Id: "3769855c-a102-11e7-b772-17b880d2f537",
+		},
+		VolumeId: "bd5b12a8-a101-11e7-941e-d77981b584d8",
+		Status:   "available",
+	}
+
+	db.C = new(dbtest.Client)
+	if _, err := VerifyVolumeSnapshotDBEntry(context.NewAdminContext(), req); err == nil {
+		t.Error("Expected error of verifying snapshot without backup, got nil")
+	}
+}
+
+func TestDeleteVolumeSnapshotDBEntryInGroupSnapshot(t *testing.T) {
+	var req = &model.VolumeSnapshotSpec{
+		BaseModel: &model.BaseModel{
+			Id: "3769855c-a102-11e7-b772-17b880d2f537",
+		},
+		VolumeId:        "bd5b12a8-a101-11e7-941e-d77981b584d8",
+		Status:          "available",
+		GroupSnapshotId: "3769855c-a102-11e7-b772-17b880d2f560",
+	}
+
+	db.C = new(dbtest.Client)
+	if err := DeleteVolumeSnapshotDBEntry(context.NewAdminContext(), req); err == nil {
+		t.Error("Expected error of deleting snapshot in group snapshot, got nil")
+	}
+}
+
+func TestDeleteAttachedVolumeSnapshotDBEntry(t *testing.T) {
+	var req = &model.VolumeSnapshotSpec{
+		BaseModel: &model.BaseModel{
+			Id: "3769855c-a102-11e7-b772-17b880d2f537",
+		},
+		VolumeId: "bd5b12a8-a101-11e7-941e-d77981b584d8",
+		Status:   "available",
+	}
+	var atcs = []*model.SnapshotAttachmentSpec{&SampleSnapshotAttachments[0]}
+
+	mockClient := new(dbtest.Client)
+	mockClient.On("ListSnapshotAttachments", context.NewAdminContext(), req.Id).Return(atcs, nil)
+	db.C = mockClient
+
+	if err := DeleteVolumeSnapshotDBEntry(context.NewAdminContext(), req); err == nil {
+		t.Error("Expected error of deleting attached snapshot, got nil")
+	}
+}
+
+func TestCreateSnapshotAttachmentDBEntry(t *testing.T) {
+	var snap = &SampleSnapshots[0]
+	var req = &model.SnapshotAttachmentSpec{
+		BaseModel:  &model.BaseModel{},
+		SnapshotId: snap.Id,
+		HostInfo:   model.HostInfo{Host: "node-01"},
+	}
+
+	mockClient := new(dbtest.Client)
+	mockClient.On("GetVolumeSnapshot", context.NewAdminContext(), snap.Id).Return(snap, nil)
+	mockClient.On("GetVolume", context.NewAdminContext(), snap.VolumeId).Return(&SampleVolumes[0], nil)
+	mockClient.On("GetPool", context.NewAdminContext(), SampleVolumes[0].PoolId).Return(&SamplePools[0], nil)
+	mockClient.On("CreateSnapshotAttachment", context.NewAdminContext(), req).Return(req, nil)
+	db.C = mockClient
+
+	result, err := CreateSnapshotAttachmentDBEntry(context.NewAdminContext(), req)
+	if err != nil {
+		t.Fatalf("Failed to create snapshot attachment, err is %v\n", err)
+	}
+	if result.Id == "" || result.Status != model.VolumeAttachCreating {
+		t.Errorf("Expected creating snapshot attachment with id, got %+v\n", result)
+	}
+}
+
+func TestCreateSnapshotAttachmentDBEntryWithUnavailableSnapshot(t *testing.T) {
+	var snap = SampleSnapshots[0]
+	snap.Status = model.VolumeSnapCreating
+	var req = &model.SnapshotAttachmentSpec{
+		BaseModel:  &model.BaseModel{},
+		SnapshotId: snap.Id,
+	}
+
+	mockClient := new(dbtest.Client)
+	mockClient.On("GetVolumeSnapshot", context.NewAdminContext(), snap.Id).Return(&snap, nil)
+	db.C = mockClient
+
+	if _, err := CreateSnapshotAttachmentDBEntry(context.NewAdminContext(), req); err == nil {
+		t.Error("Expected error of attaching unavailable snapshot, got nil")
+	}
+}
+
+func TestCreateVolumeGroupDBEntry(t *testing.T) {
+	var pol = SamplePools[0]
+	pol.Extras.Capabilities.VolumeGroup = false
+	var in = &model.VolumeGroupSpec{
+		BaseModel: &model.BaseModel{},
+		Name:      "sample-group-01",
+		Profiles:  []string{SampleProfiles[0].Id},
+		PoolId:    pol.Id,
+	}
+
+	// The pool specified must support volume group.
+	mockClient := new(dbtest.Client)
+	mockClient.On("GetPool", context.NewAdminContext(), pol.Id).Return(&pol, nil)
+	db.C = mockClient
+
+	_, err := CreateVolumeGroupDBEntry(context.NewAdminContext(), in)
+	expectedError := "volumeGroup is not supported by the driver of pool sample-pool-01"
+	if err == nil || err.Error() != expectedError {
+		t.Errorf("Expected %v, got %v\n", expectedError, err)
+	}
+}
+
+func TestUpdateVolumeGroupDBEntry(t *testing.T) {
+	var pol = SamplePools[0]
+	pol.Extras.Capabilities.VolumeGroup = false
+	var vg = SampleVolumeGroups[0]
+	vg.PoolId = pol.Id
+	var in = &model.VolumeGroupSpec{
+		BaseModel:  &model.BaseModel{Id: vg.Id},
+		Name:       vg.Name,
+		AddVolumes: []string{SampleVolumes[0].Id},
+	}
+
+	// The volumes of the group can't be changed if the pool doesn't support
+	// volume group.
+	mockClient := new(dbtest.Client)
+	mockClient.On("GetVolumeGroup", context.NewAdminContext(), vg.Id).Return(&vg, nil)
+	mockClient.On("GetPool", context.NewAdminContext(), pol.Id).Return(&pol, nil)
+	db.C = mockClient
+
+	_, err := UpdateVolumeGroupDBEntry(context.NewAdminContext(), in)
+	expectedError := "volumeGroup is not supported by the driver of pool sample-pool-01"
+	if err == nil || err.Error() != expectedError {
+		t.Errorf("Expected %v, got %v\n", expectedError, err)
+	}
+}
+
+func TestCreateVolumeGroupSnapshotDBEntry(t *testing.T) {
+	var vg = &model.VolumeGroupSpec{
+		BaseModel: &model.BaseModel{
+			Id: "3769855c-a102-11e7-b772-17b880d2f555",
+		},
+		Status: "available",
+	}
+	var vol = &model.VolumeSpec{
+		BaseModel: &model.BaseModel{
+			Id: "bd5b12a8-a101-11e7-941e-d77981b584d8",
+		},
+		Status:  "available",
+		GroupId: vg.Id,
+	}
+	var req = &model.VolumeGroupSnapshotSpec{
+		BaseModel: &model.BaseModel{
+			Id:        "3769855c-a102-11e7-b772-17b880d2f560",
+			CreatedAt: "2018-10-24T16:21:32",
+		},
+		GroupId: vg.Id,
+		Name:    "sample-group-snapshot",
+	}
+	var snp = &model.VolumeSnapshotSpec{
+		BaseModel: &model.BaseModel{
+			CreatedAt: req.CreatedAt,
+		},
+		Name:            req.Name,
+		VolumeId:        vol.Id,
+		Status:          "creating",
+		GroupSnapshotId: req.Id,
+	}
+
+	mockClient := new(dbtest.Client)
+	mockClient.On("GetVolumeGroup", context.NewAdminContext(), vg.Id).Return(vg, nil)
+	mockClient.On("ListVolumesByGroupId", context.NewAdminContext(), vg.Id).Return([]*model.VolumeSpec{vol}, nil)
+	mockClient.On("GetVolume", context.NewAdminContext(), vol.Id).Return(vol, nil)
+	// The id of snapshot is generated, so it is not matched here.
+	mockClient.On("CreateVolumeSnapshot", context.NewAdminContext(), mock.Anything).Return(snp, nil)
+	db.C = mockClient
+
+	result, err := CreateVolumeGroupSnapshotDBEntry(context.NewAdminContext(), req)
+	if err != nil {
+		t.Fatalf("Failed to create volume group snapshot, err is %v\n", err)
+	}
+	if !reflect.DeepEqual(result.Snapshots, []*model.VolumeSnapshotSpec{snp}) {
+		t.Errorf("Expected %v, got %v\n", []*model.VolumeSnapshotSpec{snp}, result.Snapshots)
+	}
+	created := mockClient.Calls[len(mockClient.Calls)-1].Arguments.Get(1).(*model.VolumeSnapshotSpec)
+	if created.GroupSnapshotId != req.Id || created.VolumeId != vol.Id || created.Status != "creating" {
+		t.Errorf("Expected snapshot of volume %s in group snapshot %s, got %+v\n", vol.Id, req.Id, created)
+	}
+}
+
+func TestDeleteVolumeGroupSnapshotDBEntry(t *testing.T) {
+	var vg = &model.VolumeGroupSpec{
+		BaseModel: &model.BaseModel{
+			Id: "3769855c-a102-11e7-b772-17b880d2f555",
+		},
+		Status:         "available",
+		GroupSnapshots: []string{"3769855c-a102-11e7-b772-17b880d2f560"},
+	}
+	var snps = []*model.VolumeSnapshotSpec{
+		{
+			BaseModel:       &model.BaseModel{Id: "3769855c-a102-11e7-b772-17b880d2f561"},
+			Status:          "available",
+			GroupSnapshotId: vg.GroupSnapshots[0],
+		},
+		{
+			BaseModel: &model.BaseModel{Id: "3769855c-a102-11e7-b772-17b880d2f537"},
+			Status:    "creating",
+		},
+	}
+
+	mockClient := new(dbtest.Client)
+	mockClient.On("GetVolumeGroup", context.NewAdminContext(), vg.Id).Return(vg, nil)
+	mockClient.On("ListVolumeSnapshots", context.NewAdminContext()).Return(snps, nil)
+	mockClient.On("UpdateVolumeSnapshot", context.NewAdminContext(), snps[0].Id, snps[0]).Return(snps[0], nil)
+	db.C = mockClient
+
+	result, err := DeleteVolumeGroupSnapshotDBEntry(context.NewAdminContext(), vg.Id, vg.GroupSnapshots[0])
+	if err != nil {
+		t.Fatalf("Failed to delete volume group snapshot, err is %v\n", err)
+	}
+	if len(result) != 1 || result[0].Status != "deleting" {
+		t.Errorf("Expected snapshot %s in deleting status, got %v\n", snps[0].Id, result)
+	}
+
+	if _, err = DeleteVolumeGroupSnapshotDBEntry(context.NewAdminContext(), vg.Id, "unknown"); err == nil {
+		t.Error("Expected error of deleting unknown group snapshot, got nil")
+	}
+}
+
+func TestCreateReplicationDBEntryWithActiveProfile(t *testing.T) {
+	var pVol, sVol = &SampleVolumes[0], SampleVolumes[1]
+	sVol.BaseModel = &model.BaseModel{Id: "c8e2b1f2-c6a4-11e8-a3b8-2f7d3f1b9a10"}
+	var prf = SampleProfiles[0]
+	prf.ReplicationProperties.ReplicaInfos.ReplicaUpdateMode = "Active"
+	var req = &model.ReplicationSpec{
+		BaseModel:         &model.BaseModel{},
+		PrimaryVolumeId:   pVol.Id,
+		SecondaryVolumeId: sVol.Id,
+		ProfileId:         prf.Id,
+	}
+
+	mockClient := new(dbtest.Client)
+	mockClient.On("GetVolume", context.NewAdminContext(), pVol.Id).Return(pVol, nil)
+	mockClient.On("GetVolume", context.NewAdminContext(), sVol.Id).Return(&sVol, nil)
+	mockClient.On("GetPool", context.NewAdminContext(), pVol.PoolId).Return(&SamplePools[0], nil)
+	mockClient.On("GetReplicationByVolumeId", context.NewAdminContext(), pVol.Id).Return(nil, nil)
+	mockClient.On("GetReplicationByVolumeId", context.NewAdminContext(), sVol.Id).Return(nil, nil)
+	mockClient.On("GetProfile", context.NewAdminContext(), prf.Id).Return(&prf, nil)
+	mockClient.On("CreateReplication", context.NewAdminContext(), req).Return(req, nil)
+	db.C = mockClient
+
+	result, err := CreateReplicationDBEntry(context.NewAdminContext(), req)
+	if err != nil {
+		t.Fatalf("Failed to create replication, err is %v\n", err)
+	}
+	if result.ReplicationMode != model.ReplicationModeActive {
+		t.Errorf("Expected replication mode %s, got %s\n", model.ReplicationModeActive, result.ReplicationMode)
+	}
+}
+
+func TestCheckBackupPolicy(t *testing.T) {
+	var full = &model.StoragePoolSpec{BaseModel: &model.BaseModel{}}
+	var incremental = &model.StoragePoolSpec{BaseModel: &model.BaseModel{}}
+	incremental.Extras.Capabilities.IncrementalBackup = true
+
+	for _, tc := range []struct {
+		policy   model.BackupPolicySpec
+		pools    []*model.StoragePoolSpec
+		expected bool
+	}{
+		{model.BackupPolicySpec{BackupDriver: "multi-cloud"}, nil, true},
+		{model.BackupPolicySpec{FullBackupInterval: 1}, nil, true},
+		{model.BackupPolicySpec{FullBackupInterval: 7}, []*model.StoragePoolSpec{full, incremental}, true},
+		// Incremental backups which no driver can take are rejected.
+		{model.BackupPolicySpec{FullBackupInterval: 7}, []*model.StoragePoolSpec{full}, false},
+	} {
+		mockClient := new(dbtest.Client)
+		mockClient.On("ListPools", context.NewAdminContext()).Return(tc.pools, nil)
+		db.C = mockClient
+
+		err := CheckBackupPolicy(context.NewAdminContext(), &tc.policy)
+		if (err == nil) != tc.expected {
+			t.Errorf("Expected valid %v of policy %+v, got %v\n", tc.expected, tc.policy, err)
+		}
+	}
+
+	var policy = model.BackupPolicySpec{}
+	policy.Topology.Path = "/var/backups"
+	if err := CheckBackupPolicy(context.NewAdminContext(), &policy); err == nil {
+		t.Error("Expected error of backup policy with topology path, got nil")
+	}
+}
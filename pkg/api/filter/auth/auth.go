@@ -50,6 +50,8 @@ func Factory() beego.FilterFunc {
 	switch config.CONF.AuthStrategy {
 	case "keystone":
 		auth = NewKeystone()
+	case "oidc":
+		auth = NewOidc()
 	case "noauth":
 		auth = NewNoAuth()
 	default:
@@ -0,0 +1,305 @@
+// Copyright (c) 2018 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+//    Licensed under the Apache License, Version 2.0 (the "License"); you may
+//    not use this file except in compliance with the License. You may obtain
+//    a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//    WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//    License for the specific language governing permissions and limitations
+//    under the License.
+
+// Verification of JSON web tokens signed with the keys of a JSON web key set,
+// only the asymmetric algorithms are supported, see RFC 7515 and RFC 7517.
+
+package auth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	_ "crypto/sha256"
+	_ "crypto/sha512"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/golang/glog"
+)
+
+// minReloadInterval limits how often the key set is loaded again because of
+// the tokens signed with unknown keys.
+const minReloadInterval = time.Minute
+
+var algorithms = map[string]struct {
+	kty  string
+	hash crypto.Hash
+	pss  bool
+	// crv is the curve which the ec keys of the algorithm must be on.
+	crv string
+}{
+	"RS256": {"RSA", crypto.SHA256, false, ""},
+	"RS384": {"RSA", crypto.SHA384, false, ""},
+	"RS512": {"RSA", crypto.SHA512, false, ""},
+	"PS256": {"RSA", crypto.SHA256, true, ""},
+	"PS384": {"RSA", crypto.SHA384, true, ""},
+	"PS512": {"RSA", crypto.SHA512, true, ""},
+	"ES256": {"EC", crypto.SHA256, false, "P-256"},
+	"ES384": {"EC", crypto.SHA384, false, "P-384"},
+	"ES512": {"EC", crypto.SHA512, false, "P-521"},
+}
+
+var curves = map[string]elliptic.Curve{
+	"P-256": elliptic.P256(),
+	"P-384": elliptic.P384(),
+	"P-521": elliptic.P521(),
+}
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+func decodeInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(b), nil
+}
+
+func (k *jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeInt(k.N)
+		if err != nil {
+			return nil, fmt.Errorf("invalid modulus: %v", err)
+		}
+		e, err := decodeInt(k.E)
+		if err != nil || !e.IsInt64() || e.Int64() > 1<<31-1 {
+			return nil, fmt.Errorf("invalid exponent %q", k.E)
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		curve, ok := curves[k.Crv]
+		if !ok {
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := decodeInt(k.X)
+		if err != nil {
+			return nil, fmt.Errorf("invalid x coordinate: %v", err)
+		}
+		y, err := decodeInt(k.Y)
+		if err != nil {
+			return nil, fmt.Errorf("invalid y coordinate: %v", err)
+		}
+		if !curve.IsOnCurve(x, y) {
+			return nil, fmt.Errorf("point is not on curve %s", k.Crv)
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+	}
+}
+
+// NewKeySet returns the key set which is loaded from the file path or the
+// http(s) url.
+func NewKeySet(uri string) *KeySet {
+	return &KeySet{Uri: uri}
+}
+
+// KeySet is the JSON web key set which the tokens are verified with, it's
+// loaded again when a token is signed with an unknown key, so that the keys
+// rotated by the identity provider are picked up. The keys are fetched
+// without holding the lock and swapped in once they are parsed, so that the
+// tokens signed with the known keys are not blocked by a slow reload.
+type KeySet struct {
+	Uri string
+
+	mutex    sync.Mutex
+	keys     map[string]crypto.PublicKey
+	loadedAt time.Time
+	loading  bool
+}
+
+func (ks *KeySet) read() ([]byte, error) {
+	if !strings.HasPrefix(ks.Uri, "http://") && !strings.HasPrefix(ks.Uri, "https://") {
+		return ioutil.ReadFile(ks.Uri)
+	}
+
+	client := &http.Client{Timeout: 10 * time.Second}
+	resp, err := client.Get(ks.Uri)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("get %s failed, status: %s", ks.Uri, resp.Status)
+	}
+	return ioutil.ReadAll(resp.Body)
+}
+
+// Load loads the keys of the set.
+func (ks *KeySet) Load() error {
+	keys, err := ks.fetch()
+	if err != nil {
+		return err
+	}
+	ks.mutex.Lock()
+	defer ks.mutex.Unlock()
+	ks.keys, ks.loadedAt = keys, time.Now()
+	return nil
+}
+
+// fetch reads and parses the keys of the set, it doesn't touch the keys in
+// use so it's called without holding the lock.
+func (ks *KeySet) fetch() (map[string]crypto.PublicKey, error) {
+	data, err := ks.read()
+	if err != nil {
+		return nil, fmt.Errorf("read json web key set failed: %v", err)
+	}
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("parse json web key set failed: %v", err)
+	}
+
+	keys := map[string]crypto.PublicKey{}
+	for _, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		key, err := k.publicKey()
+		if err != nil {
+			log.Warningf("Skip json web key %q: %v", k.Kid, err)
+			continue
+		}
+		keys[k.Kid] = key
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("no signing key is found in %s", ks.Uri)
+	}
+	log.V(4).Infof("Loaded %d json web keys from %s", len(keys), ks.Uri)
+	return keys, nil
+}
+
+// Key returns the key of the key id. The set is reloaded if the key is not
+// known, by only one caller at a time, the others don't wait for it.
+func (ks *KeySet) Key(kid string) (crypto.PublicKey, error) {
+	ks.mutex.Lock()
+	key, ok := ks.keys[kid]
+	reload := !ok && !ks.loading && time.Since(ks.loadedAt) >= minReloadInterval
+	if reload {
+		ks.loading = true
+	}
+	ks.mutex.Unlock()
+
+	if reload {
+		keys, err := ks.fetch()
+		ks.mutex.Lock()
+		ks.loading = false
+		if err == nil {
+			ks.keys, ks.loadedAt = keys, time.Now()
+			key, ok = keys[kid]
+		}
+		ks.mutex.Unlock()
+		if err != nil {
+			return nil, err
+		}
+	}
+	if !ok {
+		return nil, fmt.Errorf("signing key %q is not found", kid)
+	}
+	return key, nil
+}
+
+func decodeSegment(seg string, v interface{}) error {
+	b, err := base64.RawURLEncoding.DecodeString(seg)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
+
+// verifyToken verifies the signature of the token with the key set and
+// returns the claims of it, the claims themselves are not checked.
+func verifyToken(token string, ks *KeySet) (map[string]interface{}, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("token is not a signed jwt")
+	}
+	var header struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, fmt.Errorf("invalid header: %v", err)
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("invalid signature: %v", err)
+	}
+
+	alg, ok := algorithms[header.Alg]
+	if !ok {
+		return nil, fmt.Errorf("unsupported algorithm %q", header.Alg)
+	}
+	key, err := ks.Key(header.Kid)
+	if err != nil {
+		return nil, err
+	}
+	h := alg.hash.New()
+	h.Write([]byte(parts[0] + "." + parts[1]))
+	digest := h.Sum(nil)
+
+	switch pub := key.(type) {
+	case *rsa.PublicKey:
+		if alg.kty != "RSA" {
+			return nil, fmt.Errorf("algorithm %s doesn't match rsa key %q", header.Alg, header.Kid)
+		}
+		if alg.pss {
+			err = rsa.VerifyPSS(pub, alg.hash, digest, sig, nil)
+		} else {
+			err = rsa.VerifyPKCS1v15(pub, alg.hash, digest, sig)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("signature is invalid")
+		}
+	case *ecdsa.PublicKey:
+		if alg.kty != "EC" || pub.Curve.Params().Name != alg.crv {
+			return nil, fmt.Errorf("algorithm %s doesn't match ec key %q", header.Alg, header.Kid)
+		}
+		size := (pub.Curve.Params().BitSize + 7) / 8
+		if len(sig) != 2*size {
+			return nil, fmt.Errorf("signature is invalid")
+		}
+		r := new(big.Int).SetBytes(sig[:size])
+		s := new(big.Int).SetBytes(sig[size:])
+		if !ecdsa.Verify(pub, digest, r, s) {
+			return nil, fmt.Errorf("signature is invalid")
+		}
+	}
+
+	var claims map[string]interface{}
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return nil, fmt.Errorf("invalid claims: %v", err)
+	}
+	return claims, nil
+}
@@ -0,0 +1,192 @@
+// Copyright (c) 2018 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+//    Licensed under the Apache License, Version 2.0 (the "License"); you may
+//    not use this file except in compliance with the License. You may obtain
+//    a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//    WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//    License for the specific language governing permissions and limitations
+//    under the License.
+
+// OpenID Connect authentication middleware, the JWTs issued by the configured
+// issuer are accepted as bearer tokens.
+
+package auth
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	bctx "github.com/astaxie/beego/context"
+	log "github.com/golang/glog"
+	"github.com/opensds/opensds/pkg/context"
+	"github.com/opensds/opensds/pkg/model"
+	"github.com/opensds/opensds/pkg/utils/config"
+	"github.com/opensds/opensds/pkg/utils/constants"
+)
+
+// clockSkew is the difference of clocks tolerated when checking the times
+// claimed by the tokens.
+const clockSkew = time.Minute
+
+func NewOidc() AuthBase {
+	o := &Oidc{}
+	if err := o.SetUp(); err != nil {
+		// If auth set up failed, raise panic.
+		panic(err)
+	}
+	return o
+}
+
+type Oidc struct {
+	conf config.OidcAuthToken
+	keys *KeySet
+}
+
+func (o *Oidc) SetUp() error {
+	o.conf = config.CONF.OidcAuthToken
+	if o.conf.Issuer == "" || o.conf.JwksUri == "" {
+		return fmt.Errorf("issuer and jwks_uri of oidc_authtoken must be configured")
+	}
+	o.keys = NewKeySet(o.conf.JwksUri)
+	if err := o.keys.Load(); err != nil {
+		log.Error("When load json web key set:", err)
+		return err
+	}
+	return nil
+}
+
+// lookupClaim returns the claim of the name, the name of nested claim is the
+// names of all levels joined by dots.
+func lookupClaim(claims map[string]interface{}, name string) (interface{}, bool) {
+	var v interface{} = claims
+	for _, key := range strings.Split(name, ".") {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if v, ok = m[key]; !ok {
+			return nil, false
+		}
+	}
+	return v, true
+}
+
+func checkTime(claims map[string]interface{}) error {
+	exp, ok := claims["exp"].(float64)
+	if !ok {
+		return fmt.Errorf("expire time not found in token")
+	}
+	t := time.Now()
+	if expiresAt := time.Unix(int64(exp), 0); t.After(expiresAt.Add(clockSkew)) {
+		return fmt.Errorf("token has expired, expire time %v", expiresAt)
+	}
+	if nbf, ok := claims["nbf"].(float64); ok {
+		if notBefore := time.Unix(int64(nbf), 0); t.Before(notBefore.Add(-clockSkew)) {
+			return fmt.Errorf("token is not valid before %v", notBefore)
+		}
+	}
+	return nil
+}
+
+func checkAudience(claims map[string]interface{}, audience string) error {
+	switch aud := claims["aud"].(type) {
+	case string:
+		if aud == audience {
+			return nil
+		}
+	case []interface{}:
+		for _, a := range aud {
+			if a == audience {
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("token is not issued for audience %s", audience)
+}
+
+// validateClaims checks the claims of the verified token and maps them to
+// the parameters of policy context.
+func (o *Oidc) validateClaims(claims map[string]interface{}) (map[string]interface{}, error) {
+	if iss, _ := claims["iss"].(string); iss != o.conf.Issuer {
+		return nil, fmt.Errorf("token is issued by %q instead of %q", iss, o.conf.Issuer)
+	}
+	if err := checkTime(claims); err != nil {
+		return nil, err
+	}
+	if o.conf.Audience != "" {
+		if err := checkAudience(claims, o.conf.Audience); err != nil {
+			return nil, err
+		}
+	}
+
+	tenantId, _ := lookupClaim(claims, o.conf.TenantIdClaim)
+	if s, ok := tenantId.(string); !ok || s == "" {
+		return nil, fmt.Errorf("tenant id claim %s not found in token", o.conf.TenantIdClaim)
+	}
+	userId, _ := lookupClaim(claims, o.conf.UserIdClaim)
+	if s, ok := userId.(string); !ok || s == "" {
+		return nil, fmt.Errorf("user id claim %s not found in token", o.conf.UserIdClaim)
+	}
+
+	// The roles are either a list or a string separated by spaces.
+	var roleNames []string
+	roles, _ := lookupClaim(claims, o.conf.RolesClaim)
+	switch roles := roles.(type) {
+	case []interface{}:
+		for _, role := range roles {
+			if name, ok := role.(string); ok {
+				roleNames = append(roleNames, name)
+			}
+		}
+	case string:
+		roleNames = strings.Fields(roles)
+	}
+
+	return map[string]interface{}{
+		"TenantId":       tenantId,
+		"Roles":          roleNames,
+		"UserId":         userId,
+		"IsAdminProject": tenantId == o.conf.AdminTenantId,
+	}, nil
+}
+
+func (o *Oidc) validateToken(ctx *bctx.Context, token string) error {
+	if token == "" {
+		return model.HttpError(ctx, http.StatusUnauthorized, "token not found in header")
+	}
+
+	claims, err := verifyToken(token, o.keys)
+	if err != nil {
+		return model.HttpError(ctx, http.StatusUnauthorized, "verify token failed,%v", err)
+	}
+	param, err := o.validateClaims(claims)
+	if err != nil {
+		return model.HttpError(ctx, http.StatusUnauthorized, "validate token failed,%v", err)
+	}
+	log.V(8).Infof("token claims: %v", claims)
+
+	context.UpdateContext(ctx, param)
+	return nil
+}
+
+// bearerToken returns the token of the authorization header, the token in
+// the header of keystone is accepted as well.
+func bearerToken(ctx *bctx.Context) string {
+	authz := strings.TrimSpace(ctx.Input.Header(constants.AuthorizationHeader))
+	if len(authz) > len(constants.BearerPrefix) &&
+		strings.EqualFold(authz[:len(constants.BearerPrefix)], constants.BearerPrefix) {
+		return strings.TrimSpace(authz[len(constants.BearerPrefix):])
+	}
+	return strings.TrimSpace(ctx.Input.Header(constants.AuthTokenHeader))
+}
+
+func (o *Oidc) Filter(ctx *bctx.Context) {
+	o.validateToken(ctx, bearerToken(ctx))
+}
@@ -0,0 +1,318 @@
+// Copyright (c) 2018 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+//    Licensed under the Apache License, Version 2.0 (the "License"); you may
+//    not use this file except in compliance with the License. You may obtain
+//    a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//    WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//    License for the specific language governing permissions and limitations
+//    under the License.
+
+package auth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	bctx "github.com/astaxie/beego/context"
+	c "github.com/opensds/opensds/pkg/context"
+	"github.com/opensds/opensds/pkg/utils/config"
+	"github.com/opensds/opensds/pkg/utils/constants"
+)
+
+const (
+	testIssuer   = "https://idp.example.com/realms/opensds"
+	testAudience = "opensds"
+	testTenantId = "3769855c-a102-11e7-b772-17b880d2f537"
+)
+
+var (
+	rsaKey, _   = rsa.GenerateKey(rand.Reader, 2048)
+	ecKey, _    = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	otherKey, _ = rsa.GenerateKey(rand.Reader, 2048)
+)
+
+func encodeInt(i *big.Int) string {
+	return base64.RawURLEncoding.EncodeToString(i.Bytes())
+}
+
+func encodeSegment(v interface{}) string {
+	b, _ := json.Marshal(v)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// sign returns the token of the claims signed with the key of the key id.
+func sign(t *testing.T, alg, kid string, key crypto.Signer, claims map[string]interface{}) string {
+	data := encodeSegment(map[string]string{"alg": alg, "kid": kid, "typ": "JWT"}) +
+		"." + encodeSegment(claims)
+	hash := algorithms[alg].hash
+	h := hash.New()
+	h.Write([]byte(data))
+	digest := h.Sum(nil)
+
+	var sig []byte
+	switch key := key.(type) {
+	case *rsa.PrivateKey:
+		var err error
+		if sig, err = rsa.SignPKCS1v15(rand.Reader, key, hash, digest); err != nil {
+			t.Fatal(err)
+		}
+	case *ecdsa.PrivateKey:
+		r, s, err := ecdsa.Sign(rand.Reader, key, digest)
+		if err != nil {
+			t.Fatal(err)
+		}
+		size := (key.Curve.Params().BitSize + 7) / 8
+		sig = make([]byte, 2*size)
+		r.FillBytes(sig[:size])
+		s.FillBytes(sig[size:])
+	}
+	return data + "." + base64.RawURLEncoding.EncodeToString(sig)
+}
+
+func writeKeySet(t *testing.T) string {
+	set := map[string]interface{}{
+		"keys": []map[string]string{
+			{
+				"kty": "RSA", "kid": "rsa", "use": "sig",
+				"n": encodeInt(rsaKey.N), "e": encodeInt(big.NewInt(int64(rsaKey.E))),
+			},
+			{
+				"kty": "EC", "kid": "ec", "crv": "P-256",
+				"x": encodeInt(ecKey.X), "y": encodeInt(ecKey.Y),
+			},
+			// The encryption keys are skipped.
+			{
+				"kty": "RSA", "kid": "enc", "use": "enc",
+				"n": encodeInt(otherKey.N), "e": encodeInt(big.NewInt(int64(otherKey.E))),
+			},
+		},
+	}
+	f, err := ioutil.TempFile("", "jwks-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if err := json.NewEncoder(f).Encode(set); err != nil {
+		t.Fatal(err)
+	}
+	return f.Name()
+}
+
+func newTestOidc(t *testing.T) (*Oidc, func()) {
+	path := writeKeySet(t)
+	origin := config.CONF.OidcAuthToken
+	config.CONF.OidcAuthToken = config.OidcAuthToken{
+		Issuer:        testIssuer,
+		Audience:      testAudience,
+		JwksUri:       path,
+		TenantIdClaim: "tenant_id",
+		UserIdClaim:   "sub",
+		RolesClaim:    "realm_access.roles",
+		AdminTenantId: constants.DefaultTenantId,
+	}
+	o := &Oidc{}
+	if err := o.SetUp(); err != nil {
+		t.Fatal("Set up oidc failed:", err)
+	}
+	return o, func() {
+		config.CONF.OidcAuthToken = origin
+		os.Remove(path)
+	}
+}
+
+func testClaims() map[string]interface{} {
+	return map[string]interface{}{
+		"iss":       testIssuer,
+		"aud":       []string{testAudience, "account"},
+		"sub":       "user001",
+		"exp":       time.Now().Add(time.Hour).Unix(),
+		"tenant_id": testTenantId,
+		"realm_access": map[string]interface{}{
+			"roles": []string{"member", "reader"},
+		},
+	}
+}
+
+// filter returns the context and the response status of the request which
+// carries the header.
+func filter(o *Oidc, header, value string) (*bctx.Context, int) {
+	ctx := bctx.NewContext()
+	req := httptest.NewRequest(http.MethodGet, "/v1beta/"+testTenantId+"/block/volumes", nil)
+	if header != "" {
+		req.Header.Set(header, value)
+	}
+	rec := httptest.NewRecorder()
+	ctx.Reset(rec, req)
+	o.Filter(ctx)
+	return ctx, rec.Code
+}
+
+func TestOidcFilter(t *testing.T) {
+	o, clean := newTestOidc(t)
+	defer clean()
+
+	for _, token := range []string{
+		sign(t, "RS256", "rsa", rsaKey, testClaims()),
+		sign(t, "ES256", "ec", ecKey, testClaims()),
+	} {
+		ctx, code := filter(o, constants.AuthorizationHeader, "Bearer "+token)
+		if code != http.StatusOK {
+			t.Errorf("Expected token to be accepted, got status %d", code)
+			continue
+		}
+		expected := &c.Context{
+			TenantId: testTenantId,
+			UserId:   "user001",
+			Roles:    []string{"member", "reader"},
+		}
+		if got := c.GetContext(ctx); !reflect.DeepEqual(got, expected) {
+			t.Errorf("Expected context %+v, got %+v", expected, got)
+		}
+	}
+
+	// The token in the header of keystone is accepted as well.
+	if _, code := filter(o, constants.AuthTokenHeader, sign(t, "RS256", "rsa", rsaKey, testClaims())); code != http.StatusOK {
+		t.Errorf("Expected token in %s to be accepted, got status %d", constants.AuthTokenHeader, code)
+	}
+}
+
+func TestOidcFilterAdmin(t *testing.T) {
+	o, clean := newTestOidc(t)
+	defer clean()
+
+	claims := testClaims()
+	claims["tenant_id"] = constants.DefaultTenantId
+	claims["realm_access"] = map[string]interface{}{"roles": []string{"admin"}}
+	ctx, _ := filter(o, constants.AuthorizationHeader, "Bearer "+sign(t, "RS256", "rsa", rsaKey, claims))
+	if got := c.GetContext(ctx); !got.IsAdminProject || !reflect.DeepEqual(got.Roles, []string{"admin"}) {
+		t.Errorf("Expected admin of admin project, got %+v", got)
+	}
+}
+
+func TestOidcFilterRejected(t *testing.T) {
+	o, clean := newTestOidc(t)
+	defer clean()
+
+	with := func(key string, val interface{}) map[string]interface{} {
+		claims := testClaims()
+		if val == nil {
+			delete(claims, key)
+		} else {
+			claims[key] = val
+		}
+		return claims
+	}
+	valid := sign(t, "RS256", "rsa", rsaKey, testClaims())
+	for desc, token := range map[string]string{
+		"missing token":      "",
+		"malformed token":    "not-a-jwt",
+		"tampered claims":    valid[:len(valid)-8] + "AAAAAAAA",
+		"unsigned token":     encodeSegment(map[string]string{"alg": "none"}) + "." + encodeSegment(testClaims()) + ".",
+		"unknown key":        sign(t, "RS256", "unknown", otherKey, testClaims()),
+		"encryption key":     sign(t, "RS256", "enc", otherKey, testClaims()),
+		"wrong key":          sign(t, "RS256", "rsa", otherKey, testClaims()),
+		"alg of other key":   sign(t, "ES256", "rsa", ecKey, testClaims()),
+		"other issuer":       sign(t, "RS256", "rsa", rsaKey, with("iss", "https://evil.example.com")),
+		"other audience":     sign(t, "RS256", "rsa", rsaKey, with("aud", "account")),
+		"expired":            sign(t, "RS256", "rsa", rsaKey, with("exp", time.Now().Add(-time.Hour).Unix())),
+		"no expire time":     sign(t, "RS256", "rsa", rsaKey, with("exp", nil)),
+		"not valid yet":      sign(t, "RS256", "rsa", rsaKey, with("nbf", time.Now().Add(time.Hour).Unix())),
+		"no tenant id claim": sign(t, "RS256", "rsa", rsaKey, with("tenant_id", nil)),
+		"no user id claim":   sign(t, "RS256", "rsa", rsaKey, with("sub", nil)),
+	} {
+		if _, code := filter(o, constants.AuthorizationHeader, "Bearer "+token); code != http.StatusUnauthorized {
+			t.Errorf("Expected %s to be rejected, got status %d", desc, code)
+		}
+	}
+}
+
+func TestOidcSetUp(t *testing.T) {
+	origin := config.CONF.OidcAuthToken
+	defer func() { config.CONF.OidcAuthToken = origin }()
+
+	config.CONF.OidcAuthToken = config.OidcAuthToken{Issuer: testIssuer}
+	if err := (&Oidc{}).SetUp(); err == nil {
+		t.Error("Expected error of missing jwks_uri, got nil")
+	}
+	config.CONF.OidcAuthToken = config.OidcAuthToken{Issuer: testIssuer, JwksUri: "testdata/not-exist.json"}
+	if err := (&Oidc{}).SetUp(); err == nil {
+		t.Error("Expected error of missing key set, got nil")
+	}
+}
+
+func TestKeySetReload(t *testing.T) {
+	path := writeKeySet(t)
+	defer os.Remove(path)
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The reloads are held until they are released, the first load isn't.
+	release := make(chan struct{})
+	requests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests++; requests > 1 {
+			<-release
+		}
+		w.Write(data)
+	}))
+	defer srv.Close()
+
+	ks := NewKeySet(srv.URL)
+	if err := ks.Load(); err != nil {
+		t.Fatal("Load key set failed:", err)
+	}
+	ks.loadedAt = time.Now().Add(-minReloadInterval)
+
+	done := make(chan error)
+	go func() {
+		_, err := ks.Key("unknown")
+		done <- err
+	}()
+	// Wait for the reload to be started.
+	for {
+		ks.mutex.Lock()
+		loading := ks.loading
+		ks.mutex.Unlock()
+		if loading {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	// The known keys are served while the set is being reloaded, and the
+	// other unknown keys don't start another reload.
+	if _, err := ks.Key("rsa"); err != nil {
+		t.Error("Expected the known key during reload, got:", err)
+	}
+	if _, err := ks.Key("other"); err == nil {
+		t.Error("Expected error of unknown key during reload, got nil")
+	}
+
+	close(release)
+	if err := <-done; err == nil {
+		t.Error("Expected error of unknown key after reload, got nil")
+	}
+	if requests != 2 {
+		t.Errorf("Expected 2 requests of key set, got %d", requests)
+	}
+}
@@ -186,7 +186,7 @@ func (r *Rules) String() string {
 }
 
 func Authorize(httpCtx *bctx.Context, action string) bool {
-	if config.CONF.AuthStrategy != "keystone" {
+	if config.CONF.AuthStrategy != "keystone" && config.CONF.AuthStrategy != "oidc" {
 		return true
 	}
 	ctx := context.GetContext(httpCtx)
@@ -49,8 +49,15 @@ func (p *ProfilePortal) CreateProfile() {
 		return
 	}
 
+	ctx := c.GetContext(p.Ctx)
+	if err := CheckBackupPolicy(ctx, &profile.DataProtectionProperties.BackupPolicy); err != nil {
+		errMsg := fmt.Sprintf("create profile failed: %v", err)
+		p.ErrorHandle(model.ErrorBadRequest, errMsg)
+		return
+	}
+
 	// Call db api module to handle create profile request.
-	result, err := db.C.CreateProfile(c.GetContext(p.Ctx), &profile)
+	result, err := db.C.CreateProfile(ctx, &profile)
 	if err != nil {
 		errMsg := fmt.Sprintf("create profile failed: %v", err)
 		p.ErrorHandle(model.ErrorBadRequest, errMsg)
@@ -140,7 +147,14 @@ func (p *ProfilePortal) UpdateProfile() {
 		return
 	}
 
-	result, err := db.C.UpdateProfile(c.GetContext(p.Ctx), id, &profile)
+	ctx := c.GetContext(p.Ctx)
+	if err := CheckBackupPolicy(ctx, &profile.DataProtectionProperties.BackupPolicy); err != nil {
+		errMsg := fmt.Sprintf("update profiles failed: %v", err)
+		p.ErrorHandle(model.ErrorBadRequest, errMsg)
+		return
+	}
+
+	result, err := db.C.UpdateProfile(ctx, id, &profile)
 	if err != nil {
 		errMsg := fmt.Sprintf("update profiles failed: %v", err)
 		p.ErrorHandle(model.ErrorInternalServer, errMsg)
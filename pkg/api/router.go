@@ -89,6 +89,11 @@ func Run(apiServerCfg cfg.OsdsApiServer) {
 				beego.NSRouter("/volumes/:volumeId", NewVolumePortal(), "get:GetVolume;put:UpdateVolume;delete:DeleteVolume"),
 				// Extend Volume
 				beego.NSRouter("/volumes/:volumeId/resize", NewVolumePortal(), "post:ExtendVolume"),
+				// Changes the limits of I/O of volume, even if it's in use.
+				beego.NSRouter("/volumes/:volumeId/qos", NewVolumePortal(), "put:UpdateVolumeQos"),
+				// Shows the next run and the last result of the backup policy of volume.
+				beego.NSRouter("/volumes/:volumeId/backupSchedule", &BackupSchedulePortal{}, "get:GetBackupSchedule"),
+				beego.NSRouter("/backupSchedules", &BackupSchedulePortal{}, "get:ListBackupSchedules"),
 
 				// Creates, shows, lists, unpdates and deletes attachment.
 				beego.NSRouter("/attachments", NewVolumeAttachmentPortal(), "post:CreateVolumeAttachment;get:ListVolumeAttachments"),
@@ -98,6 +103,12 @@ func Run(apiServerCfg cfg.OsdsApiServer) {
 				// Creates, shows, lists, unpdates and deletes snapshot.
 				beego.NSRouter("/snapshots", NewVolumeSnapshotPortal(), "post:CreateVolumeSnapshot;get:ListVolumeSnapshots"),
 				beego.NSRouter("/snapshots/:snapshotId", NewVolumeSnapshotPortal(), "get:GetVolumeSnapshot;put:UpdateVolumeSnapshot;delete:DeleteVolumeSnapshot"),
+				// Checks the backup of snapshot in the cloud without restoring it.
+				beego.NSRouter("/snapshots/:snapshotId/verify", NewVolumeSnapshotPortal(), "post:VerifyVolumeSnapshot"),
+				// Creates, shows, lists and deletes snapshot attachment, through which the snapshot is
+				// exposed read-only to a host.
+				beego.NSRouter("/snapshotAttachments", NewSnapshotAttachmentPortal(), "post:CreateSnapshotAttachment;get:ListSnapshotAttachments"),
+				beego.NSRouter("/snapshotAttachments/:attachmentId", NewSnapshotAttachmentPortal(), "get:GetSnapshotAttachment;delete:DeleteSnapshotAttachment"),
 
 				// Creates, shows, lists, unpdates and deletes replication.
 				beego.NSRouter("/replications", NewReplicationPortal(), "post:CreateReplication;get:ListReplications"),
@@ -109,6 +120,8 @@ func Run(apiServerCfg cfg.OsdsApiServer) {
 				// Volume group contains a list of volumes that are used in the same application.
 				beego.NSRouter("/volumeGroups", NewVolumeGroupPortal(), "post:CreateVolumeGroup;get:ListVolumeGroups"),
 				beego.NSRouter("/volumeGroups/:groupId", NewVolumeGroupPortal(), "put:UpdateVolumeGroup;get:GetVolumeGroup;delete:DeleteVolumeGroup"),
+				beego.NSRouter("/volumeGroups/:groupId/snapshots", NewVolumeGroupPortal(), "post:CreateVolumeGroupSnapshot"),
+				beego.NSRouter("/volumeGroups/:groupId/snapshots/:groupSnapshotId", NewVolumeGroupPortal(), "delete:DeleteVolumeGroupSnapshot"),
 			),
 		)
 	pattern := fmt.Sprintf("/%s/*", constants.APIVersion)
@@ -178,6 +178,9 @@ func (v *VolumePortal) UpdateVolume() {
 	}
 
 	volume.Id = id
+	// The qos of volume is only changed through UpdateVolumeQos, which
+	// enforces it.
+	volume.Qos = nil
 	result, err := db.C.UpdateVolume(c.GetContext(v.Ctx), &volume)
 	if err != nil {
 		errMsg := fmt.Sprintf("update volume failed: %s", err.Error())
@@ -243,6 +246,56 @@ func (v *VolumePortal) ExtendVolume() {
 	return
 }
 
+// UpdateVolumeQos ...
+func (v *VolumePortal) UpdateVolumeQos() {
+	if !policy.Authorize(v.Ctx, "volume:update_qos") {
+		return
+	}
+	ctx := c.GetContext(v.Ctx)
+	var qos = model.QosSpec{}
+
+	if err := json.NewDecoder(v.Ctx.Request.Body).Decode(&qos); err != nil {
+		errMsg := fmt.Sprintf("parse volume qos request body failed: %s", err.Error())
+		v.ErrorHandle(model.ErrorBadRequest, errMsg)
+		return
+	}
+
+	id := v.Ctx.Input.Param(":volumeId")
+	result, err := UpdateVolumeQosDBEntry(ctx, id, &qos)
+	if err != nil {
+		errMsg := fmt.Sprintf("update volume qos failed: %s", err.Error())
+		v.ErrorHandle(model.ErrorBadRequest, errMsg)
+		return
+	}
+
+	// Marshal the result.
+	body, _ := json.Marshal(result)
+	v.SuccessHandle(StatusAccepted, body)
+
+	// NOTE:The real volume qos update process.
+	// Volume qos update request is sent to the Dock, and the hosts which the
+	// volume is attached to if necessary. The new qos is updated in database
+	// after it's enforced.
+	if err = v.CtrClient.Connect(CONF.OsdsLet.ApiEndpoint); err != nil {
+		log.Error("when connecting controller client:", err)
+		return
+	}
+	defer v.CtrClient.Close()
+
+	opt := &pb.UpdateVolumeQosOpts{
+		Id:       id,
+		Qos:      &pb.Qos{MaxIOPS: qos.MaxIOPS, MaxBWS: qos.MaxBWS},
+		Metadata: result.Metadata,
+		Context:  ctx.ToJson(),
+	}
+	if _, err = v.CtrClient.UpdateVolumeQos(context.Background(), opt); err != nil {
+		log.Error("update volume qos failed in controller service:", err)
+		return
+	}
+
+	return
+}
+
 func (v *VolumePortal) DeleteVolume() {
 	if !policy.Authorize(v.Ctx, "volume:delete") {
 		return
@@ -351,8 +404,13 @@ func (v *VolumeAttachmentPortal) CreateVolumeAttachment() {
 			Host:      result.Host,
 			Initiator: result.Initiator,
 		},
-		Metadata: result.Metadata,
-		Context:  ctx.ToJson(),
+		MultiPath:    result.MultiPath,
+		FsType:       result.FsType,
+		Mountpoint:   result.Mountpoint,
+		MountOptions: result.MountOptions,
+		Transport:    result.Transport,
+		Metadata:     result.Metadata,
+		Context:      ctx.ToJson(),
 	}
 	if _, err = v.CtrClient.CreateVolumeAttachment(context.Background(), opt); err != nil {
 		log.Error("create volume attachment failed in controller service:", err)
@@ -676,3 +734,229 @@ func (v *VolumeSnapshotPortal) DeleteVolumeSnapshot() {
 	v.Ctx.Output.SetStatus(StatusAccepted)
 	return
 }
+
+// VerifyVolumeSnapshot checks the backup of the volume snapshot in the
+// cloud without restoring it.
+func (v *VolumeSnapshotPortal) VerifyVolumeSnapshot() {
+	if !policy.Authorize(v.Ctx, "snapshot:verify") {
+		return
+	}
+	ctx := c.GetContext(v.Ctx)
+	id := v.Ctx.Input.Param(":snapshotId")
+
+	snapshot, err := db.C.GetVolumeSnapshot(ctx, id)
+	if err != nil {
+		errMsg := fmt.Sprintf("volume snapshot %s not found: %s", id, err.Error())
+		v.ErrorHandle(model.ErrorNotFound, errMsg)
+		return
+	}
+
+	// NOTE:It will update the the status of the volume snapshot to "verifying"
+	// and return the result immediately.
+	result, err := VerifyVolumeSnapshotDBEntry(ctx, snapshot)
+	if err != nil {
+		errMsg := fmt.Sprintf("verify volume snapshot failed: %s", err.Error())
+		v.ErrorHandle(model.ErrorBadRequest, errMsg)
+		return
+	}
+
+	// Marshal the result.
+	body, _ := json.Marshal(result)
+	v.SuccessHandle(StatusAccepted, body)
+
+	// NOTE:The real volume snapshot verification process.
+	// Volume snapshot verification request is sent to the Dock. The status of
+	// volume snapshot is updated to "available" if its backup is intact, or
+	// "errorVerifying" otherwise.
+	if err := v.CtrClient.Connect(CONF.OsdsLet.ApiEndpoint); err != nil {
+		log.Error("when connecting controller client:", err)
+		return
+	}
+	defer v.CtrClient.Close()
+
+	opt := &pb.VerifyVolumeSnapshotOpts{
+		Id:       result.Id,
+		VolumeId: result.VolumeId,
+		Metadata: result.Metadata,
+		Context:  ctx.ToJson(),
+	}
+	if _, err = v.CtrClient.VerifyVolumeSnapshot(context.Background(), opt); err != nil {
+		log.Error("verify volume snapshot failed in controller service:", err)
+		return
+	}
+
+	return
+}
+
+func NewSnapshotAttachmentPortal() *SnapshotAttachmentPortal {
+	return &SnapshotAttachmentPortal{
+		CtrClient: client.NewClient(),
+	}
+}
+
+// SnapshotAttachmentPortal exposes volume snapshots read-only to the hosts,
+// so that the point-in-time data can be backed up or inspected without
+// creating a volume from the snapshot.
+type SnapshotAttachmentPortal struct {
+	BasePortal
+
+	CtrClient client.Client
+}
+
+func (v *SnapshotAttachmentPortal) CreateSnapshotAttachment() {
+	if !policy.Authorize(v.Ctx, "snapshot:create_attachment") {
+		return
+	}
+	ctx := c.GetContext(v.Ctx)
+	var attachment = model.SnapshotAttachmentSpec{
+		BaseModel: &model.BaseModel{},
+	}
+
+	if err := json.NewDecoder(v.Ctx.Request.Body).Decode(&attachment); err != nil {
+		errMsg := fmt.Sprintf("parse snapshot attachment request body failed: %s", err.Error())
+		v.ErrorHandle(model.ErrorBadRequest, errMsg)
+		return
+	}
+
+	// NOTE:It will create a snapshot attachment entry into the database and initialize its status
+	// as "creating". It will not wait for the real snapshot attachment creation to complete
+	// and will return result immediately.
+	result, err := CreateSnapshotAttachmentDBEntry(ctx, &attachment)
+	if err != nil {
+		errMsg := fmt.Sprintf("create snapshot attachment failed: %s", err.Error())
+		v.ErrorHandle(model.ErrorBadRequest, errMsg)
+		return
+	}
+
+	// Marshal the result.
+	body, _ := json.Marshal(result)
+	v.SuccessHandle(StatusAccepted, body)
+
+	// NOTE:The real snapshot attachment creation process.
+	// Snapshot attachment creation request is sent to the Dock. Dock will update snapshot attachment status to "available"
+	// after snapshot attachment creation is completed.
+	if err := v.CtrClient.Connect(CONF.OsdsLet.ApiEndpoint); err != nil {
+		log.Error("when connecting controller client:", err)
+		return
+	}
+	defer v.CtrClient.Close()
+
+	opt := &pb.CreateSnapshotAttachmentOpts{
+		Id:         result.Id,
+		SnapshotId: result.SnapshotId,
+		HostInfo: &pb.HostInfo{
+			Platform:  result.Platform,
+			OsType:    result.OsType,
+			Ip:        result.Ip,
+			Host:      result.Host,
+			Initiator: result.Initiator,
+		},
+		Metadata: result.Metadata,
+		Context:  ctx.ToJson(),
+	}
+	if _, err = v.CtrClient.CreateSnapshotAttachment(context.Background(), opt); err != nil {
+		log.Error("create snapshot attachment failed in controller service:", err)
+		return
+	}
+
+	return
+}
+
+func (v *SnapshotAttachmentPortal) ListSnapshotAttachments() {
+	if !policy.Authorize(v.Ctx, "snapshot:list_attachments") {
+		return
+	}
+
+	m, err := v.GetParameters()
+	if err != nil {
+		errMsg := fmt.Sprintf("list snapshot attachments failed: %s", err.Error())
+		v.ErrorHandle(model.ErrorBadRequest, errMsg)
+		return
+	}
+	var snapshotId string
+	if len(m["snapshotId"]) > 0 {
+		snapshotId = m["snapshotId"][0]
+	}
+
+	result, err := db.C.ListSnapshotAttachments(c.GetContext(v.Ctx), snapshotId)
+	if err != nil {
+		errMsg := fmt.Sprintf("list snapshot attachments failed: %s", err.Error())
+		v.ErrorHandle(model.ErrorInternalServer, errMsg)
+		return
+	}
+
+	// Marshal the result.
+	body, _ := json.Marshal(result)
+	v.SuccessHandle(StatusOK, body)
+
+	return
+}
+
+func (v *SnapshotAttachmentPortal) GetSnapshotAttachment() {
+	if !policy.Authorize(v.Ctx, "snapshot:get_attachment") {
+		return
+	}
+	id := v.Ctx.Input.Param(":attachmentId")
+
+	result, err := db.C.GetSnapshotAttachment(c.GetContext(v.Ctx), id)
+	if err != nil {
+		errMsg := fmt.Sprintf("snapshot attachment %s not found: %s", id, err.Error())
+		v.ErrorHandle(model.ErrorNotFound, errMsg)
+		return
+	}
+
+	// Marshal the result.
+	body, _ := json.Marshal(result)
+	v.SuccessHandle(StatusOK, body)
+
+	return
+}
+
+func (v *SnapshotAttachmentPortal) DeleteSnapshotAttachment() {
+	if !policy.Authorize(v.Ctx, "snapshot:delete_attachment") {
+		return
+	}
+
+	ctx := c.GetContext(v.Ctx)
+
+	id := v.Ctx.Input.Param(":attachmentId")
+	attachment, err := db.C.GetSnapshotAttachment(ctx, id)
+	if err != nil {
+		errMsg := fmt.Sprintf("snapshot attachment %s not found: %s", id, err.Error())
+		v.ErrorHandle(model.ErrorNotFound, errMsg)
+		return
+	}
+	// NOTE:It will not wait for the real snapshot attachment deletion to complete
+	// and will return ok immediately.
+	v.SuccessHandle(StatusAccepted, nil)
+
+	// NOTE:The real snapshot attachment deletion process.
+	// Snapshot attachment deletion request is sent to the Dock. Dock will delete snapshot attachment from database
+	// or update its status to "errorDeleting" if snapshot connection termination failed.
+	if err := v.CtrClient.Connect(CONF.OsdsLet.ApiEndpoint); err != nil {
+		log.Error("when connecting controller client:", err)
+		return
+	}
+	defer v.CtrClient.Close()
+
+	opt := &pb.DeleteSnapshotAttachmentOpts{
+		Id:             attachment.Id,
+		SnapshotId:     attachment.SnapshotId,
+		AccessProtocol: attachment.AccessProtocol,
+		HostInfo: &pb.HostInfo{
+			Platform:  attachment.Platform,
+			OsType:    attachment.OsType,
+			Ip:        attachment.Ip,
+			Host:      attachment.Host,
+			Initiator: attachment.Initiator,
+		},
+		Metadata: attachment.Metadata,
+		Context:  ctx.ToJson(),
+	}
+	if _, err = v.CtrClient.DeleteSnapshotAttachment(context.Background(), opt); err != nil {
+		log.Error("delete snapshot attachment failed in controller service:", err)
+		return
+	}
+
+	return
+}
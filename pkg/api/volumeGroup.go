@@ -260,3 +260,110 @@ func (v *VolumeGroupPortal) ListVolumeGroups() {
 	v.SuccessHandle(StatusOK, body)
 	return
 }
+
+func (v *VolumeGroupPortal) CreateVolumeGroupSnapshot() {
+	if !policy.Authorize(v.Ctx, "volume_group:create_snapshot") {
+		return
+	}
+	ctx := c.GetContext(v.Ctx)
+
+	var groupSnapshot = &model.VolumeGroupSnapshotSpec{
+		BaseModel: &model.BaseModel{},
+	}
+	if err := json.NewDecoder(v.Ctx.Request.Body).Decode(groupSnapshot); err != nil {
+		errMsg := fmt.Sprintf("parse volume group snapshot request body failed: %s", err.Error())
+		v.ErrorHandle(model.ErrorBadRequest, errMsg)
+		return
+	}
+	groupSnapshot.GroupId = v.Ctx.Input.Param(":groupId")
+
+	// NOTE:It will create the snapshot entries of all the volumes in the group
+	// into the database and initialize their status as "creating".
+	result, err := CreateVolumeGroupSnapshotDBEntry(ctx, groupSnapshot)
+	if err != nil {
+		errMsg := fmt.Sprintf("create volume group snapshot failed: %s", err.Error())
+		v.ErrorHandle(model.ErrorBadRequest, errMsg)
+		return
+	}
+
+	// Marshal the result.
+	body, _ := json.Marshal(result)
+	v.SuccessHandle(StatusAccepted, body)
+
+	// NOTE:The real volume group snapshot creation process.
+	// Volume group snapshot creation request is sent to the Dock. Dock will
+	// update the status of snapshots to "available" after the creation.
+	if err = v.CtrClient.Connect(CONF.OsdsLet.ApiEndpoint); err != nil {
+		log.Error("when connecting controller client:", err)
+		return
+	}
+	defer v.CtrClient.Close()
+
+	opt := &pb.CreateVolumeGroupSnapshotOpts{
+		Id:      result.Id,
+		GroupId: result.GroupId,
+		Context: ctx.ToJson(),
+	}
+	for _, snp := range result.Snapshots {
+		opt.Snapshots = append(opt.Snapshots, &pb.CreateVolumeSnapshotOpts{
+			Id:          snp.Id,
+			Name:        snp.Name,
+			Description: snp.Description,
+			VolumeId:    snp.VolumeId,
+			Context:     ctx.ToJson(),
+		})
+	}
+	if _, err = v.CtrClient.CreateVolumeGroupSnapshot(context.Background(), opt); err != nil {
+		log.Error("create volume group snapshot failed in controller service:", err)
+		return
+	}
+
+	return
+}
+
+func (v *VolumeGroupPortal) DeleteVolumeGroupSnapshot() {
+	if !policy.Authorize(v.Ctx, "volume_group:delete_snapshot") {
+		return
+	}
+	ctx := c.GetContext(v.Ctx)
+
+	groupId := v.Ctx.Input.Param(":groupId")
+	id := v.Ctx.Input.Param(":groupSnapshotId")
+	snps, err := DeleteVolumeGroupSnapshotDBEntry(ctx, groupId, id)
+	if err != nil {
+		errMsg := fmt.Sprintf("delete volume group snapshot failed: %s", err.Error())
+		v.ErrorHandle(model.ErrorBadRequest, errMsg)
+		return
+	}
+
+	v.SuccessHandle(StatusAccepted, nil)
+
+	// NOTE:The real volume group snapshot deletion process.
+	// Volume group snapshot deletion request is sent to the Dock. Dock will
+	// remove the snapshot records after the deletion is completed.
+	if err = v.CtrClient.Connect(CONF.OsdsLet.ApiEndpoint); err != nil {
+		log.Error("when connecting controller client:", err)
+		return
+	}
+	defer v.CtrClient.Close()
+
+	opt := &pb.DeleteVolumeGroupSnapshotOpts{
+		Id:      id,
+		GroupId: groupId,
+		Context: ctx.ToJson(),
+	}
+	for _, snp := range snps {
+		opt.Snapshots = append(opt.Snapshots, &pb.DeleteVolumeSnapshotOpts{
+			Id:       snp.Id,
+			VolumeId: snp.VolumeId,
+			Metadata: snp.Metadata,
+			Context:  ctx.ToJson(),
+		})
+	}
+	if _, err = v.CtrClient.DeleteVolumeGroupSnapshot(context.Background(), opt); err != nil {
+		log.Error("delete volume group snapshot failed in controller service:", err)
+		return
+	}
+
+	return
+}
@@ -272,6 +272,12 @@ func TestUpdateVolumeGroup(t *testing.T) {
 	mockClient := new(dbtest.Client)
 
 	mockClient.On("GetVolumeGroup", c.NewAdminContext(), fakeVolumeGroup.Id).Return(fakeVolumeGroup, nil)
+	mockClient.On("GetPool", c.NewAdminContext(), fakeVolumeGroup.PoolId).Return(&model.StoragePoolSpec{
+		BaseModel: &model.BaseModel{Id: fakeVolumeGroup.PoolId},
+		Extras: model.StoragePoolExtraSpec{
+			Capabilities: model.DriverCapabilitiesSpec{VolumeGroup: true},
+		},
+	}, nil)
 	mockClient.On("ListVolumesByGroupId", c.NewAdminContext(), fakeVolumeGroup.Id).Return(fakeGroupVolumes, nil)
 	mockClient.On("GetVolume", c.NewAdminContext(), fakeGroupVolumeTest.Id).Return(fakeGroupVolumeTest, nil)
 	mockClient.On("GetDockByPoolId", c.NewAdminContext(), fakeVolumeGroup.PoolId).Return(nil, errors.New("db error"))
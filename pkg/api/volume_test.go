@@ -46,6 +46,8 @@ func init() {
 		"get:GetVolume;put:UpdateVolume;delete:DeleteVolume")
 	beego.Router("/v1beta/block/volumes/:volumeId/resize", NewFakeVolumePortal(),
 		"post:ExtendVolume")
+	beego.Router("/v1beta/block/volumes/:volumeId/qos", NewFakeVolumePortal(),
+		"put:UpdateVolumeQos")
 
 	beego.Router("/v1beta/block/attachments", &VolumeAttachmentPortal{},
 		"post:CreateVolumeAttachment;get:ListVolumeAttachments")
@@ -56,6 +58,11 @@ func init() {
 		"post:CreateVolumeSnapshot;get:ListVolumeSnapshots")
 	beego.Router("/v1beta/block/snapshots/:snapshotId", &VolumeSnapshotPortal{},
 		"get:GetVolumeSnapshot;put:UpdateVolumeSnapshot;delete:DeleteVolumeSnapshot")
+
+	beego.Router("/v1beta/block/snapshotAttachments", &SnapshotAttachmentPortal{},
+		"post:CreateSnapshotAttachment;get:ListSnapshotAttachments")
+	beego.Router("/v1beta/block/snapshotAttachments/:attachmentId", &SnapshotAttachmentPortal{},
+		"get:GetSnapshotAttachment;delete:DeleteSnapshotAttachment")
 }
 
 func NewFakeVolumePortal() *VolumePortal {
@@ -72,6 +79,11 @@ func NewFakeVolumePortal() *VolumePortal {
 		Size:    int64(20),
 		Context: c.NewAdminContext().ToJson(),
 	}).Return(&pb.GenericResponse{}, nil)
+	mockClient.On("UpdateVolumeQos", ctx.Background(), &pb.UpdateVolumeQosOpts{
+		Id:      "bd5b12a8-a101-11e7-941e-d77981b584d8",
+		Qos:     &pb.Qos{MaxIOPS: 1000, MaxBWS: 100},
+		Context: c.NewAdminContext().ToJson(),
+	}).Return(&pb.GenericResponse{}, nil)
 	mockClient.On("DeleteVolume", ctx.Background(), &pb.DeleteVolumeOpts{
 		Context: c.NewAdminContext().ToJson(),
 	}).
@@ -390,6 +402,64 @@ func TestExtendVolumeWithBadRequest(t *testing.T) {
 	}
 }
 
+func TestUpdateVolumeQos(t *testing.T) {
+	var jsonStr = []byte(`{"maxIOPS":1000,"maxBWS":100}`)
+	r, _ := http.NewRequest("PUT",
+		"/v1beta/block/volumes/bd5b12a8-a101-11e7-941e-d77981b584d8/qos", bytes.NewBuffer(jsonStr))
+	w := httptest.NewRecorder()
+	r.Header.Set("Content-Type", "application/JSON")
+
+	volume := &model.VolumeSpec{
+		BaseModel: &model.BaseModel{Id: "bd5b12a8-a101-11e7-941e-d77981b584d8"},
+		Status:    model.VolumeInUse,
+		PoolId:    "084bf71e-a102-11e7-88a8-e31fe6d52248",
+		Size:      1,
+	}
+
+	mockClient := new(dbtest.Client)
+	mockClient.On("GetVolume", c.NewAdminContext(), "bd5b12a8-a101-11e7-941e-d77981b584d8").Return(volume, nil)
+	mockClient.On("GetPool", c.NewAdminContext(), "084bf71e-a102-11e7-88a8-e31fe6d52248").Return(&SamplePools[0], nil)
+
+	db.C = mockClient
+	beego.InsertFilter("*", beego.BeforeExec, func(httpCtx *context.Context) {
+		httpCtx.Input.SetData("context", c.NewAdminContext())
+	})
+	beego.BeeApp.Handlers.ServeHTTP(w, r)
+
+	if w.Code != StatusAccepted {
+		t.Errorf("Expected %v, actual %v", StatusAccepted, w.Code)
+	}
+}
+
+func TestUpdateVolumeQosWithUnsupportedPool(t *testing.T) {
+	var jsonStr = []byte(`{"maxIOPS":1000,"maxBWS":100}`)
+	r, _ := http.NewRequest("PUT",
+		"/v1beta/block/volumes/bd5b12a8-a101-11e7-941e-d77981b584d8/qos", bytes.NewBuffer(jsonStr))
+	w := httptest.NewRecorder()
+	r.Header.Set("Content-Type", "application/JSON")
+
+	volume := &model.VolumeSpec{
+		BaseModel: &model.BaseModel{Id: "bd5b12a8-a101-11e7-941e-d77981b584d8"},
+		Status:    model.VolumeAvailable,
+		PoolId:    "a594b8ac-a103-11e7-985f-d723bcf01b5f",
+		Size:      1,
+	}
+
+	mockClient := new(dbtest.Client)
+	mockClient.On("GetVolume", c.NewAdminContext(), "bd5b12a8-a101-11e7-941e-d77981b584d8").Return(volume, nil)
+	mockClient.On("GetPool", c.NewAdminContext(), "a594b8ac-a103-11e7-985f-d723bcf01b5f").Return(&SamplePools[1], nil)
+
+	db.C = mockClient
+	beego.InsertFilter("*", beego.BeforeExec, func(httpCtx *context.Context) {
+		httpCtx.Input.SetData("context", c.NewAdminContext())
+	})
+	beego.BeeApp.Handlers.ServeHTTP(w, r)
+
+	if w.Code != 400 {
+		t.Errorf("Expected 400, actual %v", w.Code)
+	}
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 //                         Tests for volume snapshot                          //
 ////////////////////////////////////////////////////////////////////////////////
@@ -835,3 +905,58 @@ func TestUpdateVolumeAttachmentWithBadRequest(t *testing.T) {
 		t.Errorf("Expected 500, actual %v", w.Code)
 	}
 }
+
+////////////////////////////////////////////////////////////////////////////////
+//                      Tests for snapshot attachment                         //
+////////////////////////////////////////////////////////////////////////////////
+
+func TestListSnapshotAttachments(t *testing.T) {
+	var snapId = "3769855c-a102-11e7-b772-17b880d2f537"
+	var expected = []*model.SnapshotAttachmentSpec{&SampleSnapshotAttachments[0]}
+
+	mockClient := new(dbtest.Client)
+	mockClient.On("ListSnapshotAttachments", c.NewAdminContext(), snapId).Return(expected, nil)
+	db.C = mockClient
+
+	r, _ := http.NewRequest("GET", "/v1beta/block/snapshotAttachments?snapshotId="+snapId, nil)
+	w := httptest.NewRecorder()
+	beego.InsertFilter("*", beego.BeforeExec, func(httpCtx *context.Context) {
+		httpCtx.Input.SetData("context", c.NewAdminContext())
+	})
+	beego.BeeApp.Handlers.ServeHTTP(w, r)
+
+	var output []*model.SnapshotAttachmentSpec
+	json.Unmarshal(w.Body.Bytes(), &output)
+
+	if w.Code != 200 {
+		t.Errorf("Expected 200, actual %v", w.Code)
+	}
+	if !reflect.DeepEqual(output, expected) {
+		t.Errorf("Expected %v, actual %v", expected, output)
+	}
+}
+
+func TestGetSnapshotAttachment(t *testing.T) {
+	var expected = &SampleSnapshotAttachments[0]
+
+	mockClient := new(dbtest.Client)
+	mockClient.On("GetSnapshotAttachment", c.NewAdminContext(), expected.Id).Return(expected, nil)
+	db.C = mockClient
+
+	r, _ := http.NewRequest("GET", "/v1beta/block/snapshotAttachments/"+expected.Id, nil)
+	w := httptest.NewRecorder()
+	beego.InsertFilter("*", beego.BeforeExec, func(httpCtx *context.Context) {
+		httpCtx.Input.SetData("context", c.NewAdminContext())
+	})
+	beego.BeeApp.Handlers.ServeHTTP(w, r)
+
+	var output model.SnapshotAttachmentSpec
+	json.Unmarshal(w.Body.Bytes(), &output)
+
+	if w.Code != 200 {
+		t.Errorf("Expected 200, actual %v", w.Code)
+	}
+	if !reflect.DeepEqual(&output, expected) {
+		t.Errorf("Expected %v, actual %v", expected, &output)
+	}
+}
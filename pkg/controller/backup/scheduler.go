@@ -0,0 +1,458 @@
+// Copyright (c) 2018 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+//    Licensed under the Apache License, Version 2.0 (the "License"); you may
+//    not use this file except in compliance with the License. You may obtain
+//    a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//    WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//    License for the specific language governing permissions and limitations
+//    under the License.
+
+/*
+This module implements the backup scheduler which turns the backup policies
+of profiles into recurring backups of volumes.
+
+*/
+
+package backup
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	log "github.com/golang/glog"
+	c "github.com/opensds/opensds/pkg/context"
+	"github.com/opensds/opensds/pkg/db"
+	"github.com/opensds/opensds/pkg/model"
+	pb "github.com/opensds/opensds/pkg/model/proto"
+	"github.com/opensds/opensds/pkg/utils/constants"
+	"github.com/satori/go.uuid"
+	"golang.org/x/net/context"
+)
+
+const defaultInterval = 60 * time.Second
+
+const (
+	OccurrenceHourly  = "Hourly"
+	OccurrenceDaily   = "Daily"
+	OccurrenceWeekly  = "Weekly"
+	OccurrenceMonthly = "Monthly"
+)
+
+// SnapshotController is the part of the controller server used by the
+// scheduler, every backup is taken and removed as a volume snapshot.
+type SnapshotController interface {
+	CreateVolumeSnapshot(context.Context, *pb.CreateVolumeSnapshotOpts) (*pb.GenericResponse, error)
+	DeleteVolumeSnapshot(context.Context, *pb.DeleteVolumeSnapshotOpts) (*pb.GenericResponse, error)
+}
+
+// Scheduler periodically checks the backup policy of every volume, takes the
+// backups which are due and prunes the expired ones. All of its state is
+// stored in the database, so that it survives the restart of osdslet.
+type Scheduler struct {
+	ctrl     SnapshotController
+	interval time.Duration
+	now      func() time.Time
+}
+
+// NewScheduler method creates a scheduler which checks the backup policies
+// every interval.
+func NewScheduler(ctrl SnapshotController, interval time.Duration) *Scheduler {
+	if interval <= 0 {
+		interval = defaultInterval
+	}
+	return &Scheduler{
+		ctrl:     ctrl,
+		interval: interval,
+		now:      time.Now,
+	}
+}
+
+// Run method recovers the interrupted backups and starts the scheduling loop
+// until stopCh is closed.
+func (s *Scheduler) Run(stopCh <-chan struct{}) {
+	s.Recover()
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		if err := s.Schedule(); err != nil {
+			log.Error("when schedule backups:", err)
+		}
+		select {
+		case <-ticker.C:
+		case <-stopCh:
+			return
+		}
+	}
+}
+
+// Recover method resolves the backups which were still running when osdslet
+// stopped according to the status of their snapshots.
+func (s *Scheduler) Recover() {
+	ctx := c.NewAdminContext()
+	bss, err := db.C.ListBackupSchedules(ctx)
+	if err != nil {
+		log.Error("when list backup schedules:", err)
+		return
+	}
+	for _, bs := range bss {
+		if bs.LastResult != model.BackupResultRunning {
+			continue
+		}
+		snap, err := db.C.GetVolumeSnapshot(ctx, bs.LastBackupId)
+		if err == nil && snap.Status == model.VolumeSnapAvailable {
+			s.succeed(bs)
+		} else {
+			bs.LastResult = model.BackupResultFailed
+			bs.LastError = "backup was interrupted"
+		}
+		if _, err = db.C.UpdateBackupSchedule(ctx, bs); err != nil {
+			log.Errorf("when update backup schedule of volume %s: %v", bs.VolumeId, err)
+		}
+	}
+}
+
+// Schedule method goes through all volumes once, it takes the backups which
+// are due and prunes the expired ones.
+func (s *Scheduler) Schedule() error {
+	ctx := c.NewAdminContext()
+	vols, err := db.C.ListVolumes(ctx)
+	if err != nil {
+		return err
+	}
+	bss, err := db.C.ListBackupSchedules(ctx)
+	if err != nil {
+		return err
+	}
+	var schedules = map[string]*model.BackupScheduleSpec{}
+	for _, bs := range bss {
+		schedules[bs.VolumeId] = bs
+	}
+	var profiles = map[string]*model.ProfileSpec{}
+
+	for _, vol := range vols {
+		bs := schedules[vol.Id]
+		delete(schedules, vol.Id)
+
+		prf, ok := profiles[vol.ProfileId]
+		if !ok {
+			if prf, err = db.C.GetProfile(ctx, vol.ProfileId); err != nil {
+				log.Errorf("when get profile %s of volume %s: %v", vol.ProfileId, vol.Id, err)
+				continue
+			}
+			profiles[vol.ProfileId] = prf
+		}
+		policy := &prf.DataProtectionProperties.BackupPolicy
+		if policy.Schedule.Occurrence == "" {
+			if bs != nil {
+				s.deleteSchedule(ctx, bs)
+			}
+			continue
+		}
+
+		if bs == nil || bs.ProfileId != prf.Id {
+			if bs, err = s.createSchedule(ctx, vol, prf); err != nil {
+				log.Errorf("when create backup schedule of volume %s: %v", vol.Id, err)
+				continue
+			}
+		}
+		if err = s.prune(vol, bs, policy); err != nil {
+			log.Errorf("when prune backups of volume %s: %v", vol.Id, err)
+		}
+		if s.isDue(bs) {
+			s.backup(vol, bs, policy)
+		}
+	}
+
+	// The volumes of the remaining schedules have been deleted.
+	for _, bs := range schedules {
+		s.deleteSchedule(ctx, bs)
+	}
+	return nil
+}
+
+func (s *Scheduler) createSchedule(ctx *c.Context, vol *model.VolumeSpec, prf *model.ProfileSpec) (*model.BackupScheduleSpec, error) {
+	now := s.now()
+	policy := &prf.DataProtectionProperties.BackupPolicy
+	next := now
+	if policy.Schedule.Datetime != "" {
+		start, err := time.ParseInLocation(constants.TimeFormat, policy.Schedule.Datetime, time.Local)
+		if err != nil {
+			return nil, fmt.Errorf("invalid backup schedule datetime %s: %v", policy.Schedule.Datetime, err)
+		}
+		if next, err = NextRun(start, policy.Schedule.Occurrence, now); err != nil {
+			return nil, err
+		}
+	}
+	bs := &model.BackupScheduleSpec{
+		BaseModel: &model.BaseModel{
+			CreatedAt: now.Format(constants.TimeFormat),
+		},
+		TenantId:  vol.TenantId,
+		VolumeId:  vol.Id,
+		ProfileId: prf.Id,
+		NextRunAt: next.Format(constants.TimeFormat),
+	}
+	return db.C.CreateBackupSchedule(ctx, bs)
+}
+
+func (s *Scheduler) deleteSchedule(ctx *c.Context, bs *model.BackupScheduleSpec) {
+	if err := db.C.DeleteBackupSchedule(ctx, bs.VolumeId); err != nil {
+		log.Errorf("when delete backup schedule of volume %s: %v", bs.VolumeId, err)
+	}
+}
+
+func (s *Scheduler) isDue(bs *model.BackupScheduleSpec) bool {
+	if bs.LastResult == model.BackupResultRunning {
+		return false
+	}
+	next, err := time.ParseInLocation(constants.TimeFormat, bs.NextRunAt, time.Local)
+	if err != nil {
+		log.Errorf("invalid next run time %s of volume %s: %v", bs.NextRunAt, bs.VolumeId, err)
+		return false
+	}
+	return !next.After(s.now())
+}
+
+// backupMode returns whether the next backup of the schedule is a full or an
+// incremental one.
+func backupMode(bs *model.BackupScheduleSpec, policy *model.BackupPolicySpec) string {
+	if policy.FullBackupInterval <= 1 || bs.LastFullBackupId == "" ||
+		bs.RunCount%policy.FullBackupInterval == 0 {
+		return model.BackupModeFull
+	}
+	return model.BackupModeIncremental
+}
+
+// supportsIncremental returns whether the driver of the volume declares that
+// it supports incremental backups.
+func (s *Scheduler) supportsIncremental(ctx *c.Context, vol *model.VolumeSpec) bool {
+	pol, err := db.C.GetPool(ctx, vol.PoolId)
+	if err != nil {
+		log.Errorf("when get pool %s of volume %s: %v", vol.PoolId, vol.Id, err)
+		return false
+	}
+	return pol.Extras.Capabilities.Supports(model.CapabilityIncrementalBackup)
+}
+
+func (s *Scheduler) backup(vol *model.VolumeSpec, bs *model.BackupScheduleSpec, policy *model.BackupPolicySpec) {
+	now := s.now()
+	ctx := c.NewInternalTenantContext(vol.TenantId, vol.UserId)
+	anchor := bs.CreatedAt
+	if policy.Schedule.Datetime != "" {
+		anchor = policy.Schedule.Datetime
+	}
+	if start, err := time.ParseInLocation(constants.TimeFormat, anchor, time.Local); err != nil {
+		log.Errorf("invalid backup schedule anchor %s of volume %s: %v", anchor, vol.Id, err)
+		bs.NextRunAt = now.Add(time.Hour).Format(constants.TimeFormat)
+	} else if next, err := NextRun(start, policy.Schedule.Occurrence, now); err != nil {
+		log.Errorf("when compute next backup of volume %s: %v", vol.Id, err)
+		bs.NextRunAt = now.Add(time.Hour).Format(constants.TimeFormat)
+	} else {
+		bs.NextRunAt = next.Format(constants.TimeFormat)
+	}
+	bs.LastRunAt = now.Format(constants.TimeFormat)
+	bs.LastError = ""
+
+	if vol.Status != model.VolumeAvailable && vol.Status != model.VolumeInUse {
+		s.fail(ctx, bs, fmt.Errorf("the status of volume is %s", vol.Status))
+		return
+	}
+
+	mode := backupMode(bs, policy)
+	if mode == model.BackupModeIncremental && !s.supportsIncremental(ctx, vol) {
+		log.Warningf("driver of volume %s doesn't support incremental backups, take a full one", vol.Id)
+		mode = model.BackupModeFull
+	}
+	metadata := map[string]string{
+		model.BackupScheduleKey: bs.Id,
+		model.BackupModeKey:     mode,
+	}
+	if policy.BackupDriver != "" {
+		metadata[model.BackupDriverKey] = policy.BackupDriver
+	}
+	if policy.Topology.Bucket != "" {
+		metadata[model.BackupBucketKey] = policy.Topology.Bucket
+	}
+	if mode == model.BackupModeIncremental {
+		metadata[model.BackupBaseKey] = bs.LastFullBackupId
+	}
+
+	snap := &model.VolumeSnapshotSpec{
+		BaseModel: &model.BaseModel{
+			Id:        uuid.NewV4().String(),
+			CreatedAt: bs.LastRunAt,
+		},
+		UserId:      vol.UserId,
+		Name:        "backup-" + strings.Replace(bs.LastRunAt, ":", "", -1),
+		Description: "Scheduled backup taken by osdslet",
+		Size:        vol.Size,
+		Status:      model.VolumeSnapCreating,
+		VolumeId:    vol.Id,
+		Metadata:    metadata,
+	}
+	if _, err := db.C.CreateVolumeSnapshot(ctx, snap); err != nil {
+		s.fail(ctx, bs, err)
+		return
+	}
+
+	// Record the running backup before it starts, so that it can be resolved
+	// if osdslet stops in the middle of it.
+	bs.LastResult = model.BackupResultRunning
+	bs.LastBackupId = snap.Id
+	bs.LastBackupMode = mode
+	if _, err := db.C.UpdateBackupSchedule(ctx, bs); err != nil {
+		log.Errorf("when update backup schedule of volume %s: %v", vol.Id, err)
+	}
+
+	log.Infof("take %s backup %s of volume %s", mode, snap.Id, vol.Id)
+	opt := &pb.CreateVolumeSnapshotOpts{
+		Id:          snap.Id,
+		Name:        snap.Name,
+		Description: snap.Description,
+		VolumeId:    snap.VolumeId,
+		Size:        snap.Size,
+		Metadata:    snap.Metadata,
+		Context:     ctx.ToJson(),
+	}
+	if _, err := s.ctrl.CreateVolumeSnapshot(context.Background(), opt); err != nil {
+		s.fail(ctx, bs, err)
+		return
+	}
+
+	s.succeed(bs)
+	if _, err := db.C.UpdateBackupSchedule(ctx, bs); err != nil {
+		log.Errorf("when update backup schedule of volume %s: %v", vol.Id, err)
+	}
+}
+
+func (s *Scheduler) succeed(bs *model.BackupScheduleSpec) {
+	bs.LastResult = model.BackupResultSuccess
+	bs.LastError = ""
+	bs.RunCount++
+	if bs.LastBackupMode != model.BackupModeIncremental {
+		bs.LastFullBackupId = bs.LastBackupId
+	}
+}
+
+func (s *Scheduler) fail(ctx *c.Context, bs *model.BackupScheduleSpec, err error) {
+	log.Errorf("backup of volume %s failed: %v", bs.VolumeId, err)
+	bs.LastResult = model.BackupResultFailed
+	bs.LastError = err.Error()
+	if _, err = db.C.UpdateBackupSchedule(ctx, bs); err != nil {
+		log.Errorf("when update backup schedule of volume %s: %v", bs.VolumeId, err)
+	}
+}
+
+// prune deletes the backups which are beyond the retention of the policy.
+// A full backup is kept as long as one of the kept incremental backups is
+// based on it.
+func (s *Scheduler) prune(vol *model.VolumeSpec, bs *model.BackupScheduleSpec, policy *model.BackupPolicySpec) error {
+	if policy.Retention.Number <= 0 && policy.Retention.Duration <= 0 {
+		return nil
+	}
+	ctx := c.NewInternalTenantContext(vol.TenantId, vol.UserId)
+	snaps, err := db.C.ListSnapshotsByVolumeId(ctx, vol.Id)
+	if err != nil {
+		return err
+	}
+
+	var backups []*model.VolumeSnapshotSpec
+	for _, snap := range snaps {
+		if snap.Metadata[model.BackupScheduleKey] == bs.Id && snap.Status == model.VolumeSnapAvailable {
+			backups = append(backups, snap)
+		}
+	}
+	expired := Expired(backups, policy, s.now())
+	for _, snap := range expired {
+		log.Infof("prune expired backup %s of volume %s", snap.Id, vol.Id)
+		if err = db.UpdateVolumeSnapshotStatus(ctx, db.C, snap.Id, model.VolumeSnapDeleting); err != nil {
+			log.Errorf("when update status of backup %s: %v", snap.Id, err)
+			continue
+		}
+		opt := &pb.DeleteVolumeSnapshotOpts{
+			Id:       snap.Id,
+			VolumeId: snap.VolumeId,
+			Metadata: snap.Metadata,
+			Context:  ctx.ToJson(),
+		}
+		if _, err = s.ctrl.DeleteVolumeSnapshot(context.Background(), opt); err != nil {
+			log.Errorf("when delete expired backup %s: %v", snap.Id, err)
+		}
+	}
+	return nil
+}
+
+// Expired returns the backups which are beyond the retention by count or by
+// age of the policy.
+func Expired(backups []*model.VolumeSnapshotSpec, policy *model.BackupPolicySpec, now time.Time) []*model.VolumeSnapshotSpec {
+	sorted := make([]*model.VolumeSnapshotSpec, len(backups))
+	copy(sorted, backups)
+	// Newest first.
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].CreatedAt > sorted[j].CreatedAt })
+
+	var kept, expired []*model.VolumeSnapshotSpec
+	for i, snap := range sorted {
+		isExpired := policy.Retention.Number > 0 && int64(i) >= policy.Retention.Number
+		if policy.Retention.Duration > 0 {
+			created, err := time.ParseInLocation(constants.TimeFormat, snap.CreatedAt, time.Local)
+			if err == nil && now.Sub(created) > time.Duration(policy.Retention.Duration)*24*time.Hour {
+				isExpired = true
+			}
+		}
+		if isExpired {
+			expired = append(expired, snap)
+		} else {
+			kept = append(kept, snap)
+		}
+	}
+
+	var bases = map[string]bool{}
+	for _, snap := range kept {
+		if base := snap.Metadata[model.BackupBaseKey]; base != "" {
+			bases[base] = true
+		}
+	}
+	var result []*model.VolumeSnapshotSpec
+	for _, snap := range expired {
+		if !bases[snap.Id] {
+			result = append(result, snap)
+		}
+	}
+	return result
+}
+
+// NextRun returns the first run of a schedule which starts at start and
+// recurs every occurrence after the time after.
+func NextRun(start time.Time, occurrence string, after time.Time) (time.Time, error) {
+	if start.After(after) {
+		return start, nil
+	}
+	var period time.Duration
+	switch {
+	case strings.EqualFold(occurrence, OccurrenceHourly):
+		period = time.Hour
+	case strings.EqualFold(occurrence, OccurrenceDaily):
+		period = 24 * time.Hour
+	case strings.EqualFold(occurrence, OccurrenceWeekly):
+		period = 7 * 24 * time.Hour
+	case strings.EqualFold(occurrence, OccurrenceMonthly):
+		months := (after.Year()-start.Year())*12 + int(after.Month()-start.Month())
+		for ; ; months++ {
+			if next := start.AddDate(0, months, 0); next.After(after) {
+				return next, nil
+			}
+		}
+	default:
+		return time.Time{}, fmt.Errorf("unsupported backup schedule occurrence %s", occurrence)
+	}
+	n := after.Sub(start)/period + 1
+	return start.Add(n * period), nil
+}
@@ -0,0 +1,239 @@
+// Copyright (c) 2018 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+//    Licensed under the Apache License, Version 2.0 (the "License"); you may
+//    not use this file except in compliance with the License. You may obtain
+//    a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//    WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//    License for the specific language governing permissions and limitations
+//    under the License.
+
+package backup
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/opensds/opensds/pkg/db"
+	"github.com/opensds/opensds/pkg/model"
+	pb "github.com/opensds/opensds/pkg/model/proto"
+	"github.com/opensds/opensds/pkg/utils/constants"
+	dbtest "github.com/opensds/opensds/testutils/db/testing"
+	"github.com/stretchr/testify/mock"
+	"golang.org/x/net/context"
+)
+
+type fakeSnapshotController struct {
+	created []*pb.CreateVolumeSnapshotOpts
+	deleted []*pb.DeleteVolumeSnapshotOpts
+	err     error
+}
+
+func (f *fakeSnapshotController) CreateVolumeSnapshot(ctx context.Context, opt *pb.CreateVolumeSnapshotOpts) (*pb.GenericResponse, error) {
+	f.created = append(f.created, opt)
+	if f.err != nil {
+		return pb.GenericResponseError(f.err), f.err
+	}
+	return pb.GenericResponseResult(nil), nil
+}
+
+func (f *fakeSnapshotController) DeleteVolumeSnapshot(ctx context.Context, opt *pb.DeleteVolumeSnapshotOpts) (*pb.GenericResponse, error) {
+	f.deleted = append(f.deleted, opt)
+	return pb.GenericResponseResult(nil), nil
+}
+
+func parseTime(s string) time.Time {
+	t, _ := time.ParseInLocation(constants.TimeFormat, s, time.Local)
+	return t
+}
+
+func TestNextRun(t *testing.T) {
+	start := parseTime("2018-01-31T10:00:00")
+	var testCases = []struct {
+		occurrence string
+		after      string
+		expected   string
+	}{
+		{"Hourly", "2018-02-01T12:30:00", "2018-02-01T13:00:00"},
+		{"Daily", "2018-02-01T10:00:00", "2018-02-02T10:00:00"},
+		{"daily", "2018-01-01T00:00:00", "2018-01-31T10:00:00"},
+		{"Weekly", "2018-02-01T00:00:00", "2018-02-07T10:00:00"},
+		{"Monthly", "2018-03-05T00:00:00", "2018-03-31T10:00:00"},
+	}
+	for _, tc := range testCases {
+		next, err := NextRun(start, tc.occurrence, parseTime(tc.after))
+		if err != nil {
+			t.Error(err)
+		}
+		if result := next.Format(constants.TimeFormat); result != tc.expected {
+			t.Errorf("%s after %s: expected %s, got %s", tc.occurrence, tc.after, tc.expected, result)
+		}
+	}
+
+	if _, err := NextRun(start, "Yearly", start); err == nil {
+		t.Error("expected error with unsupported occurrence")
+	}
+}
+
+func newBackup(id, createdAt, base string) *model.VolumeSnapshotSpec {
+	metadata := map[string]string{}
+	if base != "" {
+		metadata[model.BackupBaseKey] = base
+	}
+	return &model.VolumeSnapshotSpec{
+		BaseModel: &model.BaseModel{Id: id, CreatedAt: createdAt},
+		Metadata:  metadata,
+	}
+}
+
+func TestExpired(t *testing.T) {
+	backups := []*model.VolumeSnapshotSpec{
+		newBackup("full-1", "2018-01-01T10:00:00", ""),
+		newBackup("incr-1", "2018-01-02T10:00:00", "full-1"),
+		newBackup("incr-2", "2018-01-03T10:00:00", "full-1"),
+		newBackup("full-2", "2018-01-04T10:00:00", ""),
+		newBackup("incr-3", "2018-01-05T10:00:00", "full-2"),
+	}
+	now := parseTime("2018-01-05T12:00:00")
+
+	policy := &model.BackupPolicySpec{}
+	policy.Retention.Number = 2
+	if result := Expired(backups, policy, now); !reflect.DeepEqual(result, []*model.VolumeSnapshotSpec{backups[2], backups[1], backups[0]}) {
+		t.Errorf("unexpected expired backups by number: %+v", result)
+	}
+
+	// The full backup is kept as long as one of its incremental backups is kept.
+	policy.Retention.Number = 4
+	if result := Expired(backups, policy, now); len(result) != 0 {
+		t.Errorf("unexpected expired backups by number: %+v", result)
+	}
+
+	policy = &model.BackupPolicySpec{}
+	policy.Retention.Duration = 3
+	if result := Expired(backups, policy, now); !reflect.DeepEqual(result, []*model.VolumeSnapshotSpec{backups[1]}) {
+		t.Errorf("unexpected expired backups by duration: %+v", result)
+	}
+}
+
+func TestBackupMode(t *testing.T) {
+	policy := &model.BackupPolicySpec{FullBackupInterval: 3}
+	bs := &model.BackupScheduleSpec{}
+	if mode := backupMode(bs, policy); mode != model.BackupModeFull {
+		t.Errorf("expected first backup to be full, got %s", mode)
+	}
+	bs.LastFullBackupId, bs.RunCount = "full-1", 1
+	if mode := backupMode(bs, policy); mode != model.BackupModeIncremental {
+		t.Errorf("expected second backup to be incremental, got %s", mode)
+	}
+	bs.RunCount = 3
+	if mode := backupMode(bs, policy); mode != model.BackupModeFull {
+		t.Errorf("expected fourth backup to be full, got %s", mode)
+	}
+}
+
+func TestBackupIncremental(t *testing.T) {
+	vol := &model.VolumeSpec{
+		BaseModel: &model.BaseModel{Id: "bd5b12a8-a101-11e7-941e-d77981b584d8"},
+		Size:      1,
+		Status:    model.VolumeAvailable,
+		PoolId:    "084bf71e-a102-11e7-88a8-e31fe6d52248",
+	}
+	policy := &model.BackupPolicySpec{FullBackupInterval: 3}
+	policy.Schedule.Occurrence = "Daily"
+
+	for _, supported := range []bool{true, false} {
+		pol := &model.StoragePoolSpec{BaseModel: &model.BaseModel{Id: vol.PoolId}}
+		pol.Extras.Capabilities.IncrementalBackup = supported
+		mockClient := new(dbtest.Client)
+		mockClient.On("GetPool", mock.Anything, vol.PoolId).Return(pol, nil)
+		mockClient.On("CreateVolumeSnapshot", mock.Anything, mock.Anything).Return(nil, nil)
+		mockClient.On("UpdateBackupSchedule", mock.Anything, mock.Anything).Return(nil, nil)
+		db.C = mockClient
+
+		ctrl := &fakeSnapshotController{}
+		s := NewScheduler(ctrl, time.Minute)
+		s.now = func() time.Time { return parseTime("2018-01-02T10:00:01") }
+		bs := &model.BackupScheduleSpec{
+			BaseModel:        &model.BaseModel{Id: "schedule-id", CreatedAt: "2018-01-01T09:00:00"},
+			VolumeId:         vol.Id,
+			NextRunAt:        "2018-01-02T10:00:00",
+			LastFullBackupId: "full-1",
+			RunCount:         1,
+		}
+		s.backup(vol, bs, policy)
+
+		// The backup is taken full if the driver can't take incremental ones.
+		expected, base := model.BackupModeFull, ""
+		if supported {
+			expected, base = model.BackupModeIncremental, "full-1"
+		}
+		metadata := ctrl.created[0].Metadata
+		if metadata[model.BackupModeKey] != expected || metadata[model.BackupBaseKey] != base {
+			t.Errorf("expected %s backup based on %q, got %v", expected, base, metadata)
+		}
+		if bs.LastBackupMode != expected {
+			t.Errorf("expected last backup mode %s, got %s", expected, bs.LastBackupMode)
+		}
+	}
+}
+
+func TestBackup(t *testing.T) {
+	vol := &model.VolumeSpec{
+		BaseModel: &model.BaseModel{Id: "bd5b12a8-a101-11e7-941e-d77981b584d8"},
+		Size:      1,
+		Status:    model.VolumeAvailable,
+	}
+	policy := &model.BackupPolicySpec{BackupDriver: "multi-cloud"}
+	policy.Schedule.Occurrence = "Daily"
+	policy.Schedule.Datetime = "2018-01-01T10:00:00"
+	policy.Topology.Bucket = "backups"
+
+	var testCases = []struct {
+		err            error
+		expectedResult string
+		expectedCount  int64
+	}{
+		{nil, model.BackupResultSuccess, 1},
+		{errors.New("dock error"), model.BackupResultFailed, 0},
+	}
+	for _, tc := range testCases {
+		mockClient := new(dbtest.Client)
+		mockClient.On("CreateVolumeSnapshot", mock.Anything, mock.Anything).Return(nil, nil)
+		mockClient.On("UpdateBackupSchedule", mock.Anything, mock.Anything).Return(nil, nil)
+		db.C = mockClient
+
+		ctrl := &fakeSnapshotController{err: tc.err}
+		s := NewScheduler(ctrl, time.Minute)
+		s.now = func() time.Time { return parseTime("2018-01-02T10:00:01") }
+		bs := &model.BackupScheduleSpec{
+			BaseModel: &model.BaseModel{Id: "schedule-id", CreatedAt: "2018-01-01T09:00:00"},
+			VolumeId:  vol.Id,
+			NextRunAt: "2018-01-02T10:00:00",
+		}
+		if !s.isDue(bs) {
+			t.Fatal("expected backup schedule to be due")
+		}
+		s.backup(vol, bs, policy)
+
+		if len(ctrl.created) != 1 {
+			t.Fatalf("expected one snapshot to be created, got %d", len(ctrl.created))
+		}
+		metadata := ctrl.created[0].Metadata
+		if metadata[model.BackupBucketKey] != "backups" || metadata[model.BackupScheduleKey] != "schedule-id" ||
+			metadata[model.BackupModeKey] != model.BackupModeFull {
+			t.Errorf("unexpected snapshot metadata %v", metadata)
+		}
+		if bs.LastResult != tc.expectedResult || bs.RunCount != tc.expectedCount {
+			t.Errorf("expected result %s and count %d, got %+v", tc.expectedResult, tc.expectedCount, bs)
+		}
+		if bs.NextRunAt != "2018-01-03T10:00:00" {
+			t.Errorf("expected next run 2018-01-03T10:00:00, got %s", bs.NextRunAt)
+		}
+	}
+}
@@ -25,8 +25,11 @@ import (
 	"net"
 
 	log "github.com/golang/glog"
+	"github.com/opensds/opensds/contrib/connector"
 	osdsCtx "github.com/opensds/opensds/pkg/context"
+	"github.com/opensds/opensds/pkg/controller/backup"
 	"github.com/opensds/opensds/pkg/controller/dr"
+	"github.com/opensds/opensds/pkg/controller/heartbeat"
 	"github.com/opensds/opensds/pkg/controller/policy"
 	"github.com/opensds/opensds/pkg/controller/selector"
 	"github.com/opensds/opensds/pkg/controller/volume"
@@ -34,6 +37,7 @@ import (
 	"github.com/opensds/opensds/pkg/model"
 	pb "github.com/opensds/opensds/pkg/model/proto"
 	"github.com/opensds/opensds/pkg/utils"
+	"github.com/opensds/opensds/pkg/utils/config"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 )
@@ -81,6 +85,13 @@ func (c *Controller) Run() error {
 
 	log.Info("Controller server initialized! Start listening on port:", lis.Addr())
 
+	// Start backup scheduler which carries out the backup policies of profiles.
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	go backup.NewScheduler(c, config.CONF.OsdsLet.BackupScheduleInterval).Run(stopCh)
+	// Start heartbeat monitor which takes the crashed docks offline.
+	go heartbeat.NewMonitor(config.CONF.OsdsLet.DockHeartbeatTimeout, nil).Run(stopCh)
+
 	// Start controller server watching loop.
 	defer s.Stop()
 	return s.Serve(lis)
@@ -152,6 +163,15 @@ func (c *Controller) CreateVolume(contx context.Context, opt *pb.CreateVolumeOpt
 	}
 	c.volumeController.SetDock(dockInfo)
 	opt.DriverName = dockInfo.DriverName
+	opt.BackendName = dockInfo.BackendName
+
+	// The volume is throttled to the IO connectivity of the profile.
+	qos := prf.ProvisioningProperties.Qos()
+	opt.Qos = qosOpt(qos)
+	// The data of the volume is reduced as the data storage of the profile
+	// asks, the pool selected is guaranteed to support it.
+	opt.IsCompressed = prf.ProvisioningProperties.DataStorage.IsCompressed
+	opt.IsDeduplicated = prf.ProvisioningProperties.DataStorage.IsDeduplicated
 
 	result, err := c.volumeController.CreateVolume(opt)
 	if err != nil {
@@ -161,6 +181,7 @@ func (c *Controller) CreateVolume(contx context.Context, opt *pb.CreateVolumeOpt
 		return pb.GenericResponseError(err), err
 	}
 	result.PoolId, result.ProfileId = opt.GetPoolId(), opt.GetProfileId()
+	result.Qos = qos
 
 	// Update the volume data in database.
 	db.C.UpdateStatus(ctx, result, model.VolumeAvailable)
@@ -207,6 +228,7 @@ func (c *Controller) DeleteVolume(contx context.Context, opt *pb.DeleteVolumeOpt
 	c.policyController.SetDock(dockInfo)
 	c.volumeController.SetDock(dockInfo)
 	opt.DriverName = dockInfo.DriverName
+	opt.BackendName = dockInfo.BackendName
 
 	var errChan = make(chan error, 1)
 	defer close(errChan)
@@ -241,11 +263,23 @@ func (c *Controller) ExtendVolume(contx context.Context, opt *pb.ExtendVolumeOpt
 		return pb.GenericResponseError(err), err
 	}
 
+	// The volume is in use if it's attached to any host, which is expanded
+	// on the hosts after extended.
+	atcs, err := db.C.ListAttachmentsByVolumeId(ctx, vol.Id)
+	if err != nil {
+		log.Error("list attachments failed in extend volume method: ", err.Error())
+		return pb.GenericResponseError(err), err
+	}
+	var status = model.VolumeAvailable
+	if len(atcs) > 0 {
+		status = model.VolumeInUse
+	}
+
 	// roll back size and status
 	var rollBack = false
 	defer func() {
 		if rollBack {
-			db.UpdateVolumeStatus(ctx, db.C, opt.Id, model.VolumeAvailable)
+			db.UpdateVolumeStatus(ctx, db.C, opt.Id, status)
 		}
 	}()
 
@@ -287,6 +321,7 @@ func (c *Controller) ExtendVolume(contx context.Context, opt *pb.ExtendVolumeOpt
 	c.policyController.SetDock(dockInfo)
 	c.volumeController.SetDock(dockInfo)
 	opt.DriverName = dockInfo.DriverName
+	opt.BackendName = dockInfo.BackendName
 
 	result, err := c.volumeController.ExtendVolume(opt)
 	if err != nil {
@@ -298,7 +333,9 @@ func (c *Controller) ExtendVolume(contx context.Context, opt *pb.ExtendVolumeOpt
 	// Update the volume data in database.
 	result.Size = newSize
 	result.PoolId, result.ProfileId = opt.GetPoolId(), opt.GetProfileId()
-	db.C.UpdateStatus(ctx, result, model.VolumeAvailable)
+	db.C.UpdateStatus(ctx, result, status)
+
+	c.expandVolumeAttachments(ctx, atcs)
 
 	volBody, _ := json.Marshal(result)
 	var errChan = make(chan error, 1)
@@ -313,6 +350,62 @@ func (c *Controller) ExtendVolume(contx context.Context, opt *pb.ExtendVolumeOpt
 	return pb.GenericResponseResult(result), nil
 }
 
+// UpdateVolumeQos implements pb.ControllerServer.UpdateVolumeQos
+func (c *Controller) UpdateVolumeQos(contx context.Context, opt *pb.UpdateVolumeQosOpts) (*pb.GenericResponse, error) {
+
+	log.Info("Controller server receive update volume qos request, vr =", opt)
+
+	ctx := osdsCtx.NewContextFromJson(opt.GetContext())
+	vol, err := db.C.GetVolume(ctx, opt.Id)
+	if err != nil {
+		log.Error("get volume failed in update volume qos method: ", err)
+		return pb.GenericResponseError(err), err
+	}
+	opt.Metadata = utils.MergeStringMaps(opt.Metadata, vol.Metadata)
+
+	pool, err := db.C.GetPool(ctx, vol.PoolId)
+	if err != nil {
+		log.Error("get pool failed in update volume qos method: ", err)
+		return pb.GenericResponseError(err), err
+	}
+	opt.PoolId = pool.Id
+	opt.PoolName = pool.Name
+
+	dockInfo, err := db.C.GetDockByPoolId(ctx, vol.PoolId)
+	if err != nil {
+		log.Error("when search dock in db by pool id: ", err)
+		return pb.GenericResponseError(err), err
+	}
+	c.volumeController.SetDock(dockInfo)
+	opt.DriverName = dockInfo.DriverName
+	opt.BackendName = dockInfo.BackendName
+
+	if _, err = c.volumeController.UpdateVolumeQos(opt); err != nil {
+		log.Error("update volume qos failed: ", err)
+		return pb.GenericResponseError(err), err
+	}
+
+	vol.Qos = &model.QosSpec{
+		MaxIOPS: opt.GetQos().GetMaxIOPS(),
+		MaxBWS:  opt.GetQos().GetMaxBWS(),
+	}
+	if _, err = db.C.UpdateVolume(ctx, vol); err != nil {
+		log.Error("update volume qos in db failed: ", err)
+		return pb.GenericResponseError(err), err
+	}
+
+	// The volume in use keeps being throttled by the hosts which it is
+	// attached to, if its driver relies on them to enforce the limits.
+	atcs, err := db.C.ListAttachmentsByVolumeId(ctx, vol.Id)
+	if err != nil {
+		log.Error("list attachments failed in update volume qos method: ", err)
+		return pb.GenericResponseError(err), err
+	}
+	c.throttleVolumeAttachments(ctx, atcs, vol.Qos)
+
+	return pb.GenericResponseResult(vol), nil
+}
+
 // CreateVolumeAttachment implements pb.ControllerServer.CreateVolumeAttachment
 func (c *Controller) CreateVolumeAttachment(contx context.Context, opt *pb.CreateVolumeAttachmentOpts) (*pb.GenericResponse, error) {
 
@@ -326,6 +419,7 @@ func (c *Controller) CreateVolumeAttachment(contx context.Context, opt *pb.Creat
 		return pb.GenericResponseError(err), err
 	}
 	opt.Metadata = utils.MergeStringMaps(opt.Metadata, vol.Metadata)
+	opt.Qos = qosOpt(vol.Qos)
 
 	pol, err := db.C.GetPool(ctx, vol.PoolId)
 	if err != nil {
@@ -348,6 +442,7 @@ func (c *Controller) CreateVolumeAttachment(contx context.Context, opt *pb.Creat
 	}
 	c.volumeController.SetDock(dockInfo)
 	opt.DriverName = dockInfo.DriverName
+	opt.BackendName = dockInfo.BackendName
 
 	result, err := c.volumeController.CreateVolumeAttachment(opt)
 	if err != nil {
@@ -356,6 +451,28 @@ func (c *Controller) CreateVolumeAttachment(contx context.Context, opt *pb.Creat
 	}
 
 	result.AccessProtocol = protocol
+	if opt.GetMountpoint() != "" {
+		if err = c.mountVolumeAttachment(ctx, opt, result); err != nil {
+			log.Error("mount volume attachment failed: ", err)
+			// Roll back the export of the volume, which is useless to the
+			// host without being mounted.
+			c.volumeController.SetDock(dockInfo)
+			if err := c.volumeController.DeleteVolumeAttachment(&pb.DeleteVolumeAttachmentOpts{
+				Id:             opt.Id,
+				VolumeId:       opt.VolumeId,
+				HostInfo:       opt.HostInfo,
+				Metadata:       opt.Metadata,
+				Context:        opt.Context,
+				AccessProtocol: protocol,
+				DriverName:     dockInfo.DriverName,
+				BackendName:    dockInfo.BackendName,
+			}); err != nil {
+				log.Error("terminate connection of volume attachment failed: ", err)
+			}
+			db.UpdateVolumeAttachmentStatus(ctx, db.C, opt.Id, model.VolumeAttachError)
+			return pb.GenericResponseError(err), err
+		}
+	}
 	db.C.UpdateStatus(ctx, result, model.VolumeAttachAvailable)
 	db.UpdateVolumeStatus(ctx, db.C, vol.Id, model.VolumeInUse)
 
@@ -376,6 +493,20 @@ func (c *Controller) DeleteVolumeAttachment(contx context.Context, opt *pb.Delet
 	}
 	opt.Metadata = utils.MergeStringMaps(opt.Metadata, vol.Metadata)
 
+	atc, err := db.C.GetVolumeAttachment(ctx, opt.Id)
+	if err != nil {
+		log.Error("get volume attachment failed in delete volume attachment method: ", err)
+		db.UpdateVolumeAttachmentStatus(ctx, db.C, opt.Id, model.VolumeAttachErrorDeleting)
+		return pb.GenericResponseError(err), err
+	}
+	if atc.MountStatus == model.VolumeAttachMounted {
+		if err = c.unmountVolumeAttachment(ctx, atc); err != nil {
+			log.Error("unmount volume attachment failed: ", err)
+			db.UpdateVolumeAttachmentStatus(ctx, db.C, opt.Id, model.VolumeAttachErrorDeleting)
+			return pb.GenericResponseError(err), err
+		}
+	}
+
 	dockInfo, err := db.C.GetDockByPoolId(ctx, vol.PoolId)
 	if err != nil {
 		log.Error("when search supported dock resource: ", err)
@@ -384,6 +515,7 @@ func (c *Controller) DeleteVolumeAttachment(contx context.Context, opt *pb.Delet
 	}
 	c.volumeController.SetDock(dockInfo)
 	opt.DriverName = dockInfo.DriverName
+	opt.BackendName = dockInfo.BackendName
 
 	if err = c.volumeController.DeleteVolumeAttachment(opt); err != nil {
 		db.UpdateVolumeAttachmentStatus(ctx, db.C, opt.Id, model.VolumeAttachErrorDeleting)
@@ -400,6 +532,314 @@ func (c *Controller) DeleteVolumeAttachment(contx context.Context, opt *pb.Delet
 	return pb.GenericResponseResult(nil), nil
 }
 
+// CreateSnapshotAttachment implements pb.ControllerServer.CreateSnapshotAttachment
+func (c *Controller) CreateSnapshotAttachment(contx context.Context, opt *pb.CreateSnapshotAttachmentOpts) (*pb.GenericResponse, error) {
+
+	log.Info("Controller server receive create snapshot attachment request, vr =", opt)
+
+	ctx := osdsCtx.NewContextFromJson(opt.GetContext())
+	snap, err := db.C.GetVolumeSnapshot(ctx, opt.SnapshotId)
+	if err != nil {
+		log.Error("get snapshot failed in create snapshot attachment method: ", err)
+		db.UpdateSnapshotAttachmentStatus(ctx, db.C, opt.Id, model.VolumeAttachError)
+		return pb.GenericResponseError(err), err
+	}
+	opt.Metadata = utils.MergeStringMaps(opt.Metadata, snap.Metadata)
+
+	vol, err := db.C.GetVolume(ctx, snap.VolumeId)
+	if err != nil {
+		log.Error("get volume failed in create snapshot attachment method: ", err)
+		db.UpdateSnapshotAttachmentStatus(ctx, db.C, opt.Id, model.VolumeAttachError)
+		return pb.GenericResponseError(err), err
+	}
+	pol, err := db.C.GetPool(ctx, vol.PoolId)
+	if err != nil {
+		log.Error("get pool failed in create snapshot attachment method: ", err)
+		db.UpdateSnapshotAttachmentStatus(ctx, db.C, opt.Id, model.VolumeAttachError)
+		return pb.GenericResponseError(err), err
+	}
+	var protocol = pol.Extras.IOConnectivity.AccessProtocol
+	if protocol == "" {
+		// Default protocol is iscsi
+		protocol = "iscsi"
+	}
+	opt.AccessProtocol = protocol
+
+	dockInfo, err := db.C.GetDock(ctx, pol.DockId)
+	if err != nil {
+		log.Error("when search supported dock resource:", err)
+		db.UpdateSnapshotAttachmentStatus(ctx, db.C, opt.Id, model.VolumeAttachError)
+		return pb.GenericResponseError(err), err
+	}
+	c.volumeController.SetDock(dockInfo)
+	opt.DriverName = dockInfo.DriverName
+	opt.BackendName = dockInfo.BackendName
+
+	result, err := c.volumeController.CreateSnapshotAttachment(opt)
+	if err != nil {
+		db.UpdateSnapshotAttachmentStatus(ctx, db.C, opt.Id, model.VolumeAttachError)
+		return pb.GenericResponseError(err), err
+	}
+
+	result.AccessProtocol = protocol
+	db.C.UpdateStatus(ctx, result, model.VolumeAttachAvailable)
+
+	return pb.GenericResponseResult(result), nil
+}
+
+// DeleteSnapshotAttachment implements pb.ControllerServer.DeleteSnapshotAttachment
+func (c *Controller) DeleteSnapshotAttachment(contx context.Context, opt *pb.DeleteSnapshotAttachmentOpts) (*pb.GenericResponse, error) {
+
+	log.Info("Controller server receive delete snapshot attachment request, vr =", opt)
+
+	ctx := osdsCtx.NewContextFromJson(opt.GetContext())
+	snap, err := db.C.GetVolumeSnapshot(ctx, opt.SnapshotId)
+	if err != nil {
+		log.Error("get snapshot failed in delete snapshot attachment method: ", err)
+		db.UpdateSnapshotAttachmentStatus(ctx, db.C, opt.Id, model.VolumeAttachErrorDeleting)
+		return pb.GenericResponseError(err), err
+	}
+	opt.Metadata = utils.MergeStringMaps(opt.Metadata, snap.Metadata)
+
+	vol, err := db.C.GetVolume(ctx, snap.VolumeId)
+	if err != nil {
+		log.Error("get volume failed in delete snapshot attachment method: ", err)
+		db.UpdateSnapshotAttachmentStatus(ctx, db.C, opt.Id, model.VolumeAttachErrorDeleting)
+		return pb.GenericResponseError(err), err
+	}
+	dockInfo, err := db.C.GetDockByPoolId(ctx, vol.PoolId)
+	if err != nil {
+		log.Error("when search supported dock resource: ", err)
+		db.UpdateSnapshotAttachmentStatus(ctx, db.C, opt.Id, model.VolumeAttachErrorDeleting)
+		return pb.GenericResponseError(err), err
+	}
+	c.volumeController.SetDock(dockInfo)
+	opt.DriverName = dockInfo.DriverName
+	opt.BackendName = dockInfo.BackendName
+
+	if err = c.volumeController.DeleteSnapshotAttachment(opt); err != nil {
+		db.UpdateSnapshotAttachmentStatus(ctx, db.C, opt.Id, model.VolumeAttachErrorDeleting)
+		return pb.GenericResponseError(err), err
+	}
+	if err = db.C.DeleteSnapshotAttachment(ctx, opt.Id); err != nil {
+		log.Error("error occurred in controller module when delete snapshot attachment in db: ", err)
+		db.UpdateSnapshotAttachmentStatus(ctx, db.C, opt.Id, model.VolumeAttachErrorDeleting)
+		return pb.GenericResponseError(err), err
+	}
+
+	return pb.GenericResponseResult(nil), nil
+}
+
+// attacherDockOf returns the attacher dock running on the host.
+func attacherDockOf(ctx *osdsCtx.Context, host string) (*model.DockSpec, error) {
+	dcks, err := db.C.ListDocks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, dck := range dcks {
+		if dck.Type == model.DockTypeAttacher && dck.NodeId == host {
+			return dck, nil
+		}
+	}
+	return nil, fmt.Errorf("can not find attacher dock on host %s", host)
+}
+
+// mountVolumeAttachment attaches the exported volume to the host through the
+// attacher dock of the host, which formats the device if it is blank and
+// mounts it on the mountpoint of the attachment.
+func (c *Controller) mountVolumeAttachment(ctx *osdsCtx.Context, opt *pb.CreateVolumeAttachmentOpts, atc *model.VolumeAttachmentSpec) error {
+	attacherDock, err := attacherDockOf(ctx, opt.GetHostInfo().GetHost())
+	if err != nil {
+		return err
+	}
+	connData, _ := json.Marshal(atc.ConnectionData)
+
+	c.volumeController.SetDock(attacherDock)
+	if _, err = c.volumeController.AttachVolume(&pb.AttachVolumeOpts{
+		AccessProtocol: atc.AccessProtocol,
+		ConnectionData: string(connData),
+		Metadata:       opt.GetMetadata(),
+		Context:        opt.GetContext(),
+		FsType:         opt.GetFsType(),
+		Mountpoint:     opt.GetMountpoint(),
+		MountOptions:   opt.GetMountOptions(),
+	}); err != nil {
+		return err
+	}
+
+	atc.Mountpoint = opt.GetMountpoint()
+	atc.FsType = opt.GetFsType()
+	atc.MountOptions = opt.GetMountOptions()
+	atc.MountStatus = model.VolumeAttachMounted
+	return nil
+}
+
+// unmountVolumeAttachment unmounts the volume from the mountpoint of the
+// attachment and detaches it from the host through the attacher dock of the
+// host.
+func (c *Controller) unmountVolumeAttachment(ctx *osdsCtx.Context, atc *model.VolumeAttachmentSpec) error {
+	attacherDock, err := attacherDockOf(ctx, atc.Host)
+	if err != nil {
+		return err
+	}
+	connData, _ := json.Marshal(atc.ConnectionData)
+
+	c.volumeController.SetDock(attacherDock)
+	if err = c.volumeController.DetachVolume(&pb.DetachVolumeOpts{
+		AccessProtocol: atc.AccessProtocol,
+		ConnectionData: string(connData),
+		Metadata:       atc.Metadata,
+		Context:        ctx.ToJson(),
+		Mountpoint:     atc.Mountpoint,
+	}); err != nil {
+		return err
+	}
+
+	atc.MountStatus = model.VolumeAttachUnmounted
+	_, err = db.C.UpdateVolumeAttachment(ctx, atc.Id, atc)
+	return err
+}
+
+// expandVolumeAttachments asks the attacher docks of the hosts which the
+// volume is attached to expand it, so that the hosts see its new size, and
+// the file system on it is grown if it's mounted. Only the attachments which
+// are in use on the hosts are expanded, a failure is recorded in the status
+// of the attachment while a success keeps its status, unless it failed to be
+// expanded before.
+func (c *Controller) expandVolumeAttachments(ctx *osdsCtx.Context, atcs []*model.VolumeAttachmentSpec) {
+	for _, atc := range atcs {
+		switch atc.Status {
+		case model.VolumeAttachAvailable, model.VolumeAttachErrorExpanding, model.VolumeAttachErrorThrottling:
+		default:
+			log.Infof("skip expanding volume attachment %s in status %s", atc.Id, atc.Status)
+			continue
+		}
+		if err := c.expandVolumeAttachment(ctx, atc); err != nil {
+			log.Errorf("expand volume attachment %s failed: %v", atc.Id, err)
+			db.C.UpdateStatus(ctx, atc, model.VolumeAttachErrorExpanding)
+			continue
+		}
+		if atc.Status == model.VolumeAttachErrorExpanding {
+			db.C.UpdateStatus(ctx, atc, model.VolumeAttachAvailable)
+		}
+	}
+}
+
+func (c *Controller) expandVolumeAttachment(ctx *osdsCtx.Context, atc *model.VolumeAttachmentSpec) error {
+	attacherDock, err := attacherDockOf(ctx, atc.Host)
+	if err != nil {
+		return err
+	}
+	connData, _ := json.Marshal(atc.ConnectionData)
+
+	var mountpoint string
+	if atc.MountStatus == model.VolumeAttachMounted {
+		mountpoint = atc.Mountpoint
+	}
+	c.volumeController.SetDock(attacherDock)
+	return c.volumeController.ExpandVolume(&pb.ExpandVolumeOpts{
+		AccessProtocol: atc.AccessProtocol,
+		ConnectionData: string(connData),
+		Metadata:       atc.Metadata,
+		Context:        ctx.ToJson(),
+		Mountpoint:     mountpoint,
+	})
+}
+
+// throttleVolumeAttachments asks the attacher docks of the hosts which the
+// volume is attached to throttle its I/O to the limits of qos. Only the
+// attachments whose connection data carries the limits are throttled, which
+// means the driver of the volume relies on the hosts to enforce them. The
+// result is recorded in the status of each attachment.
+func (c *Controller) throttleVolumeAttachments(ctx *osdsCtx.Context, atcs []*model.VolumeAttachmentSpec, qos *model.QosSpec) {
+	for _, atc := range atcs {
+		if _, ok := atc.ConnectionData[connector.MaxIOPS]; !ok {
+			continue
+		}
+		atc.ConnectionData[connector.MaxIOPS] = qos.MaxIOPS
+		atc.ConnectionData[connector.MaxBWS] = qos.MaxBWS
+
+		var status = model.VolumeAttachAvailable
+		if err := c.throttleVolumeAttachment(ctx, atc); err != nil {
+			log.Errorf("throttle volume attachment %s failed: %v", atc.Id, err)
+			status = model.VolumeAttachErrorThrottling
+		}
+		db.C.UpdateStatus(ctx, atc, status)
+	}
+}
+
+func (c *Controller) throttleVolumeAttachment(ctx *osdsCtx.Context, atc *model.VolumeAttachmentSpec) error {
+	attacherDock, err := attacherDockOf(ctx, atc.Host)
+	if err != nil {
+		return err
+	}
+	connData, _ := json.Marshal(atc.ConnectionData)
+
+	c.volumeController.SetDock(attacherDock)
+	return c.volumeController.ThrottleVolume(&pb.ThrottleVolumeOpts{
+		AccessProtocol: atc.AccessProtocol,
+		ConnectionData: string(connData),
+		Metadata:       atc.Metadata,
+		Context:        ctx.ToJson(),
+	})
+}
+
+// freezeVolumeAttachments asks the attacher docks of the hosts which the
+// volumes are mounted on to freeze the file systems on them, and returns the
+// frozen attachments. If any of them fails to be frozen, the ones frozen
+// already are thawed. The volumes attached without being mounted can not be
+// frozen, so none is frozen and an error is returned for them.
+func (c *Controller) freezeVolumeAttachments(ctx *osdsCtx.Context, atcs []*model.VolumeAttachmentSpec) ([]*model.VolumeAttachmentSpec, error) {
+	for _, atc := range atcs {
+		if atc.MountStatus != model.VolumeAttachMounted {
+			return nil, fmt.Errorf("volume %s is attached by %s without being mounted, which can not be quiesced", atc.VolumeId, atc.Id)
+		}
+	}
+
+	var frozen []*model.VolumeAttachmentSpec
+	for _, atc := range atcs {
+		if err := c.freezeVolumeAttachment(ctx, atc, false); err != nil {
+			c.thawVolumeAttachments(ctx, frozen)
+			return nil, fmt.Errorf("freeze volume attachment %s failed: %v", atc.Id, err)
+		}
+		frozen = append(frozen, atc)
+	}
+	return frozen, nil
+}
+
+// thawVolumeAttachments thaws the file systems frozen by
+// freezeVolumeAttachments.
+func (c *Controller) thawVolumeAttachments(ctx *osdsCtx.Context, atcs []*model.VolumeAttachmentSpec) {
+	for _, atc := range atcs {
+		if err := c.freezeVolumeAttachment(ctx, atc, true); err != nil {
+			log.Errorf("thaw volume attachment %s failed: %v", atc.Id, err)
+		}
+	}
+}
+
+func (c *Controller) freezeVolumeAttachment(ctx *osdsCtx.Context, atc *model.VolumeAttachmentSpec, thaw bool) error {
+	attacherDock, err := attacherDockOf(ctx, atc.Host)
+	if err != nil {
+		return err
+	}
+
+	c.volumeController.SetDock(attacherDock)
+	return c.volumeController.FreezeVolume(&pb.FreezeVolumeOpts{
+		Mountpoint: atc.Mountpoint,
+		Thaw:       thaw,
+		Metadata:   atc.Metadata,
+		Context:    ctx.ToJson(),
+	})
+}
+
+// qosOpt converts the limits of I/O of volume to the ones sent to the docks.
+func qosOpt(qos *model.QosSpec) *pb.Qos {
+	if qos == nil {
+		return nil
+	}
+	return &pb.Qos{MaxIOPS: qos.MaxIOPS, MaxBWS: qos.MaxBWS}
+}
+
 // CreateVolumeSnapshot implements pb.ControllerServer.CreateVolumeSnapshot
 func (c *Controller) CreateVolumeSnapshot(contx context.Context, opt *pb.CreateVolumeSnapshotOpts) (*pb.GenericResponse, error) {
 
@@ -440,6 +880,7 @@ func (c *Controller) CreateVolumeSnapshot(contx context.Context, opt *pb.CreateV
 	}
 	c.volumeController.SetDock(dockInfo)
 	opt.DriverName = dockInfo.DriverName
+	opt.BackendName = dockInfo.BackendName
 
 	result, err := c.volumeController.CreateVolumeSnapshot(opt)
 	if err != nil {
@@ -473,6 +914,7 @@ func (c *Controller) DeleteVolumeSnapshot(contx context.Context, opt *pb.DeleteV
 	}
 	c.volumeController.SetDock(dockInfo)
 	opt.DriverName = dockInfo.DriverName
+	opt.BackendName = dockInfo.BackendName
 
 	if err = c.volumeController.DeleteVolumeSnapshot(opt); err != nil {
 		log.Error("error occurred in controller module when delete volume snapshot: ", err)
@@ -488,6 +930,39 @@ func (c *Controller) DeleteVolumeSnapshot(contx context.Context, opt *pb.DeleteV
 	return pb.GenericResponseResult(nil), nil
 }
 
+// VerifyVolumeSnapshot implements pb.ControllerServer.VerifyVolumeSnapshot
+func (c *Controller) VerifyVolumeSnapshot(contx context.Context, opt *pb.VerifyVolumeSnapshotOpts) (*pb.GenericResponse, error) {
+
+	log.Info("Controller server receive verify volume snapshot request, vr =", opt)
+
+	ctx := osdsCtx.NewContextFromJson(opt.GetContext())
+	vol, err := db.C.GetVolume(ctx, opt.VolumeId)
+	if err != nil {
+		log.Error("get volume failed in verify volume snapshot method: ", err)
+		db.UpdateVolumeSnapshotStatus(ctx, db.C, opt.Id, model.VolumeSnapErrorVerifying)
+		return pb.GenericResponseError(err), err
+	}
+
+	dockInfo, err := db.C.GetDockByPoolId(ctx, vol.PoolId)
+	if err != nil {
+		log.Error("when search supported dock resource: ", err)
+		db.UpdateVolumeSnapshotStatus(ctx, db.C, opt.Id, model.VolumeSnapErrorVerifying)
+		return pb.GenericResponseError(err), err
+	}
+	c.volumeController.SetDock(dockInfo)
+	opt.DriverName = dockInfo.DriverName
+	opt.BackendName = dockInfo.BackendName
+
+	if err = c.volumeController.VerifyVolumeSnapshot(opt); err != nil {
+		log.Error("error occurred in controller module when verify volume snapshot: ", err)
+		db.UpdateVolumeSnapshotStatus(ctx, db.C, opt.Id, model.VolumeSnapErrorVerifying)
+		return pb.GenericResponseError(err), err
+	}
+
+	db.UpdateVolumeSnapshotStatus(ctx, db.C, opt.Id, model.VolumeSnapAvailable)
+	return pb.GenericResponseResult(nil), nil
+}
+
 // CreateReplication implements pb.ControllerServer.CreateReplication
 func (c *Controller) CreateReplication(contx context.Context, opt *pb.CreateReplicationOpts) (*pb.GenericResponse, error) {
 	// TODO: Get profile and do some policy action.
@@ -716,6 +1191,7 @@ func (c *Controller) CreateVolumeGroup(contx context.Context, opt *pb.CreateVolu
 	}
 	c.volumeController.SetDock(dockInfo)
 	opt.DriverName = dockInfo.DriverName
+	opt.BackendName = dockInfo.BackendName
 
 	result, err := c.volumeController.CreateVolumeGroup(opt)
 	if err != nil {
@@ -766,6 +1242,7 @@ func (c *Controller) UpdateVolumeGroup(contx context.Context, opt *pb.UpdateVolu
 	}
 	c.volumeController.SetDock(dock)
 	opt.DriverName = dock.DriverName
+	opt.BackendName = dock.BackendName
 
 	vg, err := c.volumeController.UpdateVolumeGroup(opt)
 	if err != nil {
@@ -833,6 +1310,7 @@ func (c *Controller) DeleteVolumeGroup(contx context.Context, opt *pb.DeleteVolu
 	}
 	c.volumeController.SetDock(dock)
 	opt.DriverName = dock.DriverName
+	opt.BackendName = dock.BackendName
 
 	if err = c.volumeController.DeleteVolumeGroup(opt); err != nil {
 		log.Error("when delete volume group: ", err)
@@ -849,3 +1327,143 @@ func (c *Controller) DeleteVolumeGroup(contx context.Context, opt *pb.DeleteVolu
 
 	return pb.GenericResponseResult(nil), nil
 }
+
+// CreateVolumeGroupSnapshot implements pb.ControllerServer.CreateVolumeGroupSnapshot
+func (c *Controller) CreateVolumeGroupSnapshot(contx context.Context, opt *pb.CreateVolumeGroupSnapshotOpts) (*pb.GenericResponse, error) {
+
+	log.Info("Controller server receive create volume group snapshot request, vr =", opt)
+
+	ctx := osdsCtx.NewContextFromJson(opt.GetContext())
+	var snapIds []string
+	for _, snapOpt := range opt.Snapshots {
+		snapIds = append(snapIds, snapOpt.Id)
+	}
+
+	vg, err := db.C.GetVolumeGroup(ctx, opt.GroupId)
+	if err != nil {
+		log.Error("get volume group failed in create volume group snapshot method: ", err)
+		updateSnapshotsStatus(ctx, snapIds, model.VolumeSnapError)
+		return pb.GenericResponseError(err), err
+	}
+	var atcs []*model.VolumeAttachmentSpec
+	for _, snapOpt := range opt.Snapshots {
+		vol, err := db.C.GetVolume(ctx, snapOpt.VolumeId)
+		if err != nil {
+			log.Error("get volume failed in create volume group snapshot method: ", err)
+			updateSnapshotsStatus(ctx, snapIds, model.VolumeSnapError)
+			return pb.GenericResponseError(err), err
+		}
+		snapOpt.Size = vol.Size
+		snapOpt.Metadata = utils.MergeStringMaps(snapOpt.Metadata, vol.Metadata)
+
+		volAtcs, err := db.C.ListAttachmentsByVolumeId(ctx, vol.Id)
+		if err != nil {
+			log.Error("list volume attachments failed in create volume group snapshot method: ", err)
+			updateSnapshotsStatus(ctx, snapIds, model.VolumeSnapError)
+			return pb.GenericResponseError(err), err
+		}
+		atcs = append(atcs, volAtcs...)
+	}
+	opt.PoolId = vg.PoolId
+
+	// The file systems on the volumes are frozen until all snapshots of the
+	// group are taken, so that they are consistent with each other.
+	frozen, err := c.freezeVolumeAttachments(ctx, atcs)
+	if err != nil {
+		log.Error("freeze volumes failed in create volume group snapshot method: ", err)
+		updateSnapshotsStatus(ctx, snapIds, model.VolumeSnapError)
+		return pb.GenericResponseError(err), err
+	}
+	defer c.thawVolumeAttachments(ctx, frozen)
+
+	dockInfo, err := db.C.GetDockByPoolId(ctx, vg.PoolId)
+	if err != nil {
+		log.Error("when search supported dock resource: ", err)
+		updateSnapshotsStatus(ctx, snapIds, model.VolumeSnapError)
+		return pb.GenericResponseError(err), err
+	}
+	c.volumeController.SetDock(dockInfo)
+	opt.DriverName = dockInfo.DriverName
+	opt.BackendName = dockInfo.BackendName
+
+	result, err := c.volumeController.CreateVolumeGroupSnapshot(opt)
+	if err != nil {
+		updateSnapshotsStatus(ctx, snapIds, model.VolumeSnapError)
+		return pb.GenericResponseError(err), err
+	}
+
+	for _, snp := range result {
+		db.C.UpdateStatus(ctx, snp, model.VolumeSnapAvailable)
+	}
+	// Record the group snapshot in the group, so that the group can not be
+	// deleted until all of its snapshots are deleted.
+	vg.GroupSnapshots = append(vg.GroupSnapshots, opt.Id)
+	if _, err = db.C.UpdateVolumeGroup(ctx, vg); err != nil {
+		log.Error("update volume group failed in create volume group snapshot method: ", err)
+		return pb.GenericResponseError(err), err
+	}
+	return pb.GenericResponseResult(result), nil
+}
+
+// DeleteVolumeGroupSnapshot implements pb.ControllerServer.DeleteVolumeGroupSnapshot
+func (c *Controller) DeleteVolumeGroupSnapshot(contx context.Context, opt *pb.DeleteVolumeGroupSnapshotOpts) (*pb.GenericResponse, error) {
+
+	log.Info("Controller server receive delete volume group snapshot request, vr =", opt)
+
+	ctx := osdsCtx.NewContextFromJson(opt.GetContext())
+	var snapIds []string
+	for _, snapOpt := range opt.Snapshots {
+		snapIds = append(snapIds, snapOpt.Id)
+	}
+
+	vg, err := db.C.GetVolumeGroup(ctx, opt.GroupId)
+	if err != nil {
+		log.Error("get volume group failed in delete volume group snapshot method: ", err)
+		updateSnapshotsStatus(ctx, snapIds, model.VolumeSnapErrorDeleting)
+		return pb.GenericResponseError(err), err
+	}
+	opt.PoolId = vg.PoolId
+
+	dockInfo, err := db.C.GetDockByPoolId(ctx, vg.PoolId)
+	if err != nil {
+		log.Error("when search supported dock resource: ", err)
+		updateSnapshotsStatus(ctx, snapIds, model.VolumeSnapErrorDeleting)
+		return pb.GenericResponseError(err), err
+	}
+	c.volumeController.SetDock(dockInfo)
+	opt.DriverName = dockInfo.DriverName
+	opt.BackendName = dockInfo.BackendName
+
+	if err = c.volumeController.DeleteVolumeGroupSnapshot(opt); err != nil {
+		log.Error("when delete volume group snapshot: ", err)
+		updateSnapshotsStatus(ctx, snapIds, model.VolumeSnapErrorDeleting)
+		return pb.GenericResponseError(err), err
+	}
+
+	for _, snapId := range snapIds {
+		if err = db.C.DeleteVolumeSnapshot(ctx, snapId); err != nil {
+			log.Error("error occurred in controller module when delete volume snapshot in db: ", err)
+			db.UpdateVolumeSnapshotStatus(ctx, db.C, snapId, model.VolumeSnapErrorDeleting)
+			return pb.GenericResponseError(err), err
+		}
+	}
+
+	var groupSnapshots = []string{}
+	for _, id := range vg.GroupSnapshots {
+		if id != opt.Id {
+			groupSnapshots = append(groupSnapshots, id)
+		}
+	}
+	vg.GroupSnapshots = groupSnapshots
+	if _, err = db.C.UpdateVolumeGroup(ctx, vg); err != nil {
+		log.Error("update volume group failed in delete volume group snapshot method: ", err)
+		return pb.GenericResponseError(err), err
+	}
+	return pb.GenericResponseResult(nil), nil
+}
+
+func updateSnapshotsStatus(ctx *osdsCtx.Context, snapIds []string, status string) {
+	for _, snapId := range snapIds {
+		db.UpdateVolumeSnapshotStatus(ctx, db.C, snapId, status)
+	}
+}
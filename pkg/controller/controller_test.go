@@ -26,6 +26,7 @@ import (
 	pb "github.com/opensds/opensds/pkg/model/proto"
 	. "github.com/opensds/opensds/testutils/collection"
 	dbtest "github.com/opensds/opensds/testutils/db/testing"
+	"github.com/stretchr/testify/mock"
 	"golang.org/x/net/context"
 )
 
@@ -86,6 +87,13 @@ func NewFakeVolumeController() volume.Controller {
 }
 
 type fakeVolumeController struct {
+	attachOpt    *pb.AttachVolumeOpts
+	detachOpt    *pb.DetachVolumeOpts
+	deleteAtcOpt *pb.DeleteVolumeAttachmentOpts
+	expandOpts   []*pb.ExpandVolumeOpts
+	qosOpt       *pb.UpdateVolumeQosOpts
+	throttleOpts []*pb.ThrottleVolumeOpts
+	freezeOpts   []*pb.FreezeVolumeOpts
 }
 
 func (fvc *fakeVolumeController) CreateVolume(*pb.CreateVolumeOpts) (*model.VolumeSpec, error) {
@@ -100,11 +108,25 @@ func (fvc *fakeVolumeController) ExtendVolume(*pb.ExtendVolumeOpts) (*model.Volu
 	return &SampleVolumes[0], nil
 }
 
+func (fvc *fakeVolumeController) UpdateVolumeQos(opt *pb.UpdateVolumeQosOpts) (*model.VolumeSpec, error) {
+	fvc.qosOpt = opt
+	return &SampleVolumes[0], nil
+}
+
 func (fvc *fakeVolumeController) CreateVolumeAttachment(*pb.CreateVolumeAttachmentOpts) (*model.VolumeAttachmentSpec, error) {
 	return &SampleAttachments[0], nil
 }
 
-func (fvc *fakeVolumeController) DeleteVolumeAttachment(*pb.DeleteVolumeAttachmentOpts) error {
+func (fvc *fakeVolumeController) DeleteVolumeAttachment(opt *pb.DeleteVolumeAttachmentOpts) error {
+	fvc.deleteAtcOpt = opt
+	return nil
+}
+
+func (fvc *fakeVolumeController) CreateSnapshotAttachment(*pb.CreateSnapshotAttachmentOpts) (*model.SnapshotAttachmentSpec, error) {
+	return &SampleSnapshotAttachments[0], nil
+}
+
+func (fvc *fakeVolumeController) DeleteSnapshotAttachment(*pb.DeleteSnapshotAttachmentOpts) error {
 	return nil
 }
 
@@ -116,11 +138,32 @@ func (fvc *fakeVolumeController) DeleteVolumeSnapshot(*pb.DeleteVolumeSnapshotOp
 	return nil
 }
 
-func (fvc *fakeVolumeController) AttachVolume(*pb.AttachVolumeOpts) (string, error) {
+func (fvc *fakeVolumeController) VerifyVolumeSnapshot(*pb.VerifyVolumeSnapshotOpts) error {
+	return nil
+}
+
+func (fvc *fakeVolumeController) AttachVolume(opt *pb.AttachVolumeOpts) (string, error) {
+	fvc.attachOpt = opt
 	return "", nil
 }
 
-func (fvc *fakeVolumeController) DetachVolume(*pb.DetachVolumeOpts) error {
+func (fvc *fakeVolumeController) DetachVolume(opt *pb.DetachVolumeOpts) error {
+	fvc.detachOpt = opt
+	return nil
+}
+
+func (fvc *fakeVolumeController) ExpandVolume(opt *pb.ExpandVolumeOpts) error {
+	fvc.expandOpts = append(fvc.expandOpts, opt)
+	return nil
+}
+
+func (fvc *fakeVolumeController) ThrottleVolume(opt *pb.ThrottleVolumeOpts) error {
+	fvc.throttleOpts = append(fvc.throttleOpts, opt)
+	return nil
+}
+
+func (fvc *fakeVolumeController) FreezeVolume(opt *pb.FreezeVolumeOpts) error {
+	fvc.freezeOpts = append(fvc.freezeOpts, opt)
 	return nil
 }
 
@@ -155,6 +198,23 @@ func (fvc *fakeVolumeController) UpdateVolumeGroup(*pb.UpdateVolumeGroupOpts) (*
 func (fvc *fakeVolumeController) DeleteVolumeGroup(*pb.DeleteVolumeGroupOpts) error {
 	return nil
 }
+
+func (fvc *fakeVolumeController) CreateVolumeGroupSnapshot(opt *pb.CreateVolumeGroupSnapshotOpts) ([]*model.VolumeSnapshotSpec, error) {
+	var snps []*model.VolumeSnapshotSpec
+	for _, snpOpt := range opt.GetSnapshots() {
+		snps = append(snps, &model.VolumeSnapshotSpec{
+			BaseModel:       &model.BaseModel{Id: snpOpt.GetId()},
+			VolumeId:        snpOpt.GetVolumeId(),
+			GroupSnapshotId: opt.GetId(),
+		})
+	}
+	return snps, nil
+}
+
+func (fvc *fakeVolumeController) DeleteVolumeGroupSnapshot(*pb.DeleteVolumeGroupSnapshotOpts) error {
+	return nil
+}
+
 func (fvc *fakeVolumeController) SetDock(dockInfo *model.DockSpec) { return }
 
 func TestCreateVolume(t *testing.T) {
@@ -273,6 +333,7 @@ func TestExtendVolume(t *testing.T) {
 	var vol2 = &SampleVolumes[0]
 	mockClient := new(dbtest.Client)
 	mockClient.On("GetVolume", c.NewAdminContext(), req.Id).Return(vol2, nil)
+	mockClient.On("ListAttachmentsByVolumeId", c.NewAdminContext(), req.Id).Return(nil, nil)
 	mockClient.On("GetPool", c.NewAdminContext(), req.PoolId).Return(&SamplePools[0], nil)
 	mockClient.On("GetDefaultProfile", c.NewAdminContext()).Return(&SampleProfiles[0], nil)
 	mockClient.On("GetProfile", c.NewAdminContext(), req.ProfileId).Return(&SampleProfiles[0], nil)
@@ -310,6 +371,138 @@ func TestExtendVolume(t *testing.T) {
 	}
 }
 
+func TestExtendAttachedVolume(t *testing.T) {
+	var req = &pb.ExtendVolumeOpts{
+		Id:        "bd5b12a8-a101-11e7-941e-d77981b584d8",
+		PoolId:    "084bf71e-a102-11e7-88a8-e31fe6d52248",
+		ProfileId: "1106b972-66ef-11e7-b172-db03f3689c9c",
+		Size:      int64(2),
+		Context:   c.NewAdminContext().ToJson(),
+	}
+	var vol = &SampleVolumes[0]
+	var atcs = []*model.VolumeAttachmentSpec{
+		{
+			BaseModel:      &model.BaseModel{Id: "attachment-01"},
+			VolumeId:       vol.Id,
+			HostInfo:       model.HostInfo{Host: "node-01"},
+			AccessProtocol: "iscsi",
+			Mountpoint:     "/mnt/vol01",
+			MountStatus:    model.VolumeAttachMounted,
+			Status:         model.VolumeAttachAvailable,
+		},
+		{
+			BaseModel:      &model.BaseModel{Id: "attachment-02"},
+			VolumeId:       vol.Id,
+			HostInfo:       model.HostInfo{Host: "node-02"},
+			AccessProtocol: "iscsi",
+			Status:         model.VolumeAttachAvailable,
+		},
+		{
+			BaseModel:      &model.BaseModel{Id: "attachment-03"},
+			VolumeId:       vol.Id,
+			HostInfo:       model.HostInfo{Host: "node-01"},
+			AccessProtocol: "iscsi",
+			Status:         model.VolumeAttachErrorExpanding,
+		},
+		{
+			BaseModel:      &model.BaseModel{Id: "attachment-04"},
+			VolumeId:       vol.Id,
+			HostInfo:       model.HostInfo{Host: "node-01"},
+			AccessProtocol: "iscsi",
+			Status:         model.VolumeAttachCreating,
+		},
+	}
+	mockClient := new(dbtest.Client)
+	mockClient.On("GetVolume", c.NewAdminContext(), req.Id).Return(vol, nil)
+	mockClient.On("ListAttachmentsByVolumeId", c.NewAdminContext(), req.Id).Return(atcs, nil)
+	mockClient.On("GetPool", c.NewAdminContext(), req.PoolId).Return(&SamplePools[0], nil)
+	mockClient.On("GetProfile", c.NewAdminContext(), req.ProfileId).Return(&SampleProfiles[0], nil)
+	mockClient.On("GetDockByPoolId", c.NewAdminContext(), req.PoolId).Return(&SampleDocks[0], nil)
+	mockClient.On("ListDocks", c.NewAdminContext()).Return([]*model.DockSpec{&SampleDocks[0], sampleAttacherDock}, nil)
+	mockClient.On("UpdateStatus", c.NewAdminContext(), vol, model.VolumeInUse).Return(nil)
+	mockClient.On("UpdateStatus", c.NewAdminContext(), atcs[1], model.VolumeAttachErrorExpanding).Return(nil)
+	mockClient.On("UpdateStatus", c.NewAdminContext(), atcs[2], model.VolumeAttachAvailable).Return(nil)
+	db.C = mockClient
+
+	var fvc = &fakeVolumeController{}
+	var ctrl = &Controller{
+		volumeController: fvc,
+	}
+	if _, err := ctrl.ExtendVolume(context.Background(), req); err != nil {
+		t.Errorf("Failed to extend volume: %v\n", err)
+	}
+
+	// Only node-01 runs the attacher dock, so the attachment on node-02
+	// fails to be expanded, and the one being created is skipped.
+	if len(fvc.expandOpts) != 2 {
+		t.Fatalf("Expected volume expanded 2 times, got %d\n", len(fvc.expandOpts))
+	}
+	if fvc.expandOpts[0].Mountpoint != atcs[0].Mountpoint {
+		t.Errorf("Expected file system on %s grown, got %+v\n", atcs[0].Mountpoint, fvc.expandOpts[0])
+	}
+	mockClient.AssertExpectations(t)
+	mockClient.AssertNotCalled(t, "UpdateStatus", c.NewAdminContext(), atcs[0], mock.Anything)
+	mockClient.AssertNotCalled(t, "UpdateStatus", c.NewAdminContext(), atcs[3], mock.Anything)
+}
+
+func TestUpdateVolumeQos(t *testing.T) {
+	var vol = SampleVolumes[0]
+	var req = &pb.UpdateVolumeQosOpts{
+		Id:      vol.Id,
+		Qos:     &pb.Qos{MaxIOPS: 1000, MaxBWS: 100},
+		Context: c.NewAdminContext().ToJson(),
+	}
+	var atcs = []*model.VolumeAttachmentSpec{
+		{
+			BaseModel:      &model.BaseModel{Id: "attachment-01"},
+			VolumeId:       vol.Id,
+			HostInfo:       model.HostInfo{Host: "node-01"},
+			AccessProtocol: "iscsi",
+			ConnectionData: map[string]interface{}{"maxIOPS": float64(0), "maxBWS": float64(0)},
+		},
+		{
+			BaseModel:      &model.BaseModel{Id: "attachment-02"},
+			VolumeId:       vol.Id,
+			HostInfo:       model.HostInfo{Host: "node-02"},
+			AccessProtocol: "iscsi",
+			ConnectionData: map[string]interface{}{},
+		},
+	}
+	mockClient := new(dbtest.Client)
+	mockClient.On("GetVolume", c.NewAdminContext(), req.Id).Return(&vol, nil)
+	mockClient.On("GetPool", c.NewAdminContext(), vol.PoolId).Return(&SamplePools[0], nil)
+	mockClient.On("GetDockByPoolId", c.NewAdminContext(), vol.PoolId).Return(&SampleDocks[0], nil)
+	mockClient.On("UpdateVolume", c.NewAdminContext(), &vol).Return(&vol, nil)
+	mockClient.On("ListAttachmentsByVolumeId", c.NewAdminContext(), req.Id).Return(atcs, nil)
+	mockClient.On("ListDocks", c.NewAdminContext()).Return([]*model.DockSpec{&SampleDocks[0], sampleAttacherDock}, nil)
+	mockClient.On("UpdateStatus", c.NewAdminContext(), atcs[0], model.VolumeAttachAvailable).Return(nil)
+	db.C = mockClient
+
+	var fvc = &fakeVolumeController{}
+	var ctrl = &Controller{
+		volumeController: fvc,
+	}
+	if _, err := ctrl.UpdateVolumeQos(context.Background(), req); err != nil {
+		t.Fatalf("Failed to update volume qos: %v\n", err)
+	}
+
+	if fvc.qosOpt.PoolId != SamplePools[0].Id {
+		t.Errorf("Expected qos updated on pool %s, got %+v\n", SamplePools[0].Id, fvc.qosOpt)
+	}
+	if expected := (model.QosSpec{MaxIOPS: 1000, MaxBWS: 100}); vol.Qos == nil || *vol.Qos != expected {
+		t.Errorf("Expected volume qos %+v, got %+v\n", expected, vol.Qos)
+	}
+	// Only the attachment whose connection data carries the limits is
+	// throttled on the host.
+	if len(fvc.throttleOpts) != 1 {
+		t.Fatalf("Expected volume throttled on 1 host, got %d\n", len(fvc.throttleOpts))
+	}
+	if atcs[0].ConnectionData["maxIOPS"] != int64(1000) || atcs[0].ConnectionData["maxBWS"] != int64(100) {
+		t.Errorf("Expected limits updated in connection data, got %v\n", atcs[0].ConnectionData)
+	}
+	mockClient.AssertExpectations(t)
+}
+
 func TestCreateVolumeAttachment(t *testing.T) {
 	var req = &pb.CreateVolumeAttachmentOpts{
 		Id:       "f2dda3d2-bf79-11e7-8665-f750b088f63e",
@@ -336,6 +529,183 @@ func TestCreateVolumeAttachment(t *testing.T) {
 	}
 }
 
+func TestCreateSnapshotAttachment(t *testing.T) {
+	var req = &pb.CreateSnapshotAttachmentOpts{
+		Id:         "a0cbbd38-c6a1-11e8-9c6b-0f5a3b8e2d51",
+		SnapshotId: "3769855c-a102-11e7-b772-17b880d2f537",
+		HostInfo:   &pb.HostInfo{},
+		Context:    c.NewAdminContext().ToJson(),
+	}
+	var snap, vol, snpatm = &SampleSnapshots[0], &SampleVolumes[0], &SampleSnapshotAttachments[0]
+	mockClient := new(dbtest.Client)
+	mockClient.On("GetVolumeSnapshot", c.NewAdminContext(), req.SnapshotId).Return(snap, nil)
+	mockClient.On("GetVolume", c.NewAdminContext(), snap.VolumeId).Return(vol, nil)
+	mockClient.On("GetPool", c.NewAdminContext(), vol.PoolId).Return(&SamplePools[0], nil)
+	mockClient.On("GetDock", c.NewAdminContext(), "b7602e18-771e-11e7-8f38-dbd6d291f4e0").Return(&SampleDocks[0], nil)
+	mockClient.On("UpdateStatus", c.NewAdminContext(), snpatm, model.VolumeAttachAvailable).Return(nil)
+
+	db.C = mockClient
+
+	var ctrl = &Controller{
+		volumeController: NewFakeVolumeController(),
+	}
+
+	if _, err := ctrl.CreateSnapshotAttachment(context.Background(), req); err != nil {
+		t.Errorf("Failed to create snapshot attachment: %v\n", err)
+	}
+	mockClient.AssertExpectations(t)
+}
+
+func TestDeleteSnapshotAttachment(t *testing.T) {
+	var req = &pb.DeleteSnapshotAttachmentOpts{
+		Id:         "a0cbbd38-c6a1-11e8-9c6b-0f5a3b8e2d51",
+		SnapshotId: "3769855c-a102-11e7-b772-17b880d2f537",
+		HostInfo:   &pb.HostInfo{},
+		Context:    c.NewAdminContext().ToJson(),
+	}
+	var snap, vol = &SampleSnapshots[0], &SampleVolumes[0]
+	mockClient := new(dbtest.Client)
+	mockClient.On("GetVolumeSnapshot", c.NewAdminContext(), req.SnapshotId).Return(snap, nil)
+	mockClient.On("GetVolume", c.NewAdminContext(), snap.VolumeId).Return(vol, nil)
+	mockClient.On("GetDockByPoolId", c.NewAdminContext(), vol.PoolId).Return(&SampleDocks[0], nil)
+	mockClient.On("DeleteSnapshotAttachment", c.NewAdminContext(), req.Id).Return(nil)
+
+	db.C = mockClient
+
+	var ctrl = &Controller{
+		volumeController: NewFakeVolumeController(),
+	}
+
+	if _, err := ctrl.DeleteSnapshotAttachment(context.Background(), req); err != nil {
+		t.Errorf("Failed to delete snapshot attachment: %v\n", err)
+	}
+	mockClient.AssertExpectations(t)
+}
+
+var sampleAttacherDock = &model.DockSpec{
+	BaseModel: &model.BaseModel{
+		Id: "a6c5c8d2-1d43-5b3e-9f7a-2d1c7f0f4a9b",
+	},
+	NodeId:   "node-01",
+	Endpoint: "192.168.0.2:50051",
+	Type:     model.DockTypeAttacher,
+}
+
+func TestCreateVolumeAttachmentWithMountpoint(t *testing.T) {
+	defer func(atc model.VolumeAttachmentSpec) { SampleAttachments[0] = atc }(SampleAttachments[0])
+
+	var req = &pb.CreateVolumeAttachmentOpts{
+		Id:           "f2dda3d2-bf79-11e7-8665-f750b088f63e",
+		VolumeId:     "bd5b12a8-a101-11e7-941e-d77981b584d8",
+		HostInfo:     &pb.HostInfo{Host: "node-01"},
+		FsType:       "xfs",
+		Mountpoint:   "/mnt/vol01",
+		MountOptions: []string{"noatime"},
+		Context:      c.NewAdminContext().ToJson(),
+	}
+	var vol, volatm = &SampleVolumes[0], &SampleAttachments[0]
+	mockClient := new(dbtest.Client)
+	mockClient.On("GetVolume", c.NewAdminContext(), req.VolumeId).Return(vol, nil)
+	mockClient.On("GetPool", c.NewAdminContext(), vol.PoolId).Return(&SamplePools[0], nil)
+	mockClient.On("GetDock", c.NewAdminContext(), "b7602e18-771e-11e7-8f38-dbd6d291f4e0").Return(&SampleDocks[0], nil)
+	mockClient.On("ListDocks", c.NewAdminContext()).Return([]*model.DockSpec{&SampleDocks[0], sampleAttacherDock}, nil)
+	mockClient.On("UpdateStatus", c.NewAdminContext(), volatm, model.VolumeAttachAvailable).Return(nil)
+	mockClient.On("UpdateStatus", c.NewAdminContext(), vol, model.VolumeInUse).Return(nil)
+
+	db.C = mockClient
+
+	var fvc = &fakeVolumeController{}
+	var ctrl = &Controller{
+		volumeController: fvc,
+	}
+
+	if _, err := ctrl.CreateVolumeAttachment(context.Background(), req); err != nil {
+		t.Errorf("Failed to create volume attachment: %v\n", err)
+	}
+	if fvc.attachOpt == nil {
+		t.Fatal("Expected volume attached by the attacher dock")
+	}
+	if fvc.attachOpt.Mountpoint != req.Mountpoint || fvc.attachOpt.FsType != req.FsType {
+		t.Errorf("Expected mount %s with %s, got %+v\n", req.Mountpoint, req.FsType, fvc.attachOpt)
+	}
+	if volatm.Mountpoint != req.Mountpoint || volatm.MountStatus != model.VolumeAttachMounted {
+		t.Errorf("Expected attachment mounted on %s, got %+v\n", req.Mountpoint, volatm)
+	}
+}
+
+func TestCreateVolumeAttachmentMountFailed(t *testing.T) {
+	var req = &pb.CreateVolumeAttachmentOpts{
+		Id:         "f2dda3d2-bf79-11e7-8665-f750b088f63e",
+		VolumeId:   "bd5b12a8-a101-11e7-941e-d77981b584d8",
+		HostInfo:   &pb.HostInfo{Host: "node-02"},
+		Mountpoint: "/mnt/vol01",
+		Context:    c.NewAdminContext().ToJson(),
+	}
+	var vol = &SampleVolumes[0]
+	mockClient := new(dbtest.Client)
+	mockClient.On("GetVolume", c.NewAdminContext(), req.VolumeId).Return(vol, nil)
+	mockClient.On("GetPool", c.NewAdminContext(), vol.PoolId).Return(&SamplePools[0], nil)
+	mockClient.On("GetDock", c.NewAdminContext(), "b7602e18-771e-11e7-8f38-dbd6d291f4e0").Return(&SampleDocks[0], nil)
+	mockClient.On("ListDocks", c.NewAdminContext()).Return([]*model.DockSpec{&SampleDocks[0], sampleAttacherDock}, nil)
+	mockClient.On("GetVolumeAttachment", c.NewAdminContext(), req.Id).Return(&SampleAttachments[0], nil)
+	mockClient.On("UpdateStatus", c.NewAdminContext(), &SampleAttachments[0], model.VolumeAttachError).Return(nil)
+	db.C = mockClient
+
+	var fvc = &fakeVolumeController{}
+	var ctrl = &Controller{
+		volumeController: fvc,
+	}
+
+	// node-02 doesn't run the attacher dock, so the volume fails to be
+	// mounted and its export is terminated.
+	if _, err := ctrl.CreateVolumeAttachment(context.Background(), req); err == nil {
+		t.Fatal("Expected an error when the volume can't be mounted, got nil")
+	}
+	if fvc.deleteAtcOpt == nil || fvc.deleteAtcOpt.Id != req.Id {
+		t.Errorf("Expected connection of attachment %s terminated, got %+v\n", req.Id, fvc.deleteAtcOpt)
+	}
+	mockClient.AssertNotCalled(t, "UpdateStatus", c.NewAdminContext(), vol, model.VolumeInUse)
+}
+
+func TestDeleteMountedVolumeAttachment(t *testing.T) {
+	var req = &pb.DeleteVolumeAttachmentOpts{
+		Id:       "f2dda3d2-bf79-11e7-8665-f750b088f63e",
+		VolumeId: "bd5b12a8-a101-11e7-941e-d77981b584d8",
+		HostInfo: &pb.HostInfo{Host: "node-01"},
+		Context:  c.NewAdminContext().ToJson(),
+	}
+	var vol = &SampleVolumes[0]
+	var atc = SampleAttachments[0]
+	atc.Host = "node-01"
+	atc.Mountpoint = "/mnt/vol01"
+	atc.MountStatus = model.VolumeAttachMounted
+	mockClient := new(dbtest.Client)
+	mockClient.On("GetVolume", c.NewAdminContext(), req.VolumeId).Return(vol, nil)
+	mockClient.On("GetVolumeAttachment", c.NewAdminContext(), req.Id).Return(&atc, nil)
+	mockClient.On("ListDocks", c.NewAdminContext()).Return([]*model.DockSpec{&SampleDocks[0], sampleAttacherDock}, nil)
+	mockClient.On("UpdateVolumeAttachment", c.NewAdminContext(), req.Id, &atc).Return(&atc, nil)
+	mockClient.On("GetDockByPoolId", c.NewAdminContext(), vol.PoolId).Return(&SampleDocks[0], nil)
+	mockClient.On("DeleteVolumeAttachment", c.NewAdminContext(), req.Id).Return(nil)
+	mockClient.On("UpdateStatus", c.NewAdminContext(), vol, model.VolumeAvailable).Return(nil)
+
+	db.C = mockClient
+
+	var fvc = &fakeVolumeController{}
+	var ctrl = &Controller{
+		volumeController: fvc,
+	}
+
+	if _, err := ctrl.DeleteVolumeAttachment(context.Background(), req); err != nil {
+		t.Errorf("Failed to delete volume attachment: %v\n", err)
+	}
+	if fvc.detachOpt == nil || fvc.detachOpt.Mountpoint != atc.Mountpoint {
+		t.Errorf("Expected volume unmounted from %s, got %+v\n", atc.Mountpoint, fvc.detachOpt)
+	}
+	if atc.MountStatus != model.VolumeAttachUnmounted {
+		t.Errorf("Expected mount status %s, got %s\n", model.VolumeAttachUnmounted, atc.MountStatus)
+	}
+}
+
 func TestDeleteVolumeAttachment(t *testing.T) {
 	var req = &pb.DeleteVolumeAttachmentOpts{
 		Id:       "f2dda3d2-bf79-11e7-8665-f750b088f63e",
@@ -346,6 +716,7 @@ func TestDeleteVolumeAttachment(t *testing.T) {
 	var vol = &SampleVolumes[0]
 	mockClient := new(dbtest.Client)
 	mockClient.On("GetVolume", c.NewAdminContext(), req.VolumeId).Return(vol, nil)
+	mockClient.On("GetVolumeAttachment", c.NewAdminContext(), req.Id).Return(&SampleAttachments[0], nil)
 	mockClient.On("GetDockByPoolId", c.NewAdminContext(), vol.PoolId).Return(&SampleDocks[0], nil)
 	mockClient.On("DeleteVolumeAttachment", c.NewAdminContext(), req.Id).Return(nil)
 	mockClient.On("UpdateStatus", c.NewAdminContext(), vol, model.VolumeAvailable).Return(nil)
@@ -411,6 +782,32 @@ func TestDeleteVolumeSnapshot(t *testing.T) {
 	}
 }
 
+func TestVerifyVolumeSnapshot(t *testing.T) {
+	var req = &pb.VerifyVolumeSnapshotOpts{
+		Id:       "3769855c-a102-11e7-b772-17b880d2f537",
+		VolumeId: "bd5b12a8-a101-11e7-941e-d77981b584d8",
+		Metadata: map[string]string{"bucket": "backup", "backupId": "f5c6a5f8-2a1b-4bb6-8c3f-4a6f9b3b9e1d"},
+		Context:  c.NewAdminContext().ToJson(),
+	}
+	var vol, snp = &SampleVolumes[0], &SampleSnapshots[0]
+	mockClient := new(dbtest.Client)
+	mockClient.On("GetVolume", c.NewAdminContext(), req.VolumeId).Return(vol, nil)
+	mockClient.On("GetDockByPoolId", c.NewAdminContext(), vol.PoolId).Return(&SampleDocks[0], nil)
+	mockClient.On("GetVolumeSnapshot", c.NewAdminContext(), req.Id).Return(snp, nil)
+	mockClient.On("UpdateStatus", c.NewAdminContext(), snp, model.VolumeSnapAvailable).Return(nil)
+
+	db.C = mockClient
+
+	var ctrl = &Controller{
+		volumeController: NewFakeVolumeController(),
+	}
+
+	if _, err := ctrl.VerifyVolumeSnapshot(context.Background(), req); err != nil {
+		t.Errorf("Failed to verify volume snapshot: %v\n", err)
+	}
+	mockClient.AssertCalled(t, "UpdateStatus", c.NewAdminContext(), snp, model.VolumeSnapAvailable)
+}
+
 func TestCreateReplication(t *testing.T) {
 	var req = &pb.CreateReplicationOpts{
 		Id:              "c299a978-4f3e-11e8-8a5c-977218a83359",
@@ -637,3 +1034,154 @@ func TestDeleteVolumeGroup(t *testing.T) {
 		t.Errorf("Failed to delete volume group: %v\n", err)
 	}
 }
+
+func TestCreateVolumeGroupSnapshot(t *testing.T) {
+	var req = &pb.CreateVolumeGroupSnapshotOpts{
+		Id:      "3769855c-a102-11e7-b772-17b880d2f560",
+		GroupId: "3769855c-a102-11e7-b772-17b880d2f555",
+		Snapshots: []*pb.CreateVolumeSnapshotOpts{
+			{
+				Id:       "3769855c-a102-11e7-b772-17b880d2f561",
+				VolumeId: "bd5b12a8-a101-11e7-941e-d77981b584d8",
+			},
+		},
+		Context: c.NewAdminContext().ToJson(),
+	}
+	var vg = SampleVolumeGroups[0]
+	vg.PoolId = "084bf71e-a102-11e7-88a8-e31fe6d52248"
+	var expectedVg = vg
+	expectedVg.GroupSnapshots = []string{req.Id}
+	var snp = &model.VolumeSnapshotSpec{
+		BaseModel:       &model.BaseModel{Id: req.Snapshots[0].Id},
+		VolumeId:        req.Snapshots[0].VolumeId,
+		GroupSnapshotId: req.Id,
+	}
+
+	var atcs = []*model.VolumeAttachmentSpec{
+		{
+			BaseModel:   &model.BaseModel{Id: "attachment-01"},
+			VolumeId:    req.Snapshots[0].VolumeId,
+			HostInfo:    model.HostInfo{Host: "node-01"},
+			Mountpoint:  "/mnt/vol01",
+			MountStatus: model.VolumeAttachMounted,
+		},
+	}
+
+	mockClient := new(dbtest.Client)
+	mockClient.On("GetVolumeGroup", c.NewAdminContext(), req.GroupId).Return(&vg, nil)
+	mockClient.On("GetVolume", c.NewAdminContext(), req.Snapshots[0].VolumeId).Return(&SampleVolumes[0], nil)
+	mockClient.On("ListAttachmentsByVolumeId", c.NewAdminContext(), req.Snapshots[0].VolumeId).Return(atcs, nil)
+	mockClient.On("ListDocks", c.NewAdminContext()).Return([]*model.DockSpec{&SampleDocks[0], sampleAttacherDock}, nil)
+	mockClient.On("GetDockByPoolId", c.NewAdminContext(), vg.PoolId).Return(&SampleDocks[0], nil)
+	mockClient.On("UpdateStatus", c.NewAdminContext(), snp, model.VolumeSnapAvailable).Return(nil)
+	mockClient.On("UpdateVolumeGroup", c.NewAdminContext(), &expectedVg).Return(&expectedVg, nil)
+	db.C = mockClient
+
+	var fvc = &fakeVolumeController{}
+	var ctrl = &Controller{
+		volumeController: fvc,
+	}
+
+	if _, err := ctrl.CreateVolumeGroupSnapshot(context.Background(), req); err != nil {
+		t.Errorf("Failed to create volume group snapshot: %v\n", err)
+	}
+	if req.PoolId != vg.PoolId {
+		t.Errorf("Expected pool id %s, got %s\n", vg.PoolId, req.PoolId)
+	}
+	// The mounted volume is frozen, and it is thawed after the snapshot is
+	// taken.
+	if len(fvc.freezeOpts) != 2 || fvc.freezeOpts[0].Thaw || !fvc.freezeOpts[1].Thaw ||
+		fvc.freezeOpts[0].Mountpoint != atcs[0].Mountpoint || fvc.freezeOpts[1].Mountpoint != atcs[0].Mountpoint {
+		t.Errorf("Expected %s frozen and thawed, got %v\n", atcs[0].Mountpoint, fvc.freezeOpts)
+	}
+	mockClient.AssertExpectations(t)
+}
+
+func TestCreateVolumeGroupSnapshotNotMounted(t *testing.T) {
+	var req = &pb.CreateVolumeGroupSnapshotOpts{
+		Id:      "3769855c-a102-11e7-b772-17b880d2f560",
+		GroupId: "3769855c-a102-11e7-b772-17b880d2f555",
+		Snapshots: []*pb.CreateVolumeSnapshotOpts{
+			{
+				Id:       "3769855c-a102-11e7-b772-17b880d2f561",
+				VolumeId: "bd5b12a8-a101-11e7-941e-d77981b584d8",
+			},
+		},
+		Context: c.NewAdminContext().ToJson(),
+	}
+	var vg = SampleVolumeGroups[0]
+	vg.PoolId = "084bf71e-a102-11e7-88a8-e31fe6d52248"
+
+	var atcs = []*model.VolumeAttachmentSpec{
+		{
+			BaseModel:   &model.BaseModel{Id: "attachment-01"},
+			VolumeId:    req.Snapshots[0].VolumeId,
+			HostInfo:    model.HostInfo{Host: "node-01"},
+			Mountpoint:  "/mnt/vol01",
+			MountStatus: model.VolumeAttachMounted,
+		},
+		{
+			BaseModel: &model.BaseModel{Id: "attachment-02"},
+			VolumeId:  req.Snapshots[0].VolumeId,
+			HostInfo:  model.HostInfo{Host: "node-01"},
+		},
+	}
+
+	mockClient := new(dbtest.Client)
+	mockClient.On("GetVolumeGroup", c.NewAdminContext(), req.GroupId).Return(&vg, nil)
+	mockClient.On("GetVolume", c.NewAdminContext(), req.Snapshots[0].VolumeId).Return(&SampleVolumes[0], nil)
+	mockClient.On("ListAttachmentsByVolumeId", c.NewAdminContext(), req.Snapshots[0].VolumeId).Return(atcs, nil)
+	mockClient.On("GetVolumeSnapshot", c.NewAdminContext(), req.Snapshots[0].Id).Return(&SampleSnapshots[0], nil)
+	mockClient.On("UpdateStatus", c.NewAdminContext(), &SampleSnapshots[0], model.VolumeSnapError).Return(nil)
+	db.C = mockClient
+
+	var fvc = &fakeVolumeController{}
+	var ctrl = &Controller{
+		volumeController: fvc,
+	}
+
+	// The volume attached without being mounted can not be quiesced, so the
+	// group snapshot is rejected without freezing any volume.
+	if _, err := ctrl.CreateVolumeGroupSnapshot(context.Background(), req); err == nil {
+		t.Error("Expected error when a volume of the group is not mounted")
+	}
+	if len(fvc.freezeOpts) != 0 {
+		t.Errorf("Expected no volume frozen, got %v\n", fvc.freezeOpts)
+	}
+	mockClient.AssertExpectations(t)
+}
+
+func TestDeleteVolumeGroupSnapshot(t *testing.T) {
+	var req = &pb.DeleteVolumeGroupSnapshotOpts{
+		Id:      "3769855c-a102-11e7-b772-17b880d2f560",
+		GroupId: "3769855c-a102-11e7-b772-17b880d2f555",
+		Snapshots: []*pb.DeleteVolumeSnapshotOpts{
+			{
+				Id:       "3769855c-a102-11e7-b772-17b880d2f561",
+				VolumeId: "bd5b12a8-a101-11e7-941e-d77981b584d8",
+			},
+		},
+		Context: c.NewAdminContext().ToJson(),
+	}
+	var vg = SampleVolumeGroups[0]
+	vg.PoolId = "084bf71e-a102-11e7-88a8-e31fe6d52248"
+	vg.GroupSnapshots = []string{req.Id}
+	var expectedVg = vg
+	expectedVg.GroupSnapshots = []string{}
+
+	mockClient := new(dbtest.Client)
+	mockClient.On("GetVolumeGroup", c.NewAdminContext(), req.GroupId).Return(&vg, nil)
+	mockClient.On("GetDockByPoolId", c.NewAdminContext(), vg.PoolId).Return(&SampleDocks[0], nil)
+	mockClient.On("DeleteVolumeSnapshot", c.NewAdminContext(), req.Snapshots[0].Id).Return(nil)
+	mockClient.On("UpdateVolumeGroup", c.NewAdminContext(), &expectedVg).Return(&expectedVg, nil)
+	db.C = mockClient
+
+	var ctrl = &Controller{
+		volumeController: NewFakeVolumeController(),
+	}
+
+	if _, err := ctrl.DeleteVolumeGroupSnapshot(context.Background(), req); err != nil {
+		t.Errorf("Failed to delete volume group snapshot: %v\n", err)
+	}
+	mockClient.AssertExpectations(t)
+}
@@ -142,6 +142,12 @@ func (d *DrController) CreateReplication(ctx *c.Context, replica *ReplicationSpe
 
 	secondaryVol.ReplicationDriverData = sResult.SecondaryReplicationDriverData
 	if secondaryVol.ReplicationDriverData != nil {
+		// The array-based replication may replace the secondary volume with the
+		// replica it creates, such as the mirrored image of ceph, which is
+		// located by the driver data from then on.
+		if pPool.ReplicationType == ReplicationTypeArray {
+			secondaryVol.Metadata = utils.MergeStringMaps(secondaryVol.Metadata, sResult.SecondaryReplicationDriverData)
+		}
 		secondaryVol.ReplicationDriverData["IsPrimary"] = "false"
 		db.C.UpdateVolume(ctx, secondaryVol)
 	}
@@ -302,6 +308,7 @@ func (p *PairOperator) doAttach(ctx *c.Context, vol *VolumeSpec, provisionerDock
 		AccessProtocol: protocol,
 		Metadata:       vol.Metadata,
 		DriverName:     provisionerDock.DriverName,
+		BackendName:    provisionerDock.BackendName,
 		Context:        ctx.ToJson(),
 	}
 
@@ -330,9 +337,10 @@ func (p *PairOperator) doAttach(ctx *c.Context, vol *VolumeSpec, provisionerDock
 					Host:      atm.Host,
 					Initiator: atm.Initiator,
 				},
-				Metadata:   utils.MergeStringMaps(atm.Metadata, vol.Metadata),
-				DriverName: provisionerDock.DriverName,
-				Context:    ctx.ToJson(),
+				Metadata:    utils.MergeStringMaps(atm.Metadata, vol.Metadata),
+				DriverName:  provisionerDock.DriverName,
+				BackendName: provisionerDock.BackendName,
+				Context:     ctx.ToJson(),
 			}
 			p.volumeController.SetDock(provisionerDock)
 			p.volumeController.DeleteVolumeAttachment(opt)
@@ -405,6 +413,7 @@ func (p *PairOperator) doDetach(ctx *c.Context, attachmentId string, vol *Volume
 		AccessProtocol: atm.AccessProtocol,
 		Metadata:       utils.MergeStringMaps(atm.Metadata, vol.Metadata),
 		DriverName:     provisionerDock.DriverName,
+		BackendName:    provisionerDock.BackendName,
 		Context:        ctx.ToJson(),
 	}
 
@@ -466,6 +475,7 @@ func (p *PairOperator) Create(ctx *c.Context, replica *ReplicationSpec, vol *Vol
 		PoolName:                       p.pool.Name,
 		DockId:                         p.provisionDock.Id,
 		DriverName:                     p.pool.ReplicationDriverName,
+		BackendName:                    p.provisionDock.BackendName,
 		Context:                        ctx.ToJson(),
 		IsPrimary:                      p.isPrimary,
 		VolumeDataList:                 replica.VolumeDataList,
@@ -488,6 +498,7 @@ func (p *PairOperator) Delete(ctx *c.Context, replica *ReplicationSpec, vol *Vol
 		PoolName:                       p.pool.Name,
 		DockId:                         p.provisionDock.Id,
 		DriverName:                     p.pool.ReplicationDriverName,
+		BackendName:                    p.provisionDock.BackendName,
 		Context:                        ctx.ToJson(),
 		Metadata:                       replica.Metadata,
 		IsPrimary:                      p.isPrimary,
@@ -508,6 +519,7 @@ func (p *PairOperator) Enable(ctx *c.Context, replica *ReplicationSpec, vol *Vol
 		PoolName:                       p.pool.Name,
 		DockId:                         p.provisionDock.Id,
 		DriverName:                     p.pool.ReplicationDriverName,
+		BackendName:                    p.provisionDock.BackendName,
 		Context:                        ctx.ToJson(),
 		Metadata:                       replica.Metadata,
 		IsPrimary:                      p.isPrimary,
@@ -528,6 +540,7 @@ func (p *PairOperator) Disable(ctx *c.Context, replica *ReplicationSpec, vol *Vo
 		PoolName:                       p.pool.Name,
 		DockId:                         p.provisionDock.Id,
 		DriverName:                     p.pool.ReplicationDriverName,
+		BackendName:                    p.provisionDock.BackendName,
 		Context:                        ctx.ToJson(),
 		Metadata:                       replica.Metadata,
 		IsPrimary:                      p.isPrimary,
@@ -548,6 +561,7 @@ func (p *PairOperator) Failover(ctx *c.Context, replica *ReplicationSpec, failov
 		PoolName:                       p.pool.Name,
 		DockId:                         p.provisionDock.Id,
 		DriverName:                     p.pool.ReplicationDriverName,
+		BackendName:                    p.provisionDock.BackendName,
 		Context:                        ctx.ToJson(),
 		Metadata:                       replica.Metadata,
 		AllowAttachedVolume:            failover.AllowAttachedVolume,
@@ -47,6 +47,10 @@ func (fvc *fakeVolumeController) ExtendVolume(*pb.ExtendVolumeOpts) (*model.Volu
 	return &SampleVolumes[0], nil
 }
 
+func (fvc *fakeVolumeController) UpdateVolumeQos(*pb.UpdateVolumeQosOpts) (*model.VolumeSpec, error) {
+	return &SampleVolumes[0], nil
+}
+
 func (fvc *fakeVolumeController) CreateVolumeAttachment(*pb.CreateVolumeAttachmentOpts) (*model.VolumeAttachmentSpec, error) {
 	return &SampleAttachments[0], nil
 }
@@ -55,6 +59,14 @@ func (fvc *fakeVolumeController) DeleteVolumeAttachment(*pb.DeleteVolumeAttachme
 	return nil
 }
 
+func (fvc *fakeVolumeController) CreateSnapshotAttachment(*pb.CreateSnapshotAttachmentOpts) (*model.SnapshotAttachmentSpec, error) {
+	return &SampleSnapshotAttachments[0], nil
+}
+
+func (fvc *fakeVolumeController) DeleteSnapshotAttachment(*pb.DeleteSnapshotAttachmentOpts) error {
+	return nil
+}
+
 func (fvc *fakeVolumeController) CreateVolumeSnapshot(*pb.CreateVolumeSnapshotOpts) (*model.VolumeSnapshotSpec, error) {
 	return &SampleSnapshots[0], nil
 }
@@ -63,6 +75,10 @@ func (fvc *fakeVolumeController) DeleteVolumeSnapshot(*pb.DeleteVolumeSnapshotOp
 	return nil
 }
 
+func (fvc *fakeVolumeController) VerifyVolumeSnapshot(*pb.VerifyVolumeSnapshotOpts) error {
+	return nil
+}
+
 func (fvc *fakeVolumeController) AttachVolume(*pb.AttachVolumeOpts) (string, error) {
 	return "/dev/disk/by-path/ip-192.168.56.100:3260-iscsi-iqn.2017-10.io.opensds:baec258b-8f79-4bbc-bf97-28addfa903d3-lun-1", nil
 }
@@ -71,6 +87,18 @@ func (fvc *fakeVolumeController) DetachVolume(*pb.DetachVolumeOpts) error {
 	return nil
 }
 
+func (fvc *fakeVolumeController) ExpandVolume(*pb.ExpandVolumeOpts) error {
+	return nil
+}
+
+func (fvc *fakeVolumeController) ThrottleVolume(*pb.ThrottleVolumeOpts) error {
+	return nil
+}
+
+func (fvc *fakeVolumeController) FreezeVolume(*pb.FreezeVolumeOpts) error {
+	return nil
+}
+
 func (fvc *fakeVolumeController) CreateReplication(opts *pb.CreateReplicationOpts) (*model.ReplicationSpec, error) {
 	return &SampleReplications[0], nil
 }
@@ -104,6 +132,14 @@ func (fvc *fakeVolumeController) DeleteVolumeGroup(*pb.DeleteVolumeGroupOpts) er
 	return nil
 }
 
+func (fvc *fakeVolumeController) CreateVolumeGroupSnapshot(*pb.CreateVolumeGroupSnapshotOpts) ([]*model.VolumeSnapshotSpec, error) {
+	return nil, nil
+}
+
+func (fvc *fakeVolumeController) DeleteVolumeGroupSnapshot(*pb.DeleteVolumeGroupSnapshotOpts) error {
+	return nil
+}
+
 func (fvc *fakeVolumeController) SetDock(dockInfo *model.DockSpec) { return }
 
 var (
@@ -202,6 +238,55 @@ func TestArrayBasedCreateReplication(t *testing.T) {
 	}
 }
 
+// replicaVolumeController replaces the secondary volume with the replica it
+// creates like the ceph replication driver.
+type replicaVolumeController struct {
+	fakeVolumeController
+}
+
+func (fvc *replicaVolumeController) CreateReplication(opts *pb.CreateReplicationOpts) (*model.ReplicationSpec, error) {
+	if opts.GetIsPrimary() {
+		return &model.ReplicationSpec{}, nil
+	}
+	return &model.ReplicationSpec{
+		SecondaryReplicationDriverData: map[string]string{
+			"CephImageName": "opensds-" + opts.GetPrimaryVolumeId(),
+		},
+	}, nil
+}
+
+func TestArrayBasedCreateReplicationWithReplica(t *testing.T) {
+	mockClient := new(dbtest.Client)
+	pool.ReplicationType = model.ReplicationTypeArray
+	mockClient.On("GetPool", context.NewAdminContext(), "084bf71e-a102-11e7-88a8-e31fe6d52248").Return(&pool, nil)
+	mockClient.On("GetDockByPoolId", context.NewAdminContext(), "084bf71e-a102-11e7-88a8-e31fe6d52248").Return(&SampleDocks[0], nil)
+	mockClient.On("UpdateVolume", context.NewAdminContext(), mock.Anything).Return(nil, nil)
+	db.C = mockClient
+
+	r := &model.ReplicationSpec{
+		BaseModel: &model.BaseModel{
+			Id: "c299a978-4f3e-11e8-8a5c-977218a83359",
+		},
+		PrimaryVolumeId:   volumes[0].Id,
+		SecondaryVolumeId: volumes[1].Id,
+	}
+	var primaryVol, secondaryVol = volumes[0], volumes[1]
+	secondaryVol.Metadata = map[string]string{"CephPoolName": "rbd"}
+
+	c := NewController(&replicaVolumeController{})
+	if _, err := c.CreateReplication(context.NewAdminContext(), r, &primaryVol, &secondaryVol); err != nil {
+		t.Error("Test DR CreateReplication failed, ", err)
+	}
+
+	var expected = map[string]string{
+		"CephPoolName":  "rbd",
+		"CephImageName": "opensds-" + primaryVol.Id,
+	}
+	if !reflect.DeepEqual(secondaryVol.Metadata, expected) {
+		t.Errorf("Expected %v, got %v\n", expected, secondaryVol.Metadata)
+	}
+}
+
 func TestHostBasedCreateReplication(t *testing.T) {
 	mockClient := new(dbtest.Client)
 	pool.ReplicationType = model.ReplicationTypeHost
@@ -0,0 +1,216 @@
+// Copyright (c) 2018 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+//    Licensed under the Apache License, Version 2.0 (the "License"); you may
+//    not use this file except in compliance with the License. You may obtain
+//    a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//    WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//    License for the specific language governing permissions and limitations
+//    under the License.
+
+/*
+This module implements the heartbeat monitor which detects the docks that
+stop reporting themselves alive, and takes them and their pools offline.
+
+*/
+
+package heartbeat
+
+import (
+	"time"
+
+	log "github.com/golang/glog"
+	c "github.com/opensds/opensds/pkg/context"
+	"github.com/opensds/opensds/pkg/db"
+	"github.com/opensds/opensds/pkg/model"
+	"github.com/opensds/opensds/pkg/utils/constants"
+)
+
+const defaultGracePeriod = 120 * time.Second
+
+const (
+	ResourceDock = "dock"
+	ResourcePool = "pool"
+)
+
+// StatusEvent is emitted by the monitor whenever it changes the status of a
+// dock or a pool.
+type StatusEvent struct {
+	// The type of the resource, one of "dock" or "pool".
+	ResourceType string
+	// The uuid of the resource.
+	ResourceId string
+	// The name of the resource.
+	Name string
+	// The status before the change.
+	OldStatus string
+	// The status after the change.
+	Status string
+	// Why the status is changed.
+	Reason string
+	// The time when the change happened.
+	Time string
+}
+
+// EventHandler is called with every status event emitted by the monitor.
+type EventHandler func(*StatusEvent)
+
+// LogEventHandler writes the status events into the log of osdslet.
+func LogEventHandler(e *StatusEvent) {
+	if e.Status == model.DockUnavailable || e.Status == model.PoolUnavailable {
+		log.Warningf("%s %s (%s) status changed from %q to %q: %s",
+			e.ResourceType, e.ResourceId, e.Name, e.OldStatus, e.Status, e.Reason)
+		return
+	}
+	log.Infof("%s %s (%s) status changed from %q to %q: %s",
+		e.ResourceType, e.ResourceId, e.Name, e.OldStatus, e.Status, e.Reason)
+}
+
+// Monitor periodically checks the last heartbeat of every dock. A dock which
+// misses its heartbeats for longer than the grace period is marked as
+// unavailable together with its pools, so that the selector won't place
+// volumes on them any more. The pools are brought back once the dock sends
+// heartbeats again.
+type Monitor struct {
+	gracePeriod time.Duration
+	handler     EventHandler
+	now         func() time.Time
+}
+
+// NewMonitor method creates a monitor with the given grace period. The status
+// events are written into the log if handler is nil.
+func NewMonitor(gracePeriod time.Duration, handler EventHandler) *Monitor {
+	if gracePeriod <= 0 {
+		gracePeriod = defaultGracePeriod
+	}
+	if handler == nil {
+		handler = LogEventHandler
+	}
+	return &Monitor{
+		gracePeriod: gracePeriod,
+		handler:     handler,
+		now:         time.Now,
+	}
+}
+
+// Run method checks the docks every half of the grace period until stopCh
+// is closed.
+func (m *Monitor) Run(stopCh <-chan struct{}) {
+	ticker := time.NewTicker(m.gracePeriod / 2)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := m.Check(); err != nil {
+				log.Error("when check dock heartbeats:", err)
+			}
+		case <-stopCh:
+			return
+		}
+	}
+}
+
+// Check method updates the status of docks and pools according to the last
+// heartbeats of docks.
+func (m *Monitor) Check() error {
+	ctx := c.NewAdminContext()
+	dcks, err := db.C.ListDocks(ctx)
+	if err != nil {
+		return err
+	}
+	pols, err := db.C.ListPools(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := m.now()
+	for _, dck := range dcks {
+		// The docks of old versions never send heartbeats, skip them.
+		if dck.LastHeartbeat == "" {
+			continue
+		}
+		last, err := time.ParseInLocation(constants.TimeFormat, dck.LastHeartbeat, time.Local)
+		if err != nil {
+			log.Errorf("dock %s has invalid last heartbeat %s: %v", dck.Id, dck.LastHeartbeat, err)
+			continue
+		}
+
+		if now.Sub(last) > m.gracePeriod {
+			reason := "no heartbeat since " + dck.LastHeartbeat
+			if dck.Status != model.DockUnavailable {
+				if !m.setDockUnavailable(ctx, dck, reason) {
+					// The dock has sent a heartbeat since it was listed.
+					continue
+				}
+			}
+			for _, pol := range pols {
+				if pol.DockId == dck.Id && pol.Status != model.PoolUnavailable {
+					m.setPoolStatus(ctx, pol, model.PoolUnavailable, reason)
+				}
+			}
+			continue
+		}
+
+		// The dock has set itself available in its heartbeat, only the pools
+		// need to be brought back.
+		for _, pol := range pols {
+			if pol.DockId == dck.Id && pol.Status == model.PoolUnavailable {
+				m.setPoolStatus(ctx, pol, model.PoolAvailable, "dock "+dck.Id+" is alive again")
+			}
+		}
+	}
+	return nil
+}
+
+// setDockUnavailable marks the dock unavailable unless it has sent a
+// heartbeat since it was listed. The dock is read again right before it's
+// updated, so that the spec and the heartbeat registered by the dock in the
+// meantime are not overwritten with the listed ones.
+func (m *Monitor) setDockUnavailable(ctx *c.Context, listed *model.DockSpec, reason string) bool {
+	dck, err := db.C.GetDock(ctx, listed.Id)
+	if err != nil {
+		log.Errorf("when get dock %s: %v", listed.Id, err)
+		return false
+	}
+	if dck.LastHeartbeat != listed.LastHeartbeat {
+		return false
+	}
+	if dck.Status != model.DockUnavailable {
+		m.setStatus(ctx, dck, ResourceDock, dck.Id, dck.Name, dck.Status, model.DockUnavailable, reason)
+	}
+	return true
+}
+
+// setPoolStatus updates the status of pool, which is read again right before
+// it's updated, so that the capacities reported by the dock in the meantime
+// are not overwritten with the listed ones.
+func (m *Monitor) setPoolStatus(ctx *c.Context, listed *model.StoragePoolSpec, status, reason string) {
+	pol, err := db.C.GetPool(ctx, listed.Id)
+	if err != nil {
+		log.Errorf("when get pool %s: %v", listed.Id, err)
+		return
+	}
+	if pol.Status != status {
+		m.setStatus(ctx, pol, ResourcePool, pol.Id, pol.Name, pol.Status, status, reason)
+	}
+}
+
+func (m *Monitor) setStatus(ctx *c.Context, obj interface{}, resourceType, id, name, oldStatus, status, reason string) {
+	if err := db.C.UpdateStatus(ctx, obj, status); err != nil {
+		log.Errorf("when update status of %s %s to %s: %v", resourceType, id, status, err)
+		return
+	}
+	m.handler(&StatusEvent{
+		ResourceType: resourceType,
+		ResourceId:   id,
+		Name:         name,
+		OldStatus:    oldStatus,
+		Status:       status,
+		Reason:       reason,
+		Time:         m.now().Format(constants.TimeFormat),
+	})
+}
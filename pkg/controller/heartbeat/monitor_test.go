@@ -0,0 +1,97 @@
+// Copyright (c) 2018 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+//    Licensed under the Apache License, Version 2.0 (the "License"); you may
+//    not use this file except in compliance with the License. You may obtain
+//    a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//    WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//    License for the specific language governing permissions and limitations
+//    under the License.
+
+package heartbeat
+
+import (
+	"testing"
+	"time"
+
+	c "github.com/opensds/opensds/pkg/context"
+	"github.com/opensds/opensds/pkg/db"
+	"github.com/opensds/opensds/pkg/model"
+	"github.com/opensds/opensds/pkg/utils/constants"
+	dbtest "github.com/opensds/opensds/testutils/db/testing"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCheck(t *testing.T) {
+	now := time.Date(2018, 8, 28, 10, 0, 0, 0, time.Local)
+	dcks := []*model.DockSpec{
+		{
+			BaseModel:     &model.BaseModel{Id: "crashed-dock"},
+			Status:        model.DockAvailable,
+			LastHeartbeat: now.Add(-5 * time.Minute).Format(constants.TimeFormat),
+		},
+		{
+			BaseModel:     &model.BaseModel{Id: "recovered-dock"},
+			Status:        model.DockAvailable,
+			LastHeartbeat: now.Add(-10 * time.Second).Format(constants.TimeFormat),
+		},
+		{
+			BaseModel: &model.BaseModel{Id: "legacy-dock"},
+		},
+		{
+			BaseModel:     &model.BaseModel{Id: "revived-dock"},
+			Status:        model.DockAvailable,
+			LastHeartbeat: now.Add(-5 * time.Minute).Format(constants.TimeFormat),
+		},
+	}
+	pols := []*model.StoragePoolSpec{
+		{BaseModel: &model.BaseModel{Id: "crashed-pool"}, DockId: "crashed-dock"},
+		{BaseModel: &model.BaseModel{Id: "recovered-pool"}, DockId: "recovered-dock", Status: model.PoolUnavailable},
+		{BaseModel: &model.BaseModel{Id: "healthy-pool"}, DockId: "recovered-dock"},
+		{BaseModel: &model.BaseModel{Id: "legacy-pool"}, DockId: "legacy-dock"},
+		{BaseModel: &model.BaseModel{Id: "revived-pool"}, DockId: "revived-dock"},
+	}
+	// The revived dock has sent a heartbeat since the docks were listed.
+	revived := *dcks[3]
+	revived.LastHeartbeat = now.Format(constants.TimeFormat)
+
+	mockClient := new(dbtest.Client)
+	mockClient.On("ListDocks", c.NewAdminContext()).Return(dcks, nil)
+	mockClient.On("ListPools", c.NewAdminContext()).Return(pols, nil)
+	mockClient.On("GetDock", c.NewAdminContext(), "crashed-dock").Return(dcks[0], nil)
+	mockClient.On("GetDock", c.NewAdminContext(), "revived-dock").Return(&revived, nil)
+	for _, pol := range pols {
+		mockClient.On("GetPool", c.NewAdminContext(), pol.Id).Return(pol, nil)
+	}
+	mockClient.On("UpdateStatus", c.NewAdminContext(), mock.Anything, mock.Anything).Return(nil)
+	db.C = mockClient
+
+	var events []*StatusEvent
+	m := NewMonitor(2*time.Minute, func(e *StatusEvent) { events = append(events, e) })
+	m.now = func() time.Time { return now }
+
+	if err := m.Check(); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []StatusEvent{
+		{ResourceType: ResourceDock, ResourceId: "crashed-dock", OldStatus: model.DockAvailable, Status: model.DockUnavailable},
+		{ResourceType: ResourcePool, ResourceId: "crashed-pool", OldStatus: "", Status: model.PoolUnavailable},
+		{ResourceType: ResourcePool, ResourceId: "recovered-pool", OldStatus: model.PoolUnavailable, Status: model.PoolAvailable},
+	}
+	if len(events) != len(expected) {
+		t.Fatalf("Expected %d events, got %d", len(expected), len(events))
+	}
+	for i, e := range expected {
+		got := events[i]
+		if got.ResourceType != e.ResourceType || got.ResourceId != e.ResourceId ||
+			got.OldStatus != e.OldStatus || got.Status != e.Status {
+			t.Errorf("Expected event %+v, got %+v", e, *got)
+		}
+	}
+	mockClient.AssertNumberOfCalls(t, "UpdateStatus", 3)
+}
@@ -83,6 +83,12 @@ func GetPoolCapabilityMap(pool *model.StoragePoolSpec) (map[string]interface{},
 
 // IsAvailablePool ...
 func IsAvailablePool(filterReq map[string]interface{}, pool *model.StoragePoolSpec) (bool, error) {
+	// The pool can't be used while its dock is offline.
+	if pool.Status == model.PoolUnavailable {
+		log.Info("pool: " + pool.Name + " is unavailable")
+		return false, nil
+	}
+
 	poolMap, err := GetPoolCapabilityMap(pool)
 	if nil != err {
 		return false, err
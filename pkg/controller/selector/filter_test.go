@@ -129,6 +129,16 @@ func TestIsAvailablePool(t *testing.T) {
 		t.Errorf("Expected %v, get %v", false, isAvailable)
 	}
 
+	unavailablePool := SamplePools[0]
+	unavailablePool.Status = model.PoolUnavailable
+	isAvailable, err = IsAvailablePool(map[string]interface{}{}, &unavailablePool)
+	if nil != err {
+		t.Errorf("Expected %v, get %v", nil, err)
+	}
+
+	if false != isAvailable {
+		t.Errorf("Expected %v, get %v", false, isAvailable)
+	}
 }
 
 func TestMatch(t *testing.T) {
@@ -85,8 +85,16 @@ func (s *selector) SelectSupportedPoolForVolume(in *model.VolumeSpec) (*model.St
 		// Insert some rules of provisioning properties.
 		if pp := prf.ProvisioningProperties; !pp.IsEmpty() {
 			if ds := pp.DataStorage; !ds.IsEmpty() {
-				filterRequest["extras.dataStorage.isSpaceEfficient"] =
-					"<is> " + strconv.FormatBool(ds.IsSpaceEfficient)
+				// The pool which compresses or deduplicates data is space
+				// efficient.
+				filterRequest["extras.dataStorage.isSpaceEfficient"] = "<is> " +
+					strconv.FormatBool(ds.IsSpaceEfficient || ds.IsCompressed || ds.IsDeduplicated)
+				if ds.IsCompressed {
+					filterRequest["extras.dataStorage.isCompressed"] = "<is> true"
+				}
+				if ds.IsDeduplicated {
+					filterRequest["extras.dataStorage.isDeduplicated"] = "<is> true"
+				}
 				if ds.ProvisioningPolicy != "" {
 					filterRequest["extras.dataStorage.provisioningPolicy"] =
 						ds.ProvisioningPolicy
@@ -110,6 +118,11 @@ func (s *selector) SelectSupportedPoolForVolume(in *model.VolumeSpec) (*model.St
 						">= " + strconv.Itoa(int(ic.MaxBWS))
 				}
 			}
+			// The qos limits of profile can only be enforced by the driver
+			// which supports qos.
+			if pp.Qos() != nil {
+				filterRequest["extras.capabilities."+model.CapabilityQos] = "<is> true"
+			}
 		}
 		// Insert some rules of replication properties.
 		if rp := prf.ReplicationProperties; !rp.IsEmpty() {
@@ -130,6 +143,13 @@ func (s *selector) SelectSupportedPoolForVolume(in *model.VolumeSpec) (*model.St
 				}
 			}
 		}
+		// Insert some rules of driver capabilities.
+		if in.SnapshotId != "" {
+			filterRequest["extras.capabilities."+model.CapabilityClone] = "<is> true"
+		}
+		if in.GroupId != "" {
+			filterRequest["extras.capabilities."+model.CapabilityVolumeGroup] = "<is> true"
+		}
 		return filterRequest
 	}(prf, in)
 
@@ -168,6 +188,7 @@ func (s *selector) SelectSupportedPoolForVG(in *model.VolumeGroupSpec) (*model.S
 				filterRequest = make(map[string]interface{})
 			}
 			filterRequest["availabilityZone"] = in.AvailabilityZone
+			filterRequest["extras.capabilities."+model.CapabilityVolumeGroup] = "<is> true"
 
 			isAvailable, err := IsAvailablePool(filterRequest, pool)
 			if nil != err {
@@ -30,6 +30,7 @@ func TestSelectSupportedPoolForVolume(t *testing.T) {
 	mockClient.On("GetDefaultProfile", c.NewAdminContext()).Return(fakeProfiles[0], nil)
 	mockClient.On("GetProfile", c.NewAdminContext(), "2f9c0a04-66ef-11e7-ade2-43158893e017").Return(fakeProfiles[1], nil)
 	mockClient.On("GetProfile", c.NewAdminContext(), "c611ab76-b4a8-11e8-b76f-97665ba92921").Return(fakeProfiles[2], nil)
+	mockClient.On("GetProfile", c.NewAdminContext(), "5b8a1c7e-d2b3-11e8-8a4c-5f2b0e6b3a11").Return(fakeProfiles[3], nil)
 	mockClient.On("ListPools", c.NewAdminContext()).Return(fakePools, nil)
 	db.C = mockClient
 
@@ -61,6 +62,31 @@ func TestSelectSupportedPoolForVolume(t *testing.T) {
 			},
 			expected: nil,
 		},
+		{
+			request: &model.VolumeSpec{
+				Size:             400,
+				ProfileId:        "5b8a1c7e-d2b3-11e8-8a4c-5f2b0e6b3a11",
+				AvailabilityZone: "az1",
+			},
+			expected: fakePools[1],
+		},
+		{
+			request: &model.VolumeSpec{
+				Size:             40,
+				AvailabilityZone: "az1",
+				SnapshotId:       "3769855c-a102-11e7-b772-17b880d2f537",
+			},
+			expected: fakePools[1],
+		},
+		{
+			request: &model.VolumeSpec{
+				Size:             40,
+				AvailabilityZone: "az1",
+				PoolId:           "f4486139-78d5-462d-a7b9-fdaf6c797e1b",
+				SnapshotId:       "3769855c-a102-11e7-b772-17b880d2f537",
+			},
+			expected: nil,
+		},
 	}
 
 	s := NewSelector()
@@ -146,6 +172,20 @@ var (
 				"diskType": "SSD",
 			},
 		},
+		{
+			BaseModel: &model.BaseModel{
+				Id: "5b8a1c7e-d2b3-11e8-8a4c-5f2b0e6b3a11",
+			},
+			Name:        "profile-03",
+			Description: "data reduction policy",
+			ProvisioningProperties: model.ProvisioningPropertiesSpec{
+				DataStorage: model.DataStorageLoS{
+					ProvisioningPolicy: "Thin",
+					IsCompressed:       true,
+					IsDeduplicated:     true,
+				},
+			},
+		},
 	}
 
 	fakePools = []*model.StoragePoolSpec{
@@ -196,6 +236,8 @@ var (
 					RecoveryTimeObjective: 1,
 					ProvisioningPolicy:    "Thin",
 					IsSpaceEfficient:      true,
+					IsCompressed:          true,
+					IsDeduplicated:        true,
 				},
 				IOConnectivity: model.IOConnectivityLoS{
 					AccessProtocol: "iscsi",
@@ -209,6 +251,10 @@ var (
 				Advanced: map[string]interface{}{
 					"diskType": "SATA",
 				},
+				Capabilities: model.DriverCapabilitiesSpec{
+					Clone: true,
+					Qos:   true,
+				},
 			},
 		},
 	}
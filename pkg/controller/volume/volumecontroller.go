@@ -40,14 +40,22 @@ type Controller interface {
 
 	ExtendVolume(opt *pb.ExtendVolumeOpts) (*model.VolumeSpec, error)
 
+	UpdateVolumeQos(opt *pb.UpdateVolumeQosOpts) (*model.VolumeSpec, error)
+
 	CreateVolumeAttachment(opt *pb.CreateVolumeAttachmentOpts) (*model.VolumeAttachmentSpec, error)
 
 	DeleteVolumeAttachment(opt *pb.DeleteVolumeAttachmentOpts) error
 
+	CreateSnapshotAttachment(opt *pb.CreateSnapshotAttachmentOpts) (*model.SnapshotAttachmentSpec, error)
+
+	DeleteSnapshotAttachment(opt *pb.DeleteSnapshotAttachmentOpts) error
+
 	CreateVolumeSnapshot(opt *pb.CreateVolumeSnapshotOpts) (*model.VolumeSnapshotSpec, error)
 
 	DeleteVolumeSnapshot(opt *pb.DeleteVolumeSnapshotOpts) error
 
+	VerifyVolumeSnapshot(opt *pb.VerifyVolumeSnapshotOpts) error
+
 	CreateReplication(opt *pb.CreateReplicationOpts) (*model.ReplicationSpec, error)
 
 	DeleteReplication(opt *pb.DeleteReplicationOpts) error
@@ -62,12 +70,22 @@ type Controller interface {
 
 	DetachVolume(opt *pb.DetachVolumeOpts) error
 
+	ExpandVolume(opt *pb.ExpandVolumeOpts) error
+
+	ThrottleVolume(opt *pb.ThrottleVolumeOpts) error
+
+	FreezeVolume(opt *pb.FreezeVolumeOpts) error
+
 	CreateVolumeGroup(*pb.CreateVolumeGroupOpts) (*model.VolumeGroupSpec, error)
 
 	UpdateVolumeGroup(*pb.UpdateVolumeGroupOpts) (*model.VolumeGroupSpec, error)
 
 	DeleteVolumeGroup(*pb.DeleteVolumeGroupOpts) error
 
+	CreateVolumeGroupSnapshot(*pb.CreateVolumeGroupSnapshotOpts) ([]*model.VolumeSnapshotSpec, error)
+
+	DeleteVolumeGroupSnapshot(*pb.DeleteVolumeGroupSnapshotOpts) error
+
 	SetDock(dockInfo *model.DockSpec)
 }
 
@@ -160,6 +178,34 @@ func (c *controller) ExtendVolume(opt *pb.ExtendVolumeOpts) (*model.VolumeSpec,
 	return vol, nil
 }
 
+func (c *controller) UpdateVolumeQos(opt *pb.UpdateVolumeQosOpts) (*model.VolumeSpec, error) {
+	if err := c.Client.Connect(c.DockInfo.Endpoint); err != nil {
+		log.Error("when connecting dock client:", err)
+		return nil, err
+	}
+
+	response, err := c.Client.UpdateVolumeQos(context.Background(), opt)
+	if err != nil {
+		log.Error("update volume qos failed in volume controller:", err)
+		return nil, err
+	}
+	defer c.Client.Close()
+
+	if errorMsg := response.GetError(); errorMsg != nil {
+		return nil,
+			fmt.Errorf("failed to update volume qos in volume controller, code: %v, message: %v",
+				errorMsg.GetCode(), errorMsg.GetDescription())
+	}
+
+	var vol = &model.VolumeSpec{}
+	if err = json.Unmarshal([]byte(response.GetResult().GetMessage()), vol); err != nil {
+		log.Error("update volume qos failed in volume controller:", err)
+		return nil, err
+	}
+
+	return vol, nil
+}
+
 func (c *controller) CreateVolumeAttachment(opt *pb.CreateVolumeAttachmentOpts) (*model.VolumeAttachmentSpec, error) {
 	if err := c.Client.Connect(c.DockInfo.Endpoint); err != nil {
 		log.Error("when connecting dock client:", err)
@@ -208,6 +254,54 @@ func (c *controller) DeleteVolumeAttachment(opt *pb.DeleteVolumeAttachmentOpts)
 	return nil
 }
 
+func (c *controller) CreateSnapshotAttachment(opt *pb.CreateSnapshotAttachmentOpts) (*model.SnapshotAttachmentSpec, error) {
+	if err := c.Client.Connect(c.DockInfo.Endpoint); err != nil {
+		log.Error("when connecting dock client:", err)
+		return nil, err
+	}
+
+	response, err := c.Client.CreateSnapshotAttachment(context.Background(), opt)
+	if err != nil {
+		log.Error("create snapshot attachment failed in volume controller:", err)
+		return nil, err
+	}
+	defer c.Client.Close()
+
+	if errorMsg := response.GetError(); errorMsg != nil {
+		return nil,
+			fmt.Errorf("failed to create snapshot attachment in volume controller, code: %v, message: %v",
+				errorMsg.GetCode(), errorMsg.GetDescription())
+	}
+
+	var atc = &model.SnapshotAttachmentSpec{}
+	if err = json.Unmarshal([]byte(response.GetResult().GetMessage()), atc); err != nil {
+		log.Error("create snapshot attachment failed in volume controller:", err)
+		return nil, err
+	}
+
+	return atc, nil
+}
+
+func (c *controller) DeleteSnapshotAttachment(opt *pb.DeleteSnapshotAttachmentOpts) error {
+	if err := c.Client.Connect(c.DockInfo.Endpoint); err != nil {
+		log.Error("when connecting dock client:", err)
+		return err
+	}
+
+	response, err := c.Client.DeleteSnapshotAttachment(context.Background(), opt)
+	if err != nil {
+		log.Error("delete snapshot attachment failed in volume controller:", err)
+		return err
+	}
+	defer c.Client.Close()
+
+	if errorMsg := response.GetError(); errorMsg != nil {
+		return errors.New(errorMsg.GetDescription())
+	}
+
+	return nil
+}
+
 func (c *controller) CreateVolumeSnapshot(opt *pb.CreateVolumeSnapshotOpts) (*model.VolumeSnapshotSpec, error) {
 	if err := c.Client.Connect(c.DockInfo.Endpoint); err != nil {
 		log.Error("when connecting dock client:", err)
@@ -256,6 +350,26 @@ func (c *controller) DeleteVolumeSnapshot(opt *pb.DeleteVolumeSnapshotOpts) erro
 	return nil
 }
 
+func (c *controller) VerifyVolumeSnapshot(opt *pb.VerifyVolumeSnapshotOpts) error {
+	if err := c.Client.Connect(c.DockInfo.Endpoint); err != nil {
+		log.Error("when connecting dock client:", err)
+		return err
+	}
+
+	response, err := c.Client.VerifyVolumeSnapshot(context.Background(), opt)
+	if err != nil {
+		log.Error("verify volume snapshot failed in volume controller:", err)
+		return err
+	}
+	defer c.Client.Close()
+
+	if errorMsg := response.GetError(); errorMsg != nil {
+		return errors.New(errorMsg.GetDescription())
+	}
+
+	return nil
+}
+
 func (c *controller) CreateReplication(opt *pb.CreateReplicationOpts) (*model.ReplicationSpec, error) {
 	if err := c.Client.Connect(c.DockInfo.Endpoint); err != nil {
 		log.Error("when connecting dock client:", err)
@@ -405,6 +519,63 @@ func (c *controller) DetachVolume(opt *pb.DetachVolumeOpts) error {
 	return nil
 }
 
+func (c *controller) ExpandVolume(opt *pb.ExpandVolumeOpts) error {
+	if err := c.Client.Connect(c.DockInfo.Endpoint); err != nil {
+		log.Error("when connecting dock client:", err)
+		return err
+	}
+	response, err := c.Client.ExpandVolume(context.Background(), opt)
+	if err != nil {
+		log.Error("expand volume failed in volume controller:", err)
+		return err
+	}
+	defer c.Client.Close()
+
+	if errorMsg := response.GetError(); errorMsg != nil {
+		return errors.New(errorMsg.GetDescription())
+	}
+
+	return nil
+}
+
+func (c *controller) ThrottleVolume(opt *pb.ThrottleVolumeOpts) error {
+	if err := c.Client.Connect(c.DockInfo.Endpoint); err != nil {
+		log.Error("when connecting dock client:", err)
+		return err
+	}
+	response, err := c.Client.ThrottleVolume(context.Background(), opt)
+	if err != nil {
+		log.Error("throttle volume failed in volume controller:", err)
+		return err
+	}
+	defer c.Client.Close()
+
+	if errorMsg := response.GetError(); errorMsg != nil {
+		return errors.New(errorMsg.GetDescription())
+	}
+
+	return nil
+}
+
+func (c *controller) FreezeVolume(opt *pb.FreezeVolumeOpts) error {
+	if err := c.Client.Connect(c.DockInfo.Endpoint); err != nil {
+		log.Error("when connecting dock client:", err)
+		return err
+	}
+	response, err := c.Client.FreezeVolume(context.Background(), opt)
+	if err != nil {
+		log.Error("freeze volume failed in volume controller:", err)
+		return err
+	}
+	defer c.Client.Close()
+
+	if errorMsg := response.GetError(); errorMsg != nil {
+		return errors.New(errorMsg.GetDescription())
+	}
+
+	return nil
+}
+
 func (c *controller) CreateVolumeGroup(opt *pb.CreateVolumeGroupOpts) (*model.VolumeGroupSpec, error) {
 	if err := c.Client.Connect(c.DockInfo.Endpoint); err != nil {
 		log.Error("when connecting dock client:", err)
@@ -481,6 +652,54 @@ func (c *controller) DeleteVolumeGroup(opt *pb.DeleteVolumeGroupOpts) error {
 	return nil
 }
 
+func (c *controller) CreateVolumeGroupSnapshot(opt *pb.CreateVolumeGroupSnapshotOpts) ([]*model.VolumeSnapshotSpec, error) {
+	if err := c.Client.Connect(c.DockInfo.Endpoint); err != nil {
+		log.Error("when connecting dock client:", err)
+		return nil, err
+	}
+
+	response, err := c.Client.CreateVolumeGroupSnapshot(context.Background(), opt)
+	if err != nil {
+		log.Error("create volume group snapshot failed in volume controller:", err)
+		return nil, err
+	}
+	defer c.Client.Close()
+
+	if errorMsg := response.GetError(); errorMsg != nil {
+		return nil,
+			fmt.Errorf("failed to create volume group snapshot in volume controller, code: %v, message: %v",
+				errorMsg.GetCode(), errorMsg.GetDescription())
+	}
+
+	var snps []*model.VolumeSnapshotSpec
+	if err = json.Unmarshal([]byte(response.GetResult().GetMessage()), &snps); err != nil {
+		log.Error("create volume group snapshot failed in volume controller:", err)
+		return nil, err
+	}
+
+	return snps, nil
+}
+
+func (c *controller) DeleteVolumeGroupSnapshot(opt *pb.DeleteVolumeGroupSnapshotOpts) error {
+	if err := c.Client.Connect(c.DockInfo.Endpoint); err != nil {
+		log.Error("when connecting dock client:", err)
+		return err
+	}
+
+	response, err := c.Client.DeleteVolumeGroupSnapshot(context.Background(), opt)
+	if err != nil {
+		log.Error("delete volume group snapshot failed in volume controller:", err)
+		return err
+	}
+	defer c.Client.Close()
+
+	if errorMsg := response.GetError(); errorMsg != nil {
+		return errors.New(errorMsg.GetDescription())
+	}
+
+	return nil
+}
+
 func (c *controller) SetDock(dockInfo *model.DockSpec) {
 	c.DockInfo = dockInfo
 }
@@ -71,6 +71,17 @@ func (fc *fakeClient) ExtendVolume(ctx context.Context, in *pb.ExtendVolumeOpts,
 	}, nil
 }
 
+// Update the QoS of a volume
+func (fc *fakeClient) UpdateVolumeQos(ctx context.Context, in *pb.UpdateVolumeQosOpts, opts ...grpc.CallOption) (*pb.GenericResponse, error) {
+	return &pb.GenericResponse{
+		Reply: &pb.GenericResponse_Result_{
+			Result: &pb.GenericResponse_Result{
+				Message: ByteVolume,
+			},
+		},
+	}, nil
+}
+
 // Create a volume attachment
 func (fc *fakeClient) CreateVolumeAttachment(ctx context.Context, in *pb.CreateVolumeAttachmentOpts, opts ...grpc.CallOption) (*pb.GenericResponse, error) {
 	return &pb.GenericResponse{
@@ -90,6 +101,25 @@ func (fc *fakeClient) DeleteVolumeAttachment(ctx context.Context, in *pb.DeleteV
 	}, nil
 }
 
+// Create a snapshot attachment
+func (fc *fakeClient) CreateSnapshotAttachment(ctx context.Context, in *pb.CreateSnapshotAttachmentOpts, opts ...grpc.CallOption) (*pb.GenericResponse, error) {
+	return &pb.GenericResponse{
+		Reply: &pb.GenericResponse_Result_{
+			Result: &pb.GenericResponse_Result{
+				Message: ByteSnapshotAttachment,
+			},
+		},
+	}, nil
+}
+
+func (fc *fakeClient) DeleteSnapshotAttachment(ctx context.Context, in *pb.DeleteSnapshotAttachmentOpts, opts ...grpc.CallOption) (*pb.GenericResponse, error) {
+	return &pb.GenericResponse{
+		Reply: &pb.GenericResponse_Result_{
+			Result: &pb.GenericResponse_Result{},
+		},
+	}, nil
+}
+
 // Create a volume snapshot
 func (fc *fakeClient) CreateVolumeSnapshot(ctx context.Context, in *pb.CreateVolumeSnapshotOpts, opts ...grpc.CallOption) (*pb.GenericResponse, error) {
 	return &pb.GenericResponse{
@@ -110,6 +140,15 @@ func (fc *fakeClient) DeleteVolumeSnapshot(ctx context.Context, in *pb.DeleteVol
 	}, nil
 }
 
+// Verify the backup of a volume snapshot
+func (fc *fakeClient) VerifyVolumeSnapshot(ctx context.Context, in *pb.VerifyVolumeSnapshotOpts, opts ...grpc.CallOption) (*pb.GenericResponse, error) {
+	return &pb.GenericResponse{
+		Reply: &pb.GenericResponse_Result_{
+			Result: &pb.GenericResponse_Result{},
+		},
+	}, nil
+}
+
 // Create a volume snapshot
 func (fc *fakeClient) CreateVolumeGroup(ctx context.Context, in *pb.CreateVolumeGroupOpts, opts ...grpc.CallOption) (*pb.GenericResponse, error) {
 	return &pb.GenericResponse{
@@ -141,6 +180,26 @@ func (fc *fakeClient) DeleteVolumeGroup(ctx context.Context, in *pb.DeleteVolume
 	}, nil
 }
 
+// Create a volume group snapshot
+func (fc *fakeClient) CreateVolumeGroupSnapshot(ctx context.Context, in *pb.CreateVolumeGroupSnapshotOpts, opts ...grpc.CallOption) (*pb.GenericResponse, error) {
+	return &pb.GenericResponse{
+		Reply: &pb.GenericResponse_Result_{
+			Result: &pb.GenericResponse_Result{
+				Message: "[" + ByteSnapshot + "]",
+			},
+		},
+	}, nil
+}
+
+// Delete a volume group snapshot
+func (fc *fakeClient) DeleteVolumeGroupSnapshot(ctx context.Context, in *pb.DeleteVolumeGroupSnapshotOpts, opts ...grpc.CallOption) (*pb.GenericResponse, error) {
+	return &pb.GenericResponse{
+		Reply: &pb.GenericResponse_Result_{
+			Result: &pb.GenericResponse_Result{},
+		},
+	}, nil
+}
+
 // Attach a volume
 func (fc *fakeClient) AttachVolume(ctx context.Context, in *pb.AttachVolumeOpts, opts ...grpc.CallOption) (*pb.GenericResponse, error) {
 	return &pb.GenericResponse{
@@ -161,6 +220,33 @@ func (fc *fakeClient) DetachVolume(ctx context.Context, in *pb.DetachVolumeOpts,
 	}, nil
 }
 
+// Expand an attached volume
+func (fc *fakeClient) ExpandVolume(ctx context.Context, in *pb.ExpandVolumeOpts, opts ...grpc.CallOption) (*pb.GenericResponse, error) {
+	return &pb.GenericResponse{
+		Reply: &pb.GenericResponse_Result_{
+			Result: &pb.GenericResponse_Result{},
+		},
+	}, nil
+}
+
+// Throttle an attached volume
+func (fc *fakeClient) ThrottleVolume(ctx context.Context, in *pb.ThrottleVolumeOpts, opts ...grpc.CallOption) (*pb.GenericResponse, error) {
+	return &pb.GenericResponse{
+		Reply: &pb.GenericResponse_Result_{
+			Result: &pb.GenericResponse_Result{},
+		},
+	}, nil
+}
+
+// Freeze a mounted volume
+func (fc *fakeClient) FreezeVolume(ctx context.Context, in *pb.FreezeVolumeOpts, opts ...grpc.CallOption) (*pb.GenericResponse, error) {
+	return &pb.GenericResponse{
+		Reply: &pb.GenericResponse_Result_{
+			Result: &pb.GenericResponse_Result{},
+		},
+	}, nil
+}
+
 // Create a volume attachment
 func (fc *fakeClient) CreateReplication(ctx context.Context, in *pb.CreateReplicationOpts, opts ...grpc.CallOption) (*pb.GenericResponse, error) {
 	return &pb.GenericResponse{
@@ -252,6 +338,22 @@ func TestExtendVolume(t *testing.T) {
 	}
 }
 
+func TestUpdateVolumeQos(t *testing.T) {
+	fc := NewFakeController()
+	var expected = &SampleVolumes[0]
+
+	result, err := fc.UpdateVolumeQos(&pb.UpdateVolumeQosOpts{
+		Qos: &pb.Qos{MaxIOPS: 1000, MaxBWS: 100},
+	})
+	if err != nil {
+		t.Errorf("Failed to update volume qos, err is %v\n", err)
+	}
+
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v\n", expected, result)
+	}
+}
+
 func TestCreateVolumeAttachment(t *testing.T) {
 	fc := NewFakeController()
 	var expected = &SampleAttachments[0]
@@ -275,6 +377,29 @@ func TestDeleteVolumeAttachment(t *testing.T) {
 	}
 }
 
+func TestCreateSnapshotAttachment(t *testing.T) {
+	fc := NewFakeController()
+	var expected = &SampleSnapshotAttachments[0]
+
+	result, err := fc.CreateSnapshotAttachment(&pb.CreateSnapshotAttachmentOpts{})
+	if err != nil {
+		t.Errorf("Failed to create snapshot attachment, err is %v\n", err)
+	}
+
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v\n", expected, result)
+	}
+}
+
+func TestDeleteSnapshotAttachment(t *testing.T) {
+	fc := NewFakeController()
+
+	result := fc.DeleteSnapshotAttachment(&pb.DeleteSnapshotAttachmentOpts{})
+	if result != nil {
+		t.Errorf("Expected %v, got %v\n", nil, result)
+	}
+}
+
 func TestCreateVolumeSnapshot(t *testing.T) {
 	fc := NewFakeController()
 	var expected = &SampleSnapshots[0]
@@ -298,6 +423,15 @@ func TestDeleteVolumeSnapshot(t *testing.T) {
 	}
 }
 
+func TestVerifyVolumeSnapshot(t *testing.T) {
+	fc := NewFakeController()
+
+	result := fc.VerifyVolumeSnapshot(&pb.VerifyVolumeSnapshotOpts{})
+	if result != nil {
+		t.Errorf("Expected %v, got %v\n", nil, result)
+	}
+}
+
 func TestCreateReplication(t *testing.T) {
 	fc := NewFakeController()
 	var expected = &SampleReplications[0]
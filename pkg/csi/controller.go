@@ -0,0 +1,621 @@
+// Copyright (c) 2018 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+//    Licensed under the Apache License, Version 2.0 (the "License"); you may
+//    not use this file except in compliance with the License. You may obtain
+//    a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//    WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//    License for the specific language governing permissions and limitations
+//    under the License.
+
+package csi
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"sync"
+
+	log "github.com/golang/glog"
+	"github.com/opensds/opensds/contrib/connector"
+	"github.com/opensds/opensds/pkg/api"
+	c "github.com/opensds/opensds/pkg/context"
+	"github.com/opensds/opensds/pkg/controller/client"
+	csi "github.com/opensds/opensds/pkg/csi/spec"
+	"github.com/opensds/opensds/pkg/db"
+	"github.com/opensds/opensds/pkg/model"
+	pb "github.com/opensds/opensds/pkg/model/proto"
+	. "github.com/opensds/opensds/pkg/utils/config"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// bytesPerGB is the unit of volume size in OpenSDS.
+const bytesPerGB = 1 << 30
+
+// supportedAccessModes are the access modes of the volumes provisioned by
+// OpenSDS, a volume is attached to one host at a time.
+var supportedAccessModes = []csi.VolumeCapability_AccessMode_Mode{
+	csi.VolumeCapability_AccessMode_SINGLE_NODE_WRITER,
+	csi.VolumeCapability_AccessMode_SINGLE_NODE_READER_ONLY,
+	csi.VolumeCapability_AccessMode_SINGLE_NODE_SINGLE_WRITER,
+}
+
+// controllerServer is used to implement csi.ControllerServer.
+type controllerServer struct {
+	// The client is shared by the requests which are served concurrently, it
+	// is connected once by the first request and kept open afterwards.
+	mutex     sync.Mutex
+	client    client.Client
+	connected bool
+}
+
+// NewControllerServer method creates the CSI controller service, which calls
+// the Controller RPCs of osdslet through ctrClient.
+func NewControllerServer(ctrClient client.Client) csi.ControllerServer {
+	return &controllerServer{client: ctrClient}
+}
+
+// controllerClient returns the client connected to osdslet.
+func (cs *controllerServer) controllerClient() (client.Client, error) {
+	cs.mutex.Lock()
+	defer cs.mutex.Unlock()
+
+	if !cs.connected {
+		if err := cs.client.Connect(CONF.OsdsLet.ApiEndpoint); err != nil {
+			log.Error("when connecting controller client:", err)
+			return nil, err
+		}
+		cs.connected = true
+	}
+	return cs.client, nil
+}
+
+// parseResponse turns the generic response of controller into the result or
+// the error of it.
+func parseResponse(resp *pb.GenericResponse, err error, out interface{}) error {
+	if err != nil {
+		return err
+	}
+	if errorMsg := resp.GetError(); errorMsg != nil {
+		return errors.New(errorMsg.GetDescription())
+	}
+	if out == nil {
+		return nil
+	}
+	return json.Unmarshal([]byte(resp.GetResult().GetMessage()), out)
+}
+
+// validateCapabilities checks whether all the volume capabilities are
+// supported.
+func validateCapabilities(caps []*csi.VolumeCapability) error {
+	for _, cap := range caps {
+		if cap.GetBlock() == nil && cap.GetMount() == nil {
+			return errors.New("access type of volume capability is required")
+		}
+		var supported bool
+		for _, mode := range supportedAccessModes {
+			if cap.GetAccessMode().GetMode() == mode {
+				supported = true
+				break
+			}
+		}
+		if !supported {
+			return errors.New("access mode " + cap.GetAccessMode().GetMode().String() + " is not supported")
+		}
+	}
+	return nil
+}
+
+// sizeOf returns the size in GB of the volume to create, which satisfies the
+// capacity range.
+func sizeOf(cr *csi.CapacityRange) (int64, error) {
+	size := int64(1)
+	if required := cr.GetRequiredBytes(); required > 0 {
+		size = (required + bytesPerGB - 1) / bytesPerGB
+	}
+	if limit := cr.GetLimitBytes(); limit > 0 && size*bytesPerGB > limit {
+		return 0, errors.New("volume size in GB can not satisfy the capacity range")
+	}
+	return size, nil
+}
+
+// zoneOf returns the availability zone which the topology requirement asks
+// for, the preferred topologies are considered first.
+func zoneOf(req *csi.TopologyRequirement) string {
+	for _, tops := range [][]*csi.Topology{req.GetPreferred(), req.GetRequisite()} {
+		for _, top := range tops {
+			if zone, ok := top.GetSegments()[TopologyZoneKey]; ok {
+				return zone
+			}
+		}
+	}
+	return ""
+}
+
+// findProfile searches the profile which the storage class parameter
+// selects, by id first and then by name.
+func findProfile(ctx *c.Context, idOrName string) (*model.ProfileSpec, error) {
+	if prf, err := db.C.GetProfile(ctx, idOrName); err == nil {
+		return prf, nil
+	}
+	prfs, err := db.C.ListProfiles(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, prf := range prfs {
+		if prf.Name == idOrName {
+			return prf, nil
+		}
+	}
+	return nil, errors.New("profile " + idOrName + " is not found")
+}
+
+func toCSIVolume(vol *model.VolumeSpec) *csi.Volume {
+	v := &csi.Volume{
+		VolumeId:      vol.Id,
+		CapacityBytes: vol.Size * bytesPerGB,
+	}
+	// The volume is accessible from everywhere if it's not in any zone.
+	if vol.AvailabilityZone != "" {
+		v.AccessibleTopology = []*csi.Topology{
+			{Segments: map[string]string{TopologyZoneKey: vol.AvailabilityZone}},
+		}
+	}
+	if vol.SnapshotId != "" {
+		v.ContentSource = &csi.VolumeContentSource{
+			Type: &csi.VolumeContentSource_Snapshot{
+				Snapshot: &csi.VolumeContentSource_SnapshotSource{SnapshotId: vol.SnapshotId},
+			},
+		}
+	}
+	return v
+}
+
+// CreateVolume implements csi.ControllerServer.CreateVolume
+func (cs *controllerServer) CreateVolume(ctx context.Context, req *csi.CreateVolumeRequest) (*csi.CreateVolumeResponse, error) {
+	log.Info("CSI controller receive create volume request, vr =", req)
+
+	if req.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "volume name is required")
+	}
+	if len(req.GetVolumeCapabilities()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "volume capabilities are required")
+	}
+	if err := validateCapabilities(req.GetVolumeCapabilities()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	size, err := sizeOf(req.GetCapacityRange())
+	if err != nil {
+		return nil, status.Error(codes.OutOfRange, err.Error())
+	}
+
+	osdsCtx := c.NewAdminContext()
+	// The volume name is the idempotency key of CSI, the volume which was
+	// created with the same name by the same tenant is returned.
+	vols, err := db.C.ListVolumes(osdsCtx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	for _, vol := range vols {
+		if vol.Name != req.GetName() || vol.TenantId != osdsCtx.TenantId {
+			continue
+		}
+		if vol.Size != size {
+			return nil, status.Errorf(codes.AlreadyExists, "volume %s already exists with different size", vol.Name)
+		}
+		return &csi.CreateVolumeResponse{Volume: toCSIVolume(vol)}, nil
+	}
+
+	var volume = &model.VolumeSpec{
+		BaseModel:        &model.BaseModel{},
+		Name:             req.GetName(),
+		Size:             size,
+		AvailabilityZone: zoneOf(req.GetAccessibilityRequirements()),
+	}
+	if name, ok := req.GetParameters()[ParamProfile]; ok && name != "" {
+		prf, err := findProfile(osdsCtx, name)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		volume.ProfileId = prf.Id
+	}
+	if src := req.GetVolumeContentSource(); src != nil {
+		if src.GetSnapshot() == nil {
+			return nil, status.Error(codes.InvalidArgument, "only snapshot is supported as volume content source")
+		}
+		volume.SnapshotId = src.GetSnapshot().GetSnapshotId()
+	}
+
+	result, err := api.CreateVolumeDBEntry(osdsCtx, volume)
+	if err != nil {
+		if volume.SnapshotId != "" {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctrClient, err := cs.controllerClient()
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	opt := &pb.CreateVolumeOpts{
+		Id:               result.Id,
+		Name:             result.Name,
+		Size:             result.Size,
+		AvailabilityZone: result.AvailabilityZone,
+		ProfileId:        result.ProfileId,
+		SnapshotId:       result.SnapshotId,
+		Context:          osdsCtx.ToJson(),
+	}
+	resp, err := ctrClient.CreateVolume(context.Background(), opt)
+	if err = parseResponse(resp, err, nil); err != nil {
+		log.Error("create volume failed in controller service:", err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &csi.CreateVolumeResponse{Volume: toCSIVolume(result)}, nil
+}
+
+// DeleteVolume implements csi.ControllerServer.DeleteVolume
+func (cs *controllerServer) DeleteVolume(ctx context.Context, req *csi.DeleteVolumeRequest) (*csi.DeleteVolumeResponse, error) {
+	log.Info("CSI controller receive delete volume request, vr =", req)
+
+	if req.GetVolumeId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "volume id is required")
+	}
+
+	osdsCtx := c.NewAdminContext()
+	volume, err := db.C.GetVolume(osdsCtx, req.GetVolumeId())
+	if err != nil {
+		// The volume which doesn't exist is regarded as deleted.
+		log.Warningf("volume %s not found: %v", req.GetVolumeId(), err)
+		return &csi.DeleteVolumeResponse{}, nil
+	}
+	if err = api.DeleteVolumeDBEntry(osdsCtx, volume); err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	// The volume entry which was deleted directly needn't be sent to the
+	// controller.
+	if volume.ProfileId == "" || volume.PoolId == "" {
+		return &csi.DeleteVolumeResponse{}, nil
+	}
+
+	ctrClient, err := cs.controllerClient()
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	opt := &pb.DeleteVolumeOpts{
+		Id:        volume.Id,
+		ProfileId: volume.ProfileId,
+		PoolId:    volume.PoolId,
+		Metadata:  volume.Metadata,
+		Context:   osdsCtx.ToJson(),
+	}
+	resp, err := ctrClient.DeleteVolume(context.Background(), opt)
+	if err = parseResponse(resp, err, nil); err != nil {
+		log.Error("delete volume failed in controller service:", err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &csi.DeleteVolumeResponse{}, nil
+}
+
+// publishContext returns the information which the node needs to attach the
+// volume.
+func publishContext(atc *model.VolumeAttachmentSpec) map[string]string {
+	connData, _ := json.Marshal(atc.ConnectionData)
+	return map[string]string{
+		PublishAttachmentId:   atc.Id,
+		PublishAccessProtocol: atc.AccessProtocol,
+		PublishConnectionData: string(connData),
+	}
+}
+
+// ControllerPublishVolume implements csi.ControllerServer.ControllerPublishVolume
+func (cs *controllerServer) ControllerPublishVolume(ctx context.Context, req *csi.ControllerPublishVolumeRequest) (*csi.ControllerPublishVolumeResponse, error) {
+	log.Info("CSI controller receive publish volume request, vr =", req)
+
+	if req.GetVolumeId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "volume id is required")
+	}
+	if req.GetNodeId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "node id is required")
+	}
+	if req.GetVolumeCapability() == nil {
+		return nil, status.Error(codes.InvalidArgument, "volume capability is required")
+	}
+	if err := validateCapabilities([]*csi.VolumeCapability{req.GetVolumeCapability()}); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	osdsCtx := c.NewAdminContext()
+	vol, err := db.C.GetVolume(osdsCtx, req.GetVolumeId())
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	// The node id is the id of the attacher dock on the node.
+	dck, err := db.C.GetDock(osdsCtx, req.GetNodeId())
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	atcs, err := db.C.ListAttachmentsByVolumeId(osdsCtx, vol.Id)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	for _, atc := range atcs {
+		if atc.Host != dck.NodeId {
+			return nil, status.Errorf(codes.FailedPrecondition, "volume %s is published to node %s", vol.Id, atc.Host)
+		}
+	}
+	// The attachment of the volume on the node is reused, whatever its status
+	// is, so that the volume is never attached to the node twice.
+	for _, atc := range atcs {
+		switch atc.Status {
+		case model.VolumeAttachAvailable, model.VolumeAttachErrorExpanding, model.VolumeAttachErrorThrottling:
+			return &csi.ControllerPublishVolumeResponse{PublishContext: publishContext(atc)}, nil
+		case model.VolumeAttachCreating:
+			return nil, status.Errorf(codes.Aborted, "volume %s is being published to node %s", vol.Id, atc.Host)
+		default:
+			// The attachment which failed to be created or deleted is
+			// created again.
+			if err := db.C.UpdateStatus(osdsCtx, atc, model.VolumeAttachCreating); err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+			return cs.createAttachment(osdsCtx, atc)
+		}
+	}
+
+	pol, err := db.C.GetPool(osdsCtx, vol.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	var initiator = dck.Metadata["Initiator"]
+	if pol.Extras.IOConnectivity.AccessProtocol == connector.FcDriver {
+		initiator = dck.Metadata["WWPNS"]
+	}
+	var attachment = &model.VolumeAttachmentSpec{
+		BaseModel: &model.BaseModel{},
+		VolumeId:  vol.Id,
+		HostInfo: model.HostInfo{
+			Platform:  dck.Metadata["Platform"],
+			OsType:    dck.Metadata["OsType"],
+			Ip:        dck.Metadata["HostIp"],
+			Host:      dck.NodeId,
+			Initiator: initiator,
+		},
+		// The volume is attached through all the paths if the node
+		// supports multipath I/O.
+		MultiPath: dck.Metadata["Multipath"] == "true",
+	}
+	result, err := api.CreateVolumeAttachmentDBEntry(osdsCtx, attachment)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return cs.createAttachment(osdsCtx, result)
+}
+
+// createAttachment asks the controller to create the volume attachment whose
+// entry is in the database.
+func (cs *controllerServer) createAttachment(osdsCtx *c.Context, result *model.VolumeAttachmentSpec) (*csi.ControllerPublishVolumeResponse, error) {
+	ctrClient, err := cs.controllerClient()
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	opt := &pb.CreateVolumeAttachmentOpts{
+		Id:       result.Id,
+		VolumeId: result.VolumeId,
+		HostInfo: &pb.HostInfo{
+			Platform:  result.Platform,
+			OsType:    result.OsType,
+			Ip:        result.Ip,
+			Host:      result.Host,
+			Initiator: result.Initiator,
+		},
+		MultiPath: result.MultiPath,
+		Metadata:  result.Metadata,
+		Context:   osdsCtx.ToJson(),
+	}
+	var atc = &model.VolumeAttachmentSpec{}
+	resp, err := ctrClient.CreateVolumeAttachment(context.Background(), opt)
+	if err = parseResponse(resp, err, atc); err != nil {
+		log.Error("create volume attachment failed in controller service:", err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &csi.ControllerPublishVolumeResponse{PublishContext: publishContext(atc)}, nil
+}
+
+// ControllerUnpublishVolume implements csi.ControllerServer.ControllerUnpublishVolume
+func (cs *controllerServer) ControllerUnpublishVolume(ctx context.Context, req *csi.ControllerUnpublishVolumeRequest) (*csi.ControllerUnpublishVolumeResponse, error) {
+	log.Info("CSI controller receive unpublish volume request, vr =", req)
+
+	if req.GetVolumeId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "volume id is required")
+	}
+
+	osdsCtx := c.NewAdminContext()
+	vol, err := db.C.GetVolume(osdsCtx, req.GetVolumeId())
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	// The volume is unpublished from all nodes if node id is not specified.
+	var host string
+	if req.GetNodeId() != "" {
+		dck, err := db.C.GetDock(osdsCtx, req.GetNodeId())
+		if err != nil {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		host = dck.NodeId
+	}
+
+	atcs, err := db.C.ListAttachmentsByVolumeId(osdsCtx, vol.Id)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	for _, atc := range atcs {
+		if host != "" && atc.Host != host {
+			continue
+		}
+		if err := cs.deleteAttachment(osdsCtx, atc); err != nil {
+			log.Error("delete volume attachment failed in controller service:", err)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+	return &csi.ControllerUnpublishVolumeResponse{}, nil
+}
+
+func (cs *controllerServer) deleteAttachment(osdsCtx *c.Context, atc *model.VolumeAttachmentSpec) error {
+	ctrClient, err := cs.controllerClient()
+	if err != nil {
+		return err
+	}
+
+	opt := &pb.DeleteVolumeAttachmentOpts{
+		Id:             atc.Id,
+		VolumeId:       atc.VolumeId,
+		AccessProtocol: atc.AccessProtocol,
+		HostInfo: &pb.HostInfo{
+			Platform:  atc.Platform,
+			OsType:    atc.OsType,
+			Ip:        atc.Ip,
+			Host:      atc.Host,
+			Initiator: atc.Initiator,
+		},
+		Metadata: atc.Metadata,
+		Context:  osdsCtx.ToJson(),
+	}
+	resp, err := ctrClient.DeleteVolumeAttachment(context.Background(), opt)
+	return parseResponse(resp, err, nil)
+}
+
+// ValidateVolumeCapabilities implements csi.ControllerServer.ValidateVolumeCapabilities
+func (cs *controllerServer) ValidateVolumeCapabilities(ctx context.Context, req *csi.ValidateVolumeCapabilitiesRequest) (*csi.ValidateVolumeCapabilitiesResponse, error) {
+	if req.GetVolumeId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "volume id is required")
+	}
+	if len(req.GetVolumeCapabilities()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "volume capabilities are required")
+	}
+	if _, err := db.C.GetVolume(c.NewAdminContext(), req.GetVolumeId()); err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	if err := validateCapabilities(req.GetVolumeCapabilities()); err != nil {
+		return &csi.ValidateVolumeCapabilitiesResponse{Message: err.Error()}, nil
+	}
+	return &csi.ValidateVolumeCapabilitiesResponse{
+		Confirmed: &csi.ValidateVolumeCapabilitiesResponse_Confirmed{
+			VolumeContext:      req.GetVolumeContext(),
+			VolumeCapabilities: req.GetVolumeCapabilities(),
+			Parameters:         req.GetParameters(),
+		},
+	}, nil
+}
+
+// ListVolumes implements csi.ControllerServer.ListVolumes
+func (cs *controllerServer) ListVolumes(ctx context.Context, req *csi.ListVolumesRequest) (*csi.ListVolumesResponse, error) {
+	if req.GetMaxEntries() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "max entries can not be negative")
+	}
+	vols, err := db.C.ListVolumes(c.NewAdminContext())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// The starting token is the index of the first volume to return.
+	var start int
+	if token := req.GetStartingToken(); token != "" {
+		if start, err = strconv.Atoi(token); err != nil || start < 0 || start > len(vols) {
+			return nil, status.Errorf(codes.Aborted, "invalid starting token %s", token)
+		}
+	}
+	end := len(vols)
+	if max := int(req.GetMaxEntries()); max > 0 && start+max < end {
+		end = start + max
+	}
+
+	var resp = &csi.ListVolumesResponse{}
+	for _, vol := range vols[start:end] {
+		resp.Entries = append(resp.Entries, &csi.ListVolumesResponse_Entry{Volume: toCSIVolume(vol)})
+	}
+	if end < len(vols) {
+		resp.NextToken = strconv.Itoa(end)
+	}
+	return resp, nil
+}
+
+// GetCapacity implements csi.ControllerServer.GetCapacity
+func (cs *controllerServer) GetCapacity(ctx context.Context, req *csi.GetCapacityRequest) (*csi.GetCapacityResponse, error) {
+	if err := validateCapabilities(req.GetVolumeCapabilities()); err != nil {
+		return &csi.GetCapacityResponse{}, nil
+	}
+	pols, err := db.C.ListPools(c.NewAdminContext())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	zone := req.GetAccessibleTopology().GetSegments()[TopologyZoneKey]
+	var capacity int64
+	for _, pol := range pols {
+		if zone != "" && pol.AvailabilityZone != zone {
+			continue
+		}
+		capacity += pol.FreeCapacity
+	}
+	return &csi.GetCapacityResponse{AvailableCapacity: capacity * bytesPerGB}, nil
+}
+
+// ControllerGetCapabilities implements csi.ControllerServer.ControllerGetCapabilities
+func (cs *controllerServer) ControllerGetCapabilities(ctx context.Context, req *csi.ControllerGetCapabilitiesRequest) (*csi.ControllerGetCapabilitiesResponse, error) {
+	var caps []*csi.ControllerServiceCapability
+	for _, t := range []csi.ControllerServiceCapability_RPC_Type{
+		csi.ControllerServiceCapability_RPC_CREATE_DELETE_VOLUME,
+		csi.ControllerServiceCapability_RPC_PUBLISH_UNPUBLISH_VOLUME,
+		csi.ControllerServiceCapability_RPC_LIST_VOLUMES,
+		csi.ControllerServiceCapability_RPC_GET_CAPACITY,
+	} {
+		caps = append(caps, &csi.ControllerServiceCapability{
+			Type: &csi.ControllerServiceCapability_Rpc{
+				Rpc: &csi.ControllerServiceCapability_RPC{Type: t},
+			},
+		})
+	}
+	return &csi.ControllerGetCapabilitiesResponse{Capabilities: caps}, nil
+}
+
+// CreateSnapshot implements csi.ControllerServer.CreateSnapshot
+func (cs *controllerServer) CreateSnapshot(ctx context.Context, req *csi.CreateSnapshotRequest) (*csi.CreateSnapshotResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "")
+}
+
+// DeleteSnapshot implements csi.ControllerServer.DeleteSnapshot
+func (cs *controllerServer) DeleteSnapshot(ctx context.Context, req *csi.DeleteSnapshotRequest) (*csi.DeleteSnapshotResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "")
+}
+
+// ListSnapshots implements csi.ControllerServer.ListSnapshots
+func (cs *controllerServer) ListSnapshots(ctx context.Context, req *csi.ListSnapshotsRequest) (*csi.ListSnapshotsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "")
+}
+
+// ControllerExpandVolume implements csi.ControllerServer.ControllerExpandVolume
+func (cs *controllerServer) ControllerExpandVolume(ctx context.Context, req *csi.ControllerExpandVolumeRequest) (*csi.ControllerExpandVolumeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "")
+}
+
+// ControllerGetVolume implements csi.ControllerServer.ControllerGetVolume
+func (cs *controllerServer) ControllerGetVolume(ctx context.Context, req *csi.ControllerGetVolumeRequest) (*csi.ControllerGetVolumeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "")
+}
+
+// ControllerModifyVolume implements csi.ControllerServer.ControllerModifyVolume
+func (cs *controllerServer) ControllerModifyVolume(ctx context.Context, req *csi.ControllerModifyVolumeRequest) (*csi.ControllerModifyVolumeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "")
+}
@@ -0,0 +1,351 @@
+// Copyright (c) 2018 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+//    Licensed under the Apache License, Version 2.0 (the "License"); you may
+//    not use this file except in compliance with the License. You may obtain
+//    a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//    WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//    License for the specific language governing permissions and limitations
+//    under the License.
+
+package csi
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+
+	c "github.com/opensds/opensds/pkg/context"
+	csi "github.com/opensds/opensds/pkg/csi/spec"
+	"github.com/opensds/opensds/pkg/db"
+	"github.com/opensds/opensds/pkg/model"
+	pb "github.com/opensds/opensds/pkg/model/proto"
+	. "github.com/opensds/opensds/testutils/collection"
+	ctrtest "github.com/opensds/opensds/testutils/controller/testing"
+	dbtest "github.com/opensds/opensds/testutils/db/testing"
+	"github.com/stretchr/testify/mock"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	mountCap = &csi.VolumeCapability{
+		AccessType: &csi.VolumeCapability_Mount{
+			Mount: &csi.VolumeCapability_MountVolume{},
+		},
+		AccessMode: &csi.VolumeCapability_AccessMode{
+			Mode: csi.VolumeCapability_AccessMode_SINGLE_NODE_WRITER,
+		},
+	}
+
+	attacherDock = &model.DockSpec{
+		BaseModel: &model.BaseModel{
+			Id: "6ba4a3b8-a6b8-5a33-8e8d-12dba3d3d9b5",
+		},
+		NodeId: "node-01",
+		Type:   model.DockTypeAttacher,
+		Metadata: map[string]string{
+			"Platform":  "amd64",
+			"OsType":    "linux",
+			"HostIp":    "192.168.0.10",
+			"Initiator": "iqn.1993-08.org.debian:01:node-01",
+			"Multipath": "true",
+		},
+	}
+)
+
+func newTestController() (csi.ControllerServer, *ctrtest.Client) {
+	ctrClient := new(ctrtest.Client)
+	ctrClient.On("Connect", mock.Anything).Return(nil)
+	ctrClient.On("Close").Return(nil)
+	return NewControllerServer(ctrClient), ctrClient
+}
+
+func expectCode(t *testing.T, err error, expected codes.Code) {
+	if status.Code(err) != expected {
+		t.Errorf("Expected code %v, got %v", expected, err)
+	}
+}
+
+func TestCreateVolume(t *testing.T) {
+	var created = &model.VolumeSpec{
+		BaseModel: &model.BaseModel{
+			Id: "4b0e1bba-c4c9-4c6c-a5c4-6a6ea10f3e9a",
+		},
+		Name:             "pvc-01",
+		Size:             2,
+		AvailabilityZone: "az1",
+		ProfileId:        SampleProfiles[1].Id,
+	}
+	mockClient := new(dbtest.Client)
+	mockClient.On("ListVolumes", c.NewAdminContext()).Return([]*model.VolumeSpec{}, nil)
+	mockClient.On("ListProfiles", c.NewAdminContext()).Return([]*model.ProfileSpec{&SampleProfiles[0], &SampleProfiles[1]}, nil)
+	mockClient.On("GetProfile", c.NewAdminContext(), "silver").Return(nil, errors.New("profile not found"))
+	mockClient.On("CreateVolume", c.NewAdminContext(), mock.AnythingOfType("*model.VolumeSpec")).Return(created, nil)
+	db.C = mockClient
+
+	cs, ctrClient := newTestController()
+	ctrClient.On("CreateVolume", context.Background(), mock.AnythingOfType("*proto.CreateVolumeOpts")).Return(pb.GenericResponseResult(created), nil)
+
+	resp, err := cs.CreateVolume(context.Background(), &csi.CreateVolumeRequest{
+		Name:               "pvc-01",
+		CapacityRange:      &csi.CapacityRange{RequiredBytes: 3 << 29},
+		VolumeCapabilities: []*csi.VolumeCapability{mountCap},
+		Parameters:         map[string]string{ParamProfile: "silver"},
+		AccessibilityRequirements: &csi.TopologyRequirement{
+			Preferred: []*csi.Topology{{Segments: map[string]string{TopologyZoneKey: "az1"}}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The size is rounded up to GB, the profile and the zone are picked from
+	// the storage class parameters and the topology requirement.
+	in := mockClient.Calls[len(mockClient.Calls)-1].Arguments.Get(1).(*model.VolumeSpec)
+	if in.Size != 2 || in.ProfileId != SampleProfiles[1].Id || in.AvailabilityZone != "az1" {
+		t.Errorf("Unexpected volume to create: %+v", in)
+	}
+	var expected = &csi.Volume{
+		VolumeId:      created.Id,
+		CapacityBytes: 2 << 30,
+		AccessibleTopology: []*csi.Topology{
+			{Segments: map[string]string{TopologyZoneKey: "az1"}},
+		},
+	}
+	if !reflect.DeepEqual(resp.GetVolume(), expected) {
+		t.Errorf("Expected %+v, got %+v", expected, resp.GetVolume())
+	}
+}
+
+func TestCreateVolumeWithSameName(t *testing.T) {
+	// The volume of the same name in another tenant is not the one.
+	var otherVol = SampleVolumes[0]
+	otherVol.BaseModel = &model.BaseModel{Id: "6f3a4d2c-8d9e-4b1a-9c7f-2e5d1b0a3c4f"}
+	otherVol.TenantId = "3769855c-a102-11e7-b772-17b880d2f537"
+	mockClient := new(dbtest.Client)
+	mockClient.On("ListVolumes", c.NewAdminContext()).Return([]*model.VolumeSpec{&otherVol, &SampleVolumes[0]}, nil)
+	db.C = mockClient
+
+	cs, ctrClient := newTestController()
+	req := &csi.CreateVolumeRequest{
+		Name:               SampleVolumes[0].Name,
+		VolumeCapabilities: []*csi.VolumeCapability{mountCap},
+	}
+	resp, err := cs.CreateVolume(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.GetVolume().GetVolumeId() != SampleVolumes[0].Id {
+		t.Errorf("Expected %s, got %s", SampleVolumes[0].Id, resp.GetVolume().GetVolumeId())
+	}
+	// The volume which is not in any zone has no topology.
+	if top := resp.GetVolume().GetAccessibleTopology(); top != nil {
+		t.Errorf("Expected no accessible topology, got %v", top)
+	}
+	ctrClient.AssertNotCalled(t, "CreateVolume", mock.Anything, mock.Anything)
+
+	req.CapacityRange = &csi.CapacityRange{RequiredBytes: 10 << 30}
+	_, err = cs.CreateVolume(context.Background(), req)
+	expectCode(t, err, codes.AlreadyExists)
+}
+
+func TestCreateVolumeWithInvalidArgument(t *testing.T) {
+	cs, _ := newTestController()
+
+	_, err := cs.CreateVolume(context.Background(), &csi.CreateVolumeRequest{
+		VolumeCapabilities: []*csi.VolumeCapability{mountCap},
+	})
+	expectCode(t, err, codes.InvalidArgument)
+
+	_, err = cs.CreateVolume(context.Background(), &csi.CreateVolumeRequest{Name: "pvc-01"})
+	expectCode(t, err, codes.InvalidArgument)
+
+	_, err = cs.CreateVolume(context.Background(), &csi.CreateVolumeRequest{
+		Name:               "pvc-01",
+		CapacityRange:      &csi.CapacityRange{RequiredBytes: 3 << 29, LimitBytes: 3 << 29},
+		VolumeCapabilities: []*csi.VolumeCapability{mountCap},
+	})
+	expectCode(t, err, codes.OutOfRange)
+}
+
+func TestControllerPublishVolume(t *testing.T) {
+	var atc = SampleAttachments[0]
+	atc.AccessProtocol = "iscsi"
+	mockClient := new(dbtest.Client)
+	mockClient.On("GetVolume", c.NewAdminContext(), SampleVolumes[0].Id).Return(&SampleVolumes[0], nil)
+	mockClient.On("GetDock", c.NewAdminContext(), attacherDock.Id).Return(attacherDock, nil)
+	mockClient.On("ListAttachmentsByVolumeId", c.NewAdminContext(), SampleVolumes[0].Id).Return([]*model.VolumeAttachmentSpec{}, nil)
+	mockClient.On("GetPool", c.NewAdminContext(), SampleVolumes[0].PoolId).Return(&SamplePools[1], nil)
+	mockClient.On("CreateVolumeAttachment", c.NewAdminContext(), mock.AnythingOfType("*model.VolumeAttachmentSpec")).Return(
+		func(ctx *c.Context, in *model.VolumeAttachmentSpec) *model.VolumeAttachmentSpec { return in }, nil)
+	db.C = mockClient
+
+	cs, ctrClient := newTestController()
+	ctrClient.On("CreateVolumeAttachment", context.Background(), mock.AnythingOfType("*proto.CreateVolumeAttachmentOpts")).Return(pb.GenericResponseResult(&atc), nil)
+
+	resp, err := cs.ControllerPublishVolume(context.Background(), &csi.ControllerPublishVolumeRequest{
+		VolumeId:         SampleVolumes[0].Id,
+		NodeId:           attacherDock.Id,
+		VolumeCapability: mountCap,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The host info of attachment comes from the attacher dock of the node.
+	opt := ctrClient.Calls[len(ctrClient.Calls)-1].Arguments.Get(1).(*pb.CreateVolumeAttachmentOpts)
+	var expectedHost = &pb.HostInfo{
+		Platform:  "amd64",
+		OsType:    "linux",
+		Ip:        "192.168.0.10",
+		Host:      "node-01",
+		Initiator: "iqn.1993-08.org.debian:01:node-01",
+	}
+	if !reflect.DeepEqual(opt.HostInfo, expectedHost) {
+		t.Errorf("Expected %+v, got %+v", expectedHost, opt.HostInfo)
+	}
+	if !opt.MultiPath {
+		t.Error("Expected multipath attachment on the node which supports it")
+	}
+
+	pubCtx := resp.GetPublishContext()
+	if pubCtx[PublishAttachmentId] != atc.Id || pubCtx[PublishAccessProtocol] != "iscsi" {
+		t.Errorf("Unexpected publish context: %v", pubCtx)
+	}
+	var connData map[string]interface{}
+	if err := json.Unmarshal([]byte(pubCtx[PublishConnectionData]), &connData); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(connData, atc.ConnectionData) {
+		t.Errorf("Expected %v, got %v", atc.ConnectionData, connData)
+	}
+}
+
+func TestControllerPublishVolumeToAnotherNode(t *testing.T) {
+	var atc = SampleAttachments[0]
+	atc.Host = "node-02"
+	mockClient := new(dbtest.Client)
+	mockClient.On("GetVolume", c.NewAdminContext(), SampleVolumes[0].Id).Return(&SampleVolumes[0], nil)
+	mockClient.On("GetDock", c.NewAdminContext(), attacherDock.Id).Return(attacherDock, nil)
+	mockClient.On("ListAttachmentsByVolumeId", c.NewAdminContext(), SampleVolumes[0].Id).Return([]*model.VolumeAttachmentSpec{&atc}, nil)
+	db.C = mockClient
+
+	cs, _ := newTestController()
+	_, err := cs.ControllerPublishVolume(context.Background(), &csi.ControllerPublishVolumeRequest{
+		VolumeId:         SampleVolumes[0].Id,
+		NodeId:           attacherDock.Id,
+		VolumeCapability: mountCap,
+	})
+	expectCode(t, err, codes.FailedPrecondition)
+}
+
+func TestControllerPublishVolumeReusesAttachment(t *testing.T) {
+	for _, tc := range []struct {
+		status   string
+		code     codes.Code
+		recreate bool
+	}{
+		{model.VolumeAttachAvailable, codes.OK, false},
+		{model.VolumeAttachErrorExpanding, codes.OK, false},
+		{model.VolumeAttachCreating, codes.Aborted, false},
+		{model.VolumeAttachError, codes.OK, true},
+		{model.VolumeAttachErrorDeleting, codes.OK, true},
+	} {
+		var atc = SampleAttachments[0]
+		atc.Host = attacherDock.NodeId
+		atc.Status = tc.status
+		mockClient := new(dbtest.Client)
+		mockClient.On("GetVolume", c.NewAdminContext(), SampleVolumes[0].Id).Return(&SampleVolumes[0], nil)
+		mockClient.On("GetDock", c.NewAdminContext(), attacherDock.Id).Return(attacherDock, nil)
+		mockClient.On("ListAttachmentsByVolumeId", c.NewAdminContext(), SampleVolumes[0].Id).Return([]*model.VolumeAttachmentSpec{&atc}, nil)
+		mockClient.On("UpdateStatus", c.NewAdminContext(), &atc, model.VolumeAttachCreating).Return(nil)
+		db.C = mockClient
+
+		cs, ctrClient := newTestController()
+		ctrClient.On("CreateVolumeAttachment", context.Background(), mock.AnythingOfType("*proto.CreateVolumeAttachmentOpts")).Return(pb.GenericResponseResult(&atc), nil)
+
+		resp, err := cs.ControllerPublishVolume(context.Background(), &csi.ControllerPublishVolumeRequest{
+			VolumeId:         SampleVolumes[0].Id,
+			NodeId:           attacherDock.Id,
+			VolumeCapability: mountCap,
+		})
+		expectCode(t, err, tc.code)
+		if err == nil && resp.GetPublishContext()[PublishAttachmentId] != atc.Id {
+			t.Errorf("Expected attachment %s of status %s reused, got %v", atc.Id, tc.status, resp.GetPublishContext())
+		}
+		// No new attachment is created, the failed one is created again.
+		mockClient.AssertNotCalled(t, "CreateVolumeAttachment", mock.Anything, mock.Anything)
+		if tc.recreate {
+			opt := ctrClient.Calls[len(ctrClient.Calls)-1].Arguments.Get(1).(*pb.CreateVolumeAttachmentOpts)
+			if opt.Id != atc.Id {
+				t.Errorf("Expected attachment %s created again, got %s", atc.Id, opt.Id)
+			}
+		} else {
+			ctrClient.AssertNotCalled(t, "CreateVolumeAttachment", mock.Anything, mock.Anything)
+		}
+	}
+}
+
+func TestControllerClientConnectedOnce(t *testing.T) {
+	mockClient := new(dbtest.Client)
+	mockClient.On("GetVolume", c.NewAdminContext(), SampleVolumes[0].Id).Return(&SampleVolumes[0], nil)
+	mockClient.On("ListAttachmentsByVolumeId", c.NewAdminContext(), SampleVolumes[0].Id).Return([]*model.VolumeAttachmentSpec{&SampleAttachments[0]}, nil)
+	db.C = mockClient
+
+	cs, ctrClient := newTestController()
+	ctrClient.On("DeleteVolumeAttachment", context.Background(), mock.AnythingOfType("*proto.DeleteVolumeAttachmentOpts")).Return(pb.GenericResponseResult(nil), nil)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := cs.ControllerUnpublishVolume(context.Background(), &csi.ControllerUnpublishVolumeRequest{
+				VolumeId: SampleVolumes[0].Id,
+			}); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+	ctrClient.AssertNumberOfCalls(t, "Connect", 1)
+	ctrClient.AssertNotCalled(t, "Close")
+}
+
+func TestListVolumes(t *testing.T) {
+	var vols []*model.VolumeSpec
+	for i := range SampleVolumes {
+		vols = append(vols, &SampleVolumes[i])
+	}
+	mockClient := new(dbtest.Client)
+	mockClient.On("ListVolumes", c.NewAdminContext()).Return(vols, nil)
+	db.C = mockClient
+
+	cs, _ := newTestController()
+	resp, err := cs.ListVolumes(context.Background(), &csi.ListVolumesRequest{MaxEntries: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.GetEntries()) != 1 || resp.GetNextToken() != "1" {
+		t.Errorf("Unexpected first page: %+v", resp)
+	}
+
+	resp, err = cs.ListVolumes(context.Background(), &csi.ListVolumesRequest{StartingToken: resp.GetNextToken()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.GetEntries()) != len(vols)-1 || resp.GetNextToken() != "" {
+		t.Errorf("Unexpected last page: %+v", resp)
+	}
+
+	_, err = cs.ListVolumes(context.Background(), &csi.ListVolumesRequest{StartingToken: "invalid"})
+	expectCode(t, err, codes.Aborted)
+}
@@ -0,0 +1,122 @@
+// Copyright (c) 2018 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+//    Licensed under the Apache License, Version 2.0 (the "License"); you may
+//    not use this file except in compliance with the License. You may obtain
+//    a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//    WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//    License for the specific language governing permissions and limitations
+//    under the License.
+
+/*
+This module implements the Container Storage Interface (CSI) frontend of
+OpenSDS. The Identity and Controller services map the CSI requests onto the
+Controller RPCs of osdslet, and the Node service attaches volumes on the host
+through the same connectors which are used by the attacher dock.
+
+*/
+
+package csi
+
+import (
+	"os"
+	"strings"
+
+	log "github.com/golang/glog"
+	"github.com/opensds/opensds/contrib/drivers/plugin"
+	"github.com/opensds/opensds/pkg/controller/client"
+	csi "github.com/opensds/opensds/pkg/csi/spec"
+	. "github.com/opensds/opensds/pkg/utils/config"
+	"github.com/satori/go.uuid"
+	"google.golang.org/grpc"
+
+	_ "github.com/opensds/opensds/contrib/connector/fc"
+	_ "github.com/opensds/opensds/contrib/connector/iscsi"
+	_ "github.com/opensds/opensds/contrib/connector/local"
+	_ "github.com/opensds/opensds/contrib/connector/rbd"
+)
+
+const (
+	// PluginName is the name of the OpenSDS CSI plugin.
+	PluginName = "csi.opensds.io"
+	// PluginVersion is the version of the OpenSDS CSI plugin.
+	PluginVersion = "0.1.0"
+
+	// TopologyZoneKey is the topology key which carries the availability
+	// zone of volumes and nodes.
+	TopologyZoneKey = "topology.opensds.io/zone"
+
+	// ParamProfile is the storage class parameter which selects the profile
+	// of volumes, by id or by name.
+	ParamProfile = "profile"
+
+	// Keys of the publish context which ControllerPublishVolume passes to
+	// the node.
+	PublishAttachmentId   = "attachmentId"
+	PublishAccessProtocol = "accessProtocol"
+	PublishConnectionData = "connectionData"
+)
+
+// Mode tells which CSI services the plugin serves, a controller plugin is
+// deployed once per cluster while a node plugin runs on every host.
+type Mode string
+
+const (
+	ModeController Mode = "controller"
+	ModeNode       Mode = "node"
+	ModeAll        Mode = "all"
+)
+
+// NodeId returns the id of the attacher dock of this host, which is used as
+// the CSI node id so that the controller can find the host info of the node.
+func NodeId() (string, error) {
+	host, err := os.Hostname()
+	if err != nil {
+		log.Error("When get os hostname:", err)
+		return "", err
+	}
+	segments := strings.Split(CONF.OsdsDock.ApiEndpoint, ":")
+	endpointIp := segments[len(segments)-2]
+	return uuid.NewV5(uuid.NamespaceOID, host+":"+endpointIp).String(), nil
+}
+
+// NewServer method creates the gRPC server of CSI plugin. The Identity
+// service is always served, the Controller and Node services are served
+// according to the mode.
+func NewServer(mode Mode, ctrClient client.Client, nodeId, zone string, m Mounter) *grpc.Server {
+	s := grpc.NewServer()
+	csi.RegisterIdentityServer(s, &identityServer{mode: mode})
+	if mode == ModeController || mode == ModeAll {
+		csi.RegisterControllerServer(s, NewControllerServer(ctrClient))
+	}
+	if mode == ModeNode || mode == ModeAll {
+		csi.RegisterNodeServer(s, NewNodeServer(nodeId, zone, m))
+	}
+	return s
+}
+
+// Run method serves the CSI plugin at the endpoint until the listener fails.
+func Run(edp string, mode Mode, zone string) error {
+	var nodeId string
+	if mode == ModeNode || mode == ModeAll {
+		var err error
+		if nodeId, err = NodeId(); err != nil {
+			return err
+		}
+	}
+
+	lis, err := plugin.Listen(edp)
+	if err != nil {
+		log.Errorf("failed to listen %s: %v", edp, err)
+		return err
+	}
+	log.Info("CSI plugin initialized! Start listening on:", lis.Addr())
+
+	s := NewServer(mode, client.NewClient(), nodeId, zone, NewMounter())
+	defer s.Stop()
+	return s.Serve(lis)
+}
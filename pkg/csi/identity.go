@@ -0,0 +1,57 @@
+// Copyright (c) 2018 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+//    Licensed under the Apache License, Version 2.0 (the "License"); you may
+//    not use this file except in compliance with the License. You may obtain
+//    a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//    WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//    License for the specific language governing permissions and limitations
+//    under the License.
+
+package csi
+
+import (
+	"github.com/golang/protobuf/ptypes/wrappers"
+	csi "github.com/opensds/opensds/pkg/csi/spec"
+	"golang.org/x/net/context"
+)
+
+// identityServer is used to implement csi.IdentityServer.
+type identityServer struct {
+	mode Mode
+}
+
+// GetPluginInfo implements csi.IdentityServer.GetPluginInfo
+func (is *identityServer) GetPluginInfo(ctx context.Context, req *csi.GetPluginInfoRequest) (*csi.GetPluginInfoResponse, error) {
+	return &csi.GetPluginInfoResponse{
+		Name:          PluginName,
+		VendorVersion: PluginVersion,
+	}, nil
+}
+
+// GetPluginCapabilities implements csi.IdentityServer.GetPluginCapabilities
+func (is *identityServer) GetPluginCapabilities(ctx context.Context, req *csi.GetPluginCapabilitiesRequest) (*csi.GetPluginCapabilitiesResponse, error) {
+	var caps []*csi.PluginCapability
+	if is.mode == ModeController || is.mode == ModeAll {
+		for _, t := range []csi.PluginCapability_Service_Type{
+			csi.PluginCapability_Service_CONTROLLER_SERVICE,
+			csi.PluginCapability_Service_VOLUME_ACCESSIBILITY_CONSTRAINTS,
+		} {
+			caps = append(caps, &csi.PluginCapability{
+				Type: &csi.PluginCapability_Service_{
+					Service: &csi.PluginCapability_Service{Type: t},
+				},
+			})
+		}
+	}
+	return &csi.GetPluginCapabilitiesResponse{Capabilities: caps}, nil
+}
+
+// Probe implements csi.IdentityServer.Probe
+func (is *identityServer) Probe(ctx context.Context, req *csi.ProbeRequest) (*csi.ProbeResponse, error) {
+	return &csi.ProbeResponse{Ready: &wrappers.BoolValue{Value: true}}, nil
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2018 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+//    Licensed under the Apache License, Version 2.0 (the "License"); you may
+//    not use this file except in compliance with the License. You may obtain
+//    a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//    WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//    License for the specific language governing permissions and limitations
+//    under the License.
+
+package csi
+
+import (
+	"fmt"
+
+	"github.com/opensds/opensds/contrib/connector"
+)
+
+// Mounter is an abstract description of the mount operations which the node
+// service does on the host.
+type Mounter interface {
+	// GetFSType returns the file system type of the device, which is empty
+	// if the device is not formatted.
+	GetFSType(device string) (string, error)
+	Format(device, fsType string) error
+	Mount(device, target, fsType string, flags []string) error
+	// BindMount mounts the source, a directory or a block device, onto the
+	// target.
+	BindMount(source, target string, readOnly bool) error
+	Umount(target string) error
+	IsMounted(target string) (bool, error)
+}
+
+// NewMounter method creates the mounter which runs the commands of host
+// through the helpers of connector.
+func NewMounter() Mounter { return &mounter{} }
+
+type mounter struct{}
+
+func (*mounter) GetFSType(device string) (string, error) {
+	return connector.GetFSType(device)
+}
+
+func (*mounter) Format(device, fsType string) error {
+	return connector.Format(device, fsType)
+}
+
+func (*mounter) Mount(device, target, fsType string, flags []string) error {
+	return connector.Mount(device, target, fsType, flags)
+}
+
+func (*mounter) BindMount(source, target string, readOnly bool) error {
+	if out, err := connector.ExecCmd("mount", "--bind", source, target); err != nil {
+		return fmt.Errorf("bind mount %s to %s failed: %v, output: %s", source, target, err, out)
+	}
+	if !readOnly {
+		return nil
+	}
+	// The read-only flag of bind mount only takes effect when remounting.
+	if out, err := connector.ExecCmd("mount", "-o", "remount,bind,ro", target); err != nil {
+		return fmt.Errorf("remount %s read-only failed: %v, output: %s", target, err, out)
+	}
+	return nil
+}
+
+func (*mounter) Umount(target string) error {
+	return connector.Umount(target)
+}
+
+func (*mounter) IsMounted(target string) (bool, error) {
+	return connector.IsMounted(target)
+}
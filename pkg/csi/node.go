@@ -0,0 +1,321 @@
+// Copyright (c) 2018 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+//    Licensed under the Apache License, Version 2.0 (the "License"); you may
+//    not use this file except in compliance with the License. You may obtain
+//    a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//    WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//    License for the specific language governing permissions and limitations
+//    under the License.
+
+package csi
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	log "github.com/golang/glog"
+	"github.com/opensds/opensds/contrib/connector"
+	c "github.com/opensds/opensds/pkg/context"
+	csi "github.com/opensds/opensds/pkg/csi/spec"
+	"github.com/opensds/opensds/pkg/db"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// defaultFSType is the file system which the volume is formatted with if the
+// volume capability doesn't specify one.
+const defaultFSType = "ext4"
+
+// nodeServer is used to implement csi.NodeServer.
+type nodeServer struct {
+	nodeId  string
+	zone    string
+	mounter Mounter
+}
+
+// NewNodeServer method creates the CSI node service of the host, the nodeId
+// is the id of the attacher dock on the host, and zone is the availability
+// zone of the host, which is optional.
+func NewNodeServer(nodeId, zone string, m Mounter) csi.NodeServer {
+	return &nodeServer{nodeId: nodeId, zone: zone, mounter: m}
+}
+
+// blockPath returns the file in the staging directory onto which the device
+// of block volume is mounted.
+func blockPath(stagingPath, volId string) string {
+	return filepath.Join(stagingPath, volId)
+}
+
+// NodeStageVolume implements csi.NodeServer.NodeStageVolume
+func (ns *nodeServer) NodeStageVolume(ctx context.Context, req *csi.NodeStageVolumeRequest) (*csi.NodeStageVolumeResponse, error) {
+	log.Info("CSI node receive stage volume request, vr =", req)
+
+	if req.GetVolumeId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "volume id is required")
+	}
+	if req.GetStagingTargetPath() == "" {
+		return nil, status.Error(codes.InvalidArgument, "staging target path is required")
+	}
+	cap := req.GetVolumeCapability()
+	if cap == nil {
+		return nil, status.Error(codes.InvalidArgument, "volume capability is required")
+	}
+	protocol := req.GetPublishContext()[PublishAccessProtocol]
+	var connData = make(map[string]interface{})
+	if err := json.Unmarshal([]byte(req.GetPublishContext()[PublishConnectionData]), &connData); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid connection data in publish context")
+	}
+
+	target := req.GetStagingTargetPath()
+	if cap.GetBlock() != nil {
+		target = blockPath(target, req.GetVolumeId())
+	}
+	if mounted, err := ns.mounter.IsMounted(target); err == nil && mounted {
+		return &csi.NodeStageVolumeResponse{}, nil
+	}
+
+	con := connector.NewConnector(protocol)
+	if con == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "can not find connector (%s)!", protocol)
+	}
+	device, err := con.Attach(connData)
+	if err != nil {
+		log.Error("error occurred in csi node when attach volume:", err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if err := connector.Throttle(device, connData); err != nil {
+		log.Error("error occurred in csi node when throttle volume:", err)
+		if err := con.Detach(connData); err != nil {
+			log.Error("error occurred in csi node when detach volume:", err)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if err := ns.mountDevice(device, target, cap); err != nil {
+		// The device is detached, so that it's not left on the host when the
+		// volume can't be staged.
+		unthrottle(device, connData)
+		if err := con.Detach(connData); err != nil {
+			log.Error("error occurred in csi node when detach volume:", err)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &csi.NodeStageVolumeResponse{}, nil
+}
+
+// unthrottle removes the throttling of the device if the connection data
+// asks for it, the failure is only logged as the device is detached anyway.
+func unthrottle(device string, connData map[string]interface{}) {
+	if !connector.IsThrottled(connData) {
+		return
+	}
+	if err := connector.Unthrottle(device); err != nil {
+		log.Warning("failed to unthrottle volume:", err)
+	}
+}
+
+// mountDevice mounts the device of volume onto the target as the volume
+// capability asks, it's mounted read-only if the access mode is read-only.
+func (ns *nodeServer) mountDevice(device, target string, cap *csi.VolumeCapability) error {
+	readOnly := cap.GetAccessMode().GetMode() == csi.VolumeCapability_AccessMode_SINGLE_NODE_READER_ONLY
+	if cap.GetBlock() != nil {
+		f, err := os.OpenFile(target, os.O_CREATE, 0640)
+		if err != nil {
+			return err
+		}
+		f.Close()
+		return ns.mounter.BindMount(device, target, readOnly)
+	}
+
+	fsType := cap.GetMount().GetFsType()
+	if fsType == "" {
+		fsType = defaultFSType
+	}
+	// The volume is formatted only when it has no file system, so that the
+	// data on it is kept.
+	curFSType, err := ns.mounter.GetFSType(device)
+	if err != nil {
+		return err
+	}
+	if curFSType == "" {
+		if err := ns.mounter.Format(device, fsType); err != nil {
+			return err
+		}
+	} else {
+		fsType = curFSType
+	}
+	flags := append([]string{}, cap.GetMount().GetMountFlags()...)
+	if readOnly {
+		flags = append(flags, "ro")
+	}
+	return ns.mounter.Mount(device, target, fsType, flags)
+}
+
+// NodeUnstageVolume implements csi.NodeServer.NodeUnstageVolume
+func (ns *nodeServer) NodeUnstageVolume(ctx context.Context, req *csi.NodeUnstageVolumeRequest) (*csi.NodeUnstageVolumeResponse, error) {
+	log.Info("CSI node receive unstage volume request, vr =", req)
+
+	if req.GetVolumeId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "volume id is required")
+	}
+	if req.GetStagingTargetPath() == "" {
+		return nil, status.Error(codes.InvalidArgument, "staging target path is required")
+	}
+
+	target := req.GetStagingTargetPath()
+	if _, err := os.Stat(blockPath(target, req.GetVolumeId())); err == nil {
+		target = blockPath(target, req.GetVolumeId())
+	}
+	if mounted, err := ns.mounter.IsMounted(target); err == nil && mounted {
+		if err := ns.mounter.Umount(target); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+	if target != req.GetStagingTargetPath() {
+		os.Remove(target)
+	}
+
+	// The connection data to detach the volume is kept in the attachment of
+	// the volume on this node.
+	osdsCtx := c.NewAdminContext()
+	dck, err := db.C.GetDock(osdsCtx, ns.nodeId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	atcs, err := db.C.ListAttachmentsByVolumeId(osdsCtx, req.GetVolumeId())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	for _, atc := range atcs {
+		if atc.Host != dck.NodeId {
+			continue
+		}
+		con := connector.NewConnector(atc.AccessProtocol)
+		if con == nil {
+			return nil, status.Errorf(codes.Internal, "can not find connector (%s)!", atc.AccessProtocol)
+		}
+		if connector.IsThrottled(atc.ConnectionData) {
+			// Expand rescans the attached volume, which finds out the device
+			// of it as well.
+			if device, err := con.Expand(atc.ConnectionData); err != nil {
+				log.Warning("failed to find volume device to unthrottle:", err)
+			} else {
+				unthrottle(device, atc.ConnectionData)
+			}
+		}
+		if err := con.Detach(atc.ConnectionData); err != nil {
+			log.Error("error occurred in csi node when detach volume:", err)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+	return &csi.NodeUnstageVolumeResponse{}, nil
+}
+
+// NodePublishVolume implements csi.NodeServer.NodePublishVolume
+func (ns *nodeServer) NodePublishVolume(ctx context.Context, req *csi.NodePublishVolumeRequest) (*csi.NodePublishVolumeResponse, error) {
+	log.Info("CSI node receive publish volume request, vr =", req)
+
+	if req.GetVolumeId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "volume id is required")
+	}
+	if req.GetStagingTargetPath() == "" {
+		return nil, status.Error(codes.InvalidArgument, "staging target path is required")
+	}
+	if req.GetTargetPath() == "" {
+		return nil, status.Error(codes.InvalidArgument, "target path is required")
+	}
+	cap := req.GetVolumeCapability()
+	if cap == nil {
+		return nil, status.Error(codes.InvalidArgument, "volume capability is required")
+	}
+
+	target := req.GetTargetPath()
+	if mounted, err := ns.mounter.IsMounted(target); err == nil && mounted {
+		return &csi.NodePublishVolumeResponse{}, nil
+	}
+
+	source := req.GetStagingTargetPath()
+	if cap.GetBlock() != nil {
+		source = blockPath(source, req.GetVolumeId())
+		if err := os.MkdirAll(filepath.Dir(target), 0750); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		f, err := os.OpenFile(target, os.O_CREATE, 0640)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		f.Close()
+	} else if err := os.MkdirAll(target, 0750); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if err := ns.mounter.BindMount(source, target, req.GetReadonly()); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &csi.NodePublishVolumeResponse{}, nil
+}
+
+// NodeUnpublishVolume implements csi.NodeServer.NodeUnpublishVolume
+func (ns *nodeServer) NodeUnpublishVolume(ctx context.Context, req *csi.NodeUnpublishVolumeRequest) (*csi.NodeUnpublishVolumeResponse, error) {
+	log.Info("CSI node receive unpublish volume request, vr =", req)
+
+	if req.GetVolumeId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "volume id is required")
+	}
+	if req.GetTargetPath() == "" {
+		return nil, status.Error(codes.InvalidArgument, "target path is required")
+	}
+
+	target := req.GetTargetPath()
+	if mounted, err := ns.mounter.IsMounted(target); err == nil && mounted {
+		if err := ns.mounter.Umount(target); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+	if err := os.Remove(target); err != nil && !os.IsNotExist(err) {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &csi.NodeUnpublishVolumeResponse{}, nil
+}
+
+// NodeGetVolumeStats implements csi.NodeServer.NodeGetVolumeStats
+func (ns *nodeServer) NodeGetVolumeStats(ctx context.Context, req *csi.NodeGetVolumeStatsRequest) (*csi.NodeGetVolumeStatsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "")
+}
+
+// NodeExpandVolume implements csi.NodeServer.NodeExpandVolume
+func (ns *nodeServer) NodeExpandVolume(ctx context.Context, req *csi.NodeExpandVolumeRequest) (*csi.NodeExpandVolumeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "")
+}
+
+// NodeGetCapabilities implements csi.NodeServer.NodeGetCapabilities
+func (ns *nodeServer) NodeGetCapabilities(ctx context.Context, req *csi.NodeGetCapabilitiesRequest) (*csi.NodeGetCapabilitiesResponse, error) {
+	return &csi.NodeGetCapabilitiesResponse{
+		Capabilities: []*csi.NodeServiceCapability{
+			{
+				Type: &csi.NodeServiceCapability_Rpc{
+					Rpc: &csi.NodeServiceCapability_RPC{
+						Type: csi.NodeServiceCapability_RPC_STAGE_UNSTAGE_VOLUME,
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+// NodeGetInfo implements csi.NodeServer.NodeGetInfo
+func (ns *nodeServer) NodeGetInfo(ctx context.Context, req *csi.NodeGetInfoRequest) (*csi.NodeGetInfoResponse, error) {
+	var resp = &csi.NodeGetInfoResponse{NodeId: ns.nodeId}
+	if ns.zone != "" {
+		resp.AccessibleTopology = &csi.Topology{
+			Segments: map[string]string{TopologyZoneKey: ns.zone},
+		}
+	}
+	return resp, nil
+}
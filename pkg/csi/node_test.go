@@ -0,0 +1,323 @@
+// Copyright (c) 2018 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+//    Licensed under the Apache License, Version 2.0 (the "License"); you may
+//    not use this file except in compliance with the License. You may obtain
+//    a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//    WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//    License for the specific language governing permissions and limitations
+//    under the License.
+
+package csi
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/opensds/opensds/contrib/connector"
+	c "github.com/opensds/opensds/pkg/context"
+	csi "github.com/opensds/opensds/pkg/csi/spec"
+	"github.com/opensds/opensds/pkg/db"
+	"github.com/opensds/opensds/pkg/model"
+	. "github.com/opensds/opensds/testutils/collection"
+	dbtest "github.com/opensds/opensds/testutils/db/testing"
+	"golang.org/x/net/context"
+)
+
+const fakeDevice = "/dev/fake0"
+
+type fakeConnector struct {
+	detached []map[string]interface{}
+	expanded int
+}
+
+func (f *fakeConnector) Attach(conn map[string]interface{}) (string, error) {
+	return fakeDevice, nil
+}
+
+func (f *fakeConnector) Detach(conn map[string]interface{}) error {
+	f.detached = append(f.detached, conn)
+	return nil
+}
+
+func (f *fakeConnector) Expand(conn map[string]interface{}) (string, error) {
+	f.expanded++
+	return fakeDevice, nil
+}
+
+func (f *fakeConnector) GetInitiatorInfo() (string, error) {
+	return "", nil
+}
+
+type fakeMounter struct {
+	fsType   string
+	mounts   map[string]string
+	flags    map[string][]string
+	formats  []string
+	mountErr error
+}
+
+func newFakeMounter() *fakeMounter {
+	return &fakeMounter{mounts: map[string]string{}, flags: map[string][]string{}}
+}
+
+func (m *fakeMounter) GetFSType(device string) (string, error) {
+	return m.fsType, nil
+}
+
+func (m *fakeMounter) Format(device, fsType string) error {
+	m.formats = append(m.formats, fsType)
+	m.fsType = fsType
+	return nil
+}
+
+func (m *fakeMounter) Mount(device, target, fsType string, flags []string) error {
+	if m.mountErr != nil {
+		return m.mountErr
+	}
+	m.mounts[target], m.flags[target] = device, flags
+	return nil
+}
+
+func (m *fakeMounter) BindMount(source, target string, readOnly bool) error {
+	if m.mountErr != nil {
+		return m.mountErr
+	}
+	m.mounts[target] = source
+	if readOnly {
+		m.flags[target] = []string{"ro"}
+	}
+	return nil
+}
+
+func (m *fakeMounter) Umount(target string) error {
+	delete(m.mounts, target)
+	return nil
+}
+
+func (m *fakeMounter) IsMounted(target string) (bool, error) {
+	_, ok := m.mounts[target]
+	return ok, nil
+}
+
+func newTestNode(t *testing.T) (*nodeServer, *fakeConnector, *fakeMounter, string, func()) {
+	dir, err := ioutil.TempDir("", "csi-test-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	con, m := &fakeConnector{}, newFakeMounter()
+	connector.RegisterConnector("fake", con)
+
+	ns := NewNodeServer(attacherDock.Id, "az1", m).(*nodeServer)
+	return ns, con, m, dir, func() {
+		connector.UnregisterConnector("fake")
+		os.RemoveAll(dir)
+	}
+}
+
+func TestNodeStageAndPublishVolume(t *testing.T) {
+	ns, _, m, dir, cleanup := newTestNode(t)
+	defer cleanup()
+
+	staging, target := filepath.Join(dir, "staging"), filepath.Join(dir, "target")
+	stageReq := &csi.NodeStageVolumeRequest{
+		VolumeId:          SampleVolumes[0].Id,
+		StagingTargetPath: staging,
+		VolumeCapability:  mountCap,
+		PublishContext: map[string]string{
+			PublishAccessProtocol: "fake",
+			PublishConnectionData: `{"targetIqn":"iqn.2017-10.io.opensds:volume:00000001"}`,
+		},
+	}
+	if _, err := ns.NodeStageVolume(context.Background(), stageReq); err != nil {
+		t.Fatal(err)
+	}
+	// The volume without file system is formatted with ext4 by default.
+	if !reflect.DeepEqual(m.formats, []string{"ext4"}) {
+		t.Errorf("Expected formats %v, got %v", []string{"ext4"}, m.formats)
+	}
+	if m.mounts[staging] != fakeDevice {
+		t.Errorf("Expected %s mounted on %s, got %v", fakeDevice, staging, m.mounts)
+	}
+
+	// Staging volume again is a no-op.
+	if _, err := ns.NodeStageVolume(context.Background(), stageReq); err != nil {
+		t.Fatal(err)
+	}
+	if len(m.formats) != 1 {
+		t.Errorf("Expected volume formatted once, got %v", m.formats)
+	}
+
+	if _, err := ns.NodePublishVolume(context.Background(), &csi.NodePublishVolumeRequest{
+		VolumeId:          SampleVolumes[0].Id,
+		StagingTargetPath: staging,
+		TargetPath:        target,
+		VolumeCapability:  mountCap,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if m.mounts[target] != staging {
+		t.Errorf("Expected %s bind mounted on %s, got %v", staging, target, m.mounts)
+	}
+
+	if _, err := ns.NodeUnpublishVolume(context.Background(), &csi.NodeUnpublishVolumeRequest{
+		VolumeId:   SampleVolumes[0].Id,
+		TargetPath: target,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := m.mounts[target]; ok {
+		t.Errorf("Expected %s unmounted", target)
+	}
+	if _, err := os.Stat(target); !os.IsNotExist(err) {
+		t.Errorf("Expected %s removed, got %v", target, err)
+	}
+}
+
+func TestNodeStageVolumeReadOnly(t *testing.T) {
+	ns, _, m, dir, cleanup := newTestNode(t)
+	defer cleanup()
+
+	roCap := &csi.VolumeCapability{
+		AccessType: &csi.VolumeCapability_Mount{
+			Mount: &csi.VolumeCapability_MountVolume{MountFlags: []string{"noatime"}},
+		},
+		AccessMode: &csi.VolumeCapability_AccessMode{
+			Mode: csi.VolumeCapability_AccessMode_SINGLE_NODE_READER_ONLY,
+		},
+	}
+	staging := filepath.Join(dir, "staging")
+	if _, err := ns.NodeStageVolume(context.Background(), &csi.NodeStageVolumeRequest{
+		VolumeId:          SampleVolumes[0].Id,
+		StagingTargetPath: staging,
+		VolumeCapability:  roCap,
+		PublishContext: map[string]string{
+			PublishAccessProtocol: "fake",
+			PublishConnectionData: `{}`,
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if expected := []string{"noatime", "ro"}; !reflect.DeepEqual(m.flags[staging], expected) {
+		t.Errorf("Expected mount flags %v, got %v", expected, m.flags[staging])
+	}
+	if expected := []string{"noatime"}; !reflect.DeepEqual(roCap.GetMount().GetMountFlags(), expected) {
+		t.Errorf("Expected mount flags of capability %v kept, got %v", expected, roCap.GetMount().GetMountFlags())
+	}
+}
+
+func TestNodeStageVolumeMountFailed(t *testing.T) {
+	ns, con, m, dir, cleanup := newTestNode(t)
+	defer cleanup()
+
+	m.mountErr = errors.New("mount failed")
+	if _, err := ns.NodeStageVolume(context.Background(), &csi.NodeStageVolumeRequest{
+		VolumeId:          SampleVolumes[0].Id,
+		StagingTargetPath: filepath.Join(dir, "staging"),
+		VolumeCapability:  mountCap,
+		PublishContext: map[string]string{
+			PublishAccessProtocol: "fake",
+			PublishConnectionData: `{"lun":"1"}`,
+		},
+	}); err == nil {
+		t.Fatal("Expected error of mount failure, got nil")
+	}
+	// The device isn't left attached.
+	var expected = []map[string]interface{}{{"lun": "1"}}
+	if !reflect.DeepEqual(con.detached, expected) {
+		t.Errorf("Expected detached %v, got %v", expected, con.detached)
+	}
+}
+
+func TestNodeStageVolumeThrottleFailed(t *testing.T) {
+	ns, con, _, dir, cleanup := newTestNode(t)
+	defer cleanup()
+
+	// The fake device doesn't exist, so it can't be throttled.
+	if _, err := ns.NodeStageVolume(context.Background(), &csi.NodeStageVolumeRequest{
+		VolumeId:          SampleVolumes[0].Id,
+		StagingTargetPath: filepath.Join(dir, "staging"),
+		VolumeCapability:  mountCap,
+		PublishContext: map[string]string{
+			PublishAccessProtocol: "fake",
+			PublishConnectionData: `{"lun":"1","maxIOPS":1000,"maxBWS":100}`,
+		},
+	}); err == nil {
+		t.Fatal("Expected error of throttle failure, got nil")
+	}
+	if len(con.detached) != 1 {
+		t.Errorf("Expected the device detached, got %v", con.detached)
+	}
+}
+
+func TestNodeUnstageVolume(t *testing.T) {
+	ns, con, m, dir, cleanup := newTestNode(t)
+	defer cleanup()
+
+	var atcs = []*model.VolumeAttachmentSpec{
+		{
+			BaseModel:      &model.BaseModel{Id: "attachment-01"},
+			VolumeId:       SampleVolumes[0].Id,
+			HostInfo:       model.HostInfo{Host: "node-02"},
+			AccessProtocol: "fake",
+			ConnectionInfo: model.ConnectionInfo{ConnectionData: map[string]interface{}{"lun": "1"}},
+		},
+		{
+			BaseModel:      &model.BaseModel{Id: "attachment-02"},
+			VolumeId:       SampleVolumes[0].Id,
+			HostInfo:       model.HostInfo{Host: attacherDock.NodeId},
+			AccessProtocol: "fake",
+			ConnectionInfo: model.ConnectionInfo{ConnectionData: map[string]interface{}{"lun": "2", "maxIOPS": 1000}},
+		},
+	}
+	mockClient := new(dbtest.Client)
+	mockClient.On("GetDock", c.NewAdminContext(), attacherDock.Id).Return(attacherDock, nil)
+	mockClient.On("ListAttachmentsByVolumeId", c.NewAdminContext(), SampleVolumes[0].Id).Return(atcs, nil)
+	db.C = mockClient
+
+	staging := filepath.Join(dir, "staging")
+	m.mounts[staging] = fakeDevice
+	if _, err := ns.NodeUnstageVolume(context.Background(), &csi.NodeUnstageVolumeRequest{
+		VolumeId:          SampleVolumes[0].Id,
+		StagingTargetPath: staging,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := m.mounts[staging]; ok {
+		t.Errorf("Expected %s unmounted", staging)
+	}
+	// Only the attachment on this node is detached, the device of which is
+	// found out to be unthrottled.
+	var expected = []map[string]interface{}{{"lun": "2", "maxIOPS": 1000}}
+	if !reflect.DeepEqual(con.detached, expected) {
+		t.Errorf("Expected detached %v, got %v", expected, con.detached)
+	}
+	if con.expanded != 1 {
+		t.Errorf("Expected the device of throttled volume found out once, got %d", con.expanded)
+	}
+}
+
+func TestNodeGetInfo(t *testing.T) {
+	ns := NewNodeServer(attacherDock.Id, "az1", newFakeMounter())
+	resp, err := ns.NodeGetInfo(context.Background(), &csi.NodeGetInfoRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var expected = &csi.NodeGetInfoResponse{
+		NodeId: attacherDock.Id,
+		AccessibleTopology: &csi.Topology{
+			Segments: map[string]string{TopologyZoneKey: "az1"},
+		},
+	}
+	if !reflect.DeepEqual(resp, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, resp)
+	}
+}
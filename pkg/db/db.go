@@ -129,6 +129,16 @@ type Client interface {
 
 	DeleteVolumeAttachment(ctx *c.Context, attachmentId string) error
 
+	CreateSnapshotAttachment(ctx *c.Context, attachment *model.SnapshotAttachmentSpec) (*model.SnapshotAttachmentSpec, error)
+
+	GetSnapshotAttachment(ctx *c.Context, attachmentId string) (*model.SnapshotAttachmentSpec, error)
+
+	ListSnapshotAttachments(ctx *c.Context, snapshotId string) ([]*model.SnapshotAttachmentSpec, error)
+
+	UpdateSnapshotAttachment(ctx *c.Context, attachmentId string, attachment *model.SnapshotAttachmentSpec) (*model.SnapshotAttachmentSpec, error)
+
+	DeleteSnapshotAttachment(ctx *c.Context, attachmentId string) error
+
 	CreateVolumeSnapshot(ctx *c.Context, vs *model.VolumeSnapshotSpec) (*model.VolumeSnapshotSpec, error)
 
 	GetVolumeSnapshot(ctx *c.Context, snapshotID string) (*model.VolumeSnapshotSpec, error)
@@ -176,6 +186,16 @@ type Client interface {
 	VolumesToUpdate(ctx *c.Context, volumeList []*model.VolumeSpec) ([]*model.VolumeSpec, error)
 
 	ListVolumeGroupsWithFilter(ctx *c.Context, m map[string][]string) ([]*model.VolumeGroupSpec, error)
+
+	CreateBackupSchedule(ctx *c.Context, bs *model.BackupScheduleSpec) (*model.BackupScheduleSpec, error)
+
+	GetBackupSchedule(ctx *c.Context, volumeId string) (*model.BackupScheduleSpec, error)
+
+	ListBackupSchedules(ctx *c.Context) ([]*model.BackupScheduleSpec, error)
+
+	UpdateBackupSchedule(ctx *c.Context, bs *model.BackupScheduleSpec) (*model.BackupScheduleSpec, error)
+
+	DeleteBackupSchedule(ctx *c.Context, volumeId string) error
 }
 
 func UpdateVolumeStatus(ctx *c.Context, client Client, volID, status string) error {
@@ -188,6 +208,11 @@ func UpdateVolumeAttachmentStatus(ctx *c.Context, client Client, atcID, status s
 	return client.UpdateStatus(ctx, atc, status)
 }
 
+func UpdateSnapshotAttachmentStatus(ctx *c.Context, client Client, atcID, status string) error {
+	atc, _ := client.GetSnapshotAttachment(ctx, atcID)
+	return client.UpdateStatus(ctx, atc, status)
+}
+
 func UpdateVolumeSnapshotStatus(ctx *c.Context, client Client, snapID, status string) error {
 	snap, _ := client.GetVolumeSnapshot(ctx, snapID)
 	return client.UpdateStatus(ctx, snap, status)
@@ -1207,6 +1207,9 @@ func (c *Client) UpdateVolume(ctx *c.Context, vol *model.VolumeSpec) (*model.Vol
 	if vol.ReplicationDriverData != nil {
 		result.ReplicationDriverData = vol.ReplicationDriverData
 	}
+	if vol.Qos != nil {
+		result.Qos = vol.Qos
+	}
 	result.GroupId = vol.GroupId
 
 	// Set update time
@@ -1516,6 +1519,15 @@ func (c *Client) UpdateVolumeAttachment(ctx *c.Context, attachmentId string, att
 	if len(attachment.AccessProtocol) > 0 {
 		result.AccessProtocol = attachment.AccessProtocol
 	}
+	if len(attachment.FsType) > 0 {
+		result.FsType = attachment.FsType
+	}
+	if attachment.MountOptions != nil {
+		result.MountOptions = attachment.MountOptions
+	}
+	if len(attachment.MountStatus) > 0 {
+		result.MountStatus = attachment.MountStatus
+	}
 	// Update metadata
 	if attachment.Metadata != nil {
 		result.Metadata = utils.MergeStringMaps(result.Metadata, attachment.Metadata)
@@ -1574,6 +1586,168 @@ func (c *Client) DeleteVolumeAttachment(ctx *c.Context, attachmentId string) err
 	return nil
 }
 
+// CreateSnapshotAttachment
+func (c *Client) CreateSnapshotAttachment(ctx *c.Context, attachment *model.SnapshotAttachmentSpec) (*model.SnapshotAttachmentSpec, error) {
+	attachment.TenantId = ctx.TenantId
+
+	atcBody, err := json.Marshal(attachment)
+	if err != nil {
+		return nil, err
+	}
+	dbReq := &Request{
+		Url:     urls.GenerateSnapshotAttachmentURL(urls.Etcd, ctx.TenantId, attachment.Id),
+		Content: string(atcBody),
+	}
+	dbRes := c.Create(dbReq)
+	if dbRes.Status != "Success" {
+		log.Error("When create snapshot attachment in db:", dbRes.Error)
+		return nil, errors.New(dbRes.Error)
+	}
+
+	return attachment, nil
+}
+
+func (c *Client) GetSnapshotAttachment(ctx *c.Context, attachmentId string) (*model.SnapshotAttachmentSpec, error) {
+	attach, err := c.getSnapshotAttachment(ctx, attachmentId)
+	if !IsAdminContext(ctx) || err == nil {
+		return attach, err
+	}
+	attachs, err := c.ListSnapshotAttachments(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range attachs {
+		if v.Id == attachmentId {
+			return v, nil
+		}
+	}
+	return nil, fmt.Errorf("specified snapshot attachment(%s) can't find", attachmentId)
+}
+
+// GetSnapshotAttachment
+func (c *Client) getSnapshotAttachment(ctx *c.Context, attachmentId string) (*model.SnapshotAttachmentSpec, error) {
+	dbReq := &Request{
+		Url: urls.GenerateSnapshotAttachmentURL(urls.Etcd, ctx.TenantId, attachmentId),
+	}
+	dbRes := c.Get(dbReq)
+	if dbRes.Status != "Success" {
+		log.Error("When get snapshot attachment in db:", dbRes.Error)
+		return nil, errors.New(dbRes.Error)
+	}
+
+	var atc = &model.SnapshotAttachmentSpec{}
+	if err := json.Unmarshal([]byte(dbRes.Message[0]), atc); err != nil {
+		log.Error("When parsing snapshot attachment in db:", dbRes.Error)
+		return nil, errors.New(dbRes.Error)
+	}
+	return atc, nil
+}
+
+// ListSnapshotAttachments
+func (c *Client) ListSnapshotAttachments(ctx *c.Context, snapshotId string) ([]*model.SnapshotAttachmentSpec, error) {
+	dbReq := &Request{
+		Url: urls.GenerateSnapshotAttachmentURL(urls.Etcd, ctx.TenantId),
+	}
+	if IsAdminContext(ctx) {
+		dbReq.Url = urls.GenerateSnapshotAttachmentURL(urls.Etcd, "")
+	}
+	dbRes := c.List(dbReq)
+	if dbRes.Status != "Success" {
+		log.Error("When list snapshot attachments in db:", dbRes.Error)
+		return nil, errors.New(dbRes.Error)
+	}
+
+	var atcs = []*model.SnapshotAttachmentSpec{}
+	for _, msg := range dbRes.Message {
+		var atc = &model.SnapshotAttachmentSpec{}
+		if err := json.Unmarshal([]byte(msg), atc); err != nil {
+			log.Error("When parsing snapshot attachment in db:", dbRes.Error)
+			return nil, errors.New(dbRes.Error)
+		}
+
+		if len(snapshotId) == 0 || atc.SnapshotId == snapshotId {
+			atcs = append(atcs, atc)
+		}
+	}
+	return atcs, nil
+}
+
+// UpdateSnapshotAttachment
+func (c *Client) UpdateSnapshotAttachment(ctx *c.Context, attachmentId string, attachment *model.SnapshotAttachmentSpec) (*model.SnapshotAttachmentSpec, error) {
+	result, err := c.GetSnapshotAttachment(ctx, attachmentId)
+	if err != nil {
+		return nil, err
+	}
+	if len(attachment.Status) > 0 {
+		result.Status = attachment.Status
+	}
+	if len(attachment.DriverVolumeType) > 0 {
+		result.DriverVolumeType = attachment.DriverVolumeType
+	}
+	if len(attachment.AccessProtocol) > 0 {
+		result.AccessProtocol = attachment.AccessProtocol
+	}
+	// Update metadata
+	if attachment.Metadata != nil {
+		result.Metadata = utils.MergeStringMaps(result.Metadata, attachment.Metadata)
+	}
+	// Update connectionData
+	if len(attachment.ConnectionData) > 0 && result.ConnectionData == nil {
+		result.ConnectionData = map[string]interface{}{}
+	}
+	for k, v := range attachment.ConnectionData {
+		result.ConnectionData[k] = v
+	}
+	// Set update time
+	result.UpdatedAt = time.Now().Format(constants.TimeFormat)
+
+	atcBody, err := json.Marshal(result)
+	if err != nil {
+		return nil, err
+	}
+
+	// If an admin want to access other tenant's resource just fake other's tenantId.
+	if !IsAdminContext(ctx) && !AuthorizeProjectContext(ctx, result.TenantId) {
+		return nil, fmt.Errorf("opertaion is not permitted")
+	}
+
+	dbReq := &Request{
+		Url:        urls.GenerateSnapshotAttachmentURL(urls.Etcd, result.TenantId, attachmentId),
+		NewContent: string(atcBody),
+	}
+
+	dbRes := c.Update(dbReq)
+	if dbRes.Status != "Success" {
+		log.Error("When update snapshot attachment in db:", dbRes.Error)
+		return nil, errors.New(dbRes.Error)
+	}
+	return result, nil
+}
+
+// DeleteSnapshotAttachment
+func (c *Client) DeleteSnapshotAttachment(ctx *c.Context, attachmentId string) error {
+	// If an admin want to access other tenant's resource just fake other's tenantId.
+	tenantId := ctx.TenantId
+	if IsAdminContext(ctx) {
+		attach, err := c.GetSnapshotAttachment(ctx, attachmentId)
+		if err != nil {
+			log.Error(err)
+			return err
+		}
+		tenantId = attach.TenantId
+	}
+	dbReq := &Request{
+		Url: urls.GenerateSnapshotAttachmentURL(urls.Etcd, tenantId, attachmentId),
+	}
+
+	dbRes := c.Delete(dbReq)
+	if dbRes.Status != "Success" {
+		log.Error("When delete snapshot attachment in db:", dbRes.Error)
+		return errors.New(dbRes.Error)
+	}
+	return nil
+}
+
 // CreateVolumeSnapshot
 func (c *Client) CreateVolumeSnapshot(ctx *c.Context, snp *model.VolumeSnapshotSpec) (*model.VolumeSnapshotSpec, error) {
 	snp.TenantId = ctx.TenantId
@@ -2192,6 +2366,9 @@ func (c *Client) UpdateVolumeGroup(ctx *c.Context, vgUpdate *model.VolumeGroupSp
 	if vgUpdate.UpdatedAt != "" && vgUpdate.UpdatedAt != vg.UpdatedAt {
 		vg.UpdatedAt = vgUpdate.UpdatedAt
 	}
+	if vgUpdate.GroupSnapshots != nil {
+		vg.GroupSnapshots = vgUpdate.GroupSnapshots
+	}
 
 	vgBody, err := json.Marshal(vg)
 	if err != nil {
@@ -2228,6 +2405,14 @@ func (c *Client) UpdateStatus(ctx *c.Context, in interface{}, status string) err
 			return errUpdate
 		}
 
+	case *model.SnapshotAttachmentSpec:
+		attm := in.(*model.SnapshotAttachmentSpec)
+		attm.Status = status
+		if _, errUpdate := c.UpdateSnapshotAttachment(ctx, attm.Id, attm); errUpdate != nil {
+			log.Error("When update snapshot attachment status in db:", errUpdate)
+			return errUpdate
+		}
+
 	case *model.VolumeSpec:
 		volume := in.(*model.VolumeSpec)
 		volume.Status = status
@@ -2244,6 +2429,40 @@ func (c *Client) UpdateStatus(ctx *c.Context, in interface{}, status string) err
 			return errUpdate
 		}
 
+	case *model.DockSpec:
+		dck := in.(*model.DockSpec)
+		dck.Status = status
+		dck.UpdatedAt = time.Now().Format(constants.TimeFormat)
+		dckBody, err := json.Marshal(dck)
+		if err != nil {
+			return err
+		}
+		dbReq := &Request{
+			Url:        urls.GenerateDockURL(urls.Etcd, "", dck.Id),
+			NewContent: string(dckBody),
+		}
+		if dbRes := c.Update(dbReq); dbRes.Status != "Success" {
+			log.Error("When update dock status in db:", dbRes.Error)
+			return errors.New(dbRes.Error)
+		}
+
+	case *model.StoragePoolSpec:
+		pol := in.(*model.StoragePoolSpec)
+		pol.Status = status
+		pol.UpdatedAt = time.Now().Format(constants.TimeFormat)
+		polBody, err := json.Marshal(pol)
+		if err != nil {
+			return err
+		}
+		dbReq := &Request{
+			Url:        urls.GeneratePoolURL(urls.Etcd, "", pol.Id),
+			NewContent: string(polBody),
+		}
+		if dbRes := c.Update(dbReq); dbRes.Status != "Success" {
+			log.Error("When update pool status in db:", dbRes.Error)
+			return errors.New(dbRes.Error)
+		}
+
 	case []*model.VolumeSpec:
 		vols := in.([]*model.VolumeSpec)
 		if _, errUpdate := c.VolumesToUpdate(ctx, vols); errUpdate != nil {
@@ -2432,3 +2651,112 @@ func (c *Client) SelectVolumeGroup(param map[string][]string, vgs []*model.Volum
 	}
 	return vglist
 }
+
+// CreateBackupSchedule
+func (c *Client) CreateBackupSchedule(ctx *c.Context, bs *model.BackupScheduleSpec) (*model.BackupScheduleSpec, error) {
+	if bs.Id == "" {
+		bs.Id = uuid.NewV4().String()
+	}
+	if bs.CreatedAt == "" {
+		bs.CreatedAt = time.Now().Format(constants.TimeFormat)
+	}
+
+	bsBody, err := json.Marshal(bs)
+	if err != nil {
+		return nil, err
+	}
+
+	dbReq := &Request{
+		Url:     urls.GenerateBackupScheduleURL(urls.Etcd, "", bs.VolumeId),
+		Content: string(bsBody),
+	}
+	dbRes := c.Create(dbReq)
+	if dbRes.Status != "Success" {
+		log.Error("When create backup schedule in db:", dbRes.Error)
+		return nil, errors.New(dbRes.Error)
+	}
+
+	return bs, nil
+}
+
+// GetBackupSchedule
+func (c *Client) GetBackupSchedule(ctx *c.Context, volumeId string) (*model.BackupScheduleSpec, error) {
+	dbReq := &Request{
+		Url: urls.GenerateBackupScheduleURL(urls.Etcd, "", volumeId),
+	}
+	dbRes := c.Get(dbReq)
+	if dbRes.Status != "Success" {
+		log.Error("When get backup schedule in db:", dbRes.Error)
+		return nil, errors.New(dbRes.Error)
+	}
+
+	var bs = &model.BackupScheduleSpec{}
+	if err := json.Unmarshal([]byte(dbRes.Message[0]), bs); err != nil {
+		log.Error("When parsing backup schedule in db:", dbRes.Error)
+		return nil, errors.New(dbRes.Error)
+	}
+	if !IsAdminContext(ctx) && !AuthorizeProjectContext(ctx, bs.TenantId) {
+		return nil, fmt.Errorf("specified backup schedule of volume(%s) can't find", volumeId)
+	}
+	return bs, nil
+}
+
+// ListBackupSchedules
+func (c *Client) ListBackupSchedules(ctx *c.Context) ([]*model.BackupScheduleSpec, error) {
+	dbReq := &Request{
+		Url: urls.GenerateBackupScheduleURL(urls.Etcd, ""),
+	}
+	dbRes := c.List(dbReq)
+	if dbRes.Status != "Success" {
+		log.Error("When list backup schedules in db:", dbRes.Error)
+		return nil, errors.New(dbRes.Error)
+	}
+
+	var bss = []*model.BackupScheduleSpec{}
+	for _, msg := range dbRes.Message {
+		var bs = &model.BackupScheduleSpec{}
+		if err := json.Unmarshal([]byte(msg), bs); err != nil {
+			log.Error("When parsing backup schedule in db:", dbRes.Error)
+			return nil, errors.New(dbRes.Error)
+		}
+		if !IsAdminContext(ctx) && !AuthorizeProjectContext(ctx, bs.TenantId) {
+			continue
+		}
+		bss = append(bss, bs)
+	}
+	return bss, nil
+}
+
+// UpdateBackupSchedule
+func (c *Client) UpdateBackupSchedule(ctx *c.Context, bs *model.BackupScheduleSpec) (*model.BackupScheduleSpec, error) {
+	bs.UpdatedAt = time.Now().Format(constants.TimeFormat)
+
+	bsBody, err := json.Marshal(bs)
+	if err != nil {
+		return nil, err
+	}
+
+	dbReq := &Request{
+		Url:        urls.GenerateBackupScheduleURL(urls.Etcd, "", bs.VolumeId),
+		NewContent: string(bsBody),
+	}
+	dbRes := c.Update(dbReq)
+	if dbRes.Status != "Success" {
+		log.Error("When update backup schedule in db:", dbRes.Error)
+		return nil, errors.New(dbRes.Error)
+	}
+	return bs, nil
+}
+
+// DeleteBackupSchedule
+func (c *Client) DeleteBackupSchedule(ctx *c.Context, volumeId string) error {
+	dbReq := &Request{
+		Url: urls.GenerateBackupScheduleURL(urls.Etcd, "", volumeId),
+	}
+	dbRes := c.Delete(dbReq)
+	if dbRes.Status != "Success" {
+		log.Error("When delete backup schedule in db:", dbRes.Error)
+		return errors.New(dbRes.Error)
+	}
+	return nil
+}
@@ -62,6 +62,12 @@ func (*fakeClientCaller) Get(req *Request) *Response {
 	if strings.Contains(req.Url, "replications") {
 		resp = append(resp, StringSliceReplications[0])
 	}
+	if strings.Contains(req.Url, "backupSchedules") {
+		resp = append(resp, StringSliceBackupSchedules[0])
+	}
+	if strings.Contains(req.Url, "snapshotAttachments") {
+		resp = append(resp, StringSliceSnapshotAttachments[0])
+	}
 	return &Response{
 		Status:  "Success",
 		Message: resp,
@@ -92,6 +98,12 @@ func (*fakeClientCaller) List(req *Request) *Response {
 	if strings.Contains(req.Url, "replications") {
 		resp = StringSliceReplications
 	}
+	if strings.Contains(req.Url, "backupSchedules") {
+		resp = StringSliceBackupSchedules
+	}
+	if strings.Contains(req.Url, "snapshotAttachments") {
+		resp = StringSliceSnapshotAttachments
+	}
 	return &Response{
 		Status:  "Success",
 		Message: resp,
@@ -333,6 +345,33 @@ func TestListVolumes(t *testing.T) {
 	}
 }
 
+func TestUpdateDockAndPoolStatus(t *testing.T) {
+	var dck = &model.DockSpec{
+		BaseModel: &model.BaseModel{
+			Id: "b7602e18-771e-11e7-8f38-dbd6d291f4e0",
+		},
+		Status: model.DockAvailable,
+	}
+	if err := fc.UpdateStatus(c.NewAdminContext(), dck, model.DockUnavailable); err != nil {
+		t.Error("Update dock status failed:", err)
+	}
+	if dck.Status != model.DockUnavailable {
+		t.Errorf("Expected %+v, got %+v\n", model.DockUnavailable, dck.Status)
+	}
+
+	var pol = &model.StoragePoolSpec{
+		BaseModel: &model.BaseModel{
+			Id: "084bf71e-a102-11e7-88a8-e31fe6d52248",
+		},
+	}
+	if err := fc.UpdateStatus(c.NewAdminContext(), pol, model.PoolUnavailable); err != nil {
+		t.Error("Update pool status failed:", err)
+	}
+	if pol.Status != model.PoolUnavailable {
+		t.Errorf("Expected %+v, got %+v\n", model.PoolUnavailable, pol.Status)
+	}
+}
+
 func TestUpdateVolume(t *testing.T) {
 	var vol = model.VolumeSpec{
 		BaseModel: &model.BaseModel{
@@ -391,9 +430,10 @@ func TestUpdateVolumeAttachment(t *testing.T) {
 		BaseModel: &model.BaseModel{
 			Id: "f2dda3d2-bf79-11e7-8665-f750b088f63e",
 		},
-		Mountpoint: "Test Mountpoint",
-		Status:     "Test Status",
-		VolumeId:   "bd5b12a8-a101-11e7-941e-d77981b584d8",
+		Mountpoint:  "Test Mountpoint",
+		MountStatus: "Test MountStatus",
+		Status:      "Test Status",
+		VolumeId:    "bd5b12a8-a101-11e7-941e-d77981b584d8",
 		HostInfo: model.HostInfo{Platform: "Test Platform",
 			OsType:    "Test OsType",
 			Ip:        "Test Ip",
@@ -419,6 +459,10 @@ func TestUpdateVolumeAttachment(t *testing.T) {
 		t.Errorf("Expected %+v, got %+v\n", "Test Mountpoint", result.Mountpoint)
 	}
 
+	if result.MountStatus != "Test MountStatus" {
+		t.Errorf("Expected %+v, got %+v\n", "Test MountStatus", result.MountStatus)
+	}
+
 	if result.Status != "Test Status" {
 		t.Errorf("Expected %+v, got %+v\n", "Test Status", result.Status)
 	}
@@ -613,3 +657,86 @@ func TestExtendVolume(t *testing.T) {
 		t.Errorf("Expected %+v, got %+v\n", 9, result.Size)
 	}
 }
+
+func TestCreateBackupSchedule(t *testing.T) {
+	if _, err := fc.CreateBackupSchedule(c.NewAdminContext(), &model.BackupScheduleSpec{BaseModel: &model.BaseModel{}}); err != nil {
+		t.Error("Create backup schedule failed:", err)
+	}
+}
+
+func TestGetBackupSchedule(t *testing.T) {
+	bs, err := fc.GetBackupSchedule(c.NewAdminContext(), "bd5b12a8-a101-11e7-941e-d77981b584d8")
+	if err != nil {
+		t.Error("Get backup schedule failed:", err)
+	}
+
+	var expected = &SampleBackupSchedules[0]
+	if !reflect.DeepEqual(bs, expected) {
+		t.Errorf("Expected %+v, got %+v\n", expected, bs)
+	}
+}
+
+func TestListBackupSchedules(t *testing.T) {
+	bss, err := fc.ListBackupSchedules(c.NewAdminContext())
+	if err != nil {
+		t.Error("List backup schedules failed:", err)
+	}
+
+	var expected []*model.BackupScheduleSpec
+	expected = append(expected, &SampleBackupSchedules[0])
+	if !reflect.DeepEqual(bss, expected) {
+		t.Errorf("Expected %+v, got %+v\n", expected, bss)
+	}
+}
+
+func TestDeleteBackupSchedule(t *testing.T) {
+	if err := fc.DeleteBackupSchedule(c.NewAdminContext(), ""); err != nil {
+		t.Error("Delete backup schedule failed:", err)
+	}
+}
+
+func TestCreateSnapshotAttachment(t *testing.T) {
+	if _, err := fc.CreateSnapshotAttachment(c.NewAdminContext(), &model.SnapshotAttachmentSpec{BaseModel: &model.BaseModel{}}); err != nil {
+		t.Error("Create snapshot attachment failed:", err)
+	}
+}
+
+func TestGetSnapshotAttachment(t *testing.T) {
+	atc, err := fc.GetSnapshotAttachment(c.NewAdminContext(), "")
+	if err != nil {
+		t.Error("Get snapshot attachment failed:", err)
+	}
+
+	var expected = &SampleSnapshotAttachments[0]
+	if !reflect.DeepEqual(atc, expected) {
+		t.Errorf("Expected %+v, got %+v\n", expected, atc)
+	}
+}
+
+func TestListSnapshotAttachments(t *testing.T) {
+	atcs, err := fc.ListSnapshotAttachments(c.NewAdminContext(), "3769855c-a102-11e7-b772-17b880d2f537")
+	if err != nil {
+		t.Error("List snapshot attachments failed:", err)
+	}
+
+	var expected []*model.SnapshotAttachmentSpec
+	expected = append(expected, &SampleSnapshotAttachments[0])
+	if !reflect.DeepEqual(atcs, expected) {
+		t.Errorf("Expected %+v, got %+v\n", expected, atcs)
+	}
+
+	// The attachments of other snapshots are filtered out.
+	atcs, err = fc.ListSnapshotAttachments(c.NewAdminContext(), "3bfaf2cc-a102-11e7-8ecb-63aea739d755")
+	if err != nil {
+		t.Error("List snapshot attachments failed:", err)
+	}
+	if len(atcs) != 0 {
+		t.Errorf("Expected no snapshot attachment, got %+v\n", atcs)
+	}
+}
+
+func TestDeleteSnapshotAttachment(t *testing.T) {
+	if err := fc.DeleteSnapshotAttachment(c.NewAdminContext(), ""); err != nil {
+		t.Error("Delete snapshot attachment failed:", err)
+	}
+}
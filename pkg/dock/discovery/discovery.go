@@ -24,6 +24,7 @@ import (
 	"os"
 	"runtime"
 	"strings"
+	"sync"
 	"time"
 
 	log "github.com/golang/glog"
@@ -33,9 +34,14 @@ import (
 	"github.com/opensds/opensds/pkg/db"
 	"github.com/opensds/opensds/pkg/model"
 	. "github.com/opensds/opensds/pkg/utils/config"
+	"github.com/opensds/opensds/pkg/utils/constants"
 	"github.com/satori/go.uuid"
 )
 
+// DefaultHeartbeatInterval is used when the heartbeat interval of dock is not
+// configured.
+const DefaultHeartbeatInterval = 30 * time.Second
+
 type Context struct {
 	StopChan chan bool
 	ErrChan  chan error
@@ -61,12 +67,35 @@ func DiscoveryAndReport(dd DockDiscoverer, ctx *Context) {
 	}
 }
 
+// Heartbeat method tells the controller that the docks managed by dd are
+// still alive at every interval until the context is stopped.
+func Heartbeat(dd DockDiscoverer, ctx *Context, interval time.Duration) {
+	if interval <= 0 {
+		interval = DefaultHeartbeatInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.StopChan:
+			return
+		case <-ticker.C:
+			if err := dd.Heartbeat(); err != nil {
+				log.Error("When send dock heartbeat:", err)
+			}
+		}
+	}
+}
+
 type DockDiscoverer interface {
 	Init() error
 
 	Discover() error
 
 	Report() error
+
+	Heartbeat() error
 }
 
 // NewDockDiscoverer method creates a new DockDiscoverer.
@@ -103,18 +132,21 @@ func (pdd *provisionDockDiscoverer) Init() error {
 	}
 
 	for _, v := range CONF.EnabledBackends {
-		b := bm[v]
-		if b.Name == "" {
+		b, ok := bm[v]
+		if !ok {
 			continue
 		}
 
+		// One dock is registered for each backend, so the dock is keyed by
+		// the backend name unless it's the default backend of the driver.
 		dck := &model.DockSpec{
 			BaseModel: &model.BaseModel{
-				Id: uuid.NewV5(uuid.NamespaceOID, host+":"+b.DriverName).String(),
+				Id: DockIdOf(host, v),
 			},
 			Name:        b.Name,
 			Description: b.Description,
 			DriverName:  b.DriverName,
+			BackendName: v,
 			Endpoint:    CONF.OsdsDock.ApiEndpoint,
 			NodeId:      host,
 			Type:        model.DockTypeProvioner,
@@ -132,7 +164,8 @@ func (pdd *provisionDockDiscoverer) Discover() error {
 
 	for _, dck := range pdd.dcks {
 		// Call function of StorageDrivers configured by storage drivers.
-		pols, err := drivers.Init(dck.DriverName).ListPools()
+		d := drivers.Init(dck.BackendName)
+		pols, err := d.ListPools()
 		if err != nil {
 			log.Error("Call driver to list pools failed:", err)
 			continue
@@ -144,15 +177,24 @@ func (pdd *provisionDockDiscoverer) Discover() error {
 
 		replicationDriverName := dck.Metadata["HostReplicationDriver"]
 		replicationType := model.ReplicationTypeHost
-		if drivers.IsSupportHostBasedReplication(dck.DriverName) {
+		if drivers.IsSupportHostBasedReplication(dck.BackendName) {
 			replicationType = model.ReplicationTypeArray
 			replicationDriverName = dck.DriverName
 		}
+		// The volumes can be replicated by the array if the driver supports
+		// and the backend enables it, or by the host-based replication
+		// driver of the dock.
+		caps := d.Capabilities()
+		if replicationType != model.ReplicationTypeArray {
+			caps.Replication = replicationDriverName != ""
+		}
 		for _, pol := range pols {
-			log.Infof("Backend %s discovered pool %s", dck.DriverName, pol.Name)
+			log.Infof("Backend %s discovered pool %s", dck.BackendName, pol.Name)
+			pol.Id = PoolIdOf(dck.BackendName, pol.Id)
 			pol.DockId = dck.Id
 			pol.ReplicationType = replicationType
 			pol.ReplicationDriverName = replicationDriverName
+			pol.Extras.Capabilities = caps
 		}
 		pdd.pols = append(pdd.pols, pols...)
 	}
@@ -184,12 +226,30 @@ func (pdd *provisionDockDiscoverer) Report() error {
 	return err
 }
 
+func (pdd *provisionDockDiscoverer) Heartbeat() error {
+	for _, dck := range pdd.dcks {
+		if err := pdd.Register(dck); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // attachDockDiscoverer is a struct for exposing some operations of attach
 // dock service discovery.
 type attachDockDiscoverer struct {
 	*DockRegister
 
-	dck *model.DockSpec
+	// The dock is discovered again periodically while the heartbeats are
+	// sent by another goroutine.
+	mutex sync.Mutex
+	dck   *model.DockSpec
+}
+
+func (add *attachDockDiscoverer) dock() *model.DockSpec {
+	add.mutex.Lock()
+	defer add.mutex.Unlock()
+	return add.dck
 }
 
 func (add *attachDockDiscoverer) Init() error { return nil }
@@ -201,13 +261,20 @@ func (add *attachDockDiscoverer) Discover() error {
 		return err
 	}
 
-	var localIqn string
-
-	localIqn, err = connector.NewConnector(connector.IscsiDriver).GetInitiatorInfo()
+	iscsiInitiator, err := connector.NewConnector(connector.IscsiDriver).GetInitiatorInfo()
 	if err != nil {
 		log.Error("get initiator failed", err)
 		return err
 	}
+	// The iscsi initiator info is the iqn followed by the multipath support.
+	iscsiInfo := strings.Split(iscsiInitiator, ",")
+	localIqn := iscsiInfo[0]
+	multipath := "false"
+	for _, v := range iscsiInfo[1:] {
+		if strings.HasPrefix(v, connector.MultiPath+":") {
+			multipath = strings.TrimPrefix(v, connector.MultiPath+":")
+		}
+	}
 
 	bindIp := CONF.BindIp
 	if bindIp == "" {
@@ -230,7 +297,7 @@ func (add *attachDockDiscoverer) Discover() error {
 
 	segments := strings.Split(CONF.OsdsDock.ApiEndpoint, ":")
 	endpointIp := segments[len(segments)-2]
-	add.dck = &model.DockSpec{
+	dck := &model.DockSpec{
 		BaseModel: &model.BaseModel{
 			Id: uuid.NewV5(uuid.NamespaceOID, host+":"+endpointIp).String(),
 		},
@@ -243,13 +310,27 @@ func (add *attachDockDiscoverer) Discover() error {
 			"HostIp":    bindIp,
 			"Initiator": localIqn,
 			"WWPNS":     strings.Join(wwpns, ","),
+			"Multipath": multipath,
 		},
 	}
+
+	add.mutex.Lock()
+	add.dck = dck
+	add.mutex.Unlock()
 	return nil
 }
 
 func (add *attachDockDiscoverer) Report() error {
-	return add.Register(add.dck)
+	return add.Register(add.dock())
+}
+
+func (add *attachDockDiscoverer) Heartbeat() error {
+	// The dock resource is not ready until the first discovery is done.
+	dck := add.dock()
+	if dck == nil {
+		return nil
+	}
+	return add.Register(dck)
 }
 
 func NewDockRegister() *DockRegister {
@@ -258,6 +339,10 @@ func NewDockRegister() *DockRegister {
 
 type DockRegister struct {
 	c db.Client
+
+	// The docks are registered by both the reports and the heartbeats, which
+	// run in different goroutines and update the same dock specs.
+	mutex sync.Mutex
 }
 
 func (dr *DockRegister) Register(in interface{}) error {
@@ -266,6 +351,12 @@ func (dr *DockRegister) Register(in interface{}) error {
 	switch in.(type) {
 	case *model.DockSpec:
 		dck := in.(*model.DockSpec)
+		dr.mutex.Lock()
+		defer dr.mutex.Unlock()
+		// Every registration of the dock means that it is alive, so it's
+		// regarded as a heartbeat.
+		dck.Status = model.DockAvailable
+		dck.LastHeartbeat = time.Now().Format(constants.TimeFormat)
 		// Call db module to create dock resource.
 		if _, err := dr.c.CreateDock(ctx, dck); err != nil {
 			log.Errorf("When create dock %s in db: %v\n", dck.Id, err)
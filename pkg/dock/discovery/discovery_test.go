@@ -23,6 +23,7 @@ import (
 	. "github.com/opensds/opensds/pkg/utils/config"
 	. "github.com/opensds/opensds/testutils/collection"
 	dbtest "github.com/opensds/opensds/testutils/db/testing"
+	"github.com/satori/go.uuid"
 )
 
 const (
@@ -35,8 +36,8 @@ func init() {
 	CONF.OsdsDock = OsdsDock{
 		ApiEndpoint:     "localhost:50050",
 		EnabledBackends: []string{"sample"},
-		Backends: Backends{
-			Sample: BackendProperties{
+		Backends: map[string]BackendProperties{
+			"sample": {
 				Name:        "sample",
 				Description: "sample backend service",
 				DriverName:  "sample",
@@ -72,6 +73,41 @@ func TestInit(t *testing.T) {
 	}
 }
 
+func TestInitWithBackendsOfSameDriver(t *testing.T) {
+	var fdd = NewFakeDockDiscoverer()
+	var backends = CONF.OsdsDock.Backends
+	var enabledBackends = CONF.OsdsDock.EnabledBackends
+	defer func() {
+		CONF.OsdsDock.Backends = backends
+		CONF.OsdsDock.EnabledBackends = enabledBackends
+	}()
+
+	CONF.OsdsDock.EnabledBackends = []string{"sample", "sample_2"}
+	CONF.OsdsDock.Backends = map[string]BackendProperties{
+		"sample":   {Name: "sample", DriverName: "sample"},
+		"sample_2": {Name: "sample_2", DriverName: "sample"},
+	}
+	if err := fdd.Init(); err != nil {
+		t.Errorf("Failed to init discoverer struct: %v\n", err)
+	}
+	if len(fdd.dcks) != 2 {
+		t.Fatalf("Expected 2 docks, got %d\n", len(fdd.dcks))
+	}
+	if fdd.dcks[0].Id == fdd.dcks[1].Id {
+		t.Errorf("Expected docks of different backends to have different ids, got %s\n", fdd.dcks[0].Id)
+	}
+	// The default backend of the driver keeps the dock id keyed by the
+	// driver name, which is used before.
+	if id := uuid.NewV5(uuid.NamespaceOID, fdd.dcks[0].NodeId+":sample").String(); fdd.dcks[0].Id != id {
+		t.Errorf("Expected dock id %s of default backend, got %s\n", id, fdd.dcks[0].Id)
+	}
+	for i, name := range CONF.OsdsDock.EnabledBackends {
+		if fdd.dcks[i].BackendName != name {
+			t.Errorf("Expected %s, got %s\n", name, fdd.dcks[i].BackendName)
+		}
+	}
+}
+
 func TestDiscover(t *testing.T) {
 	var fdd = NewFakeDockDiscoverer()
 	var expected []*model.StoragePoolSpec
@@ -91,6 +127,12 @@ func TestDiscover(t *testing.T) {
 	if !reflect.DeepEqual(fdd.pols, expected) {
 		t.Errorf("Expected %+v, got %+v\n", expected, fdd.pols)
 	}
+	for _, pol := range fdd.pols {
+		if !pol.Extras.Capabilities.Clone || pol.Extras.Capabilities.VolumeGroup {
+			t.Errorf("Expected capabilities of sample driver in pool %s, got %+v\n",
+				pol.Name, pol.Extras.Capabilities)
+		}
+	}
 }
 
 func TestReport(t *testing.T) {
@@ -113,3 +155,28 @@ func TestReport(t *testing.T) {
 		t.Errorf("Failed to store docks and pools into database: %v\n", err)
 	}
 }
+
+func TestHeartbeat(t *testing.T) {
+	var fdd = NewFakeDockDiscoverer()
+	var dck = &model.DockSpec{
+		BaseModel: &model.BaseModel{
+			Id: "b7602e18-771e-11e7-8f38-dbd6d291f4e0",
+		},
+		Status: model.DockUnavailable,
+	}
+	fdd.dcks = append(fdd.dcks, dck)
+
+	mockClient := new(dbtest.Client)
+	mockClient.On("CreateDock", c.NewAdminContext(), dck).Return(nil, nil)
+	fdd.c = mockClient
+
+	if err := fdd.Heartbeat(); err != nil {
+		t.Errorf("Failed to send dock heartbeat: %v\n", err)
+	}
+	if dck.Status != model.DockAvailable {
+		t.Errorf("Expected %+v, got %+v\n", model.DockAvailable, dck.Status)
+	}
+	if dck.LastHeartbeat == "" {
+		t.Error("Expected the last heartbeat of dock to be set")
+	}
+}
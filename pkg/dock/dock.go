@@ -27,16 +27,19 @@ import (
 	log "github.com/golang/glog"
 	"github.com/opensds/opensds/contrib/connector"
 	"github.com/opensds/opensds/contrib/drivers"
+	"github.com/opensds/opensds/contrib/drivers/plugin"
 	c "github.com/opensds/opensds/pkg/context"
 	"github.com/opensds/opensds/pkg/db"
 	"github.com/opensds/opensds/pkg/dock/discovery"
 	"github.com/opensds/opensds/pkg/model"
 	pb "github.com/opensds/opensds/pkg/model/proto"
+	. "github.com/opensds/opensds/pkg/utils/config"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 
 	_ "github.com/opensds/opensds/contrib/connector/fc"
 	_ "github.com/opensds/opensds/contrib/connector/iscsi"
+	_ "github.com/opensds/opensds/contrib/connector/local"
 	_ "github.com/opensds/opensds/contrib/connector/rbd"
 )
 
@@ -68,6 +71,21 @@ func (ds *dockServer) Run() error {
 	pb.RegisterProvisionDockServer(s, ds)
 	pb.RegisterAttachDockServer(s, ds)
 
+	// Launch the driver plugins managed by dock service before discovering
+	// pools from them.
+	if CONF.OsdsDock.DockType == model.DockTypeProvioner {
+		for name, b := range CONF.Backends {
+			if b.PluginCommand == "" {
+				continue
+			}
+			l := plugin.NewLauncher(name, b.PluginCommand, b.PluginEndpoint)
+			if err := l.Start(); err != nil {
+				return err
+			}
+			defer l.Stop()
+		}
+	}
+
 	// Trigger the discovery and report loop so that the dock service would
 	// update the capabilities from backends automatically.
 	if err := func() error {
@@ -81,6 +99,11 @@ func (ds *dockServer) Run() error {
 			MetaChan: make(chan string),
 		}
 		go discovery.DiscoveryAndReport(ds.Discoverer, ctx)
+		// Heartbeat keeps going even if the discovery is stopped, because
+		// the dock service is still able to serve requests.
+		go discovery.Heartbeat(ds.Discoverer, &discovery.Context{
+			StopChan: make(chan bool),
+		}, CONF.OsdsDock.HeartbeatInterval)
 		go func(ctx *discovery.Context) {
 			if err = <-ctx.ErrChan; err != nil {
 				log.Error("when calling capabilty report method:", err)
@@ -106,10 +129,27 @@ func (ds *dockServer) Run() error {
 	return s.Serve(lis)
 }
 
+// backendOpts is satisfied by the options of every request which is served by
+// a volume driver.
+type backendOpts interface {
+	GetDriverName() string
+	GetBackendName() string
+}
+
+// backendOf returns the backend which the request is sent to. The request
+// from a controller which doesn't name the backend is served by the driver
+// with its default configuration.
+func backendOf(opt backendOpts) string {
+	if opt.GetBackendName() != "" {
+		return opt.GetBackendName()
+	}
+	return opt.GetDriverName()
+}
+
 // CreateVolume implements pb.DockServer.CreateVolume
 func (ds *dockServer) CreateVolume(ctx context.Context, opt *pb.CreateVolumeOpts) (*pb.GenericResponse, error) {
 	// Get the storage drivers and do some initializations.
-	ds.Driver = drivers.Init(opt.GetDriverName())
+	ds.Driver = drivers.Init(backendOf(opt))
 	defer drivers.Clean(ds.Driver)
 
 	log.Info("Dock server receive create volume request, vr =", opt)
@@ -126,7 +166,7 @@ func (ds *dockServer) CreateVolume(ctx context.Context, opt *pb.CreateVolumeOpts
 // DeleteVolume implements pb.DockServer.DeleteVolume
 func (ds *dockServer) DeleteVolume(ctx context.Context, opt *pb.DeleteVolumeOpts) (*pb.GenericResponse, error) {
 	// Get the storage drivers and do some initializations.
-	ds.Driver = drivers.Init(opt.GetDriverName())
+	ds.Driver = drivers.Init(backendOf(opt))
 	defer drivers.Clean(ds.Driver)
 
 	log.Info("Dock server receive delete volume request, vr =", opt)
@@ -142,7 +182,7 @@ func (ds *dockServer) DeleteVolume(ctx context.Context, opt *pb.DeleteVolumeOpts
 // ExtendVolume implements pb.DockServer.ExtendVolume
 func (ds *dockServer) ExtendVolume(ctx context.Context, opt *pb.ExtendVolumeOpts) (*pb.GenericResponse, error) {
 	// Get the storage drivers and do some initializations.
-	ds.Driver = drivers.Init(opt.GetDriverName())
+	ds.Driver = drivers.Init(backendOf(opt))
 	defer drivers.Clean(ds.Driver)
 
 	log.Info("Dock server receive extend volume request, vr =", opt)
@@ -159,7 +199,7 @@ func (ds *dockServer) ExtendVolume(ctx context.Context, opt *pb.ExtendVolumeOpts
 // CreateVolumeAttachment implements pb.DockServer.CreateVolumeAttachment
 func (ds *dockServer) CreateVolumeAttachment(ctx context.Context, opt *pb.CreateVolumeAttachmentOpts) (*pb.GenericResponse, error) {
 	// Get the storage drivers and do some initializations.
-	ds.Driver = drivers.Init(opt.GetDriverName())
+	ds.Driver = drivers.Init(backendOf(opt))
 	defer drivers.Clean(ds.Driver)
 
 	log.Info("Dock server receive create volume attachment request, vr =", opt)
@@ -184,6 +224,15 @@ func (ds *dockServer) CreateVolumeAttachment(ctx context.Context, opt *pb.Create
 		},
 		ConnectionInfo: *connInfo,
 		Metadata:       opt.GetMetadata(),
+		MultiPath:      opt.GetMultiPath(),
+	}
+	// The connector on the host learns from connection data whether it
+	// should attach the volume through all the paths.
+	if opt.GetMultiPath() {
+		if atc.ConnectionData == nil {
+			atc.ConnectionData = map[string]interface{}{}
+		}
+		atc.ConnectionData[connector.MultiPath] = true
 	}
 	return pb.GenericResponseResult(atc), nil
 }
@@ -191,7 +240,7 @@ func (ds *dockServer) CreateVolumeAttachment(ctx context.Context, opt *pb.Create
 // DeleteVolumeAttachment implements pb.DockServer.DeleteVolumeAttachment
 func (ds *dockServer) DeleteVolumeAttachment(ctx context.Context, opt *pb.DeleteVolumeAttachmentOpts) (*pb.GenericResponse, error) {
 	// Get the storage drivers and do some initializations.
-	ds.Driver = drivers.Init(opt.GetDriverName())
+	ds.Driver = drivers.Init(backendOf(opt))
 	defer drivers.Clean(ds.Driver)
 
 	log.Info("Dock server receive delete volume attachment request, vr =", opt)
@@ -204,10 +253,62 @@ func (ds *dockServer) DeleteVolumeAttachment(ctx context.Context, opt *pb.Delete
 	return pb.GenericResponseResult(nil), nil
 }
 
+// CreateSnapshotAttachment implements pb.DockServer.CreateSnapshotAttachment
+func (ds *dockServer) CreateSnapshotAttachment(ctx context.Context, opt *pb.CreateSnapshotAttachmentOpts) (*pb.GenericResponse, error) {
+	// Get the storage drivers and do some initializations.
+	ds.Driver = drivers.Init(backendOf(opt))
+	defer drivers.Clean(ds.Driver)
+
+	log.Info("Dock server receive create snapshot attachment request, vr =", opt)
+
+	connInfo, err := ds.Driver.InitializeSnapshotConnection(opt)
+	if err != nil {
+		log.Error("error occurred in dock module when initialize snapshot connection:", err)
+		return pb.GenericResponseError(err), err
+	}
+	var atc = &model.SnapshotAttachmentSpec{
+		BaseModel: &model.BaseModel{
+			Id: opt.GetId(),
+		},
+		SnapshotId: opt.GetSnapshotId(),
+		HostInfo: model.HostInfo{
+			Platform:  opt.HostInfo.GetPlatform(),
+			OsType:    opt.HostInfo.GetOsType(),
+			Ip:        opt.HostInfo.GetIp(),
+			Host:      opt.HostInfo.GetHost(),
+			Initiator: opt.HostInfo.GetInitiator(),
+		},
+		ConnectionInfo: *connInfo,
+		Metadata:       opt.GetMetadata(),
+	}
+	// The snapshot is always exposed read-only, the connector on the host
+	// learns it from connection data.
+	if atc.ConnectionData == nil {
+		atc.ConnectionData = map[string]interface{}{}
+	}
+	atc.ConnectionData[connector.ReadOnly] = true
+	return pb.GenericResponseResult(atc), nil
+}
+
+// DeleteSnapshotAttachment implements pb.DockServer.DeleteSnapshotAttachment
+func (ds *dockServer) DeleteSnapshotAttachment(ctx context.Context, opt *pb.DeleteSnapshotAttachmentOpts) (*pb.GenericResponse, error) {
+	// Get the storage drivers and do some initializations.
+	ds.Driver = drivers.Init(backendOf(opt))
+	defer drivers.Clean(ds.Driver)
+
+	log.Info("Dock server receive delete snapshot attachment request, vr =", opt)
+
+	if err := ds.Driver.TerminateSnapshotConnection(opt); err != nil {
+		log.Error("error occurred in dock module when terminate snapshot connection:", err)
+		return pb.GenericResponseError(err), err
+	}
+	return pb.GenericResponseResult(nil), nil
+}
+
 // CreateVolumeSnapshot implements pb.DockServer.CreateVolumeSnapshot
 func (ds *dockServer) CreateVolumeSnapshot(ctx context.Context, opt *pb.CreateVolumeSnapshotOpts) (*pb.GenericResponse, error) {
 	// Get the storage drivers and do some initializations.
-	ds.Driver = drivers.Init(opt.GetDriverName())
+	ds.Driver = drivers.Init(backendOf(opt))
 	defer drivers.Clean(ds.Driver)
 
 	log.Info("Dock server receive create volume snapshot request, vr =", opt)
@@ -224,7 +325,7 @@ func (ds *dockServer) CreateVolumeSnapshot(ctx context.Context, opt *pb.CreateVo
 // DeleteVolumeSnapshot implements pb.DockServer.DeleteVolumeSnapshot
 func (ds *dockServer) DeleteVolumeSnapshot(ctx context.Context, opt *pb.DeleteVolumeSnapshotOpts) (*pb.GenericResponse, error) {
 	// Get the storage drivers and do some initializations.
-	ds.Driver = drivers.Init(opt.GetDriverName())
+	ds.Driver = drivers.Init(backendOf(opt))
 	defer drivers.Clean(ds.Driver)
 
 	log.Info("Dock server receive delete volume snapshot request, vr =", opt)
@@ -237,6 +338,51 @@ func (ds *dockServer) DeleteVolumeSnapshot(ctx context.Context, opt *pb.DeleteVo
 	return pb.GenericResponseResult(nil), nil
 }
 
+// VerifyVolumeSnapshot implements pb.DockServer.VerifyVolumeSnapshot
+func (ds *dockServer) VerifyVolumeSnapshot(ctx context.Context, opt *pb.VerifyVolumeSnapshotOpts) (*pb.GenericResponse, error) {
+	// Get the storage drivers and do some initializations.
+	ds.Driver = drivers.Init(backendOf(opt))
+	defer drivers.Clean(ds.Driver)
+
+	log.Info("Dock server receive verify volume snapshot request, vr =", opt)
+
+	driver, ok := ds.Driver.(drivers.BackupVerifyDriver)
+	if !ok {
+		err := &model.NotImplementError{S: "snapshot backup verification is not supported by driver " + opt.GetDriverName()}
+		return pb.GenericResponseError(err), err
+	}
+	if err := driver.VerifySnapshotBackup(opt); err != nil {
+		log.Error("error occurred in dock module when verify snapshot backup:", err)
+		return pb.GenericResponseError(err), err
+	}
+
+	log.Infof("Verify backup of snapshot (%s) successfully.\n", opt.GetId())
+	return pb.GenericResponseResult(nil), nil
+}
+
+// UpdateVolumeQos implements pb.DockServer.UpdateVolumeQos
+func (ds *dockServer) UpdateVolumeQos(ctx context.Context, opt *pb.UpdateVolumeQosOpts) (*pb.GenericResponse, error) {
+	// Get the storage drivers and do some initializations.
+	ds.Driver = drivers.Init(backendOf(opt))
+	defer drivers.Clean(ds.Driver)
+
+	log.Info("Dock server receive update volume qos request, vr =", opt)
+
+	driver, ok := ds.Driver.(drivers.QosDriver)
+	if !ok {
+		err := &model.NotImplementError{S: "volume qos is not supported by driver " + opt.GetDriverName()}
+		return pb.GenericResponseError(err), err
+	}
+	vol, err := driver.UpdateVolumeQos(opt)
+	if err != nil {
+		log.Error("when calling volume driver to update volume qos:", err)
+		return pb.GenericResponseError(err), err
+	}
+
+	log.Infof("Update qos of volume (%s) successfully.\n", opt.GetId())
+	return pb.GenericResponseResult(vol), nil
+}
+
 // AttachVolume implements pb.DockServer.AttachVolume
 func (ds *dockServer) AttachVolume(ctx context.Context, opt *pb.AttachVolumeOpts) (*pb.GenericResponse, error) {
 	var connData = make(map[string]interface{})
@@ -257,6 +403,32 @@ func (ds *dockServer) AttachVolume(ctx context.Context, opt *pb.AttachVolumeOpts
 		log.Error("error occurred in dock module when attach volume:", err)
 		return pb.GenericResponseError(err), err
 	}
+	if err = connector.Throttle(atc, connData); err != nil {
+		log.Error("error occurred in dock module when throttle volume:", err)
+		if err := con.Detach(connData); err != nil {
+			log.Error("error occurred in dock module when detach volume:", err)
+		}
+		return pb.GenericResponseError(err), err
+	}
+	if connector.IsThrottled(connData) {
+		cgroup, _ := connector.ThrottleCgroup(atc)
+		log.Infof("volume is throttled for the processes in cgroup %s", cgroup)
+	}
+	if mountpoint := opt.GetMountpoint(); mountpoint != "" {
+		// The volume attached read-only, such as a snapshot, is mounted
+		// read-only as well.
+		flags := opt.GetMountOptions()
+		if connector.IsReadOnly(connData) {
+			flags = append(append([]string{}, flags...), "ro")
+		}
+		if err = connector.FormatAndMount(atc, mountpoint, opt.GetFsType(), flags); err != nil {
+			log.Error("error occurred in dock module when mount volume:", err)
+			if err := con.Detach(connData); err != nil {
+				log.Error("error occurred in dock module when detach volume:", err)
+			}
+			return pb.GenericResponseError(err), err
+		}
+	}
 	// TODO: maybe need to update status in DB.
 	return pb.GenericResponseResult(atc), nil
 }
@@ -276,6 +448,23 @@ func (ds *dockServer) DetachVolume(ctx context.Context, opt *pb.DetachVolumeOpts
 		err := fmt.Errorf("can not find connector (%s)!", opt.GetAccessProtocol())
 		return pb.GenericResponseError(err), err
 	}
+	if mountpoint := opt.GetMountpoint(); mountpoint != "" {
+		if mounted, _ := connector.IsMounted(mountpoint); mounted {
+			if err := connector.Umount(mountpoint); err != nil {
+				log.Error("error occurred in dock module when umount volume:", err)
+				return pb.GenericResponseError(err), err
+			}
+		}
+	}
+	if connector.IsThrottled(connData) {
+		// Expand rescans the attached volume, which finds out the device of
+		// it as well.
+		if device, err := con.Expand(connData); err != nil {
+			log.Warning("failed to find volume device to unthrottle:", err)
+		} else if err := connector.Unthrottle(device); err != nil {
+			log.Warning("failed to unthrottle volume:", err)
+		}
+	}
 	if err := con.Detach(connData); err != nil {
 		log.Error("error occurred in dock module when detach volume:", err)
 		return pb.GenericResponseError(err), err
@@ -284,10 +473,79 @@ func (ds *dockServer) DetachVolume(ctx context.Context, opt *pb.DetachVolumeOpts
 	return pb.GenericResponseResult(nil), nil
 }
 
+// ExpandVolume implements pb.DockServer.ExpandVolume
+func (ds *dockServer) ExpandVolume(ctx context.Context, opt *pb.ExpandVolumeOpts) (*pb.GenericResponse, error) {
+	var connData = make(map[string]interface{})
+	if err := json.Unmarshal([]byte(opt.GetConnectionData()), &connData); err != nil {
+		log.Error("error occurred in dock module when unmarshalling connection data!")
+		return pb.GenericResponseError(err), err
+	}
+
+	log.Info("Dock server receive expand volume request, vr =", opt)
+
+	con := connector.NewConnector(opt.GetAccessProtocol())
+	if con == nil {
+		err := fmt.Errorf("can not find connector (%s)!", opt.GetAccessProtocol())
+		return pb.GenericResponseError(err), err
+	}
+	device, err := con.Expand(connData)
+	if err != nil {
+		log.Error("error occurred in dock module when expand volume:", err)
+		return pb.GenericResponseError(err), err
+	}
+	if mountpoint := opt.GetMountpoint(); mountpoint != "" {
+		if err = connector.ResizeFS(device, mountpoint); err != nil {
+			log.Error("error occurred in dock module when resize file system:", err)
+			return pb.GenericResponseError(err), err
+		}
+	}
+	return pb.GenericResponseResult(device), nil
+}
+
+// ThrottleVolume implements pb.DockServer.ThrottleVolume
+func (ds *dockServer) ThrottleVolume(ctx context.Context, opt *pb.ThrottleVolumeOpts) (*pb.GenericResponse, error) {
+	var connData = make(map[string]interface{})
+	if err := json.Unmarshal([]byte(opt.GetConnectionData()), &connData); err != nil {
+		log.Error("error occurred in dock module when unmarshalling connection data!")
+		return pb.GenericResponseError(err), err
+	}
+
+	log.Info("Dock server receive throttle volume request, vr =", opt)
+
+	con := connector.NewConnector(opt.GetAccessProtocol())
+	if con == nil {
+		err := fmt.Errorf("can not find connector (%s)!", opt.GetAccessProtocol())
+		return pb.GenericResponseError(err), err
+	}
+	// Expand rescans the attached volume, which finds out the device of it
+	// as well.
+	device, err := con.Expand(connData)
+	if err != nil {
+		log.Error("error occurred in dock module when find volume device:", err)
+		return pb.GenericResponseError(err), err
+	}
+	if err = connector.Throttle(device, connData); err != nil {
+		log.Error("error occurred in dock module when throttle volume:", err)
+		return pb.GenericResponseError(err), err
+	}
+	return pb.GenericResponseResult(device), nil
+}
+
+// FreezeVolume implements pb.DockServer.FreezeVolume
+func (ds *dockServer) FreezeVolume(ctx context.Context, opt *pb.FreezeVolumeOpts) (*pb.GenericResponse, error) {
+	log.Info("Dock server receive freeze volume request, vr =", opt)
+
+	if err := connector.FreezeFS(opt.GetMountpoint(), opt.GetThaw()); err != nil {
+		log.Error("error occurred in dock module when freeze volume:", err)
+		return pb.GenericResponseError(err), err
+	}
+	return pb.GenericResponseResult(nil), nil
+}
+
 // CreateReplication implements opensds.DockServer
 func (ds *dockServer) CreateReplication(ctx context.Context, opt *pb.CreateReplicationOpts) (*pb.GenericResponse, error) {
 	//Get the storage replication drivers and do some initializations.
-	driver, _ := drivers.InitReplicationDriver(opt.GetDriverName())
+	driver, _ := drivers.InitReplicationDriver(opt.GetDriverName(), opt.GetBackendName())
 	defer drivers.CleanReplicationDriver(driver)
 
 	log.Info("Dock server receive create replication request, vr =", opt)
@@ -306,7 +564,7 @@ func (ds *dockServer) CreateReplication(ctx context.Context, opt *pb.CreateRepli
 
 func (ds *dockServer) DeleteReplication(ctx context.Context, opt *pb.DeleteReplicationOpts) (*pb.GenericResponse, error) {
 	// Get the storage replication drivers and do some initializations.
-	driver, _ := drivers.InitReplicationDriver(opt.GetDriverName())
+	driver, _ := drivers.InitReplicationDriver(opt.GetDriverName(), opt.GetBackendName())
 	defer drivers.CleanReplicationDriver(driver)
 
 	log.Info("Dock server receive delete replication request, vr =", opt)
@@ -321,7 +579,7 @@ func (ds *dockServer) DeleteReplication(ctx context.Context, opt *pb.DeleteRepli
 
 func (ds *dockServer) EnableReplication(ctx context.Context, opt *pb.EnableReplicationOpts) (*pb.GenericResponse, error) {
 	// Get the storage replication drivers and do some initializations.
-	driver, _ := drivers.InitReplicationDriver(opt.GetDriverName())
+	driver, _ := drivers.InitReplicationDriver(opt.GetDriverName(), opt.GetBackendName())
 	defer drivers.CleanReplicationDriver(driver)
 
 	log.Info("Dock server receive enable replication request, vr =", opt)
@@ -330,13 +588,14 @@ func (ds *dockServer) EnableReplication(ctx context.Context, opt *pb.EnableRepli
 		log.Error("error occurred in dock module when enable replication:", err)
 		return pb.GenericResponseError(err), err
 	}
+	refreshReplicationState(driver, opt.GetId(), opt.GetMetadata(), opt.GetContext())
 
 	return pb.GenericResponseResult(nil), nil
 }
 
 func (ds *dockServer) DisableReplication(ctx context.Context, opt *pb.DisableReplicationOpts) (*pb.GenericResponse, error) {
 	// Get the storage replication drivers and do some initializations.
-	driver, _ := drivers.InitReplicationDriver(opt.GetDriverName())
+	driver, _ := drivers.InitReplicationDriver(opt.GetDriverName(), opt.GetBackendName())
 	defer drivers.CleanReplicationDriver(driver)
 
 	log.Info("Dock server receive disable replication request, vr =", opt)
@@ -351,7 +610,7 @@ func (ds *dockServer) DisableReplication(ctx context.Context, opt *pb.DisableRep
 
 func (ds *dockServer) FailoverReplication(ctx context.Context, opt *pb.FailoverReplicationOpts) (*pb.GenericResponse, error) {
 	// Get the storage replication drivers and do some initializations.
-	driver, _ := drivers.InitReplicationDriver(opt.GetDriverName())
+	driver, _ := drivers.InitReplicationDriver(opt.GetDriverName(), opt.GetBackendName())
 	defer drivers.CleanReplicationDriver(driver)
 
 	log.Info("Dock server receive failover replication request, vr =", opt)
@@ -360,14 +619,32 @@ func (ds *dockServer) FailoverReplication(ctx context.Context, opt *pb.FailoverR
 		log.Error("error occurred in dock module when failover replication:", err)
 		return pb.GenericResponseError(err), err
 	}
+	refreshReplicationState(driver, opt.GetId(), opt.GetMetadata(), opt.GetContext())
 
 	return pb.GenericResponseResult(nil), nil
 }
 
+// refreshReplicationState updates the state recorded in the metadata of the
+// replication if the driver is able to report it.
+func refreshReplicationState(driver drivers.ReplicationDriver, id string, metadata map[string]string, ctx string) {
+	sd, ok := driver.(drivers.ReplicationStateDriver)
+	if !ok {
+		return
+	}
+	state, err := sd.GetReplicationState(metadata)
+	if err != nil {
+		log.Warning("failed to get state of replication:", err)
+		return
+	}
+	if _, err := db.C.UpdateReplication(c.NewContextFromJson(ctx), id, &model.ReplicationSpec{Metadata: state}); err != nil {
+		log.Warning("failed to update state of replication:", err)
+	}
+}
+
 // CreateVolumeGroup implements pb.DockServer.CreateVolumeGroup
 func (ds *dockServer) CreateVolumeGroup(ctx context.Context, opt *pb.CreateVolumeGroupOpts) (*pb.GenericResponse, error) {
 	// Get the storage drivers and do some initializations.
-	ds.Driver = drivers.Init(opt.GetDriverName())
+	ds.Driver = drivers.Init(backendOf(opt))
 	defer drivers.Clean(ds.Driver)
 
 	log.Info("Dock server receive create volume group request, vr =", opt)
@@ -392,7 +669,7 @@ func (ds *dockServer) CreateVolumeGroup(ctx context.Context, opt *pb.CreateVolum
 
 func (ds *dockServer) UpdateVolumeGroup(ctx context.Context, opt *pb.UpdateVolumeGroupOpts) (*pb.GenericResponse, error) {
 	// Get the storage drivers and do some initializations.
-	ds.Driver = drivers.Init(opt.GetDriverName())
+	ds.Driver = drivers.Init(backendOf(opt))
 	defer drivers.Clean(ds.Driver)
 
 	log.Info("Dock server receive update volume group request, vr =", opt)
@@ -417,7 +694,7 @@ func (ds *dockServer) UpdateVolumeGroup(ctx context.Context, opt *pb.UpdateVolum
 
 func (ds *dockServer) DeleteVolumeGroup(ctx context.Context, opt *pb.DeleteVolumeGroupOpts) (*pb.GenericResponse, error) {
 	// Get the storage drivers and do some initializations.
-	ds.Driver = drivers.Init(opt.GetDriverName())
+	ds.Driver = drivers.Init(backendOf(opt))
 	defer drivers.Clean(ds.Driver)
 
 	log.Info("Dock server receive delete volume group request, vr =", opt)
@@ -426,9 +703,11 @@ func (ds *dockServer) DeleteVolumeGroup(ctx context.Context, opt *pb.DeleteVolum
 		if _, ok := err.(*model.NotImplementError); !ok {
 			return pb.GenericResponseError(err), err
 		}
-		if err = ds.deleteGroupGeneric(opt); err != nil {
-			return pb.GenericResponseError(err), err
-		}
+	}
+	// The drivers only remove the group itself, so the volumes in it are
+	// deleted one by one here.
+	if err := ds.deleteGroupGeneric(opt); err != nil {
+		return pb.GenericResponseError(err), err
 	}
 
 	log.Infof("Delete volume group (%s) successfully.\n", opt.GetId())
@@ -458,3 +737,48 @@ func (ds *dockServer) deleteGroupGeneric(opt *pb.DeleteVolumeGroupOpts) error {
 
 	return nil
 }
+
+// CreateVolumeGroupSnapshot implements pb.DockServer.CreateVolumeGroupSnapshot
+func (ds *dockServer) CreateVolumeGroupSnapshot(ctx context.Context, opt *pb.CreateVolumeGroupSnapshotOpts) (*pb.GenericResponse, error) {
+	// Get the storage drivers and do some initializations.
+	ds.Driver = drivers.Init(backendOf(opt))
+	defer drivers.Clean(ds.Driver)
+
+	log.Info("Dock server receive create volume group snapshot request, vr =", opt)
+
+	driver, ok := ds.Driver.(drivers.GroupSnapshotDriver)
+	if !ok {
+		err := &model.NotImplementError{S: "volume group snapshot is not supported by driver " + opt.GetDriverName()}
+		return pb.GenericResponseError(err), err
+	}
+	snps, err := driver.CreateGroupSnapshot(opt)
+	if err != nil {
+		log.Error("when calling volume driver to create volume group snapshot:", err)
+		return pb.GenericResponseError(err), err
+	}
+
+	log.Infof("Create volume group snapshot (%s) successfully.\n", opt.GetId())
+	return pb.GenericResponseResult(snps), nil
+}
+
+// DeleteVolumeGroupSnapshot implements pb.DockServer.DeleteVolumeGroupSnapshot
+func (ds *dockServer) DeleteVolumeGroupSnapshot(ctx context.Context, opt *pb.DeleteVolumeGroupSnapshotOpts) (*pb.GenericResponse, error) {
+	// Get the storage drivers and do some initializations.
+	ds.Driver = drivers.Init(backendOf(opt))
+	defer drivers.Clean(ds.Driver)
+
+	log.Info("Dock server receive delete volume group snapshot request, vr =", opt)
+
+	driver, ok := ds.Driver.(drivers.GroupSnapshotDriver)
+	if !ok {
+		err := &model.NotImplementError{S: "volume group snapshot is not supported by driver " + opt.GetDriverName()}
+		return pb.GenericResponseError(err), err
+	}
+	if err := driver.DeleteGroupSnapshot(opt); err != nil {
+		log.Error("when calling volume driver to delete volume group snapshot:", err)
+		return pb.GenericResponseError(err), err
+	}
+
+	log.Infof("Delete volume group snapshot (%s) successfully.\n", opt.GetId())
+	return pb.GenericResponseResult(nil), nil
+}
@@ -0,0 +1,87 @@
+// Copyright (c) 2018 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+//    Licensed under the Apache License, Version 2.0 (the "License"); you may
+//    not use this file except in compliance with the License. You may obtain
+//    a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//    WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//    License for the specific language governing permissions and limitations
+//    under the License.
+
+/*
+This module implements the common data structure.
+
+*/
+
+package model
+
+const (
+	BackupModeFull        = "full"
+	BackupModeIncremental = "incremental"
+)
+
+const (
+	BackupResultRunning = "running"
+	BackupResultSuccess = "success"
+	BackupResultFailed  = "failed"
+)
+
+// Keys of the snapshot metadata which are set on the snapshots taken by the
+// backup scheduler.
+const (
+	BackupScheduleKey = "backupScheduleId"
+	BackupDriverKey   = "backupDriver"
+	BackupBucketKey   = "bucket"
+	BackupModeKey     = "backupMode"
+	BackupBaseKey     = "backupBaseId"
+)
+
+// BackupScheduleSpec records the state of the backup policy of one volume,
+// it is created and updated by the backup scheduler running in osdslet.
+type BackupScheduleSpec struct {
+	*BaseModel
+
+	// The uuid of the project that the volume belongs to.
+	TenantId string `json:"tenantId,omitempty"`
+
+	// The uuid of the volume which is backed up.
+	VolumeId string `json:"volumeId,omitempty"`
+
+	// The uuid of the profile which the backup policy comes from.
+	ProfileId string `json:"profileId,omitempty"`
+
+	// NextRunAt is the time when the next backup will be taken.
+	NextRunAt string `json:"nextRunAt,omitempty"`
+
+	// LastRunAt is the time when the latest backup was taken.
+	// +optional
+	LastRunAt string `json:"lastRunAt,omitempty"`
+
+	// The result of the latest backup.
+	// One of: "running", "success" or "failed".
+	// +optional
+	LastResult string `json:"lastResult,omitempty"`
+
+	// The error message of the latest backup if it failed.
+	// +optional
+	LastError string `json:"lastError,omitempty"`
+
+	// The uuid of the snapshot which holds the latest backup.
+	// +optional
+	LastBackupId string `json:"lastBackupId,omitempty"`
+
+	// The mode of the latest backup, one of "full" or "incremental".
+	// +optional
+	LastBackupMode string `json:"lastBackupMode,omitempty"`
+
+	// The uuid of the snapshot which holds the latest full backup.
+	// +optional
+	LastFullBackupId string `json:"lastFullBackupId,omitempty"`
+
+	// The number of backups taken by the schedule.
+	RunCount int64 `json:"runCount"`
+}
@@ -54,6 +54,16 @@ type DataStorageLoS struct {
 	// IsSpaceEfficient indicates that the storage is compressed or deduplicated.
 	// The default value for this prperty is false.
 	IsSpaceEfficient bool `json:"isSpaceEfficient" yaml:"isSpaceEfficient,omitempty"`
+
+	// IsCompressed indicates that the data is compressed by the storage. In a
+	// profile it asks for compression of the volumes, and in a pool it
+	// advertises that the volumes can be compressed.
+	IsCompressed bool `json:"isCompressed,omitempty" yaml:"isCompressed,omitempty"`
+
+	// IsDeduplicated indicates that the data is deduplicated by the storage.
+	// In a profile it asks for deduplication of the volumes, and in a pool it
+	// advertises that the volumes can be deduplicated.
+	IsDeduplicated bool `json:"isDeduplicated,omitempty" yaml:"isDeduplicated,omitempty"`
 }
 
 func (ds DataStorageLoS) IsEmpty() bool {
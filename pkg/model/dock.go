@@ -44,6 +44,11 @@ type DockSpec struct {
 	// One of: "available" or "unavailable".
 	Status string `json:"status,omitempty"`
 
+	// LastHeartbeat is the time when the dock service reported itself alive
+	// for the last time.
+	// +optional
+	LastHeartbeat string `json:"lastHeartbeat,omitempty"`
+
 	// The storage type of the dock.
 	// One of: "block", "file" or "object".
 	StorageType string `json:"storageType,omitempty"`
@@ -59,6 +64,11 @@ type DockSpec struct {
 	// Currently One of: "cinder", "ceph", "lvm", "default".
 	DriverName string `json:"driverName,omitempty"`
 
+	// BackendName represents the backend section in the configuration of
+	// dock service which this dock serves, several docks on one node may
+	// share the same driver but never the same backend.
+	BackendName string `json:"backendName,omitempty"`
+
 	// Metadata should be kept until the scemantics between opensds volume
 	// attachment and backend attached storage resouce description are clear.
 	// +optional
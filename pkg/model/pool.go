@@ -55,6 +55,12 @@ type StoragePoolSpec struct {
 	// Default unit of FreeCapacity is GB.
 	FreeCapacity int64 `json:"freeCapacity,omitempty"`
 
+	// The ratio of the data written by hosts to the space consumed in the
+	// pool, which is reported by the pools whose data can be compressed or
+	// deduplicated. For example, 2.5 means 2.5:1.
+	// +readOnly
+	DataReductionRatio float64 `json:"dataReductionRatio,omitempty"`
+
 	// The storage type of the storage pool.
 	// One of: "block", "file" or "object".
 	StorageType string `json:"storageType,omitempty"`
@@ -78,6 +84,10 @@ type StoragePoolExtraSpec struct {
 	IOConnectivity IOConnectivityLoS `json:"ioConnectivity,omitempty" yaml:"ioConnectivity,omitempty"`
 	// DataProtection represents some suggested data protection capabilities.
 	DataProtection DataProtectionLoS `json:"dataProtection,omitempty" yaml:"dataProtection,omitempty"`
+	// Capabilities represents the optional operations which the driver of the
+	// pool supports, it's declared by the driver and can't be configured.
+	// +readOnly
+	Capabilities DriverCapabilitiesSpec `json:"capabilities" yaml:"-"`
 
 	// Besides those basic suggested pool properties above, vendors can configure
 	// some advanced features (diskType, IOPS, throughout, latency, etc)
@@ -85,3 +95,72 @@ type StoragePoolExtraSpec struct {
 	// and filtered by selector in a extensible way.
 	Advanced map[string]interface{} `json:"advanced,omitempty" yaml:"advanced,omitempty"`
 }
+
+// The optional operations of volume drivers, which are the keys of
+// DriverCapabilitiesSpec in the pool extras.
+const (
+	CapabilityVolumeGroup        = "volumeGroup"
+	CapabilitySnapshotAttachment = "snapshotAttachment"
+	CapabilityClone              = "clone"
+	CapabilityRevert             = "revert"
+	CapabilityQos                = "qos"
+	CapabilityOnlineExtend       = "onlineExtend"
+	CapabilityReplication        = "replication"
+	CapabilityIncrementalBackup  = "incrementalBackup"
+)
+
+// DriverCapabilitiesSpec declares which optional operations a volume driver
+// supports, the requests of the operations which are not supported are
+// rejected before they are sent to the dock.
+type DriverCapabilitiesSpec struct {
+	// VolumeGroup indicates that volumes can be managed in volume groups.
+	VolumeGroup bool `json:"volumeGroup"`
+
+	// SnapshotAttachment indicates that snapshots can be attached to hosts.
+	SnapshotAttachment bool `json:"snapshotAttachment"`
+
+	// Clone indicates that volumes can be created from snapshots.
+	Clone bool `json:"clone"`
+
+	// Revert indicates that volumes can be reverted to their snapshots, no
+	// driver supports it yet but it is declared so that clients can discover it.
+	Revert bool `json:"revert"`
+
+	// Qos indicates that the I/O of volumes can be limited, and the limits
+	// can be changed while the volumes are in use.
+	Qos bool `json:"qos"`
+
+	// OnlineExtend indicates that volumes can be extended while in use.
+	OnlineExtend bool `json:"onlineExtend"`
+
+	// Replication indicates that volumes can be replicated.
+	Replication bool `json:"replication"`
+
+	// IncrementalBackup indicates that the backups of volumes can hold only
+	// the changes since the snapshot of their base backups.
+	IncrementalBackup bool `json:"incrementalBackup"`
+}
+
+// Supports returns whether the capability named, which is one of the
+// Capability* constants, is declared.
+func (dc DriverCapabilitiesSpec) Supports(capability string) bool {
+	switch capability {
+	case CapabilityVolumeGroup:
+		return dc.VolumeGroup
+	case CapabilitySnapshotAttachment:
+		return dc.SnapshotAttachment
+	case CapabilityClone:
+		return dc.Clone
+	case CapabilityRevert:
+		return dc.Revert
+	case CapabilityQos:
+		return dc.Qos
+	case CapabilityOnlineExtend:
+		return dc.OnlineExtend
+	case CapabilityReplication:
+		return dc.Replication
+	case CapabilityIncrementalBackup:
+		return dc.IncrementalBackup
+	}
+	return false
+}
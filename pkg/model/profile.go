@@ -81,6 +81,19 @@ func (pps ProvisioningPropertiesSpec) IsEmpty() bool {
 	return false
 }
 
+// Qos returns the limits of I/O which the volumes provisioned with the
+// properties are throttled to, or nil if there is no limit.
+func (pps ProvisioningPropertiesSpec) Qos() *QosSpec {
+	qos := QosSpec{
+		MaxIOPS: pps.IOConnectivity.MaxIOPS,
+		MaxBWS:  pps.IOConnectivity.MaxBWS,
+	}
+	if qos.IsEmpty() {
+		return nil
+	}
+	return &qos
+}
+
 type ReplicationPropertiesSpec struct {
 	// DataProtection represents some suggested data protection capabilities.
 	DataProtection DataProtectionLoS `json:"dataProtection,omitempty"`
@@ -156,6 +169,10 @@ type DataProtectionPropertiesSpec struct {
 	// ConsistencyEnabled indicates that the source and target shall be
 	// consistent. The default value is false.
 	ConsistencyEnabled bool `json:"consistencyEnabled,omitempty"`
+	// BackupPolicy describes the recurring backups which will be taken of
+	// every volume created with this profile.
+	// +optional
+	BackupPolicy BackupPolicySpec `json:"backupPolicy,omitempty"`
 }
 
 func (dps DataProtectionPropertiesSpec) IsEmpty() bool {
@@ -165,6 +182,55 @@ func (dps DataProtectionPropertiesSpec) IsEmpty() bool {
 	return false
 }
 
+// BackupPolicySpec describes how backups of a volume are scheduled, where they
+// are stored and how long they are kept.
+type BackupPolicySpec struct {
+	// The property defines when to take backups.
+	Schedule struct {
+		// This vaule is represented as a string in ISO 8601 datetime format,
+		// such as "2008-09-15T15:53:00", and specifies the first run of the
+		// schedule. The schedule starts immediately if it is not specified.
+		Datetime string `json:"datetime,omitempty"`
+		// The value specifies the duration of executing a operation, which
+		// contains four options including Hourly, Daily, Weekly and Monthly.
+		Occurrence string `json:"occurrence,omitempty"`
+	} `json:"schedule,omitempty"`
+	// The name of the backup driver which stores the backups, such as
+	// "multi-cloud".
+	BackupDriver string `json:"backupDriver,omitempty"`
+	Topology     struct {
+		// The bucket which the backups are uploaded to.
+		Bucket string `json:"bucket,omitempty"`
+		// The path which the backups are stored in if the backup driver is
+		// file based. No such driver exists yet, so the policy with a path
+		// is rejected.
+		Path string `json:"path,omitempty"`
+	} `json:"topology,omitempty"`
+	// FullBackupInterval specifies that every Nth backup is a full backup and
+	// the others are incremental backups based on the latest full one. The
+	// default value 0 (or 1) means that every backup is a full backup, so do
+	// the volumes whose drivers don't support incremental backups. The policy
+	// is rejected if the driver of no pool supports incremental backups.
+	// +optional
+	FullBackupInterval int64 `json:"fullBackupInterval,omitempty"`
+	Retention          struct {
+		// The value specifies the total number of backups for retention.
+		// +optional
+		Number int64 `json:"number,omitempty"`
+		// The value specifies the duration of backups for retention.
+		// +optional
+		// +units:day
+		Duration int64 `json:"duration,omitempty"`
+	} `json:"retention,omitempty"`
+}
+
+func (bps BackupPolicySpec) IsEmpty() bool {
+	if (BackupPolicySpec{}) == bps {
+		return true
+	}
+	return false
+}
+
 // CustomPropertiesSpec is a dictionary object that contains unique keys and
 // JSON objects.
 type CustomPropertiesSpec map[string]interface{}
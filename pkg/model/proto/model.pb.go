@@ -57,7 +57,15 @@ type CreateVolumeOpts struct {
 	// The size of snapshot
 	SnapshotSize int64 `protobuf:"varint,15,opt,name=snapshotSize,proto3" json:"snapshotSize,omitempty"`
 	// Down load snapshot from cloud
-	SnapshotFromCloud    bool     `protobuf:"varint,16,opt,name=snapshotFromCloud,proto3" json:"snapshotFromCloud,omitempty"`
+	SnapshotFromCloud bool `protobuf:"varint,16,opt,name=snapshotFromCloud,proto3" json:"snapshotFromCloud,omitempty"`
+	// The name of backend which serves the request.
+	BackendName string `protobuf:"bytes,17,opt,name=backendName,proto3" json:"backendName,omitempty"`
+	// The limits of I/O of the volume, optional.
+	Qos *Qos `protobuf:"bytes,18,opt,name=qos,proto3" json:"qos,omitempty"`
+	// Whether the data of the volume is compressed by the storage, optional.
+	IsCompressed bool `protobuf:"varint,19,opt,name=isCompressed,proto3" json:"isCompressed,omitempty"`
+	// Whether the data of the volume is deduplicated by the storage, optional.
+	IsDeduplicated       bool     `protobuf:"varint,20,opt,name=isDeduplicated,proto3" json:"isDeduplicated,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -67,7 +75,7 @@ func (m *CreateVolumeOpts) Reset()         { *m = CreateVolumeOpts{} }
 func (m *CreateVolumeOpts) String() string { return proto.CompactTextString(m) }
 func (*CreateVolumeOpts) ProtoMessage()    {}
 func (*CreateVolumeOpts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_a8adca89daa441ca, []int{0}
+	return fileDescriptor_model_87c8f56f027e671b, []int{0}
 }
 func (m *CreateVolumeOpts) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateVolumeOpts.Unmarshal(m, b)
@@ -199,6 +207,34 @@ func (m *CreateVolumeOpts) GetSnapshotFromCloud() bool {
 	return false
 }
 
+func (m *CreateVolumeOpts) GetBackendName() string {
+	if m != nil {
+		return m.BackendName
+	}
+	return ""
+}
+
+func (m *CreateVolumeOpts) GetQos() *Qos {
+	if m != nil {
+		return m.Qos
+	}
+	return nil
+}
+
+func (m *CreateVolumeOpts) GetIsCompressed() bool {
+	if m != nil {
+		return m.IsCompressed
+	}
+	return false
+}
+
+func (m *CreateVolumeOpts) GetIsDeduplicated() bool {
+	if m != nil {
+		return m.IsDeduplicated
+	}
+	return false
+}
+
 // DeleteVolumeOpts is a structure which indicates all required properties
 // for deleting a volume.
 type DeleteVolumeOpts struct {
@@ -213,7 +249,9 @@ type DeleteVolumeOpts struct {
 	// The storage driver type.
 	DriverName string `protobuf:"bytes,5,opt,name=driverName,proto3" json:"driverName,omitempty"`
 	// The Context
-	Context              string   `protobuf:"bytes,6,opt,name=context,proto3" json:"context,omitempty"`
+	Context string `protobuf:"bytes,6,opt,name=context,proto3" json:"context,omitempty"`
+	// The name of backend which serves the request.
+	BackendName          string   `protobuf:"bytes,7,opt,name=backendName,proto3" json:"backendName,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -223,7 +261,7 @@ func (m *DeleteVolumeOpts) Reset()         { *m = DeleteVolumeOpts{} }
 func (m *DeleteVolumeOpts) String() string { return proto.CompactTextString(m) }
 func (*DeleteVolumeOpts) ProtoMessage()    {}
 func (*DeleteVolumeOpts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_a8adca89daa441ca, []int{1}
+	return fileDescriptor_model_87c8f56f027e671b, []int{1}
 }
 func (m *DeleteVolumeOpts) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteVolumeOpts.Unmarshal(m, b)
@@ -285,6 +323,13 @@ func (m *DeleteVolumeOpts) GetContext() string {
 	return ""
 }
 
+func (m *DeleteVolumeOpts) GetBackendName() string {
+	if m != nil {
+		return m.BackendName
+	}
+	return ""
+}
+
 // ExtendVolumeOpts is a structure which indicates all required properties
 // for Extending a volume.
 type ExtendVolumeOpts struct {
@@ -311,7 +356,9 @@ type ExtendVolumeOpts struct {
 	// The storage driver type.
 	DriverName string `protobuf:"bytes,11,opt,name=driverName,proto3" json:"driverName,omitempty"`
 	// The Context
-	Context              string   `protobuf:"bytes,12,opt,name=context,proto3" json:"context,omitempty"`
+	Context string `protobuf:"bytes,12,opt,name=context,proto3" json:"context,omitempty"`
+	// The name of backend which serves the request.
+	BackendName          string   `protobuf:"bytes,13,opt,name=backendName,proto3" json:"backendName,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -321,7 +368,7 @@ func (m *ExtendVolumeOpts) Reset()         { *m = ExtendVolumeOpts{} }
 func (m *ExtendVolumeOpts) String() string { return proto.CompactTextString(m) }
 func (*ExtendVolumeOpts) ProtoMessage()    {}
 func (*ExtendVolumeOpts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_a8adca89daa441ca, []int{2}
+	return fileDescriptor_model_87c8f56f027e671b, []int{2}
 }
 func (m *ExtendVolumeOpts) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ExtendVolumeOpts.Unmarshal(m, b)
@@ -425,6 +472,166 @@ func (m *ExtendVolumeOpts) GetContext() string {
 	return ""
 }
 
+func (m *ExtendVolumeOpts) GetBackendName() string {
+	if m != nil {
+		return m.BackendName
+	}
+	return ""
+}
+
+// Qos is a structure which indicates the limits of I/O of a volume.
+type Qos struct {
+	// The maximum IOs per second of the volume, 0 means no limit.
+	MaxIOPS int64 `protobuf:"varint,1,opt,name=maxIOPS,proto3" json:"maxIOPS,omitempty"`
+	// The maximum bandwidth of the volume in MB/s, 0 means no limit.
+	MaxBWS               int64    `protobuf:"varint,2,opt,name=maxBWS,proto3" json:"maxBWS,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Qos) Reset()         { *m = Qos{} }
+func (m *Qos) String() string { return proto.CompactTextString(m) }
+func (*Qos) ProtoMessage()    {}
+func (*Qos) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_87c8f56f027e671b, []int{3}
+}
+func (m *Qos) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Qos.Unmarshal(m, b)
+}
+func (m *Qos) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Qos.Marshal(b, m, deterministic)
+}
+func (dst *Qos) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Qos.Merge(dst, src)
+}
+func (m *Qos) XXX_Size() int {
+	return xxx_messageInfo_Qos.Size(m)
+}
+func (m *Qos) XXX_DiscardUnknown() {
+	xxx_messageInfo_Qos.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Qos proto.InternalMessageInfo
+
+func (m *Qos) GetMaxIOPS() int64 {
+	if m != nil {
+		return m.MaxIOPS
+	}
+	return 0
+}
+
+func (m *Qos) GetMaxBWS() int64 {
+	if m != nil {
+		return m.MaxBWS
+	}
+	return 0
+}
+
+// UpdateVolumeQosOpts is a structure which indicates all required properties
+// for updating the QoS of a volume.
+type UpdateVolumeQosOpts struct {
+	// The uuid of the volume, required.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The new limits of I/O of the volume, required.
+	Qos *Qos `protobuf:"bytes,2,opt,name=qos,proto3" json:"qos,omitempty"`
+	// The uuid of the pool which the volume belongs to.
+	PoolId string `protobuf:"bytes,3,opt,name=poolId,proto3" json:"poolId,omitempty"`
+	// The name of the pool which the volume belongs to.
+	PoolName string `protobuf:"bytes,4,opt,name=poolName,proto3" json:"poolName,omitempty"`
+	// The metadata of the volume, optional.
+	Metadata map[string]string `protobuf:"bytes,5,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The storage driver type.
+	DriverName string `protobuf:"bytes,6,opt,name=driverName,proto3" json:"driverName,omitempty"`
+	// The Context
+	Context string `protobuf:"bytes,7,opt,name=context,proto3" json:"context,omitempty"`
+	// The name of backend which serves the request.
+	BackendName          string   `protobuf:"bytes,8,opt,name=backendName,proto3" json:"backendName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateVolumeQosOpts) Reset()         { *m = UpdateVolumeQosOpts{} }
+func (m *UpdateVolumeQosOpts) String() string { return proto.CompactTextString(m) }
+func (*UpdateVolumeQosOpts) ProtoMessage()    {}
+func (*UpdateVolumeQosOpts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_87c8f56f027e671b, []int{4}
+}
+func (m *UpdateVolumeQosOpts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateVolumeQosOpts.Unmarshal(m, b)
+}
+func (m *UpdateVolumeQosOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateVolumeQosOpts.Marshal(b, m, deterministic)
+}
+func (dst *UpdateVolumeQosOpts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateVolumeQosOpts.Merge(dst, src)
+}
+func (m *UpdateVolumeQosOpts) XXX_Size() int {
+	return xxx_messageInfo_UpdateVolumeQosOpts.Size(m)
+}
+func (m *UpdateVolumeQosOpts) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateVolumeQosOpts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateVolumeQosOpts proto.InternalMessageInfo
+
+func (m *UpdateVolumeQosOpts) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *UpdateVolumeQosOpts) GetQos() *Qos {
+	if m != nil {
+		return m.Qos
+	}
+	return nil
+}
+
+func (m *UpdateVolumeQosOpts) GetPoolId() string {
+	if m != nil {
+		return m.PoolId
+	}
+	return ""
+}
+
+func (m *UpdateVolumeQosOpts) GetPoolName() string {
+	if m != nil {
+		return m.PoolName
+	}
+	return ""
+}
+
+func (m *UpdateVolumeQosOpts) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *UpdateVolumeQosOpts) GetDriverName() string {
+	if m != nil {
+		return m.DriverName
+	}
+	return ""
+}
+
+func (m *UpdateVolumeQosOpts) GetContext() string {
+	if m != nil {
+		return m.Context
+	}
+	return ""
+}
+
+func (m *UpdateVolumeQosOpts) GetBackendName() string {
+	if m != nil {
+		return m.BackendName
+	}
+	return ""
+}
+
 // CreateVolumeSnapshotOpts is a structure which indicates all required
 // properties for creating a volume snapshot.
 type CreateVolumeSnapshotOpts struct {
@@ -445,7 +652,9 @@ type CreateVolumeSnapshotOpts struct {
 	// The storage driver type.
 	DriverName string `protobuf:"bytes,8,opt,name=driverName,proto3" json:"driverName,omitempty"`
 	// The Context
-	Context              string   `protobuf:"bytes,9,opt,name=context,proto3" json:"context,omitempty"`
+	Context string `protobuf:"bytes,9,opt,name=context,proto3" json:"context,omitempty"`
+	// The name of backend which serves the request.
+	BackendName          string   `protobuf:"bytes,10,opt,name=backendName,proto3" json:"backendName,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -455,7 +664,7 @@ func (m *CreateVolumeSnapshotOpts) Reset()         { *m = CreateVolumeSnapshotOp
 func (m *CreateVolumeSnapshotOpts) String() string { return proto.CompactTextString(m) }
 func (*CreateVolumeSnapshotOpts) ProtoMessage()    {}
 func (*CreateVolumeSnapshotOpts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_a8adca89daa441ca, []int{3}
+	return fileDescriptor_model_87c8f56f027e671b, []int{5}
 }
 func (m *CreateVolumeSnapshotOpts) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateVolumeSnapshotOpts.Unmarshal(m, b)
@@ -538,6 +747,13 @@ func (m *CreateVolumeSnapshotOpts) GetContext() string {
 	return ""
 }
 
+func (m *CreateVolumeSnapshotOpts) GetBackendName() string {
+	if m != nil {
+		return m.BackendName
+	}
+	return ""
+}
+
 // DeleteVolumeSnapshotOpts is a structure which indicates all required
 // properties for deleting a volume snapshot.
 type DeleteVolumeSnapshotOpts struct {
@@ -550,7 +766,9 @@ type DeleteVolumeSnapshotOpts struct {
 	// The storage driver type.
 	DriverName string `protobuf:"bytes,4,opt,name=driverName,proto3" json:"driverName,omitempty"`
 	// The Context
-	Context              string   `protobuf:"bytes,5,opt,name=context,proto3" json:"context,omitempty"`
+	Context string `protobuf:"bytes,5,opt,name=context,proto3" json:"context,omitempty"`
+	// The name of backend which serves the request.
+	BackendName          string   `protobuf:"bytes,6,opt,name=backendName,proto3" json:"backendName,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -560,7 +778,7 @@ func (m *DeleteVolumeSnapshotOpts) Reset()         { *m = DeleteVolumeSnapshotOp
 func (m *DeleteVolumeSnapshotOpts) String() string { return proto.CompactTextString(m) }
 func (*DeleteVolumeSnapshotOpts) ProtoMessage()    {}
 func (*DeleteVolumeSnapshotOpts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_a8adca89daa441ca, []int{4}
+	return fileDescriptor_model_87c8f56f027e671b, []int{6}
 }
 func (m *DeleteVolumeSnapshotOpts) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteVolumeSnapshotOpts.Unmarshal(m, b)
@@ -615,6 +833,100 @@ func (m *DeleteVolumeSnapshotOpts) GetContext() string {
 	return ""
 }
 
+func (m *DeleteVolumeSnapshotOpts) GetBackendName() string {
+	if m != nil {
+		return m.BackendName
+	}
+	return ""
+}
+
+// VerifyVolumeSnapshotOpts is a structure which indicates all required
+// properties for verifying the backup of a volume snapshot.
+type VerifyVolumeSnapshotOpts struct {
+	// The uuid of the volume snapshot, required.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The uuid of the volume that snapshot belongs to, required.
+	VolumeId string `protobuf:"bytes,2,opt,name=volumeId,proto3" json:"volumeId,omitempty"`
+	// The metadata of the volume snapshot, which tells where the backup
+	// is stored, required.
+	Metadata map[string]string `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The storage driver type.
+	DriverName string `protobuf:"bytes,4,opt,name=driverName,proto3" json:"driverName,omitempty"`
+	// The Context
+	Context string `protobuf:"bytes,5,opt,name=context,proto3" json:"context,omitempty"`
+	// The name of backend which serves the request.
+	BackendName          string   `protobuf:"bytes,6,opt,name=backendName,proto3" json:"backendName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VerifyVolumeSnapshotOpts) Reset()         { *m = VerifyVolumeSnapshotOpts{} }
+func (m *VerifyVolumeSnapshotOpts) String() string { return proto.CompactTextString(m) }
+func (*VerifyVolumeSnapshotOpts) ProtoMessage()    {}
+func (*VerifyVolumeSnapshotOpts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_87c8f56f027e671b, []int{7}
+}
+func (m *VerifyVolumeSnapshotOpts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyVolumeSnapshotOpts.Unmarshal(m, b)
+}
+func (m *VerifyVolumeSnapshotOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyVolumeSnapshotOpts.Marshal(b, m, deterministic)
+}
+func (dst *VerifyVolumeSnapshotOpts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyVolumeSnapshotOpts.Merge(dst, src)
+}
+func (m *VerifyVolumeSnapshotOpts) XXX_Size() int {
+	return xxx_messageInfo_VerifyVolumeSnapshotOpts.Size(m)
+}
+func (m *VerifyVolumeSnapshotOpts) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyVolumeSnapshotOpts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyVolumeSnapshotOpts proto.InternalMessageInfo
+
+func (m *VerifyVolumeSnapshotOpts) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *VerifyVolumeSnapshotOpts) GetVolumeId() string {
+	if m != nil {
+		return m.VolumeId
+	}
+	return ""
+}
+
+func (m *VerifyVolumeSnapshotOpts) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *VerifyVolumeSnapshotOpts) GetDriverName() string {
+	if m != nil {
+		return m.DriverName
+	}
+	return ""
+}
+
+func (m *VerifyVolumeSnapshotOpts) GetContext() string {
+	if m != nil {
+		return m.Context
+	}
+	return ""
+}
+
+func (m *VerifyVolumeSnapshotOpts) GetBackendName() string {
+	if m != nil {
+		return m.BackendName
+	}
+	return ""
+}
+
 // CreateVolumeAttachmentOpts is a structure which indicates all required
 // properties for creating a volume attachment.
 type CreateVolumeAttachmentOpts struct {
@@ -635,7 +947,22 @@ type CreateVolumeAttachmentOpts struct {
 	// The Context
 	Context string `protobuf:"bytes,8,opt,name=context,proto3" json:"context,omitempty"`
 	// The protocol
-	AccessProtocol       string   `protobuf:"bytes,9,opt,name=AccessProtocol,proto3" json:"AccessProtocol,omitempty"`
+	AccessProtocol string `protobuf:"bytes,9,opt,name=AccessProtocol,proto3" json:"AccessProtocol,omitempty"`
+	// The name of backend which serves the request.
+	BackendName string `protobuf:"bytes,10,opt,name=backendName,proto3" json:"backendName,omitempty"`
+	// The file system type which the volume is formatted with if it is
+	// blank, optional.
+	FsType string `protobuf:"bytes,11,opt,name=fsType,proto3" json:"fsType,omitempty"`
+	// The path on which the volume is mounted after attached, optional.
+	Mountpoint string `protobuf:"bytes,12,opt,name=mountpoint,proto3" json:"mountpoint,omitempty"`
+	// The options which the volume is mounted with, optional.
+	MountOptions []string `protobuf:"bytes,13,rep,name=mountOptions,proto3" json:"mountOptions,omitempty"`
+	// The transport of NVMe-oF through which the volume is attached, one of
+	// "tcp", "rdma" and "loop", optional.
+	Transport string `protobuf:"bytes,14,opt,name=transport,proto3" json:"transport,omitempty"`
+	// The limits of I/O of the volume, which the driver may ask the host
+	// to enforce, optional.
+	Qos                  *Qos     `protobuf:"bytes,15,opt,name=qos,proto3" json:"qos,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -645,7 +972,7 @@ func (m *CreateVolumeAttachmentOpts) Reset()         { *m = CreateVolumeAttachme
 func (m *CreateVolumeAttachmentOpts) String() string { return proto.CompactTextString(m) }
 func (*CreateVolumeAttachmentOpts) ProtoMessage()    {}
 func (*CreateVolumeAttachmentOpts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_a8adca89daa441ca, []int{5}
+	return fileDescriptor_model_87c8f56f027e671b, []int{8}
 }
 func (m *CreateVolumeAttachmentOpts) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateVolumeAttachmentOpts.Unmarshal(m, b)
@@ -728,6 +1055,48 @@ func (m *CreateVolumeAttachmentOpts) GetAccessProtocol() string {
 	return ""
 }
 
+func (m *CreateVolumeAttachmentOpts) GetBackendName() string {
+	if m != nil {
+		return m.BackendName
+	}
+	return ""
+}
+
+func (m *CreateVolumeAttachmentOpts) GetFsType() string {
+	if m != nil {
+		return m.FsType
+	}
+	return ""
+}
+
+func (m *CreateVolumeAttachmentOpts) GetMountpoint() string {
+	if m != nil {
+		return m.Mountpoint
+	}
+	return ""
+}
+
+func (m *CreateVolumeAttachmentOpts) GetMountOptions() []string {
+	if m != nil {
+		return m.MountOptions
+	}
+	return nil
+}
+
+func (m *CreateVolumeAttachmentOpts) GetTransport() string {
+	if m != nil {
+		return m.Transport
+	}
+	return ""
+}
+
+func (m *CreateVolumeAttachmentOpts) GetQos() *Qos {
+	if m != nil {
+		return m.Qos
+	}
+	return nil
+}
+
 // DeleteVolumeAttachmentOpts is a structure which indicates all required
 // properties for deleting a volume attachment.
 type DeleteVolumeAttachmentOpts struct {
@@ -744,7 +1113,9 @@ type DeleteVolumeAttachmentOpts struct {
 	// The Context
 	Context string `protobuf:"bytes,6,opt,name=context,proto3" json:"context,omitempty"`
 	// The protocol
-	AccessProtocol       string   `protobuf:"bytes,7,opt,name=AccessProtocol,proto3" json:"AccessProtocol,omitempty"`
+	AccessProtocol string `protobuf:"bytes,7,opt,name=AccessProtocol,proto3" json:"AccessProtocol,omitempty"`
+	// The name of backend which serves the request.
+	BackendName          string   `protobuf:"bytes,8,opt,name=backendName,proto3" json:"backendName,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -754,7 +1125,7 @@ func (m *DeleteVolumeAttachmentOpts) Reset()         { *m = DeleteVolumeAttachme
 func (m *DeleteVolumeAttachmentOpts) String() string { return proto.CompactTextString(m) }
 func (*DeleteVolumeAttachmentOpts) ProtoMessage()    {}
 func (*DeleteVolumeAttachmentOpts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_a8adca89daa441ca, []int{6}
+	return fileDescriptor_model_87c8f56f027e671b, []int{9}
 }
 func (m *DeleteVolumeAttachmentOpts) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteVolumeAttachmentOpts.Unmarshal(m, b)
@@ -823,6 +1194,13 @@ func (m *DeleteVolumeAttachmentOpts) GetAccessProtocol() string {
 	return ""
 }
 
+func (m *DeleteVolumeAttachmentOpts) GetBackendName() string {
+	if m != nil {
+		return m.BackendName
+	}
+	return ""
+}
+
 // CreateSnapshotAttachmentOpts is a structure which indicates all required
 // properties for creating a snapshot attachment.
 type CreateSnapshotAttachmentOpts struct {
@@ -843,7 +1221,9 @@ type CreateSnapshotAttachmentOpts struct {
 	// The Context
 	Context string `protobuf:"bytes,8,opt,name=context,proto3" json:"context,omitempty"`
 	// The protocol
-	AccessProtocol       string   `protobuf:"bytes,9,opt,name=AccessProtocol,proto3" json:"AccessProtocol,omitempty"`
+	AccessProtocol string `protobuf:"bytes,9,opt,name=AccessProtocol,proto3" json:"AccessProtocol,omitempty"`
+	// The name of backend which serves the request.
+	BackendName          string   `protobuf:"bytes,10,opt,name=backendName,proto3" json:"backendName,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -853,7 +1233,7 @@ func (m *CreateSnapshotAttachmentOpts) Reset()         { *m = CreateSnapshotAtta
 func (m *CreateSnapshotAttachmentOpts) String() string { return proto.CompactTextString(m) }
 func (*CreateSnapshotAttachmentOpts) ProtoMessage()    {}
 func (*CreateSnapshotAttachmentOpts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_a8adca89daa441ca, []int{7}
+	return fileDescriptor_model_87c8f56f027e671b, []int{10}
 }
 func (m *CreateSnapshotAttachmentOpts) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateSnapshotAttachmentOpts.Unmarshal(m, b)
@@ -936,6 +1316,13 @@ func (m *CreateSnapshotAttachmentOpts) GetAccessProtocol() string {
 	return ""
 }
 
+func (m *CreateSnapshotAttachmentOpts) GetBackendName() string {
+	if m != nil {
+		return m.BackendName
+	}
+	return ""
+}
+
 // DeleteSnapshotAttachmentOpts is a structure which indicates all required
 // properties for deleting a snapshot attachment.
 type DeleteSnapshotAttachmentOpts struct {
@@ -952,7 +1339,9 @@ type DeleteSnapshotAttachmentOpts struct {
 	// The Context
 	Context string `protobuf:"bytes,6,opt,name=context,proto3" json:"context,omitempty"`
 	// The protocol
-	AccessProtocol       string   `protobuf:"bytes,7,opt,name=AccessProtocol,proto3" json:"AccessProtocol,omitempty"`
+	AccessProtocol string `protobuf:"bytes,7,opt,name=AccessProtocol,proto3" json:"AccessProtocol,omitempty"`
+	// The name of backend which serves the request.
+	BackendName          string   `protobuf:"bytes,8,opt,name=backendName,proto3" json:"backendName,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -962,7 +1351,7 @@ func (m *DeleteSnapshotAttachmentOpts) Reset()         { *m = DeleteSnapshotAtta
 func (m *DeleteSnapshotAttachmentOpts) String() string { return proto.CompactTextString(m) }
 func (*DeleteSnapshotAttachmentOpts) ProtoMessage()    {}
 func (*DeleteSnapshotAttachmentOpts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_a8adca89daa441ca, []int{8}
+	return fileDescriptor_model_87c8f56f027e671b, []int{11}
 }
 func (m *DeleteSnapshotAttachmentOpts) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteSnapshotAttachmentOpts.Unmarshal(m, b)
@@ -1031,6 +1420,13 @@ func (m *DeleteSnapshotAttachmentOpts) GetAccessProtocol() string {
 	return ""
 }
 
+func (m *DeleteSnapshotAttachmentOpts) GetBackendName() string {
+	if m != nil {
+		return m.BackendName
+	}
+	return ""
+}
+
 type HostInfo struct {
 	// The platform of the host, such as "x86_64"
 	Platform string `protobuf:"bytes,1,opt,name=platform,proto3" json:"platform,omitempty"`
@@ -1051,7 +1447,7 @@ func (m *HostInfo) Reset()         { *m = HostInfo{} }
 func (m *HostInfo) String() string { return proto.CompactTextString(m) }
 func (*HostInfo) ProtoMessage()    {}
 func (*HostInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_a8adca89daa441ca, []int{9}
+	return fileDescriptor_model_87c8f56f027e671b, []int{12}
 }
 func (m *HostInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_HostInfo.Unmarshal(m, b)
@@ -1117,7 +1513,7 @@ func (m *VolumeData) Reset()         { *m = VolumeData{} }
 func (m *VolumeData) String() string { return proto.CompactTextString(m) }
 func (*VolumeData) ProtoMessage()    {}
 func (*VolumeData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_a8adca89daa441ca, []int{10}
+	return fileDescriptor_model_87c8f56f027e671b, []int{13}
 }
 func (m *VolumeData) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VolumeData.Unmarshal(m, b)
@@ -1187,7 +1583,9 @@ type CreateReplicationOpts struct {
 	// 0 means sync replication.
 	ReplicationPeriod int64 `protobuf:"varint,20,opt,name=ReplicationPeriod,proto3" json:"ReplicationPeriod,omitempty"`
 	// replication bandwidth
-	ReplicationBandwidth int64    `protobuf:"varint,21,opt,name=ReplicationBandwidth,proto3" json:"ReplicationBandwidth,omitempty"`
+	ReplicationBandwidth int64 `protobuf:"varint,21,opt,name=ReplicationBandwidth,proto3" json:"ReplicationBandwidth,omitempty"`
+	// The name of backend which serves the request.
+	BackendName          string   `protobuf:"bytes,22,opt,name=backendName,proto3" json:"backendName,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1197,7 +1595,7 @@ func (m *CreateReplicationOpts) Reset()         { *m = CreateReplicationOpts{} }
 func (m *CreateReplicationOpts) String() string { return proto.CompactTextString(m) }
 func (*CreateReplicationOpts) ProtoMessage()    {}
 func (*CreateReplicationOpts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_a8adca89daa441ca, []int{11}
+	return fileDescriptor_model_87c8f56f027e671b, []int{14}
 }
 func (m *CreateReplicationOpts) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateReplicationOpts.Unmarshal(m, b)
@@ -1357,6 +1755,13 @@ func (m *CreateReplicationOpts) GetReplicationBandwidth() int64 {
 	return 0
 }
 
+func (m *CreateReplicationOpts) GetBackendName() string {
+	if m != nil {
+		return m.BackendName
+	}
+	return ""
+}
+
 // Delete ReplicationOpts is a structure which indicates all required properties
 // for deleting a replication.
 // NOTE: Need to figure out how to handle more than 2 sites.
@@ -1392,7 +1797,9 @@ type DeleteReplicationOpts struct {
 	// The replication metadata
 	Metadata map[string]string `protobuf:"bytes,16,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	// Whether is primary replication
-	IsPrimary            bool     `protobuf:"varint,17,opt,name=isPrimary,proto3" json:"isPrimary,omitempty"`
+	IsPrimary bool `protobuf:"varint,17,opt,name=isPrimary,proto3" json:"isPrimary,omitempty"`
+	// The name of backend which serves the request.
+	BackendName          string   `protobuf:"bytes,18,opt,name=backendName,proto3" json:"backendName,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1402,7 +1809,7 @@ func (m *DeleteReplicationOpts) Reset()         { *m = DeleteReplicationOpts{} }
 func (m *DeleteReplicationOpts) String() string { return proto.CompactTextString(m) }
 func (*DeleteReplicationOpts) ProtoMessage()    {}
 func (*DeleteReplicationOpts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_a8adca89daa441ca, []int{12}
+	return fileDescriptor_model_87c8f56f027e671b, []int{15}
 }
 func (m *DeleteReplicationOpts) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteReplicationOpts.Unmarshal(m, b)
@@ -1534,6 +1941,13 @@ func (m *DeleteReplicationOpts) GetIsPrimary() bool {
 	return false
 }
 
+func (m *DeleteReplicationOpts) GetBackendName() string {
+	if m != nil {
+		return m.BackendName
+	}
+	return ""
+}
+
 // Delete ReplicationOpts is a structure which indicates all required properties
 type EnableReplicationOpts struct {
 	// The uuid of the replication, optional when creating.
@@ -1567,7 +1981,9 @@ type EnableReplicationOpts struct {
 	// The replication metadata
 	Metadata map[string]string `protobuf:"bytes,16,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	// Whether is primary replication
-	IsPrimary            bool     `protobuf:"varint,17,opt,name=isPrimary,proto3" json:"isPrimary,omitempty"`
+	IsPrimary bool `protobuf:"varint,17,opt,name=isPrimary,proto3" json:"isPrimary,omitempty"`
+	// The name of backend which serves the request.
+	BackendName          string   `protobuf:"bytes,18,opt,name=backendName,proto3" json:"backendName,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1577,7 +1993,7 @@ func (m *EnableReplicationOpts) Reset()         { *m = EnableReplicationOpts{} }
 func (m *EnableReplicationOpts) String() string { return proto.CompactTextString(m) }
 func (*EnableReplicationOpts) ProtoMessage()    {}
 func (*EnableReplicationOpts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_a8adca89daa441ca, []int{13}
+	return fileDescriptor_model_87c8f56f027e671b, []int{16}
 }
 func (m *EnableReplicationOpts) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EnableReplicationOpts.Unmarshal(m, b)
@@ -1709,6 +2125,13 @@ func (m *EnableReplicationOpts) GetIsPrimary() bool {
 	return false
 }
 
+func (m *EnableReplicationOpts) GetBackendName() string {
+	if m != nil {
+		return m.BackendName
+	}
+	return ""
+}
+
 // Delete ReplicationOpts is a structure which indicates all required properties
 type DisableReplicationOpts struct {
 	// The uuid of the replication, optional when creating.
@@ -1742,7 +2165,9 @@ type DisableReplicationOpts struct {
 	// The replication metadata
 	Metadata map[string]string `protobuf:"bytes,16,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	// Whether is primary replication
-	IsPrimary            bool     `protobuf:"varint,17,opt,name=isPrimary,proto3" json:"isPrimary,omitempty"`
+	IsPrimary bool `protobuf:"varint,17,opt,name=isPrimary,proto3" json:"isPrimary,omitempty"`
+	// The name of backend which serves the request.
+	BackendName          string   `protobuf:"bytes,18,opt,name=backendName,proto3" json:"backendName,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1752,7 +2177,7 @@ func (m *DisableReplicationOpts) Reset()         { *m = DisableReplicationOpts{}
 func (m *DisableReplicationOpts) String() string { return proto.CompactTextString(m) }
 func (*DisableReplicationOpts) ProtoMessage()    {}
 func (*DisableReplicationOpts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_a8adca89daa441ca, []int{14}
+	return fileDescriptor_model_87c8f56f027e671b, []int{17}
 }
 func (m *DisableReplicationOpts) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DisableReplicationOpts.Unmarshal(m, b)
@@ -1884,6 +2309,13 @@ func (m *DisableReplicationOpts) GetIsPrimary() bool {
 	return false
 }
 
+func (m *DisableReplicationOpts) GetBackendName() string {
+	if m != nil {
+		return m.BackendName
+	}
+	return ""
+}
+
 // Delete ReplicationOpts is a structure which indicates all required properties
 type FailoverReplicationOpts struct {
 	// The uuid of the replication, optional when creating.
@@ -1921,7 +2353,9 @@ type FailoverReplicationOpts struct {
 	// The replication metadata
 	Metadata map[string]string `protobuf:"bytes,18,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	// Whether is primary replication
-	IsPrimary            bool     `protobuf:"varint,19,opt,name=isPrimary,proto3" json:"isPrimary,omitempty"`
+	IsPrimary bool `protobuf:"varint,19,opt,name=isPrimary,proto3" json:"isPrimary,omitempty"`
+	// The name of backend which serves the request.
+	BackendName          string   `protobuf:"bytes,20,opt,name=backendName,proto3" json:"backendName,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1931,7 +2365,7 @@ func (m *FailoverReplicationOpts) Reset()         { *m = FailoverReplicationOpts
 func (m *FailoverReplicationOpts) String() string { return proto.CompactTextString(m) }
 func (*FailoverReplicationOpts) ProtoMessage()    {}
 func (*FailoverReplicationOpts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_a8adca89daa441ca, []int{15}
+	return fileDescriptor_model_87c8f56f027e671b, []int{18}
 }
 func (m *FailoverReplicationOpts) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FailoverReplicationOpts.Unmarshal(m, b)
@@ -2077,6 +2511,13 @@ func (m *FailoverReplicationOpts) GetIsPrimary() bool {
 	return false
 }
 
+func (m *FailoverReplicationOpts) GetBackendName() string {
+	if m != nil {
+		return m.BackendName
+	}
+	return ""
+}
+
 type FailoverReplicationOpts_FailoverRequest struct {
 	AllowAttachedVolume  bool     `protobuf:"varint,1,opt,name=allowAttachedVolume,proto3" json:"allowAttachedVolume,omitempty"`
 	SecondaryBackendId   string   `protobuf:"bytes,2,opt,name=secondaryBackendId,proto3" json:"secondaryBackendId,omitempty"`
@@ -2091,7 +2532,7 @@ func (m *FailoverReplicationOpts_FailoverRequest) Reset() {
 func (m *FailoverReplicationOpts_FailoverRequest) String() string { return proto.CompactTextString(m) }
 func (*FailoverReplicationOpts_FailoverRequest) ProtoMessage()    {}
 func (*FailoverReplicationOpts_FailoverRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_a8adca89daa441ca, []int{15, 3}
+	return fileDescriptor_model_87c8f56f027e671b, []int{18, 3}
 }
 func (m *FailoverReplicationOpts_FailoverRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FailoverReplicationOpts_FailoverRequest.Unmarshal(m, b)
@@ -2145,7 +2586,9 @@ type CreateVolumeGroupOpts struct {
 	// The pool belongs to the group.
 	PoolId string `protobuf:"bytes,8,opt,name=poolId,proto3" json:"poolId,omitempty"`
 	// The Context
-	Context              string   `protobuf:"bytes,9,opt,name=context,proto3" json:"context,omitempty"`
+	Context string `protobuf:"bytes,9,opt,name=context,proto3" json:"context,omitempty"`
+	// The name of backend which serves the request.
+	BackendName          string   `protobuf:"bytes,10,opt,name=backendName,proto3" json:"backendName,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2155,7 +2598,7 @@ func (m *CreateVolumeGroupOpts) Reset()         { *m = CreateVolumeGroupOpts{} }
 func (m *CreateVolumeGroupOpts) String() string { return proto.CompactTextString(m) }
 func (*CreateVolumeGroupOpts) ProtoMessage()    {}
 func (*CreateVolumeGroupOpts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_a8adca89daa441ca, []int{16}
+	return fileDescriptor_model_87c8f56f027e671b, []int{19}
 }
 func (m *CreateVolumeGroupOpts) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateVolumeGroupOpts.Unmarshal(m, b)
@@ -2238,6 +2681,13 @@ func (m *CreateVolumeGroupOpts) GetContext() string {
 	return ""
 }
 
+func (m *CreateVolumeGroupOpts) GetBackendName() string {
+	if m != nil {
+		return m.BackendName
+	}
+	return ""
+}
+
 type UpdateVolumeGroupOpts struct {
 	// The uuid of the volume group, optional when updating.
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -2250,7 +2700,9 @@ type UpdateVolumeGroupOpts struct {
 	// The pool belongs to the group.
 	PoolId string `protobuf:"bytes,5,opt,name=poolId,proto3" json:"poolId,omitempty"`
 	// The Context
-	Context              string   `protobuf:"bytes,6,opt,name=context,proto3" json:"context,omitempty"`
+	Context string `protobuf:"bytes,6,opt,name=context,proto3" json:"context,omitempty"`
+	// The name of backend which serves the request.
+	BackendName          string   `protobuf:"bytes,7,opt,name=backendName,proto3" json:"backendName,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2260,7 +2712,7 @@ func (m *UpdateVolumeGroupOpts) Reset()         { *m = UpdateVolumeGroupOpts{} }
 func (m *UpdateVolumeGroupOpts) String() string { return proto.CompactTextString(m) }
 func (*UpdateVolumeGroupOpts) ProtoMessage()    {}
 func (*UpdateVolumeGroupOpts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_a8adca89daa441ca, []int{17}
+	return fileDescriptor_model_87c8f56f027e671b, []int{20}
 }
 func (m *UpdateVolumeGroupOpts) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateVolumeGroupOpts.Unmarshal(m, b)
@@ -2322,6 +2774,13 @@ func (m *UpdateVolumeGroupOpts) GetContext() string {
 	return ""
 }
 
+func (m *UpdateVolumeGroupOpts) GetBackendName() string {
+	if m != nil {
+		return m.BackendName
+	}
+	return ""
+}
+
 type DeleteVolumeGroupOpts struct {
 	// The uuid of the volume group, optional when deleting.
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -2330,7 +2789,9 @@ type DeleteVolumeGroupOpts struct {
 	// The driver of the volume group.
 	DriverName string `protobuf:"bytes,3,opt,name=driverName,proto3" json:"driverName,omitempty"`
 	// The Context
-	Context              string   `protobuf:"bytes,4,opt,name=context,proto3" json:"context,omitempty"`
+	Context string `protobuf:"bytes,4,opt,name=context,proto3" json:"context,omitempty"`
+	// The name of backend which serves the request.
+	BackendName          string   `protobuf:"bytes,5,opt,name=backendName,proto3" json:"backendName,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2340,7 +2801,7 @@ func (m *DeleteVolumeGroupOpts) Reset()         { *m = DeleteVolumeGroupOpts{} }
 func (m *DeleteVolumeGroupOpts) String() string { return proto.CompactTextString(m) }
 func (*DeleteVolumeGroupOpts) ProtoMessage()    {}
 func (*DeleteVolumeGroupOpts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_a8adca89daa441ca, []int{18}
+	return fileDescriptor_model_87c8f56f027e671b, []int{21}
 }
 func (m *DeleteVolumeGroupOpts) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteVolumeGroupOpts.Unmarshal(m, b)
@@ -2388,6 +2849,203 @@ func (m *DeleteVolumeGroupOpts) GetContext() string {
 	return ""
 }
 
+func (m *DeleteVolumeGroupOpts) GetBackendName() string {
+	if m != nil {
+		return m.BackendName
+	}
+	return ""
+}
+
+// CreateVolumeGroupSnapshotOpts is a structure which indicates all required
+// properties for creating a snapshot of all the volumes in a volume group.
+type CreateVolumeGroupSnapshotOpts struct {
+	// The uuid of the volume group snapshot, required.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The uuid of the volume group, required.
+	GroupId string `protobuf:"bytes,2,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	// The pool belongs to the group.
+	PoolId string `protobuf:"bytes,3,opt,name=poolId,proto3" json:"poolId,omitempty"`
+	// The snapshots of the volumes in the group, one for each volume.
+	Snapshots []*CreateVolumeSnapshotOpts `protobuf:"bytes,4,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+	// The storage driver type.
+	DriverName string `protobuf:"bytes,5,opt,name=driverName,proto3" json:"driverName,omitempty"`
+	// The Context
+	Context string `protobuf:"bytes,6,opt,name=context,proto3" json:"context,omitempty"`
+	// The name of backend which serves the request.
+	BackendName          string   `protobuf:"bytes,7,opt,name=backendName,proto3" json:"backendName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateVolumeGroupSnapshotOpts) Reset()         { *m = CreateVolumeGroupSnapshotOpts{} }
+func (m *CreateVolumeGroupSnapshotOpts) String() string { return proto.CompactTextString(m) }
+func (*CreateVolumeGroupSnapshotOpts) ProtoMessage()    {}
+func (*CreateVolumeGroupSnapshotOpts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_87c8f56f027e671b, []int{22}
+}
+func (m *CreateVolumeGroupSnapshotOpts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateVolumeGroupSnapshotOpts.Unmarshal(m, b)
+}
+func (m *CreateVolumeGroupSnapshotOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateVolumeGroupSnapshotOpts.Marshal(b, m, deterministic)
+}
+func (dst *CreateVolumeGroupSnapshotOpts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateVolumeGroupSnapshotOpts.Merge(dst, src)
+}
+func (m *CreateVolumeGroupSnapshotOpts) XXX_Size() int {
+	return xxx_messageInfo_CreateVolumeGroupSnapshotOpts.Size(m)
+}
+func (m *CreateVolumeGroupSnapshotOpts) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateVolumeGroupSnapshotOpts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateVolumeGroupSnapshotOpts proto.InternalMessageInfo
+
+func (m *CreateVolumeGroupSnapshotOpts) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *CreateVolumeGroupSnapshotOpts) GetGroupId() string {
+	if m != nil {
+		return m.GroupId
+	}
+	return ""
+}
+
+func (m *CreateVolumeGroupSnapshotOpts) GetPoolId() string {
+	if m != nil {
+		return m.PoolId
+	}
+	return ""
+}
+
+func (m *CreateVolumeGroupSnapshotOpts) GetSnapshots() []*CreateVolumeSnapshotOpts {
+	if m != nil {
+		return m.Snapshots
+	}
+	return nil
+}
+
+func (m *CreateVolumeGroupSnapshotOpts) GetDriverName() string {
+	if m != nil {
+		return m.DriverName
+	}
+	return ""
+}
+
+func (m *CreateVolumeGroupSnapshotOpts) GetContext() string {
+	if m != nil {
+		return m.Context
+	}
+	return ""
+}
+
+func (m *CreateVolumeGroupSnapshotOpts) GetBackendName() string {
+	if m != nil {
+		return m.BackendName
+	}
+	return ""
+}
+
+// DeleteVolumeGroupSnapshotOpts is a structure which indicates all required
+// properties for deleting a volume group snapshot.
+type DeleteVolumeGroupSnapshotOpts struct {
+	// The uuid of the volume group snapshot, required.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The uuid of the volume group, required.
+	GroupId string `protobuf:"bytes,2,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	// The pool belongs to the group.
+	PoolId string `protobuf:"bytes,3,opt,name=poolId,proto3" json:"poolId,omitempty"`
+	// The snapshots of the volumes in the group snapshot.
+	Snapshots []*DeleteVolumeSnapshotOpts `protobuf:"bytes,4,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+	// The storage driver type.
+	DriverName string `protobuf:"bytes,5,opt,name=driverName,proto3" json:"driverName,omitempty"`
+	// The Context
+	Context string `protobuf:"bytes,6,opt,name=context,proto3" json:"context,omitempty"`
+	// The name of backend which serves the request.
+	BackendName          string   `protobuf:"bytes,7,opt,name=backendName,proto3" json:"backendName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteVolumeGroupSnapshotOpts) Reset()         { *m = DeleteVolumeGroupSnapshotOpts{} }
+func (m *DeleteVolumeGroupSnapshotOpts) String() string { return proto.CompactTextString(m) }
+func (*DeleteVolumeGroupSnapshotOpts) ProtoMessage()    {}
+func (*DeleteVolumeGroupSnapshotOpts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_87c8f56f027e671b, []int{23}
+}
+func (m *DeleteVolumeGroupSnapshotOpts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteVolumeGroupSnapshotOpts.Unmarshal(m, b)
+}
+func (m *DeleteVolumeGroupSnapshotOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteVolumeGroupSnapshotOpts.Marshal(b, m, deterministic)
+}
+func (dst *DeleteVolumeGroupSnapshotOpts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteVolumeGroupSnapshotOpts.Merge(dst, src)
+}
+func (m *DeleteVolumeGroupSnapshotOpts) XXX_Size() int {
+	return xxx_messageInfo_DeleteVolumeGroupSnapshotOpts.Size(m)
+}
+func (m *DeleteVolumeGroupSnapshotOpts) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteVolumeGroupSnapshotOpts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteVolumeGroupSnapshotOpts proto.InternalMessageInfo
+
+func (m *DeleteVolumeGroupSnapshotOpts) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *DeleteVolumeGroupSnapshotOpts) GetGroupId() string {
+	if m != nil {
+		return m.GroupId
+	}
+	return ""
+}
+
+func (m *DeleteVolumeGroupSnapshotOpts) GetPoolId() string {
+	if m != nil {
+		return m.PoolId
+	}
+	return ""
+}
+
+func (m *DeleteVolumeGroupSnapshotOpts) GetSnapshots() []*DeleteVolumeSnapshotOpts {
+	if m != nil {
+		return m.Snapshots
+	}
+	return nil
+}
+
+func (m *DeleteVolumeGroupSnapshotOpts) GetDriverName() string {
+	if m != nil {
+		return m.DriverName
+	}
+	return ""
+}
+
+func (m *DeleteVolumeGroupSnapshotOpts) GetContext() string {
+	if m != nil {
+		return m.Context
+	}
+	return ""
+}
+
+func (m *DeleteVolumeGroupSnapshotOpts) GetBackendName() string {
+	if m != nil {
+		return m.BackendName
+	}
+	return ""
+}
+
 // AttachVolumeOpts is a structure which indicates all required
 // properties for attaching a volume.
 type AttachVolumeOpts struct {
@@ -2398,7 +3056,15 @@ type AttachVolumeOpts struct {
 	// The metadata for attaching a volume, optional.
 	Metadata map[string]string `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	// The Context
-	Context              string   `protobuf:"bytes,4,opt,name=context,proto3" json:"context,omitempty"`
+	Context string `protobuf:"bytes,4,opt,name=context,proto3" json:"context,omitempty"`
+	// The file system type which the volume is formatted with if it is
+	// blank, optional.
+	FsType string `protobuf:"bytes,5,opt,name=fsType,proto3" json:"fsType,omitempty"`
+	// The path on which the volume is mounted, optional. The volume is
+	// attached as a raw device if it is empty.
+	Mountpoint string `protobuf:"bytes,6,opt,name=mountpoint,proto3" json:"mountpoint,omitempty"`
+	// The options which the volume is mounted with, optional.
+	MountOptions         []string `protobuf:"bytes,7,rep,name=mountOptions,proto3" json:"mountOptions,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2408,7 +3074,7 @@ func (m *AttachVolumeOpts) Reset()         { *m = AttachVolumeOpts{} }
 func (m *AttachVolumeOpts) String() string { return proto.CompactTextString(m) }
 func (*AttachVolumeOpts) ProtoMessage()    {}
 func (*AttachVolumeOpts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_a8adca89daa441ca, []int{19}
+	return fileDescriptor_model_87c8f56f027e671b, []int{24}
 }
 func (m *AttachVolumeOpts) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AttachVolumeOpts.Unmarshal(m, b)
@@ -2456,6 +3122,27 @@ func (m *AttachVolumeOpts) GetContext() string {
 	return ""
 }
 
+func (m *AttachVolumeOpts) GetFsType() string {
+	if m != nil {
+		return m.FsType
+	}
+	return ""
+}
+
+func (m *AttachVolumeOpts) GetMountpoint() string {
+	if m != nil {
+		return m.Mountpoint
+	}
+	return ""
+}
+
+func (m *AttachVolumeOpts) GetMountOptions() []string {
+	if m != nil {
+		return m.MountOptions
+	}
+	return nil
+}
+
 // DetachVolumeOpts is a structure which indicates all required
 // properties for detaching a volume.
 type DetachVolumeOpts struct {
@@ -2466,7 +3153,9 @@ type DetachVolumeOpts struct {
 	// The metadata for detaching a volume, optional.
 	Metadata map[string]string `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	// The Context
-	Context              string   `protobuf:"bytes,4,opt,name=context,proto3" json:"context,omitempty"`
+	Context string `protobuf:"bytes,4,opt,name=context,proto3" json:"context,omitempty"`
+	// The path from which the volume is unmounted before detached, optional.
+	Mountpoint           string   `protobuf:"bytes,5,opt,name=mountpoint,proto3" json:"mountpoint,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2476,7 +3165,7 @@ func (m *DetachVolumeOpts) Reset()         { *m = DetachVolumeOpts{} }
 func (m *DetachVolumeOpts) String() string { return proto.CompactTextString(m) }
 func (*DetachVolumeOpts) ProtoMessage()    {}
 func (*DetachVolumeOpts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_a8adca89daa441ca, []int{20}
+	return fileDescriptor_model_87c8f56f027e671b, []int{25}
 }
 func (m *DetachVolumeOpts) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DetachVolumeOpts.Unmarshal(m, b)
@@ -2524,82 +3213,304 @@ func (m *DetachVolumeOpts) GetContext() string {
 	return ""
 }
 
-// Generic response, it return:
-// 1. Return result with message when create/update resource successfully.
-// 2. Return result without message when delete resource successfully.
-// 3. Return Error with error code and message when operate unsuccessfully.
-type GenericResponse struct {
-	// Types that are valid to be assigned to Reply:
-	//	*GenericResponse_Result_
-	//	*GenericResponse_Error_
-	Reply                isGenericResponse_Reply `protobuf_oneof:"reply"`
-	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
-	XXX_unrecognized     []byte                  `json:"-"`
-	XXX_sizecache        int32                   `json:"-"`
+func (m *DetachVolumeOpts) GetMountpoint() string {
+	if m != nil {
+		return m.Mountpoint
+	}
+	return ""
 }
 
-func (m *GenericResponse) Reset()         { *m = GenericResponse{} }
-func (m *GenericResponse) String() string { return proto.CompactTextString(m) }
-func (*GenericResponse) ProtoMessage()    {}
-func (*GenericResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_a8adca89daa441ca, []int{21}
+// ExpandVolumeOpts is a structure which indicates all required
+// properties for expanding an attached volume on the host.
+type ExpandVolumeOpts struct {
+	// The access protocol of the attached volume.
+	AccessProtocol string `protobuf:"bytes,1,opt,name=accessProtocol,proto3" json:"accessProtocol,omitempty"`
+	// The connectionData of the attached volume.
+	ConnectionData string `protobuf:"bytes,2,opt,name=connectionData,proto3" json:"connectionData,omitempty"`
+	// The metadata for expanding a volume, optional.
+	Metadata map[string]string `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The Context
+	Context string `protobuf:"bytes,4,opt,name=context,proto3" json:"context,omitempty"`
+	// The path on which the volume is mounted, optional. The file system
+	// on the volume is grown if it is given.
+	Mountpoint           string   `protobuf:"bytes,5,opt,name=mountpoint,proto3" json:"mountpoint,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *GenericResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GenericResponse.Unmarshal(m, b)
+
+func (m *ExpandVolumeOpts) Reset()         { *m = ExpandVolumeOpts{} }
+func (m *ExpandVolumeOpts) String() string { return proto.CompactTextString(m) }
+func (*ExpandVolumeOpts) ProtoMessage()    {}
+func (*ExpandVolumeOpts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_87c8f56f027e671b, []int{26}
 }
-func (m *GenericResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GenericResponse.Marshal(b, m, deterministic)
+func (m *ExpandVolumeOpts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExpandVolumeOpts.Unmarshal(m, b)
 }
-func (dst *GenericResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GenericResponse.Merge(dst, src)
+func (m *ExpandVolumeOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExpandVolumeOpts.Marshal(b, m, deterministic)
 }
-func (m *GenericResponse) XXX_Size() int {
-	return xxx_messageInfo_GenericResponse.Size(m)
+func (dst *ExpandVolumeOpts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExpandVolumeOpts.Merge(dst, src)
 }
-func (m *GenericResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GenericResponse.DiscardUnknown(m)
+func (m *ExpandVolumeOpts) XXX_Size() int {
+	return xxx_messageInfo_ExpandVolumeOpts.Size(m)
+}
+func (m *ExpandVolumeOpts) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExpandVolumeOpts.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GenericResponse proto.InternalMessageInfo
+var xxx_messageInfo_ExpandVolumeOpts proto.InternalMessageInfo
 
-type isGenericResponse_Reply interface {
-	isGenericResponse_Reply()
+func (m *ExpandVolumeOpts) GetAccessProtocol() string {
+	if m != nil {
+		return m.AccessProtocol
+	}
+	return ""
 }
 
-type GenericResponse_Result_ struct {
-	Result *GenericResponse_Result `protobuf:"bytes,1,opt,name=result,proto3,oneof"`
+func (m *ExpandVolumeOpts) GetConnectionData() string {
+	if m != nil {
+		return m.ConnectionData
+	}
+	return ""
 }
 
-type GenericResponse_Error_ struct {
-	Error *GenericResponse_Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+func (m *ExpandVolumeOpts) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
 }
 
-func (*GenericResponse_Result_) isGenericResponse_Reply() {}
+func (m *ExpandVolumeOpts) GetContext() string {
+	if m != nil {
+		return m.Context
+	}
+	return ""
+}
 
-func (*GenericResponse_Error_) isGenericResponse_Reply() {}
+func (m *ExpandVolumeOpts) GetMountpoint() string {
+	if m != nil {
+		return m.Mountpoint
+	}
+	return ""
+}
 
-func (m *GenericResponse) GetReply() isGenericResponse_Reply {
+// ThrottleVolumeOpts is a structure which indicates all required
+// properties for throttling the I/O of an attached volume on the host.
+type ThrottleVolumeOpts struct {
+	// The access protocol of the attached volume.
+	AccessProtocol string `protobuf:"bytes,1,opt,name=accessProtocol,proto3" json:"accessProtocol,omitempty"`
+	// The connectionData of the attached volume, which carries the limits
+	// of I/O of the volume.
+	ConnectionData string `protobuf:"bytes,2,opt,name=connectionData,proto3" json:"connectionData,omitempty"`
+	// The metadata for throttling a volume, optional.
+	Metadata map[string]string `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The Context
+	Context              string   `protobuf:"bytes,4,opt,name=context,proto3" json:"context,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ThrottleVolumeOpts) Reset()         { *m = ThrottleVolumeOpts{} }
+func (m *ThrottleVolumeOpts) String() string { return proto.CompactTextString(m) }
+func (*ThrottleVolumeOpts) ProtoMessage()    {}
+func (*ThrottleVolumeOpts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_87c8f56f027e671b, []int{27}
+}
+func (m *ThrottleVolumeOpts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ThrottleVolumeOpts.Unmarshal(m, b)
+}
+func (m *ThrottleVolumeOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ThrottleVolumeOpts.Marshal(b, m, deterministic)
+}
+func (dst *ThrottleVolumeOpts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThrottleVolumeOpts.Merge(dst, src)
+}
+func (m *ThrottleVolumeOpts) XXX_Size() int {
+	return xxx_messageInfo_ThrottleVolumeOpts.Size(m)
+}
+func (m *ThrottleVolumeOpts) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThrottleVolumeOpts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ThrottleVolumeOpts proto.InternalMessageInfo
+
+func (m *ThrottleVolumeOpts) GetAccessProtocol() string {
 	if m != nil {
-		return m.Reply
+		return m.AccessProtocol
 	}
-	return nil
+	return ""
 }
 
-func (m *GenericResponse) GetResult() *GenericResponse_Result {
-	if x, ok := m.GetReply().(*GenericResponse_Result_); ok {
-		return x.Result
+func (m *ThrottleVolumeOpts) GetConnectionData() string {
+	if m != nil {
+		return m.ConnectionData
 	}
-	return nil
+	return ""
 }
 
-func (m *GenericResponse) GetError() *GenericResponse_Error {
-	if x, ok := m.GetReply().(*GenericResponse_Error_); ok {
-		return x.Error
+func (m *ThrottleVolumeOpts) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
 	}
 	return nil
 }
 
-// XXX_OneofFuncs is for the internal use of the proto package.
+func (m *ThrottleVolumeOpts) GetContext() string {
+	if m != nil {
+		return m.Context
+	}
+	return ""
+}
+
+// FreezeVolumeOpts is a structure which indicates all required properties
+// for freezing or thawing the file system of a mounted volume.
+type FreezeVolumeOpts struct {
+	// The path which the volume is mounted on.
+	Mountpoint string `protobuf:"bytes,1,opt,name=mountpoint,proto3" json:"mountpoint,omitempty"`
+	// Thaw the file system instead of freezing it.
+	Thaw bool `protobuf:"varint,2,opt,name=thaw,proto3" json:"thaw,omitempty"`
+	// The metadata for freezing a volume, optional.
+	Metadata map[string]string `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The Context
+	Context              string   `protobuf:"bytes,4,opt,name=context,proto3" json:"context,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FreezeVolumeOpts) Reset()         { *m = FreezeVolumeOpts{} }
+func (m *FreezeVolumeOpts) String() string { return proto.CompactTextString(m) }
+func (*FreezeVolumeOpts) ProtoMessage()    {}
+func (*FreezeVolumeOpts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_87c8f56f027e671b, []int{28}
+}
+func (m *FreezeVolumeOpts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FreezeVolumeOpts.Unmarshal(m, b)
+}
+func (m *FreezeVolumeOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FreezeVolumeOpts.Marshal(b, m, deterministic)
+}
+func (dst *FreezeVolumeOpts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FreezeVolumeOpts.Merge(dst, src)
+}
+func (m *FreezeVolumeOpts) XXX_Size() int {
+	return xxx_messageInfo_FreezeVolumeOpts.Size(m)
+}
+func (m *FreezeVolumeOpts) XXX_DiscardUnknown() {
+	xxx_messageInfo_FreezeVolumeOpts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FreezeVolumeOpts proto.InternalMessageInfo
+
+func (m *FreezeVolumeOpts) GetMountpoint() string {
+	if m != nil {
+		return m.Mountpoint
+	}
+	return ""
+}
+
+func (m *FreezeVolumeOpts) GetThaw() bool {
+	if m != nil {
+		return m.Thaw
+	}
+	return false
+}
+
+func (m *FreezeVolumeOpts) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *FreezeVolumeOpts) GetContext() string {
+	if m != nil {
+		return m.Context
+	}
+	return ""
+}
+
+// Generic response, it return:
+// 1. Return result with message when create/update resource successfully.
+// 2. Return result without message when delete resource successfully.
+// 3. Return Error with error code and message when operate unsuccessfully.
+type GenericResponse struct {
+	// Types that are valid to be assigned to Reply:
+	//	*GenericResponse_Result_
+	//	*GenericResponse_Error_
+	Reply                isGenericResponse_Reply `protobuf_oneof:"reply"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *GenericResponse) Reset()         { *m = GenericResponse{} }
+func (m *GenericResponse) String() string { return proto.CompactTextString(m) }
+func (*GenericResponse) ProtoMessage()    {}
+func (*GenericResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_87c8f56f027e671b, []int{29}
+}
+func (m *GenericResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GenericResponse.Unmarshal(m, b)
+}
+func (m *GenericResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GenericResponse.Marshal(b, m, deterministic)
+}
+func (dst *GenericResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenericResponse.Merge(dst, src)
+}
+func (m *GenericResponse) XXX_Size() int {
+	return xxx_messageInfo_GenericResponse.Size(m)
+}
+func (m *GenericResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenericResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenericResponse proto.InternalMessageInfo
+
+type isGenericResponse_Reply interface {
+	isGenericResponse_Reply()
+}
+
+type GenericResponse_Result_ struct {
+	Result *GenericResponse_Result `protobuf:"bytes,1,opt,name=result,proto3,oneof"`
+}
+
+type GenericResponse_Error_ struct {
+	Error *GenericResponse_Error `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*GenericResponse_Result_) isGenericResponse_Reply() {}
+
+func (*GenericResponse_Error_) isGenericResponse_Reply() {}
+
+func (m *GenericResponse) GetReply() isGenericResponse_Reply {
+	if m != nil {
+		return m.Reply
+	}
+	return nil
+}
+
+func (m *GenericResponse) GetResult() *GenericResponse_Result {
+	if x, ok := m.GetReply().(*GenericResponse_Result_); ok {
+		return x.Result
+	}
+	return nil
+}
+
+func (m *GenericResponse) GetError() *GenericResponse_Error {
+	if x, ok := m.GetReply().(*GenericResponse_Error_); ok {
+		return x.Error
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
 func (*GenericResponse) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _GenericResponse_OneofMarshaler, _GenericResponse_OneofUnmarshaler, _GenericResponse_OneofSizer, []interface{}{
 		(*GenericResponse_Result_)(nil),
@@ -2684,7 +3595,7 @@ func (m *GenericResponse_Result) Reset()         { *m = GenericResponse_Result{}
 func (m *GenericResponse_Result) String() string { return proto.CompactTextString(m) }
 func (*GenericResponse_Result) ProtoMessage()    {}
 func (*GenericResponse_Result) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_a8adca89daa441ca, []int{21, 0}
+	return fileDescriptor_model_87c8f56f027e671b, []int{29, 0}
 }
 func (m *GenericResponse_Result) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GenericResponse_Result.Unmarshal(m, b)
@@ -2723,7 +3634,7 @@ func (m *GenericResponse_Error) Reset()         { *m = GenericResponse_Error{} }
 func (m *GenericResponse_Error) String() string { return proto.CompactTextString(m) }
 func (*GenericResponse_Error) ProtoMessage()    {}
 func (*GenericResponse_Error) Descriptor() ([]byte, []int) {
-	return fileDescriptor_model_a8adca89daa441ca, []int{21, 1}
+	return fileDescriptor_model_87c8f56f027e671b, []int{29, 1}
 }
 func (m *GenericResponse_Error) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GenericResponse_Error.Unmarshal(m, b)
@@ -2757,6 +3668,188 @@ func (m *GenericResponse_Error) GetDescription() string {
 	return ""
 }
 
+// PullVolumeOpts is a structure which indicates all required properties
+// for pulling a volume from the backend.
+type PullVolumeOpts struct {
+	// The identifier of the volume in the backend.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The Context
+	Context              string   `protobuf:"bytes,2,opt,name=context,proto3" json:"context,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PullVolumeOpts) Reset()         { *m = PullVolumeOpts{} }
+func (m *PullVolumeOpts) String() string { return proto.CompactTextString(m) }
+func (*PullVolumeOpts) ProtoMessage()    {}
+func (*PullVolumeOpts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_87c8f56f027e671b, []int{30}
+}
+func (m *PullVolumeOpts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PullVolumeOpts.Unmarshal(m, b)
+}
+func (m *PullVolumeOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PullVolumeOpts.Marshal(b, m, deterministic)
+}
+func (dst *PullVolumeOpts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PullVolumeOpts.Merge(dst, src)
+}
+func (m *PullVolumeOpts) XXX_Size() int {
+	return xxx_messageInfo_PullVolumeOpts.Size(m)
+}
+func (m *PullVolumeOpts) XXX_DiscardUnknown() {
+	xxx_messageInfo_PullVolumeOpts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PullVolumeOpts proto.InternalMessageInfo
+
+func (m *PullVolumeOpts) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *PullVolumeOpts) GetContext() string {
+	if m != nil {
+		return m.Context
+	}
+	return ""
+}
+
+// PullVolumeSnapshotOpts is a structure which indicates all required
+// properties for pulling a volume snapshot from the backend.
+type PullVolumeSnapshotOpts struct {
+	// The identifier of the volume snapshot in the backend.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The Context
+	Context              string   `protobuf:"bytes,2,opt,name=context,proto3" json:"context,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PullVolumeSnapshotOpts) Reset()         { *m = PullVolumeSnapshotOpts{} }
+func (m *PullVolumeSnapshotOpts) String() string { return proto.CompactTextString(m) }
+func (*PullVolumeSnapshotOpts) ProtoMessage()    {}
+func (*PullVolumeSnapshotOpts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_87c8f56f027e671b, []int{31}
+}
+func (m *PullVolumeSnapshotOpts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PullVolumeSnapshotOpts.Unmarshal(m, b)
+}
+func (m *PullVolumeSnapshotOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PullVolumeSnapshotOpts.Marshal(b, m, deterministic)
+}
+func (dst *PullVolumeSnapshotOpts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PullVolumeSnapshotOpts.Merge(dst, src)
+}
+func (m *PullVolumeSnapshotOpts) XXX_Size() int {
+	return xxx_messageInfo_PullVolumeSnapshotOpts.Size(m)
+}
+func (m *PullVolumeSnapshotOpts) XXX_DiscardUnknown() {
+	xxx_messageInfo_PullVolumeSnapshotOpts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PullVolumeSnapshotOpts proto.InternalMessageInfo
+
+func (m *PullVolumeSnapshotOpts) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *PullVolumeSnapshotOpts) GetContext() string {
+	if m != nil {
+		return m.Context
+	}
+	return ""
+}
+
+// ListPoolsOpts is a structure which indicates all required properties
+// for listing the storage pools of the backend.
+type ListPoolsOpts struct {
+	// The Context
+	Context              string   `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListPoolsOpts) Reset()         { *m = ListPoolsOpts{} }
+func (m *ListPoolsOpts) String() string { return proto.CompactTextString(m) }
+func (*ListPoolsOpts) ProtoMessage()    {}
+func (*ListPoolsOpts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_87c8f56f027e671b, []int{32}
+}
+func (m *ListPoolsOpts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListPoolsOpts.Unmarshal(m, b)
+}
+func (m *ListPoolsOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListPoolsOpts.Marshal(b, m, deterministic)
+}
+func (dst *ListPoolsOpts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPoolsOpts.Merge(dst, src)
+}
+func (m *ListPoolsOpts) XXX_Size() int {
+	return xxx_messageInfo_ListPoolsOpts.Size(m)
+}
+func (m *ListPoolsOpts) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPoolsOpts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListPoolsOpts proto.InternalMessageInfo
+
+func (m *ListPoolsOpts) GetContext() string {
+	if m != nil {
+		return m.Context
+	}
+	return ""
+}
+
+// GetCapabilitiesOpts is a structure which indicates all required properties
+// for getting the capabilities of the driver.
+type GetCapabilitiesOpts struct {
+	// The Context
+	Context              string   `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCapabilitiesOpts) Reset()         { *m = GetCapabilitiesOpts{} }
+func (m *GetCapabilitiesOpts) String() string { return proto.CompactTextString(m) }
+func (*GetCapabilitiesOpts) ProtoMessage()    {}
+func (*GetCapabilitiesOpts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_model_87c8f56f027e671b, []int{33}
+}
+func (m *GetCapabilitiesOpts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCapabilitiesOpts.Unmarshal(m, b)
+}
+func (m *GetCapabilitiesOpts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCapabilitiesOpts.Marshal(b, m, deterministic)
+}
+func (dst *GetCapabilitiesOpts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCapabilitiesOpts.Merge(dst, src)
+}
+func (m *GetCapabilitiesOpts) XXX_Size() int {
+	return xxx_messageInfo_GetCapabilitiesOpts.Size(m)
+}
+func (m *GetCapabilitiesOpts) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCapabilitiesOpts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCapabilitiesOpts proto.InternalMessageInfo
+
+func (m *GetCapabilitiesOpts) GetContext() string {
+	if m != nil {
+		return m.Context
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*CreateVolumeOpts)(nil), "proto.CreateVolumeOpts")
 	proto.RegisterMapType((map[string]string)(nil), "proto.CreateVolumeOpts.MetadataEntry")
@@ -2765,10 +3858,15 @@ func init() {
 	proto.RegisterMapType((map[string]string)(nil), "proto.DeleteVolumeOpts.MetadataEntry")
 	proto.RegisterType((*ExtendVolumeOpts)(nil), "proto.ExtendVolumeOpts")
 	proto.RegisterMapType((map[string]string)(nil), "proto.ExtendVolumeOpts.MetadataEntry")
+	proto.RegisterType((*Qos)(nil), "proto.Qos")
+	proto.RegisterType((*UpdateVolumeQosOpts)(nil), "proto.UpdateVolumeQosOpts")
+	proto.RegisterMapType((map[string]string)(nil), "proto.UpdateVolumeQosOpts.MetadataEntry")
 	proto.RegisterType((*CreateVolumeSnapshotOpts)(nil), "proto.CreateVolumeSnapshotOpts")
 	proto.RegisterMapType((map[string]string)(nil), "proto.CreateVolumeSnapshotOpts.MetadataEntry")
 	proto.RegisterType((*DeleteVolumeSnapshotOpts)(nil), "proto.DeleteVolumeSnapshotOpts")
 	proto.RegisterMapType((map[string]string)(nil), "proto.DeleteVolumeSnapshotOpts.MetadataEntry")
+	proto.RegisterType((*VerifyVolumeSnapshotOpts)(nil), "proto.VerifyVolumeSnapshotOpts")
+	proto.RegisterMapType((map[string]string)(nil), "proto.VerifyVolumeSnapshotOpts.MetadataEntry")
 	proto.RegisterType((*CreateVolumeAttachmentOpts)(nil), "proto.CreateVolumeAttachmentOpts")
 	proto.RegisterMapType((map[string]string)(nil), "proto.CreateVolumeAttachmentOpts.MetadataEntry")
 	proto.RegisterType((*DeleteVolumeAttachmentOpts)(nil), "proto.DeleteVolumeAttachmentOpts")
@@ -2804,13 +3902,25 @@ func init() {
 	proto.RegisterType((*CreateVolumeGroupOpts)(nil), "proto.CreateVolumeGroupOpts")
 	proto.RegisterType((*UpdateVolumeGroupOpts)(nil), "proto.UpdateVolumeGroupOpts")
 	proto.RegisterType((*DeleteVolumeGroupOpts)(nil), "proto.DeleteVolumeGroupOpts")
+	proto.RegisterType((*CreateVolumeGroupSnapshotOpts)(nil), "proto.CreateVolumeGroupSnapshotOpts")
+	proto.RegisterType((*DeleteVolumeGroupSnapshotOpts)(nil), "proto.DeleteVolumeGroupSnapshotOpts")
 	proto.RegisterType((*AttachVolumeOpts)(nil), "proto.AttachVolumeOpts")
 	proto.RegisterMapType((map[string]string)(nil), "proto.AttachVolumeOpts.MetadataEntry")
 	proto.RegisterType((*DetachVolumeOpts)(nil), "proto.DetachVolumeOpts")
 	proto.RegisterMapType((map[string]string)(nil), "proto.DetachVolumeOpts.MetadataEntry")
+	proto.RegisterType((*ExpandVolumeOpts)(nil), "proto.ExpandVolumeOpts")
+	proto.RegisterMapType((map[string]string)(nil), "proto.ExpandVolumeOpts.MetadataEntry")
+	proto.RegisterType((*ThrottleVolumeOpts)(nil), "proto.ThrottleVolumeOpts")
+	proto.RegisterMapType((map[string]string)(nil), "proto.ThrottleVolumeOpts.MetadataEntry")
+	proto.RegisterType((*FreezeVolumeOpts)(nil), "proto.FreezeVolumeOpts")
+	proto.RegisterMapType((map[string]string)(nil), "proto.FreezeVolumeOpts.MetadataEntry")
 	proto.RegisterType((*GenericResponse)(nil), "proto.GenericResponse")
 	proto.RegisterType((*GenericResponse_Result)(nil), "proto.GenericResponse.Result")
 	proto.RegisterType((*GenericResponse_Error)(nil), "proto.GenericResponse.Error")
+	proto.RegisterType((*PullVolumeOpts)(nil), "proto.PullVolumeOpts")
+	proto.RegisterType((*PullVolumeSnapshotOpts)(nil), "proto.PullVolumeSnapshotOpts")
+	proto.RegisterType((*ListPoolsOpts)(nil), "proto.ListPoolsOpts")
+	proto.RegisterType((*GetCapabilitiesOpts)(nil), "proto.GetCapabilitiesOpts")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2831,14 +3941,22 @@ type ControllerClient interface {
 	DeleteVolume(ctx context.Context, in *DeleteVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error)
 	// Extend a volume
 	ExtendVolume(ctx context.Context, in *ExtendVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Update the QoS of a volume
+	UpdateVolumeQos(ctx context.Context, in *UpdateVolumeQosOpts, opts ...grpc.CallOption) (*GenericResponse, error)
 	// Create a volume snapshot
 	CreateVolumeSnapshot(ctx context.Context, in *CreateVolumeSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error)
 	// Delete a volume snapshot
 	DeleteVolumeSnapshot(ctx context.Context, in *DeleteVolumeSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Verify the backup of a volume snapshot without restoring it
+	VerifyVolumeSnapshot(ctx context.Context, in *VerifyVolumeSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error)
 	// Create a volume attachment
 	CreateVolumeAttachment(ctx context.Context, in *CreateVolumeAttachmentOpts, opts ...grpc.CallOption) (*GenericResponse, error)
 	// Delete a volume attachment
 	DeleteVolumeAttachment(ctx context.Context, in *DeleteVolumeAttachmentOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Create a snapshot attachment
+	CreateSnapshotAttachment(ctx context.Context, in *CreateSnapshotAttachmentOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Delete a snapshot attachment
+	DeleteSnapshotAttachment(ctx context.Context, in *DeleteSnapshotAttachmentOpts, opts ...grpc.CallOption) (*GenericResponse, error)
 	// Create a replication
 	CreateReplication(ctx context.Context, in *CreateReplicationOpts, opts ...grpc.CallOption) (*GenericResponse, error)
 	// Delete a replication
@@ -2855,6 +3973,10 @@ type ControllerClient interface {
 	UpdateVolumeGroup(ctx context.Context, in *UpdateVolumeGroupOpts, opts ...grpc.CallOption) (*GenericResponse, error)
 	// Delete volume group
 	DeleteVolumeGroup(ctx context.Context, in *DeleteVolumeGroupOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Create a consistent snapshot of all the volumes in a volume group
+	CreateVolumeGroupSnapshot(ctx context.Context, in *CreateVolumeGroupSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Delete a volume group snapshot
+	DeleteVolumeGroupSnapshot(ctx context.Context, in *DeleteVolumeGroupSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error)
 }
 
 type controllerClient struct {
@@ -2892,6 +4014,15 @@ func (c *controllerClient) ExtendVolume(ctx context.Context, in *ExtendVolumeOpt
 	return out, nil
 }
 
+func (c *controllerClient) UpdateVolumeQos(ctx context.Context, in *UpdateVolumeQosOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.Controller/UpdateVolumeQos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *controllerClient) CreateVolumeSnapshot(ctx context.Context, in *CreateVolumeSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
 	out := new(GenericResponse)
 	err := c.cc.Invoke(ctx, "/proto.Controller/CreateVolumeSnapshot", in, out, opts...)
@@ -2910,6 +4041,15 @@ func (c *controllerClient) DeleteVolumeSnapshot(ctx context.Context, in *DeleteV
 	return out, nil
 }
 
+func (c *controllerClient) VerifyVolumeSnapshot(ctx context.Context, in *VerifyVolumeSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.Controller/VerifyVolumeSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *controllerClient) CreateVolumeAttachment(ctx context.Context, in *CreateVolumeAttachmentOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
 	out := new(GenericResponse)
 	err := c.cc.Invoke(ctx, "/proto.Controller/CreateVolumeAttachment", in, out, opts...)
@@ -2928,6 +4068,24 @@ func (c *controllerClient) DeleteVolumeAttachment(ctx context.Context, in *Delet
 	return out, nil
 }
 
+func (c *controllerClient) CreateSnapshotAttachment(ctx context.Context, in *CreateSnapshotAttachmentOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.Controller/CreateSnapshotAttachment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerClient) DeleteSnapshotAttachment(ctx context.Context, in *DeleteSnapshotAttachmentOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.Controller/DeleteSnapshotAttachment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *controllerClient) CreateReplication(ctx context.Context, in *CreateReplicationOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
 	out := new(GenericResponse)
 	err := c.cc.Invoke(ctx, "/proto.Controller/CreateReplication", in, out, opts...)
@@ -3000,6 +4158,24 @@ func (c *controllerClient) DeleteVolumeGroup(ctx context.Context, in *DeleteVolu
 	return out, nil
 }
 
+func (c *controllerClient) CreateVolumeGroupSnapshot(ctx context.Context, in *CreateVolumeGroupSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.Controller/CreateVolumeGroupSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controllerClient) DeleteVolumeGroupSnapshot(ctx context.Context, in *DeleteVolumeGroupSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.Controller/DeleteVolumeGroupSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ControllerServer is the server API for Controller service.
 type ControllerServer interface {
 	// Create a volume
@@ -3008,14 +4184,22 @@ type ControllerServer interface {
 	DeleteVolume(context.Context, *DeleteVolumeOpts) (*GenericResponse, error)
 	// Extend a volume
 	ExtendVolume(context.Context, *ExtendVolumeOpts) (*GenericResponse, error)
+	// Update the QoS of a volume
+	UpdateVolumeQos(context.Context, *UpdateVolumeQosOpts) (*GenericResponse, error)
 	// Create a volume snapshot
 	CreateVolumeSnapshot(context.Context, *CreateVolumeSnapshotOpts) (*GenericResponse, error)
 	// Delete a volume snapshot
 	DeleteVolumeSnapshot(context.Context, *DeleteVolumeSnapshotOpts) (*GenericResponse, error)
+	// Verify the backup of a volume snapshot without restoring it
+	VerifyVolumeSnapshot(context.Context, *VerifyVolumeSnapshotOpts) (*GenericResponse, error)
 	// Create a volume attachment
 	CreateVolumeAttachment(context.Context, *CreateVolumeAttachmentOpts) (*GenericResponse, error)
 	// Delete a volume attachment
 	DeleteVolumeAttachment(context.Context, *DeleteVolumeAttachmentOpts) (*GenericResponse, error)
+	// Create a snapshot attachment
+	CreateSnapshotAttachment(context.Context, *CreateSnapshotAttachmentOpts) (*GenericResponse, error)
+	// Delete a snapshot attachment
+	DeleteSnapshotAttachment(context.Context, *DeleteSnapshotAttachmentOpts) (*GenericResponse, error)
 	// Create a replication
 	CreateReplication(context.Context, *CreateReplicationOpts) (*GenericResponse, error)
 	// Delete a replication
@@ -3032,6 +4216,10 @@ type ControllerServer interface {
 	UpdateVolumeGroup(context.Context, *UpdateVolumeGroupOpts) (*GenericResponse, error)
 	// Delete volume group
 	DeleteVolumeGroup(context.Context, *DeleteVolumeGroupOpts) (*GenericResponse, error)
+	// Create a consistent snapshot of all the volumes in a volume group
+	CreateVolumeGroupSnapshot(context.Context, *CreateVolumeGroupSnapshotOpts) (*GenericResponse, error)
+	// Delete a volume group snapshot
+	DeleteVolumeGroupSnapshot(context.Context, *DeleteVolumeGroupSnapshotOpts) (*GenericResponse, error)
 }
 
 func RegisterControllerServer(s *grpc.Server, srv ControllerServer) {
@@ -3092,7 +4280,25 @@ func _Controller_ExtendVolume_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Controller_CreateVolumeSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Controller_UpdateVolumeQos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateVolumeQosOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerServer).UpdateVolumeQos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Controller/UpdateVolumeQos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerServer).UpdateVolumeQos(ctx, req.(*UpdateVolumeQosOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Controller_CreateVolumeSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateVolumeSnapshotOpts)
 	if err := dec(in); err != nil {
 		return nil, err
@@ -3128,6 +4334,24 @@ func _Controller_DeleteVolumeSnapshot_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Controller_VerifyVolumeSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyVolumeSnapshotOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerServer).VerifyVolumeSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Controller/VerifyVolumeSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerServer).VerifyVolumeSnapshot(ctx, req.(*VerifyVolumeSnapshotOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Controller_CreateVolumeAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateVolumeAttachmentOpts)
 	if err := dec(in); err != nil {
@@ -3164,6 +4388,42 @@ func _Controller_DeleteVolumeAttachment_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Controller_CreateSnapshotAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSnapshotAttachmentOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerServer).CreateSnapshotAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Controller/CreateSnapshotAttachment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerServer).CreateSnapshotAttachment(ctx, req.(*CreateSnapshotAttachmentOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Controller_DeleteSnapshotAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSnapshotAttachmentOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerServer).DeleteSnapshotAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Controller/DeleteSnapshotAttachment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerServer).DeleteSnapshotAttachment(ctx, req.(*DeleteSnapshotAttachmentOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Controller_CreateReplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateReplicationOpts)
 	if err := dec(in); err != nil {
@@ -3308,6 +4568,42 @@ func _Controller_DeleteVolumeGroup_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Controller_CreateVolumeGroupSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVolumeGroupSnapshotOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerServer).CreateVolumeGroupSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Controller/CreateVolumeGroupSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerServer).CreateVolumeGroupSnapshot(ctx, req.(*CreateVolumeGroupSnapshotOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Controller_DeleteVolumeGroupSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteVolumeGroupSnapshotOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControllerServer).DeleteVolumeGroupSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Controller/DeleteVolumeGroupSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControllerServer).DeleteVolumeGroupSnapshot(ctx, req.(*DeleteVolumeGroupSnapshotOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Controller_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "proto.Controller",
 	HandlerType: (*ControllerServer)(nil),
@@ -3324,6 +4620,10 @@ var _Controller_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ExtendVolume",
 			Handler:    _Controller_ExtendVolume_Handler,
 		},
+		{
+			MethodName: "UpdateVolumeQos",
+			Handler:    _Controller_UpdateVolumeQos_Handler,
+		},
 		{
 			MethodName: "CreateVolumeSnapshot",
 			Handler:    _Controller_CreateVolumeSnapshot_Handler,
@@ -3332,6 +4632,10 @@ var _Controller_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteVolumeSnapshot",
 			Handler:    _Controller_DeleteVolumeSnapshot_Handler,
 		},
+		{
+			MethodName: "VerifyVolumeSnapshot",
+			Handler:    _Controller_VerifyVolumeSnapshot_Handler,
+		},
 		{
 			MethodName: "CreateVolumeAttachment",
 			Handler:    _Controller_CreateVolumeAttachment_Handler,
@@ -3340,6 +4644,14 @@ var _Controller_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteVolumeAttachment",
 			Handler:    _Controller_DeleteVolumeAttachment_Handler,
 		},
+		{
+			MethodName: "CreateSnapshotAttachment",
+			Handler:    _Controller_CreateSnapshotAttachment_Handler,
+		},
+		{
+			MethodName: "DeleteSnapshotAttachment",
+			Handler:    _Controller_DeleteSnapshotAttachment_Handler,
+		},
 		{
 			MethodName: "CreateReplication",
 			Handler:    _Controller_CreateReplication_Handler,
@@ -3372,6 +4684,14 @@ var _Controller_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteVolumeGroup",
 			Handler:    _Controller_DeleteVolumeGroup_Handler,
 		},
+		{
+			MethodName: "CreateVolumeGroupSnapshot",
+			Handler:    _Controller_CreateVolumeGroupSnapshot_Handler,
+		},
+		{
+			MethodName: "DeleteVolumeGroupSnapshot",
+			Handler:    _Controller_DeleteVolumeGroupSnapshot_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "model.proto",
@@ -3387,14 +4707,22 @@ type ProvisionDockClient interface {
 	DeleteVolume(ctx context.Context, in *DeleteVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error)
 	// Extend a volume
 	ExtendVolume(ctx context.Context, in *ExtendVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Update the QoS of a volume
+	UpdateVolumeQos(ctx context.Context, in *UpdateVolumeQosOpts, opts ...grpc.CallOption) (*GenericResponse, error)
 	// Create a volume snapshot
 	CreateVolumeSnapshot(ctx context.Context, in *CreateVolumeSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error)
 	// Delete a volume snapshot
 	DeleteVolumeSnapshot(ctx context.Context, in *DeleteVolumeSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Verify the backup of a volume snapshot without restoring it
+	VerifyVolumeSnapshot(ctx context.Context, in *VerifyVolumeSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error)
 	// Create a volume attachment
 	CreateVolumeAttachment(ctx context.Context, in *CreateVolumeAttachmentOpts, opts ...grpc.CallOption) (*GenericResponse, error)
 	// Delete a volume attachment
 	DeleteVolumeAttachment(ctx context.Context, in *DeleteVolumeAttachmentOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Create a snapshot attachment
+	CreateSnapshotAttachment(ctx context.Context, in *CreateSnapshotAttachmentOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Delete a snapshot attachment
+	DeleteSnapshotAttachment(ctx context.Context, in *DeleteSnapshotAttachmentOpts, opts ...grpc.CallOption) (*GenericResponse, error)
 	// Create a replication
 	CreateReplication(ctx context.Context, in *CreateReplicationOpts, opts ...grpc.CallOption) (*GenericResponse, error)
 	// Delete a replication
@@ -3411,6 +4739,10 @@ type ProvisionDockClient interface {
 	UpdateVolumeGroup(ctx context.Context, in *UpdateVolumeGroupOpts, opts ...grpc.CallOption) (*GenericResponse, error)
 	// Delete volume group
 	DeleteVolumeGroup(ctx context.Context, in *DeleteVolumeGroupOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Create a consistent snapshot of all the volumes in a volume group
+	CreateVolumeGroupSnapshot(ctx context.Context, in *CreateVolumeGroupSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Delete a volume group snapshot
+	DeleteVolumeGroupSnapshot(ctx context.Context, in *DeleteVolumeGroupSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error)
 }
 
 type provisionDockClient struct {
@@ -3448,6 +4780,15 @@ func (c *provisionDockClient) ExtendVolume(ctx context.Context, in *ExtendVolume
 	return out, nil
 }
 
+func (c *provisionDockClient) UpdateVolumeQos(ctx context.Context, in *UpdateVolumeQosOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.ProvisionDock/UpdateVolumeQos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *provisionDockClient) CreateVolumeSnapshot(ctx context.Context, in *CreateVolumeSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
 	out := new(GenericResponse)
 	err := c.cc.Invoke(ctx, "/proto.ProvisionDock/CreateVolumeSnapshot", in, out, opts...)
@@ -3466,6 +4807,15 @@ func (c *provisionDockClient) DeleteVolumeSnapshot(ctx context.Context, in *Dele
 	return out, nil
 }
 
+func (c *provisionDockClient) VerifyVolumeSnapshot(ctx context.Context, in *VerifyVolumeSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.ProvisionDock/VerifyVolumeSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *provisionDockClient) CreateVolumeAttachment(ctx context.Context, in *CreateVolumeAttachmentOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
 	out := new(GenericResponse)
 	err := c.cc.Invoke(ctx, "/proto.ProvisionDock/CreateVolumeAttachment", in, out, opts...)
@@ -3484,6 +4834,24 @@ func (c *provisionDockClient) DeleteVolumeAttachment(ctx context.Context, in *De
 	return out, nil
 }
 
+func (c *provisionDockClient) CreateSnapshotAttachment(ctx context.Context, in *CreateSnapshotAttachmentOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.ProvisionDock/CreateSnapshotAttachment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *provisionDockClient) DeleteSnapshotAttachment(ctx context.Context, in *DeleteSnapshotAttachmentOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.ProvisionDock/DeleteSnapshotAttachment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *provisionDockClient) CreateReplication(ctx context.Context, in *CreateReplicationOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
 	out := new(GenericResponse)
 	err := c.cc.Invoke(ctx, "/proto.ProvisionDock/CreateReplication", in, out, opts...)
@@ -3556,6 +4924,24 @@ func (c *provisionDockClient) DeleteVolumeGroup(ctx context.Context, in *DeleteV
 	return out, nil
 }
 
+func (c *provisionDockClient) CreateVolumeGroupSnapshot(ctx context.Context, in *CreateVolumeGroupSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.ProvisionDock/CreateVolumeGroupSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *provisionDockClient) DeleteVolumeGroupSnapshot(ctx context.Context, in *DeleteVolumeGroupSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.ProvisionDock/DeleteVolumeGroupSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProvisionDockServer is the server API for ProvisionDock service.
 type ProvisionDockServer interface {
 	// Create a volume
@@ -3564,14 +4950,22 @@ type ProvisionDockServer interface {
 	DeleteVolume(context.Context, *DeleteVolumeOpts) (*GenericResponse, error)
 	// Extend a volume
 	ExtendVolume(context.Context, *ExtendVolumeOpts) (*GenericResponse, error)
+	// Update the QoS of a volume
+	UpdateVolumeQos(context.Context, *UpdateVolumeQosOpts) (*GenericResponse, error)
 	// Create a volume snapshot
 	CreateVolumeSnapshot(context.Context, *CreateVolumeSnapshotOpts) (*GenericResponse, error)
 	// Delete a volume snapshot
 	DeleteVolumeSnapshot(context.Context, *DeleteVolumeSnapshotOpts) (*GenericResponse, error)
+	// Verify the backup of a volume snapshot without restoring it
+	VerifyVolumeSnapshot(context.Context, *VerifyVolumeSnapshotOpts) (*GenericResponse, error)
 	// Create a volume attachment
 	CreateVolumeAttachment(context.Context, *CreateVolumeAttachmentOpts) (*GenericResponse, error)
 	// Delete a volume attachment
 	DeleteVolumeAttachment(context.Context, *DeleteVolumeAttachmentOpts) (*GenericResponse, error)
+	// Create a snapshot attachment
+	CreateSnapshotAttachment(context.Context, *CreateSnapshotAttachmentOpts) (*GenericResponse, error)
+	// Delete a snapshot attachment
+	DeleteSnapshotAttachment(context.Context, *DeleteSnapshotAttachmentOpts) (*GenericResponse, error)
 	// Create a replication
 	CreateReplication(context.Context, *CreateReplicationOpts) (*GenericResponse, error)
 	// Delete a replication
@@ -3588,6 +4982,10 @@ type ProvisionDockServer interface {
 	UpdateVolumeGroup(context.Context, *UpdateVolumeGroupOpts) (*GenericResponse, error)
 	// Delete volume group
 	DeleteVolumeGroup(context.Context, *DeleteVolumeGroupOpts) (*GenericResponse, error)
+	// Create a consistent snapshot of all the volumes in a volume group
+	CreateVolumeGroupSnapshot(context.Context, *CreateVolumeGroupSnapshotOpts) (*GenericResponse, error)
+	// Delete a volume group snapshot
+	DeleteVolumeGroupSnapshot(context.Context, *DeleteVolumeGroupSnapshotOpts) (*GenericResponse, error)
 }
 
 func RegisterProvisionDockServer(s *grpc.Server, srv ProvisionDockServer) {
@@ -3648,6 +5046,24 @@ func _ProvisionDock_ExtendVolume_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProvisionDock_UpdateVolumeQos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateVolumeQosOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvisionDockServer).UpdateVolumeQos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ProvisionDock/UpdateVolumeQos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvisionDockServer).UpdateVolumeQos(ctx, req.(*UpdateVolumeQosOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProvisionDock_CreateVolumeSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateVolumeSnapshotOpts)
 	if err := dec(in); err != nil {
@@ -3684,6 +5100,24 @@ func _ProvisionDock_DeleteVolumeSnapshot_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProvisionDock_VerifyVolumeSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyVolumeSnapshotOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvisionDockServer).VerifyVolumeSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ProvisionDock/VerifyVolumeSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvisionDockServer).VerifyVolumeSnapshot(ctx, req.(*VerifyVolumeSnapshotOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProvisionDock_CreateVolumeAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateVolumeAttachmentOpts)
 	if err := dec(in); err != nil {
@@ -3720,6 +5154,42 @@ func _ProvisionDock_DeleteVolumeAttachment_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProvisionDock_CreateSnapshotAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSnapshotAttachmentOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvisionDockServer).CreateSnapshotAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ProvisionDock/CreateSnapshotAttachment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvisionDockServer).CreateSnapshotAttachment(ctx, req.(*CreateSnapshotAttachmentOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvisionDock_DeleteSnapshotAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSnapshotAttachmentOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvisionDockServer).DeleteSnapshotAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ProvisionDock/DeleteSnapshotAttachment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvisionDockServer).DeleteSnapshotAttachment(ctx, req.(*DeleteSnapshotAttachmentOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProvisionDock_CreateReplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateReplicationOpts)
 	if err := dec(in); err != nil {
@@ -3864,6 +5334,42 @@ func _ProvisionDock_DeleteVolumeGroup_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProvisionDock_CreateVolumeGroupSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVolumeGroupSnapshotOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvisionDockServer).CreateVolumeGroupSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ProvisionDock/CreateVolumeGroupSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvisionDockServer).CreateVolumeGroupSnapshot(ctx, req.(*CreateVolumeGroupSnapshotOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProvisionDock_DeleteVolumeGroupSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteVolumeGroupSnapshotOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvisionDockServer).DeleteVolumeGroupSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ProvisionDock/DeleteVolumeGroupSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvisionDockServer).DeleteVolumeGroupSnapshot(ctx, req.(*DeleteVolumeGroupSnapshotOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ProvisionDock_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "proto.ProvisionDock",
 	HandlerType: (*ProvisionDockServer)(nil),
@@ -3880,6 +5386,10 @@ var _ProvisionDock_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ExtendVolume",
 			Handler:    _ProvisionDock_ExtendVolume_Handler,
 		},
+		{
+			MethodName: "UpdateVolumeQos",
+			Handler:    _ProvisionDock_UpdateVolumeQos_Handler,
+		},
 		{
 			MethodName: "CreateVolumeSnapshot",
 			Handler:    _ProvisionDock_CreateVolumeSnapshot_Handler,
@@ -3888,6 +5398,10 @@ var _ProvisionDock_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteVolumeSnapshot",
 			Handler:    _ProvisionDock_DeleteVolumeSnapshot_Handler,
 		},
+		{
+			MethodName: "VerifyVolumeSnapshot",
+			Handler:    _ProvisionDock_VerifyVolumeSnapshot_Handler,
+		},
 		{
 			MethodName: "CreateVolumeAttachment",
 			Handler:    _ProvisionDock_CreateVolumeAttachment_Handler,
@@ -3896,6 +5410,14 @@ var _ProvisionDock_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteVolumeAttachment",
 			Handler:    _ProvisionDock_DeleteVolumeAttachment_Handler,
 		},
+		{
+			MethodName: "CreateSnapshotAttachment",
+			Handler:    _ProvisionDock_CreateSnapshotAttachment_Handler,
+		},
+		{
+			MethodName: "DeleteSnapshotAttachment",
+			Handler:    _ProvisionDock_DeleteSnapshotAttachment_Handler,
+		},
 		{
 			MethodName: "CreateReplication",
 			Handler:    _ProvisionDock_CreateReplication_Handler,
@@ -3928,6 +5450,14 @@ var _ProvisionDock_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteVolumeGroup",
 			Handler:    _ProvisionDock_DeleteVolumeGroup_Handler,
 		},
+		{
+			MethodName: "CreateVolumeGroupSnapshot",
+			Handler:    _ProvisionDock_CreateVolumeGroupSnapshot_Handler,
+		},
+		{
+			MethodName: "DeleteVolumeGroupSnapshot",
+			Handler:    _ProvisionDock_DeleteVolumeGroupSnapshot_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "model.proto",
@@ -3941,6 +5471,12 @@ type AttachDockClient interface {
 	AttachVolume(ctx context.Context, in *AttachVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error)
 	// Detach a volume
 	DetachVolume(ctx context.Context, in *DetachVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Expand an attached volume on the host
+	ExpandVolume(ctx context.Context, in *ExpandVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Throttle the I/O of an attached volume on the host
+	ThrottleVolume(ctx context.Context, in *ThrottleVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Freeze or thaw the file system of a mounted volume on the host
+	FreezeVolume(ctx context.Context, in *FreezeVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error)
 }
 
 type attachDockClient struct {
@@ -3969,12 +5505,45 @@ func (c *attachDockClient) DetachVolume(ctx context.Context, in *DetachVolumeOpt
 	return out, nil
 }
 
+func (c *attachDockClient) ExpandVolume(ctx context.Context, in *ExpandVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.AttachDock/ExpandVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attachDockClient) ThrottleVolume(ctx context.Context, in *ThrottleVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.AttachDock/ThrottleVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attachDockClient) FreezeVolume(ctx context.Context, in *FreezeVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.AttachDock/FreezeVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AttachDockServer is the server API for AttachDock service.
 type AttachDockServer interface {
 	// Attach a volume
 	AttachVolume(context.Context, *AttachVolumeOpts) (*GenericResponse, error)
 	// Detach a volume
 	DetachVolume(context.Context, *DetachVolumeOpts) (*GenericResponse, error)
+	// Expand an attached volume on the host
+	ExpandVolume(context.Context, *ExpandVolumeOpts) (*GenericResponse, error)
+	// Throttle the I/O of an attached volume on the host
+	ThrottleVolume(context.Context, *ThrottleVolumeOpts) (*GenericResponse, error)
+	// Freeze or thaw the file system of a mounted volume on the host
+	FreezeVolume(context.Context, *FreezeVolumeOpts) (*GenericResponse, error)
 }
 
 func RegisterAttachDockServer(s *grpc.Server, srv AttachDockServer) {
@@ -4017,139 +5586,1048 @@ func _AttachDock_DetachVolume_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
-var _AttachDock_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "proto.AttachDock",
-	HandlerType: (*AttachDockServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "AttachVolume",
-			Handler:    _AttachDock_AttachVolume_Handler,
-		},
-		{
-			MethodName: "DetachVolume",
-			Handler:    _AttachDock_DetachVolume_Handler,
+func _AttachDock_ExpandVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpandVolumeOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttachDockServer).ExpandVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.AttachDock/ExpandVolume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttachDockServer).ExpandVolume(ctx, req.(*ExpandVolumeOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttachDock_ThrottleVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ThrottleVolumeOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttachDockServer).ThrottleVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.AttachDock/ThrottleVolume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttachDockServer).ThrottleVolume(ctx, req.(*ThrottleVolumeOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttachDock_FreezeVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FreezeVolumeOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttachDockServer).FreezeVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.AttachDock/FreezeVolume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttachDockServer).FreezeVolume(ctx, req.(*FreezeVolumeOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _AttachDock_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.AttachDock",
+	HandlerType: (*AttachDockServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AttachVolume",
+			Handler:    _AttachDock_AttachVolume_Handler,
+		},
+		{
+			MethodName: "DetachVolume",
+			Handler:    _AttachDock_DetachVolume_Handler,
+		},
+		{
+			MethodName: "ExpandVolume",
+			Handler:    _AttachDock_ExpandVolume_Handler,
+		},
+		{
+			MethodName: "ThrottleVolume",
+			Handler:    _AttachDock_ThrottleVolume_Handler,
+		},
+		{
+			MethodName: "FreezeVolume",
+			Handler:    _AttachDock_FreezeVolume_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "model.proto",
+}
+
+// VolumeDriverPluginClient is the client API for VolumeDriverPlugin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type VolumeDriverPluginClient interface {
+	// Create a volume
+	CreateVolume(ctx context.Context, in *CreateVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Pull a volume
+	PullVolume(ctx context.Context, in *PullVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Delete a volume
+	DeleteVolume(ctx context.Context, in *DeleteVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Extend a volume
+	ExtendVolume(ctx context.Context, in *ExtendVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Initialize the connection of a volume
+	InitializeConnection(ctx context.Context, in *CreateVolumeAttachmentOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Terminate the connection of a volume
+	TerminateConnection(ctx context.Context, in *DeleteVolumeAttachmentOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Create a volume snapshot
+	CreateSnapshot(ctx context.Context, in *CreateVolumeSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Pull a volume snapshot
+	PullSnapshot(ctx context.Context, in *PullVolumeSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Delete a volume snapshot
+	DeleteSnapshot(ctx context.Context, in *DeleteVolumeSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Initialize the connection of a volume snapshot
+	InitializeSnapshotConnection(ctx context.Context, in *CreateSnapshotAttachmentOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Terminate the connection of a volume snapshot
+	TerminateSnapshotConnection(ctx context.Context, in *DeleteSnapshotAttachmentOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Create a volume group
+	CreateVolumeGroup(ctx context.Context, in *CreateVolumeGroupOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Update volume group
+	UpdateVolumeGroup(ctx context.Context, in *UpdateVolumeGroupOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Delete a volume group
+	DeleteVolumeGroup(ctx context.Context, in *DeleteVolumeGroupOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// List the storage pools
+	ListPools(ctx context.Context, in *ListPoolsOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Get the optional operations supported by the driver
+	GetCapabilities(ctx context.Context, in *GetCapabilitiesOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+}
+
+type volumeDriverPluginClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewVolumeDriverPluginClient(cc *grpc.ClientConn) VolumeDriverPluginClient {
+	return &volumeDriverPluginClient{cc}
+}
+
+func (c *volumeDriverPluginClient) CreateVolume(ctx context.Context, in *CreateVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.VolumeDriverPlugin/CreateVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeDriverPluginClient) PullVolume(ctx context.Context, in *PullVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.VolumeDriverPlugin/PullVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeDriverPluginClient) DeleteVolume(ctx context.Context, in *DeleteVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.VolumeDriverPlugin/DeleteVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeDriverPluginClient) ExtendVolume(ctx context.Context, in *ExtendVolumeOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.VolumeDriverPlugin/ExtendVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeDriverPluginClient) InitializeConnection(ctx context.Context, in *CreateVolumeAttachmentOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.VolumeDriverPlugin/InitializeConnection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeDriverPluginClient) TerminateConnection(ctx context.Context, in *DeleteVolumeAttachmentOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.VolumeDriverPlugin/TerminateConnection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeDriverPluginClient) CreateSnapshot(ctx context.Context, in *CreateVolumeSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.VolumeDriverPlugin/CreateSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeDriverPluginClient) PullSnapshot(ctx context.Context, in *PullVolumeSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.VolumeDriverPlugin/PullSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeDriverPluginClient) DeleteSnapshot(ctx context.Context, in *DeleteVolumeSnapshotOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.VolumeDriverPlugin/DeleteSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeDriverPluginClient) InitializeSnapshotConnection(ctx context.Context, in *CreateSnapshotAttachmentOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.VolumeDriverPlugin/InitializeSnapshotConnection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeDriverPluginClient) TerminateSnapshotConnection(ctx context.Context, in *DeleteSnapshotAttachmentOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.VolumeDriverPlugin/TerminateSnapshotConnection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeDriverPluginClient) CreateVolumeGroup(ctx context.Context, in *CreateVolumeGroupOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.VolumeDriverPlugin/CreateVolumeGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeDriverPluginClient) UpdateVolumeGroup(ctx context.Context, in *UpdateVolumeGroupOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.VolumeDriverPlugin/UpdateVolumeGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeDriverPluginClient) DeleteVolumeGroup(ctx context.Context, in *DeleteVolumeGroupOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.VolumeDriverPlugin/DeleteVolumeGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeDriverPluginClient) ListPools(ctx context.Context, in *ListPoolsOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.VolumeDriverPlugin/ListPools", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeDriverPluginClient) GetCapabilities(ctx context.Context, in *GetCapabilitiesOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.VolumeDriverPlugin/GetCapabilities", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// VolumeDriverPluginServer is the server API for VolumeDriverPlugin service.
+type VolumeDriverPluginServer interface {
+	// Create a volume
+	CreateVolume(context.Context, *CreateVolumeOpts) (*GenericResponse, error)
+	// Pull a volume
+	PullVolume(context.Context, *PullVolumeOpts) (*GenericResponse, error)
+	// Delete a volume
+	DeleteVolume(context.Context, *DeleteVolumeOpts) (*GenericResponse, error)
+	// Extend a volume
+	ExtendVolume(context.Context, *ExtendVolumeOpts) (*GenericResponse, error)
+	// Initialize the connection of a volume
+	InitializeConnection(context.Context, *CreateVolumeAttachmentOpts) (*GenericResponse, error)
+	// Terminate the connection of a volume
+	TerminateConnection(context.Context, *DeleteVolumeAttachmentOpts) (*GenericResponse, error)
+	// Create a volume snapshot
+	CreateSnapshot(context.Context, *CreateVolumeSnapshotOpts) (*GenericResponse, error)
+	// Pull a volume snapshot
+	PullSnapshot(context.Context, *PullVolumeSnapshotOpts) (*GenericResponse, error)
+	// Delete a volume snapshot
+	DeleteSnapshot(context.Context, *DeleteVolumeSnapshotOpts) (*GenericResponse, error)
+	// Initialize the connection of a volume snapshot
+	InitializeSnapshotConnection(context.Context, *CreateSnapshotAttachmentOpts) (*GenericResponse, error)
+	// Terminate the connection of a volume snapshot
+	TerminateSnapshotConnection(context.Context, *DeleteSnapshotAttachmentOpts) (*GenericResponse, error)
+	// Create a volume group
+	CreateVolumeGroup(context.Context, *CreateVolumeGroupOpts) (*GenericResponse, error)
+	// Update volume group
+	UpdateVolumeGroup(context.Context, *UpdateVolumeGroupOpts) (*GenericResponse, error)
+	// Delete a volume group
+	DeleteVolumeGroup(context.Context, *DeleteVolumeGroupOpts) (*GenericResponse, error)
+	// List the storage pools
+	ListPools(context.Context, *ListPoolsOpts) (*GenericResponse, error)
+	// Get the optional operations supported by the driver
+	GetCapabilities(context.Context, *GetCapabilitiesOpts) (*GenericResponse, error)
+}
+
+func RegisterVolumeDriverPluginServer(s *grpc.Server, srv VolumeDriverPluginServer) {
+	s.RegisterService(&_VolumeDriverPlugin_serviceDesc, srv)
+}
+
+func _VolumeDriverPlugin_CreateVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVolumeOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeDriverPluginServer).CreateVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VolumeDriverPlugin/CreateVolume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeDriverPluginServer).CreateVolume(ctx, req.(*CreateVolumeOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VolumeDriverPlugin_PullVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PullVolumeOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeDriverPluginServer).PullVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VolumeDriverPlugin/PullVolume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeDriverPluginServer).PullVolume(ctx, req.(*PullVolumeOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VolumeDriverPlugin_DeleteVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteVolumeOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeDriverPluginServer).DeleteVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VolumeDriverPlugin/DeleteVolume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeDriverPluginServer).DeleteVolume(ctx, req.(*DeleteVolumeOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VolumeDriverPlugin_ExtendVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtendVolumeOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeDriverPluginServer).ExtendVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VolumeDriverPlugin/ExtendVolume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeDriverPluginServer).ExtendVolume(ctx, req.(*ExtendVolumeOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VolumeDriverPlugin_InitializeConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVolumeAttachmentOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeDriverPluginServer).InitializeConnection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VolumeDriverPlugin/InitializeConnection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeDriverPluginServer).InitializeConnection(ctx, req.(*CreateVolumeAttachmentOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VolumeDriverPlugin_TerminateConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteVolumeAttachmentOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeDriverPluginServer).TerminateConnection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VolumeDriverPlugin/TerminateConnection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeDriverPluginServer).TerminateConnection(ctx, req.(*DeleteVolumeAttachmentOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VolumeDriverPlugin_CreateSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVolumeSnapshotOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeDriverPluginServer).CreateSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VolumeDriverPlugin/CreateSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeDriverPluginServer).CreateSnapshot(ctx, req.(*CreateVolumeSnapshotOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VolumeDriverPlugin_PullSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PullVolumeSnapshotOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeDriverPluginServer).PullSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VolumeDriverPlugin/PullSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeDriverPluginServer).PullSnapshot(ctx, req.(*PullVolumeSnapshotOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VolumeDriverPlugin_DeleteSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteVolumeSnapshotOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeDriverPluginServer).DeleteSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VolumeDriverPlugin/DeleteSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeDriverPluginServer).DeleteSnapshot(ctx, req.(*DeleteVolumeSnapshotOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VolumeDriverPlugin_InitializeSnapshotConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSnapshotAttachmentOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeDriverPluginServer).InitializeSnapshotConnection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VolumeDriverPlugin/InitializeSnapshotConnection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeDriverPluginServer).InitializeSnapshotConnection(ctx, req.(*CreateSnapshotAttachmentOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VolumeDriverPlugin_TerminateSnapshotConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSnapshotAttachmentOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeDriverPluginServer).TerminateSnapshotConnection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VolumeDriverPlugin/TerminateSnapshotConnection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeDriverPluginServer).TerminateSnapshotConnection(ctx, req.(*DeleteSnapshotAttachmentOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VolumeDriverPlugin_CreateVolumeGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVolumeGroupOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeDriverPluginServer).CreateVolumeGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VolumeDriverPlugin/CreateVolumeGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeDriverPluginServer).CreateVolumeGroup(ctx, req.(*CreateVolumeGroupOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VolumeDriverPlugin_UpdateVolumeGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateVolumeGroupOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeDriverPluginServer).UpdateVolumeGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VolumeDriverPlugin/UpdateVolumeGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeDriverPluginServer).UpdateVolumeGroup(ctx, req.(*UpdateVolumeGroupOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VolumeDriverPlugin_DeleteVolumeGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteVolumeGroupOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeDriverPluginServer).DeleteVolumeGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VolumeDriverPlugin/DeleteVolumeGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeDriverPluginServer).DeleteVolumeGroup(ctx, req.(*DeleteVolumeGroupOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VolumeDriverPlugin_ListPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPoolsOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeDriverPluginServer).ListPools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VolumeDriverPlugin/ListPools",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeDriverPluginServer).ListPools(ctx, req.(*ListPoolsOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VolumeDriverPlugin_GetCapabilities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCapabilitiesOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeDriverPluginServer).GetCapabilities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.VolumeDriverPlugin/GetCapabilities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeDriverPluginServer).GetCapabilities(ctx, req.(*GetCapabilitiesOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _VolumeDriverPlugin_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.VolumeDriverPlugin",
+	HandlerType: (*VolumeDriverPluginServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateVolume",
+			Handler:    _VolumeDriverPlugin_CreateVolume_Handler,
+		},
+		{
+			MethodName: "PullVolume",
+			Handler:    _VolumeDriverPlugin_PullVolume_Handler,
+		},
+		{
+			MethodName: "DeleteVolume",
+			Handler:    _VolumeDriverPlugin_DeleteVolume_Handler,
+		},
+		{
+			MethodName: "ExtendVolume",
+			Handler:    _VolumeDriverPlugin_ExtendVolume_Handler,
+		},
+		{
+			MethodName: "InitializeConnection",
+			Handler:    _VolumeDriverPlugin_InitializeConnection_Handler,
+		},
+		{
+			MethodName: "TerminateConnection",
+			Handler:    _VolumeDriverPlugin_TerminateConnection_Handler,
+		},
+		{
+			MethodName: "CreateSnapshot",
+			Handler:    _VolumeDriverPlugin_CreateSnapshot_Handler,
+		},
+		{
+			MethodName: "PullSnapshot",
+			Handler:    _VolumeDriverPlugin_PullSnapshot_Handler,
+		},
+		{
+			MethodName: "DeleteSnapshot",
+			Handler:    _VolumeDriverPlugin_DeleteSnapshot_Handler,
+		},
+		{
+			MethodName: "InitializeSnapshotConnection",
+			Handler:    _VolumeDriverPlugin_InitializeSnapshotConnection_Handler,
+		},
+		{
+			MethodName: "TerminateSnapshotConnection",
+			Handler:    _VolumeDriverPlugin_TerminateSnapshotConnection_Handler,
+		},
+		{
+			MethodName: "CreateVolumeGroup",
+			Handler:    _VolumeDriverPlugin_CreateVolumeGroup_Handler,
+		},
+		{
+			MethodName: "UpdateVolumeGroup",
+			Handler:    _VolumeDriverPlugin_UpdateVolumeGroup_Handler,
+		},
+		{
+			MethodName: "DeleteVolumeGroup",
+			Handler:    _VolumeDriverPlugin_DeleteVolumeGroup_Handler,
+		},
+		{
+			MethodName: "ListPools",
+			Handler:    _VolumeDriverPlugin_ListPools_Handler,
+		},
+		{
+			MethodName: "GetCapabilities",
+			Handler:    _VolumeDriverPlugin_GetCapabilities_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "model.proto",
+}
+
+// ReplicationDriverPluginClient is the client API for ReplicationDriverPlugin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ReplicationDriverPluginClient interface {
+	// Create a replication
+	CreateReplication(ctx context.Context, in *CreateReplicationOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Delete a replication
+	DeleteReplication(ctx context.Context, in *DeleteReplicationOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Enable a replication
+	EnableReplication(ctx context.Context, in *EnableReplicationOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Disable a replication
+	DisableReplication(ctx context.Context, in *DisableReplicationOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+	// Failover a replication
+	FailoverReplication(ctx context.Context, in *FailoverReplicationOpts, opts ...grpc.CallOption) (*GenericResponse, error)
+}
+
+type replicationDriverPluginClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewReplicationDriverPluginClient(cc *grpc.ClientConn) ReplicationDriverPluginClient {
+	return &replicationDriverPluginClient{cc}
+}
+
+func (c *replicationDriverPluginClient) CreateReplication(ctx context.Context, in *CreateReplicationOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.ReplicationDriverPlugin/CreateReplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *replicationDriverPluginClient) DeleteReplication(ctx context.Context, in *DeleteReplicationOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.ReplicationDriverPlugin/DeleteReplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *replicationDriverPluginClient) EnableReplication(ctx context.Context, in *EnableReplicationOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.ReplicationDriverPlugin/EnableReplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *replicationDriverPluginClient) DisableReplication(ctx context.Context, in *DisableReplicationOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.ReplicationDriverPlugin/DisableReplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *replicationDriverPluginClient) FailoverReplication(ctx context.Context, in *FailoverReplicationOpts, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/proto.ReplicationDriverPlugin/FailoverReplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ReplicationDriverPluginServer is the server API for ReplicationDriverPlugin service.
+type ReplicationDriverPluginServer interface {
+	// Create a replication
+	CreateReplication(context.Context, *CreateReplicationOpts) (*GenericResponse, error)
+	// Delete a replication
+	DeleteReplication(context.Context, *DeleteReplicationOpts) (*GenericResponse, error)
+	// Enable a replication
+	EnableReplication(context.Context, *EnableReplicationOpts) (*GenericResponse, error)
+	// Disable a replication
+	DisableReplication(context.Context, *DisableReplicationOpts) (*GenericResponse, error)
+	// Failover a replication
+	FailoverReplication(context.Context, *FailoverReplicationOpts) (*GenericResponse, error)
+}
+
+func RegisterReplicationDriverPluginServer(s *grpc.Server, srv ReplicationDriverPluginServer) {
+	s.RegisterService(&_ReplicationDriverPlugin_serviceDesc, srv)
+}
+
+func _ReplicationDriverPlugin_CreateReplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReplicationOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReplicationDriverPluginServer).CreateReplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ReplicationDriverPlugin/CreateReplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReplicationDriverPluginServer).CreateReplication(ctx, req.(*CreateReplicationOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReplicationDriverPlugin_DeleteReplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteReplicationOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReplicationDriverPluginServer).DeleteReplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ReplicationDriverPlugin/DeleteReplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReplicationDriverPluginServer).DeleteReplication(ctx, req.(*DeleteReplicationOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReplicationDriverPlugin_EnableReplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnableReplicationOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReplicationDriverPluginServer).EnableReplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ReplicationDriverPlugin/EnableReplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReplicationDriverPluginServer).EnableReplication(ctx, req.(*EnableReplicationOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReplicationDriverPlugin_DisableReplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableReplicationOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReplicationDriverPluginServer).DisableReplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ReplicationDriverPlugin/DisableReplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReplicationDriverPluginServer).DisableReplication(ctx, req.(*DisableReplicationOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReplicationDriverPlugin_FailoverReplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FailoverReplicationOpts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReplicationDriverPluginServer).FailoverReplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ReplicationDriverPlugin/FailoverReplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReplicationDriverPluginServer).FailoverReplication(ctx, req.(*FailoverReplicationOpts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ReplicationDriverPlugin_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.ReplicationDriverPlugin",
+	HandlerType: (*ReplicationDriverPluginServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateReplication",
+			Handler:    _ReplicationDriverPlugin_CreateReplication_Handler,
+		},
+		{
+			MethodName: "DeleteReplication",
+			Handler:    _ReplicationDriverPlugin_DeleteReplication_Handler,
+		},
+		{
+			MethodName: "EnableReplication",
+			Handler:    _ReplicationDriverPlugin_EnableReplication_Handler,
+		},
+		{
+			MethodName: "DisableReplication",
+			Handler:    _ReplicationDriverPlugin_DisableReplication_Handler,
+		},
+		{
+			MethodName: "FailoverReplication",
+			Handler:    _ReplicationDriverPlugin_FailoverReplication_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "model.proto",
 }
 
-func init() { proto.RegisterFile("model.proto", fileDescriptor_model_a8adca89daa441ca) }
-
-var fileDescriptor_model_a8adca89daa441ca = []byte{
-	// 1824 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0x4b, 0x73, 0xdb, 0x46,
-	0x12, 0x36, 0xc1, 0x77, 0x53, 0x0f, 0x6a, 0xf4, 0x30, 0x8a, 0x2b, 0x6b, 0x65, 0xae, 0xd7, 0xa5,
-	0xb2, 0xbd, 0xf2, 0x9a, 0xbb, 0x55, 0xde, 0x47, 0xed, 0x26, 0x92, 0x25, 0x5b, 0x2c, 0x5b, 0xb1,
-	0x4c, 0xc7, 0x3e, 0xe4, 0x06, 0x03, 0xe3, 0x08, 0x25, 0x10, 0xc3, 0x00, 0x10, 0x6d, 0xe5, 0x94,
-	0x4a, 0x72, 0x70, 0x72, 0xf4, 0x25, 0x55, 0xb9, 0xa5, 0x52, 0x39, 0xe6, 0x17, 0xe4, 0x90, 0x4b,
-	0x7e, 0x46, 0x0e, 0xc9, 0x31, 0x55, 0x39, 0xf8, 0x07, 0xe4, 0x90, 0xc2, 0xe0, 0x41, 0x0c, 0x30,
-	0x18, 0x82, 0xa1, 0x2c, 0xcb, 0x65, 0x9e, 0x48, 0xf4, 0x0c, 0x7a, 0xba, 0xbf, 0xee, 0xaf, 0xe7,
-	0x81, 0x81, 0x5a, 0x97, 0x68, 0xd8, 0x58, 0xef, 0x59, 0xc4, 0x21, 0xa8, 0x48, 0x7f, 0x9a, 0x2f,
-	0x8a, 0x50, 0xbf, 0x61, 0x61, 0xc5, 0xc1, 0x0f, 0x89, 0x71, 0xd8, 0xc5, 0x77, 0x7b, 0x8e, 0x8d,
-	0x66, 0x40, 0xd2, 0x35, 0x39, 0xb7, 0x9a, 0x5b, 0xab, 0x76, 0x24, 0x5d, 0x43, 0x08, 0x0a, 0xa6,
-	0xd2, 0xc5, 0xb2, 0x44, 0x25, 0xf4, 0xbf, 0x2b, 0xb3, 0xf5, 0x0f, 0xb1, 0x9c, 0x5f, 0xcd, 0xad,
-	0xe5, 0x3b, 0xf4, 0x3f, 0x5a, 0x85, 0x9a, 0x86, 0x6d, 0xd5, 0xd2, 0x7b, 0x8e, 0x4e, 0x4c, 0xb9,
-	0x40, 0xbb, 0x47, 0x45, 0x68, 0x05, 0xc0, 0x36, 0x95, 0x9e, 0xbd, 0x4f, 0x9c, 0xb6, 0x26, 0x17,
-	0x69, 0x87, 0x88, 0x04, 0x5d, 0x82, 0xba, 0xd2, 0x57, 0x74, 0x43, 0x79, 0xa4, 0x1b, 0xba, 0x73,
-	0xf4, 0x1e, 0x31, 0xb1, 0x5c, 0xa2, 0xbd, 0x12, 0x72, 0xb4, 0x0c, 0xd5, 0x9e, 0x45, 0x1e, 0xeb,
-	0x06, 0x6e, 0x6b, 0x72, 0x99, 0x76, 0x1a, 0x08, 0xd0, 0x12, 0x94, 0x7a, 0x84, 0x18, 0x6d, 0x4d,
-	0xae, 0xd0, 0x26, 0xff, 0x09, 0x35, 0xa0, 0xe2, 0xfe, 0x7b, 0xc7, 0xf5, 0xa7, 0x4a, 0x5b, 0xc2,
-	0x67, 0xb4, 0x01, 0x95, 0x2e, 0x76, 0x14, 0x4d, 0x71, 0x14, 0x19, 0x56, 0xf3, 0x6b, 0xb5, 0xd6,
-	0x5f, 0x3d, 0xb4, 0xd6, 0xe3, 0x10, 0xad, 0xef, 0xfa, 0xfd, 0xb6, 0x4d, 0xc7, 0x3a, 0xea, 0x84,
-	0xaf, 0xb9, 0x0e, 0x6a, 0x96, 0xde, 0xc7, 0x16, 0x1d, 0xa0, 0xe6, 0x39, 0x38, 0x90, 0x20, 0x19,
-	0xca, 0x2a, 0x31, 0x1d, 0xfc, 0xd4, 0x91, 0xa7, 0x68, 0x63, 0xf0, 0x88, 0xf6, 0x61, 0xd1, 0xc2,
-	0x3d, 0x43, 0x57, 0x15, 0x17, 0xa9, 0x2d, 0xfa, 0xca, 0x96, 0x6b, 0xc9, 0x34, 0xb5, 0xa4, 0x95,
-	0x66, 0x49, 0x87, 0xf7, 0x92, 0x67, 0x16, 0x5f, 0x21, 0xba, 0x00, 0xd3, 0x91, 0x86, 0xb6, 0x26,
-	0xcf, 0x50, 0x4b, 0x58, 0x21, 0x6a, 0xc2, 0x54, 0x10, 0x98, 0xfb, 0x6e, 0xa0, 0x67, 0x69, 0xa0,
-	0x19, 0x19, 0xba, 0x02, 0x73, 0xc1, 0xf3, 0x4d, 0x8b, 0x74, 0x6f, 0x18, 0xe4, 0x50, 0x93, 0xeb,
-	0xab, 0xb9, 0xb5, 0x4a, 0x27, 0xd9, 0xd0, 0xf8, 0x2f, 0x4c, 0x33, 0xb0, 0xa1, 0x3a, 0xe4, 0x0f,
-	0xf0, 0x91, 0x9f, 0x68, 0xee, 0x5f, 0xb4, 0x00, 0xc5, 0xbe, 0x62, 0x1c, 0x06, 0xa9, 0xe6, 0x3d,
-	0xfc, 0x47, 0xfa, 0x57, 0xae, 0xb1, 0x03, 0x8d, 0x74, 0x4f, 0x47, 0xd1, 0xd4, 0x7c, 0x2e, 0x41,
-	0x7d, 0x0b, 0x1b, 0x58, 0x98, 0xf2, 0x4c, 0x72, 0x49, 0xe9, 0xc9, 0x95, 0x67, 0x92, 0x2b, 0x9a,
-	0x40, 0x05, 0x26, 0x81, 0xe2, 0x03, 0x66, 0x4c, 0xa0, 0xa2, 0x28, 0x81, 0x4a, 0x4c, 0x02, 0x8d,
-	0x05, 0x6f, 0xf3, 0x87, 0x3c, 0xd4, 0xb7, 0x9f, 0x3a, 0xd8, 0xd4, 0x26, 0x75, 0x40, 0x50, 0x07,
-	0xe2, 0x10, 0x1d, 0x7f, 0x1d, 0x18, 0x2f, 0x8c, 0x2f, 0x24, 0x90, 0xa3, 0x15, 0xe2, 0xbe, 0x0f,
-	0xe9, 0x4b, 0x0e, 0x67, 0x03, 0x2a, 0x7d, 0x3a, 0x5e, 0x18, 0xcc, 0xf0, 0x99, 0x0d, 0x4f, 0x29,
-	0x1e, 0x9e, 0x76, 0x04, 0xea, 0x32, 0x85, 0xfa, 0x6f, 0x9c, 0x42, 0x17, 0x75, 0x23, 0x23, 0xe4,
-	0x15, 0x11, 0xe4, 0xd5, 0x63, 0x84, 0xfc, 0x99, 0x04, 0x72, 0x94, 0xdd, 0x42, 0xc8, 0xa3, 0x40,
-	0x49, 0x31, 0xa0, 0xa2, 0x50, 0xe4, 0x19, 0x28, 0xd2, 0xd4, 0x67, 0x84, 0xa2, 0x20, 0x82, 0xa2,
-	0x78, 0x8c, 0x50, 0x7c, 0x93, 0x87, 0x46, 0x34, 0x6c, 0x1b, 0x8e, 0xa3, 0xa8, 0xfb, 0x5d, 0x6c,
-	0x8e, 0x0e, 0xc6, 0x05, 0x98, 0xd6, 0xc8, 0x1d, 0xa2, 0x2a, 0x86, 0xa7, 0x84, 0x26, 0x64, 0xa5,
-	0xc3, 0x0a, 0xdd, 0xdc, 0xea, 0x1e, 0x1a, 0x8e, 0xbe, 0xa7, 0x38, 0xfb, 0xd4, 0xcd, 0x4a, 0x67,
-	0x20, 0x40, 0x97, 0xa1, 0xb2, 0x4f, 0x6c, 0xa7, 0x6d, 0x3e, 0x26, 0xd4, 0xcd, 0x5a, 0x6b, 0xd6,
-	0x07, 0x74, 0xc7, 0x17, 0x77, 0xc2, 0x0e, 0xe8, 0x76, 0x04, 0xfd, 0x12, 0x45, 0xff, 0x2a, 0x27,
-	0x11, 0x59, 0x8f, 0x32, 0xe2, 0x5f, 0x16, 0xe1, 0x5f, 0x61, 0x57, 0x01, 0x17, 0x61, 0x66, 0x43,
-	0x55, 0xb1, 0x6d, 0xef, 0xb9, 0x63, 0xab, 0xc4, 0xf0, 0x73, 0x35, 0x26, 0x1d, 0x2f, 0x4e, 0x3f,
-	0x4b, 0xd0, 0x88, 0xe6, 0xd4, 0x18, 0x71, 0x8a, 0x62, 0x9c, 0x1f, 0x05, 0xe3, 0x02, 0x83, 0x71,
-	0xba, 0x35, 0xc7, 0x3f, 0x51, 0x72, 0x30, 0x2e, 0x1f, 0x3f, 0xc6, 0xdf, 0xe6, 0x61, 0xd9, 0xcb,
-	0x9c, 0x80, 0xb1, 0x43, 0x50, 0x66, 0xa7, 0x44, 0x29, 0x31, 0x25, 0x9e, 0x38, 0x23, 0x76, 0x13,
-	0x8c, 0xb8, 0xc6, 0x30, 0x82, 0xef, 0xd7, 0xeb, 0xca, 0x89, 0x5f, 0x24, 0x58, 0xf6, 0xb2, 0xf0,
-	0x98, 0xe2, 0x35, 0x12, 0x33, 0x76, 0x13, 0xcc, 0xb8, 0xc6, 0x30, 0x63, 0x2c, 0xac, 0x4f, 0x1d,
-	0x37, 0x3e, 0xca, 0x41, 0x25, 0x00, 0x81, 0x2e, 0xc4, 0x0c, 0xc5, 0x79, 0x4c, 0xac, 0xae, 0xff,
-	0x76, 0xf8, 0xec, 0x2e, 0xde, 0x88, 0xfd, 0xee, 0x51, 0x2f, 0xd0, 0xe1, 0x3f, 0xb9, 0xab, 0x14,
-	0x17, 0x3a, 0x7f, 0xf5, 0x4d, 0xff, 0xd3, 0xf8, 0xf4, 0xfc, 0xb9, 0x4e, 0xd2, 0x7b, 0x2e, 0x13,
-	0x74, 0x53, 0x77, 0x74, 0xc5, 0x21, 0x96, 0x0f, 0xc1, 0x40, 0xd0, 0xec, 0x03, 0x78, 0xd5, 0x86,
-	0xee, 0x88, 0xae, 0x42, 0x81, 0x42, 0x9f, 0xa3, 0xd0, 0xff, 0xc9, 0x87, 0x7e, 0xd0, 0x61, 0x7d,
-	0xb0, 0xa7, 0xa2, 0x1d, 0x1b, 0xd7, 0xa1, 0xfa, 0xc7, 0x36, 0x1f, 0x5f, 0x57, 0x61, 0xd1, 0xa3,
-	0x4f, 0x64, 0x37, 0x93, 0x79, 0x75, 0x16, 0x5b, 0x89, 0xe5, 0x93, 0x2b, 0xb1, 0x35, 0x98, 0xed,
-	0x59, 0x7a, 0x57, 0xb1, 0x8e, 0x1e, 0x06, 0x25, 0xdb, 0x83, 0x24, 0x2e, 0xa6, 0x7b, 0x37, 0xac,
-	0x12, 0x53, 0x8b, 0xf6, 0xf5, 0x70, 0x4a, 0x36, 0xbc, 0xe2, 0x05, 0xf9, 0xc7, 0x39, 0x58, 0xf6,
-	0xed, 0xe7, 0x6e, 0x02, 0xe5, 0x1a, 0x0d, 0xdc, 0xff, 0x99, 0xfa, 0x14, 0x03, 0x78, 0x7d, 0x4f,
-	0xa0, 0xc0, 0x8b, 0xad, 0x70, 0x0c, 0xf4, 0x2c, 0x07, 0x2b, 0x21, 0x30, 0x7c, 0x33, 0xa6, 0xa8,
-	0x19, 0x6f, 0x0b, 0xcd, 0xb8, 0x2f, 0x54, 0xe1, 0x19, 0x32, 0x64, 0x1c, 0x17, 0x43, 0x8d, 0xa8,
-	0x07, 0x6d, 0x4d, 0x9e, 0xf6, 0x30, 0xf4, 0x9e, 0x62, 0xbc, 0x9f, 0x11, 0xf1, 0x7e, 0x96, 0xe5,
-	0xbd, 0xcb, 0x16, 0xdb, 0x47, 0xc8, 0xdf, 0xc1, 0x0f, 0x04, 0xe8, 0x66, 0xa4, 0x3c, 0xcd, 0x51,
-	0x1f, 0x2f, 0x09, 0x7d, 0x4c, 0xab, 0x4b, 0xff, 0x86, 0x99, 0x7e, 0x48, 0xaa, 0x3b, 0xba, 0xed,
-	0xc8, 0x88, 0x6a, 0x9b, 0x4b, 0x30, 0xae, 0x13, 0xeb, 0xe8, 0x26, 0x76, 0xe4, 0x7c, 0x62, 0x97,
-	0x68, 0x58, 0x9e, 0xf7, 0x12, 0x3b, 0x26, 0x76, 0x13, 0x3b, 0x62, 0xcf, 0x1e, 0xb6, 0x74, 0xa2,
-	0xc9, 0x0b, 0x74, 0x3f, 0x93, 0x6c, 0x40, 0x2d, 0x58, 0x88, 0x08, 0x37, 0x15, 0x53, 0x7b, 0xa2,
-	0x6b, 0xce, 0xbe, 0xbc, 0x48, 0x5f, 0xe0, 0xb6, 0x35, 0xee, 0xc2, 0xf9, 0xa1, 0xc9, 0x34, 0xd2,
-	0xe1, 0xc6, 0x3d, 0xf8, 0x4b, 0x86, 0xb4, 0x18, 0x49, 0xe5, 0x58, 0x05, 0xfa, 0xc7, 0x32, 0x2c,
-	0x7a, 0x13, 0xcf, 0xa4, 0x4a, 0xbd, 0xb4, 0x2a, 0xc5, 0x05, 0xf8, 0xe4, 0xab, 0x14, 0xdf, 0x8c,
-	0xd3, 0x59, 0xa5, 0xa2, 0x75, 0xa8, 0xce, 0xd4, 0x21, 0xbe, 0x17, 0x69, 0x75, 0x88, 0xa9, 0x76,
-	0x73, 0xb1, 0x6a, 0xf7, 0x66, 0xd0, 0x7b, 0xdb, 0x54, 0x1e, 0x19, 0x13, 0x7a, 0xbf, 0x3c, 0x7a,
-	0x73, 0x01, 0x3e, 0x79, 0x7a, 0xf3, 0xcd, 0x78, 0xdd, 0xe8, 0xcd, 0xf7, 0x62, 0x42, 0x6f, 0x2e,
-	0xbd, 0x7f, 0x2a, 0xc3, 0xd2, 0x96, 0x6e, 0x4f, 0xf8, 0x3d, 0x1a, 0xbf, 0x3f, 0xc9, 0xc6, 0xef,
-	0xb7, 0x82, 0x19, 0x87, 0x8b, 0xf0, 0xd8, 0x04, 0xff, 0x2c, 0x2b, 0xc1, 0x37, 0xc4, 0x76, 0x9c,
-	0x4e, 0x86, 0xdf, 0x4a, 0x30, 0xfc, 0xb2, 0xd8, 0x8d, 0x09, 0xc5, 0xb9, 0x14, 0xff, 0xae, 0x0a,
-	0x67, 0x6f, 0x2a, 0xba, 0x41, 0xfa, 0xd8, 0x9a, 0x70, 0x3c, 0x3b, 0xc7, 0x3f, 0xcd, 0xc6, 0xf1,
-	0x60, 0xf2, 0x4c, 0x81, 0x78, 0x6c, 0x92, 0x7f, 0x9e, 0x95, 0xe4, 0x9b, 0x43, 0x0c, 0x39, 0x9d,
-	0x2c, 0xff, 0x3b, 0xcc, 0x2b, 0x86, 0x41, 0x9e, 0x78, 0xa7, 0x95, 0xd8, 0xff, 0x5e, 0xea, 0x1f,
-	0x2b, 0xf0, 0x9a, 0xd0, 0x3a, 0xa0, 0xd0, 0xca, 0x4d, 0x45, 0x3d, 0xc0, 0xa6, 0xd6, 0xd6, 0x28,
-	0xaf, 0xab, 0x1d, 0x4e, 0x0b, 0xda, 0x89, 0xd4, 0x11, 0xef, 0x08, 0xe1, 0xca, 0x10, 0xa4, 0x32,
-	0x15, 0x92, 0xf9, 0x37, 0xad, 0x90, 0x34, 0x6c, 0x98, 0x1d, 0x20, 0xf6, 0xc1, 0x21, 0xb6, 0x53,
-	0xa3, 0x97, 0x1b, 0x35, 0x7a, 0x52, 0x5a, 0xf4, 0x9a, 0x5f, 0x49, 0xc1, 0x21, 0xa8, 0xa7, 0xe0,
-	0x96, 0x45, 0x0e, 0x7b, 0x99, 0x6b, 0x17, 0x9b, 0x97, 0xf9, 0x44, 0x5e, 0x0e, 0xff, 0x5c, 0xcd,
-	0xab, 0x41, 0xc5, 0x94, 0x1a, 0xb4, 0x02, 0xa0, 0x68, 0xbe, 0xa3, 0x36, 0xfd, 0x0e, 0x52, 0xed,
-	0x44, 0x24, 0xde, 0x65, 0x9a, 0x2e, 0xe9, 0xe3, 0xa0, 0x4b, 0x99, 0x76, 0x61, 0x85, 0xa9, 0xb5,
-	0x2a, 0xf5, 0x9b, 0x74, 0xf3, 0xfb, 0x1c, 0x2c, 0x3e, 0xe8, 0x69, 0x19, 0x30, 0x62, 0xf1, 0x90,
-	0x12, 0x78, 0xb0, 0x1e, 0xe4, 0x87, 0x7b, 0x50, 0x10, 0x7b, 0x50, 0x4c, 0xf3, 0x80, 0xfd, 0x94,
-	0xd0, 0x3c, 0x0a, 0xce, 0x90, 0x86, 0x39, 0x30, 0x50, 0x2d, 0x31, 0xaa, 0x87, 0x05, 0x3a, 0x32,
-	0x74, 0x81, 0x1d, 0xfa, 0xb7, 0x1c, 0xd4, 0xbd, 0x1c, 0x8d, 0xdc, 0x66, 0xb9, 0x08, 0x33, 0x0a,
-	0xfb, 0x69, 0xc3, 0x33, 0x21, 0x26, 0x75, 0xfb, 0xa9, 0xc4, 0x34, 0xb1, 0x4a, 0x89, 0xe9, 0x56,
-	0x18, 0xcf, 0xac, 0x98, 0x94, 0xb9, 0x25, 0x92, 0x67, 0x6e, 0x89, 0xc4, 0x87, 0x4e, 0x2d, 0x3e,
-	0xa9, 0x1e, 0x8c, 0xb7, 0x3a, 0x70, 0xdd, 0xdf, 0xc2, 0xaf, 0xcc, 0xfd, 0xf8, 0xd0, 0x27, 0xed,
-	0xfe, 0xaf, 0x39, 0x98, 0xbd, 0x85, 0x4d, 0x6c, 0xe9, 0x6a, 0x07, 0xdb, 0x3d, 0x62, 0xda, 0x18,
-	0x5d, 0x87, 0x92, 0x85, 0xed, 0x43, 0xc3, 0xa1, 0x2a, 0x6a, 0xad, 0x73, 0xbe, 0xad, 0xb1, 0x7e,
-	0xeb, 0x1d, 0xda, 0x69, 0xe7, 0x4c, 0xc7, 0xef, 0x8e, 0xfe, 0x09, 0x45, 0x6c, 0x59, 0xc4, 0xa2,
-	0xc3, 0xd4, 0x5a, 0xcb, 0x29, 0xef, 0x6d, 0xbb, 0x7d, 0x76, 0xce, 0x74, 0xbc, 0xce, 0x8d, 0x26,
-	0x94, 0x3c, 0x4d, 0xae, 0x8f, 0x5d, 0x6c, 0xdb, 0xca, 0xfb, 0xd8, 0x37, 0x3e, 0x78, 0x6c, 0xfc,
-	0x0f, 0x8a, 0xf4, 0x2d, 0xb7, 0xc8, 0xa9, 0x44, 0x0b, 0xda, 0xe9, 0xff, 0x78, 0x11, 0x93, 0x12,
-	0x45, 0x6c, 0xb3, 0x0c, 0x45, 0x0b, 0xf7, 0x8c, 0xa3, 0xd6, 0x17, 0x55, 0x80, 0x1b, 0xc4, 0x74,
-	0x2c, 0x62, 0x18, 0xd8, 0x42, 0x1b, 0x30, 0x15, 0xad, 0xad, 0xe8, 0x6c, 0xca, 0xc5, 0xc1, 0xc6,
-	0x12, 0xdf, 0x95, 0xe6, 0x19, 0x57, 0x45, 0x94, 0xb9, 0xa1, 0x8a, 0xf8, 0x25, 0x36, 0xb1, 0x8a,
-	0xe8, 0x5d, 0xa9, 0x50, 0x45, 0xfc, 0x02, 0x95, 0x40, 0xc5, 0x3d, 0x58, 0xe0, 0xdd, 0x01, 0x42,
-	0x7f, 0x1e, 0x72, 0x41, 0x48, 0xac, 0x92, 0x77, 0x97, 0x26, 0x54, 0x99, 0x76, 0xd1, 0x46, 0xa0,
-	0xf2, 0x01, 0x2c, 0xf1, 0x2f, 0x88, 0xa0, 0xf3, 0x43, 0xef, 0x8f, 0x88, 0xd5, 0xf2, 0xef, 0x44,
-	0x84, 0x6a, 0xd3, 0xaf, 0x4c, 0x08, 0xd4, 0xde, 0x86, 0xb9, 0xc4, 0x17, 0x1b, 0xb4, 0x2c, 0xfa,
-	0x96, 0x23, 0x56, 0x96, 0x38, 0x76, 0x0d, 0x95, 0x71, 0x0f, 0x64, 0xc5, 0xca, 0x12, 0x87, 0x3c,
-	0xa1, 0x32, 0xee, 0xf1, 0x8f, 0x40, 0xd9, 0x2e, 0xa0, 0xe4, 0x7e, 0x12, 0x9d, 0x13, 0x6e, 0x35,
-	0x05, 0xea, 0xee, 0xc2, 0x3c, 0x67, 0x59, 0x89, 0x56, 0xc4, 0x4b, 0xce, 0x2c, 0x61, 0x88, 0x4c,
-	0x8d, 0xb1, 0x30, 0xc4, 0x26, 0x4d, 0xb1, 0xb2, 0xc4, 0x42, 0x21, 0x54, 0xc6, 0x5d, 0x42, 0x64,
-	0x89, 0x29, 0x4f, 0x19, 0x77, 0x3a, 0x4f, 0x57, 0xd6, 0xfa, 0xb2, 0x0a, 0xd3, 0x7b, 0x16, 0xe9,
-	0xeb, 0xb6, 0x3b, 0x69, 0x10, 0xf5, 0x60, 0x52, 0x9c, 0x26, 0xc5, 0x69, 0x52, 0x9c, 0x26, 0xc5,
-	0xe9, 0x34, 0x14, 0xa7, 0xe7, 0x39, 0x00, 0x2f, 0x35, 0x83, 0xca, 0x14, 0x5d, 0xb6, 0x87, 0x35,
-	0x21, 0xbe, 0x96, 0x1f, 0x56, 0x99, 0x38, 0x2a, 0xe2, 0xeb, 0xe1, 0x74, 0x15, 0x8f, 0x4a, 0xb4,
-	0xe1, 0x1f, 0xbf, 0x07, 0x00, 0x00, 0xff, 0xff, 0xbe, 0x38, 0xf4, 0x80, 0xab, 0x33, 0x00, 0x00,
+func init() { proto.RegisterFile("model.proto", fileDescriptor_model_87c8f56f027e671b) }
+
+var fileDescriptor_model_87c8f56f027e671b = []byte{
+	// 2560 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5b, 0xcd, 0x73, 0x1c, 0x47,
+	0x15, 0xcf, 0xce, 0xec, 0xe7, 0x93, 0xb5, 0x92, 0x5a, 0x1f, 0x1e, 0x6f, 0x64, 0xa3, 0x6c, 0x8c,
+	0x11, 0x49, 0x90, 0x89, 0xa0, 0xca, 0x90, 0x94, 0x01, 0xeb, 0xc3, 0xf2, 0x96, 0x63, 0x24, 0x8d,
+	0x9c, 0x50, 0x10, 0x38, 0x8c, 0x77, 0x5a, 0xd6, 0x94, 0x67, 0xa7, 0x37, 0x33, 0xb3, 0xb2, 0xe4,
+	0x53, 0x2a, 0xe1, 0xc0, 0xc7, 0x8d, 0x2b, 0x1c, 0xa8, 0xa2, 0x8a, 0x03, 0x67, 0xfe, 0x03, 0x8a,
+	0x33, 0x55, 0x1c, 0x28, 0x2e, 0x54, 0xe0, 0xc2, 0x89, 0x3f, 0x21, 0x45, 0x51, 0xd3, 0xf3, 0xb1,
+	0xdd, 0xf3, 0xd1, 0x3b, 0xeb, 0xd5, 0xca, 0x32, 0xd9, 0xd3, 0x6e, 0xbf, 0xe9, 0x79, 0xd3, 0xfd,
+	0x7b, 0xaf, 0x7f, 0xaf, 0xfb, 0xcd, 0x1b, 0x98, 0xea, 0x10, 0x1d, 0x9b, 0x6b, 0x5d, 0x9b, 0xb8,
+	0x04, 0x95, 0xe8, 0x4f, 0xf3, 0xf3, 0x32, 0xcc, 0x6e, 0xda, 0x58, 0x73, 0xf1, 0x07, 0xc4, 0xec,
+	0x75, 0xf0, 0x6e, 0xd7, 0x75, 0x50, 0x1d, 0x24, 0x43, 0x57, 0x0a, 0x2b, 0x85, 0xd5, 0x9a, 0x2a,
+	0x19, 0x3a, 0x42, 0x50, 0xb4, 0xb4, 0x0e, 0x56, 0x24, 0x2a, 0xa1, 0xff, 0x3d, 0x99, 0x63, 0x3c,
+	0xc3, 0x8a, 0xbc, 0x52, 0x58, 0x95, 0x55, 0xfa, 0x1f, 0xad, 0xc0, 0x94, 0x8e, 0x9d, 0xb6, 0x6d,
+	0x74, 0x5d, 0x83, 0x58, 0x4a, 0x91, 0x76, 0x67, 0x45, 0xe8, 0x1a, 0x80, 0x63, 0x69, 0x5d, 0xe7,
+	0x88, 0xb8, 0x2d, 0x5d, 0x29, 0xd1, 0x0e, 0x8c, 0x04, 0xbd, 0x01, 0xb3, 0xda, 0xb1, 0x66, 0x98,
+	0xda, 0x23, 0xc3, 0x34, 0xdc, 0xd3, 0x1f, 0x11, 0x0b, 0x2b, 0x65, 0xda, 0x2b, 0x21, 0x47, 0xcb,
+	0x50, 0xeb, 0xda, 0xe4, 0xd0, 0x30, 0x71, 0x4b, 0x57, 0x2a, 0xb4, 0x53, 0x5f, 0x80, 0x96, 0xa0,
+	0xdc, 0x25, 0xc4, 0x6c, 0xe9, 0x4a, 0x95, 0x5e, 0x0a, 0x5a, 0xa8, 0x01, 0x55, 0xef, 0xdf, 0xf7,
+	0xbd, 0xf9, 0xd4, 0xe8, 0x95, 0xa8, 0x8d, 0xee, 0x40, 0xb5, 0x83, 0x5d, 0x4d, 0xd7, 0x5c, 0x4d,
+	0x81, 0x15, 0x79, 0x75, 0x6a, 0xfd, 0xcb, 0x3e, 0x5a, 0x6b, 0x71, 0x88, 0xd6, 0x1e, 0x04, 0xfd,
+	0xb6, 0x2d, 0xd7, 0x3e, 0x55, 0xa3, 0xdb, 0xbc, 0x09, 0xea, 0xb6, 0x71, 0x8c, 0x6d, 0xfa, 0x80,
+	0x29, 0x7f, 0x82, 0x7d, 0x09, 0x52, 0xa0, 0xd2, 0x26, 0x96, 0x8b, 0x4f, 0x5c, 0xe5, 0x12, 0xbd,
+	0x18, 0x36, 0xd1, 0x11, 0x2c, 0xda, 0xb8, 0x6b, 0x1a, 0x6d, 0xcd, 0x43, 0x6a, 0x8b, 0xde, 0xb2,
+	0xe5, 0x8d, 0x64, 0x9a, 0x8e, 0x64, 0x3d, 0x6b, 0x24, 0x6a, 0xda, 0x4d, 0xfe, 0xb0, 0xd2, 0x15,
+	0xa2, 0xeb, 0x30, 0xcd, 0x5c, 0x68, 0xe9, 0x4a, 0x9d, 0x8e, 0x84, 0x17, 0xa2, 0x26, 0x5c, 0x0a,
+	0x0d, 0x73, 0xe0, 0x19, 0x7a, 0x86, 0x1a, 0x9a, 0x93, 0xa1, 0xb7, 0x60, 0x2e, 0x6c, 0xdf, 0xb5,
+	0x49, 0x67, 0xd3, 0x24, 0x3d, 0x5d, 0x99, 0x5d, 0x29, 0xac, 0x56, 0xd5, 0xe4, 0x05, 0xcf, 0x3d,
+	0x1e, 0x69, 0xed, 0x27, 0xd8, 0xd2, 0x29, 0x38, 0x73, 0xbe, 0x7b, 0x30, 0x22, 0xb4, 0x0c, 0xf2,
+	0x47, 0xc4, 0x51, 0xd0, 0x4a, 0x61, 0x75, 0x6a, 0x1d, 0x82, 0x19, 0xef, 0x13, 0x47, 0xf5, 0xc4,
+	0xde, 0x88, 0x0c, 0x67, 0x93, 0x74, 0xba, 0x36, 0x76, 0x1c, 0xac, 0x2b, 0xf3, 0xf4, 0x41, 0x9c,
+	0x0c, 0xdd, 0x80, 0xba, 0xe1, 0x6c, 0x61, 0xbd, 0xe7, 0x4f, 0x05, 0xeb, 0xca, 0x02, 0xed, 0x15,
+	0x93, 0x36, 0xde, 0x85, 0x69, 0xce, 0x84, 0x68, 0x16, 0xe4, 0x27, 0xf8, 0x34, 0x70, 0x7a, 0xef,
+	0x2f, 0x5a, 0x80, 0xd2, 0xb1, 0x66, 0xf6, 0x42, 0xb7, 0xf7, 0x1b, 0xef, 0x48, 0xdf, 0x2a, 0x34,
+	0xee, 0x41, 0x23, 0x1b, 0xf5, 0x61, 0x34, 0x35, 0xff, 0x28, 0xc1, 0xec, 0x16, 0x36, 0xb1, 0x70,
+	0xf9, 0x71, 0x8e, 0x2e, 0x65, 0x3b, 0xba, 0xcc, 0x39, 0x3a, 0xeb, 0xcc, 0x45, 0xce, 0x99, 0xe3,
+	0x0f, 0xcc, 0xe9, 0xcc, 0x25, 0x91, 0x33, 0x97, 0x79, 0x67, 0x8e, 0x99, 0xba, 0x92, 0x30, 0xf5,
+	0x48, 0x06, 0x68, 0xfe, 0x5b, 0x86, 0xd9, 0xed, 0x13, 0x17, 0x5b, 0xfa, 0x84, 0xb5, 0x04, 0xac,
+	0x15, 0x87, 0x68, 0x0c, 0xac, 0x15, 0x33, 0xf4, 0xf4, 0x19, 0x1b, 0xfa, 0x16, 0xc8, 0xfb, 0xc4,
+	0xf1, 0x9e, 0xdf, 0xd1, 0x4e, 0x5a, 0xbb, 0x7b, 0x07, 0xf4, 0x36, 0x59, 0x0d, 0x9b, 0x1e, 0x60,
+	0x1d, 0xed, 0x64, 0xe3, 0x07, 0x07, 0xf4, 0x5e, 0x59, 0x0d, 0x5a, 0xcd, 0xbf, 0x49, 0x30, 0xff,
+	0x7e, 0x57, 0x8f, 0xa8, 0x72, 0x9f, 0x38, 0x19, 0x6b, 0x8b, 0x32, 0x8e, 0x94, 0xce, 0x38, 0x59,
+	0x6b, 0x8b, 0x35, 0x47, 0x31, 0x66, 0x8e, 0x2d, 0xc6, 0x1c, 0x25, 0x6a, 0x8e, 0xd5, 0x40, 0x6d,
+	0xca, 0x78, 0x72, 0x5a, 0xa4, 0x2c, 0xb2, 0x48, 0x45, 0x68, 0x91, 0xea, 0x19, 0x5b, 0xe4, 0x57,
+	0x32, 0x28, 0x6c, 0x0c, 0x3a, 0x08, 0x96, 0xc1, 0x98, 0x97, 0x60, 0x03, 0xaa, 0xc7, 0xf4, 0x79,
+	0xd1, 0x02, 0x8c, 0xda, 0xfc, 0x92, 0x2a, 0xc7, 0x97, 0x54, 0x8b, 0xb1, 0x47, 0x85, 0xda, 0xe3,
+	0x6b, 0x29, 0xa1, 0x94, 0x9d, 0x46, 0x4e, 0xa3, 0x54, 0x45, 0x46, 0xa9, 0x09, 0x8d, 0x02, 0x67,
+	0x6c, 0x94, 0x3f, 0x48, 0xa0, 0xb0, 0xac, 0x2e, 0x34, 0x0a, 0x0b, 0xa5, 0x14, 0x83, 0x92, 0x05,
+	0x4b, 0xe6, 0xc0, 0xca, 0x52, 0x9f, 0x13, 0xac, 0xa2, 0x08, 0xac, 0x92, 0x10, 0xac, 0xf2, 0x18,
+	0xc0, 0xfa, 0x00, 0xdb, 0xc6, 0xe1, 0xe9, 0xd8, 0xc0, 0xca, 0x52, 0xff, 0xf2, 0x81, 0xf5, 0x59,
+	0x11, 0x1a, 0xec, 0x3a, 0xb9, 0xe3, 0xba, 0x5a, 0xfb, 0xa8, 0x83, 0xad, 0xe1, 0xe1, 0xba, 0x0e,
+	0xd3, 0x3a, 0x79, 0x8f, 0xb4, 0x35, 0xd3, 0x57, 0x42, 0x19, 0xa0, 0xaa, 0xf2, 0x42, 0x6f, 0x31,
+	0x77, 0x7a, 0xa6, 0x6b, 0xec, 0x69, 0xee, 0x11, 0x05, 0xa2, 0xaa, 0xf6, 0x05, 0xe8, 0x4d, 0xa8,
+	0x1e, 0x11, 0xc7, 0x6d, 0x59, 0x87, 0x84, 0x02, 0x31, 0xb5, 0x3e, 0x13, 0x40, 0x7e, 0x2f, 0x10,
+	0xab, 0x51, 0x07, 0x74, 0x9f, 0xb1, 0x4f, 0x99, 0xda, 0xe7, 0x66, 0xca, 0xca, 0xe7, 0x67, 0x94,
+	0xd3, 0x42, 0x15, 0x91, 0x85, 0xaa, 0xbc, 0x85, 0x6e, 0x40, 0xfd, 0x4e, 0xbb, 0x8d, 0x1d, 0x67,
+	0xcf, 0x7b, 0x76, 0x9b, 0x98, 0x01, 0x39, 0xc4, 0xa4, 0x83, 0x39, 0xc2, 0x0b, 0x47, 0x87, 0xce,
+	0xc3, 0xd3, 0x6e, 0x18, 0xa2, 0x83, 0x96, 0x37, 0xb6, 0x0e, 0xe9, 0x59, 0x6e, 0x97, 0x18, 0x56,
+	0x18, 0xa1, 0x19, 0x89, 0xb7, 0x71, 0xa6, 0xad, 0x5d, 0xca, 0xa5, 0x0e, 0x3d, 0x51, 0xd4, 0x54,
+	0x4e, 0xe6, 0xe1, 0xee, 0xda, 0x9a, 0xe5, 0x74, 0x89, 0xed, 0x06, 0x07, 0x82, 0xbe, 0x20, 0x0c,
+	0x93, 0x33, 0xa9, 0x61, 0x72, 0x34, 0x0f, 0xfb, 0x54, 0x86, 0x06, 0x4b, 0x2e, 0x23, 0x78, 0x18,
+	0xeb, 0x1d, 0xf2, 0x30, 0xde, 0x51, 0xe4, 0xbc, 0x23, 0x7b, 0x34, 0x63, 0xd8, 0x29, 0x27, 0xbd,
+	0xa3, 0x92, 0xc7, 0x3b, 0xce, 0x3a, 0xac, 0xff, 0x45, 0x86, 0x65, 0x7f, 0x55, 0x84, 0x7c, 0x35,
+	0xc0, 0x0e, 0xfc, 0x9e, 0x58, 0x4a, 0xec, 0x89, 0xcf, 0x7d, 0xb5, 0x3f, 0x48, 0xac, 0xf6, 0xb7,
+	0xb9, 0xd5, 0x9e, 0x3e, 0xaf, 0x8b, 0xbc, 0xde, 0x47, 0xb3, 0xe8, 0x2f, 0x65, 0x58, 0xf6, 0x3d,
+	0xf9, 0x8c, 0x2c, 0x3a, 0xd4, 0xea, 0x7a, 0x90, 0x58, 0x5d, 0x6f, 0x73, 0xab, 0x6b, 0x24, 0x6b,
+	0xbc, 0x84, 0xeb, 0xeb, 0xe3, 0x02, 0x54, 0x43, 0x98, 0xe8, 0xf1, 0xc1, 0xd4, 0xdc, 0x43, 0x62,
+	0x77, 0x82, 0xbb, 0xa3, 0xb6, 0xc7, 0xf1, 0xc4, 0xe7, 0x78, 0x5f, 0x47, 0xd0, 0xf2, 0xb6, 0xcd,
+	0x1e, 0xb8, 0xc1, 0x41, 0x84, 0xfe, 0xa7, 0x16, 0xec, 0x06, 0xbb, 0x05, 0xc9, 0xe8, 0x7a, 0xab,
+	0xc9, 0xb0, 0x0c, 0xd7, 0xd0, 0x5c, 0x62, 0x07, 0x20, 0xf5, 0x05, 0xcd, 0x63, 0x00, 0x9f, 0xd3,
+	0x68, 0x12, 0xe8, 0x26, 0x14, 0xa9, 0x71, 0x0a, 0xd4, 0x38, 0xaf, 0x86, 0x1b, 0x97, 0xa8, 0xc3,
+	0x5a, 0x3f, 0x8d, 0x44, 0x3b, 0x36, 0x6e, 0x41, 0xed, 0xf9, 0x72, 0x1c, 0x7f, 0xae, 0xc1, 0xa2,
+	0xbf, 0x04, 0x99, 0xa4, 0x49, 0xee, 0xe3, 0x42, 0xec, 0x68, 0x20, 0x27, 0x8f, 0x06, 0xab, 0x30,
+	0xd3, 0xb5, 0x8d, 0x8e, 0x66, 0x07, 0x1b, 0xae, 0x96, 0x1e, 0x40, 0x12, 0x17, 0xd3, 0x74, 0x15,
+	0x6e, 0x13, 0x4b, 0x67, 0xfb, 0xfa, 0x38, 0x25, 0x2f, 0xbc, 0xe0, 0x53, 0xfd, 0x27, 0x05, 0x58,
+	0x0e, 0xc6, 0x9f, 0x9a, 0x6b, 0x52, 0xa6, 0xa8, 0xe1, 0xbe, 0xc3, 0x71, 0x5c, 0x0c, 0xe0, 0xb5,
+	0x3d, 0x81, 0x02, 0xdf, 0xb6, 0xc2, 0x67, 0xa0, 0x9f, 0x15, 0xe0, 0x5a, 0x04, 0x4c, 0xfa, 0x30,
+	0x2e, 0xd1, 0x61, 0x7c, 0x4f, 0x38, 0x8c, 0x03, 0xa1, 0x0a, 0x7f, 0x20, 0x03, 0x9e, 0xe3, 0x61,
+	0xa8, 0x93, 0xf6, 0x93, 0x96, 0x1e, 0xe4, 0x18, 0x82, 0x56, 0x8c, 0x19, 0xea, 0x22, 0x66, 0x98,
+	0xe1, 0x99, 0xc1, 0x5b, 0x2d, 0x4e, 0x80, 0x50, 0x90, 0xb4, 0xec, 0x0b, 0xd0, 0x5d, 0x86, 0xc0,
+	0xe6, 0xe8, 0x1c, 0xdf, 0x10, 0xce, 0x31, 0x8b, 0xb9, 0xbe, 0x0d, 0xf5, 0xe3, 0x68, 0x51, 0xbd,
+	0x67, 0x38, 0xae, 0x82, 0xa8, 0xb6, 0xb9, 0xc4, 0x8a, 0x53, 0x63, 0x1d, 0x3d, 0xc7, 0x66, 0x52,
+	0xb2, 0x0f, 0x88, 0x8e, 0x69, 0xca, 0xb3, 0xa6, 0xc6, 0xc5, 0x9e, 0x63, 0x33, 0xe3, 0xd9, 0xc3,
+	0xb6, 0x41, 0xfc, 0xc4, 0xa7, 0xac, 0x26, 0x2f, 0xa0, 0x75, 0x58, 0x60, 0x84, 0x1b, 0x9a, 0xa5,
+	0x3f, 0x35, 0x74, 0xf7, 0x48, 0x59, 0xa4, 0x37, 0xa4, 0x5e, 0x8b, 0xd3, 0xe3, 0x52, 0x92, 0x1e,
+	0x77, 0xe1, 0xb5, 0x81, 0xee, 0x36, 0x54, 0x96, 0x75, 0x1f, 0x5e, 0xcf, 0xe1, 0x38, 0x43, 0xa9,
+	0x1c, 0x89, 0xc2, 0xff, 0x5b, 0x81, 0x45, 0x3f, 0x78, 0x4d, 0x78, 0x6c, 0x6c, 0x3c, 0x96, 0x0a,
+	0xf0, 0xf9, 0xf3, 0x58, 0xfa, 0x30, 0x2e, 0x26, 0x8f, 0xb1, 0x4c, 0x35, 0xcb, 0x31, 0x55, 0xfa,
+	0x2c, 0xb2, 0x98, 0x8a, 0xe3, 0xc3, 0xb9, 0x38, 0x1f, 0xc6, 0x08, 0x00, 0x7d, 0x41, 0x09, 0x60,
+	0xdb, 0xd2, 0x1e, 0x99, 0x13, 0x02, 0x18, 0x1f, 0x01, 0xa4, 0x02, 0x7c, 0xfe, 0x04, 0x90, 0x3e,
+	0x8c, 0x97, 0x8d, 0x00, 0xd2, 0x67, 0x31, 0x21, 0x80, 0xe7, 0x24, 0x80, 0x4f, 0xaa, 0xb0, 0xb4,
+	0x65, 0x38, 0x13, 0x06, 0x18, 0x8e, 0x01, 0x3e, 0xcd, 0xc7, 0x00, 0xdf, 0x0d, 0xa3, 0x96, 0xe1,
+	0x8c, 0x83, 0x02, 0x7e, 0x9e, 0x97, 0x02, 0xee, 0x88, 0xc7, 0x71, 0x31, 0x39, 0x60, 0x27, 0xc1,
+	0x01, 0x6f, 0x8a, 0xa7, 0x31, 0x21, 0x81, 0xe7, 0x24, 0x81, 0x7f, 0xd4, 0xe0, 0xf2, 0x5d, 0xcd,
+	0x30, 0xc9, 0x31, 0xb6, 0x27, 0x2c, 0x90, 0x9f, 0x05, 0x7e, 0x9a, 0x8f, 0x05, 0xc2, 0x00, 0x9c,
+	0x01, 0xf1, 0xc8, 0x34, 0xf0, 0x8b, 0xbc, 0x34, 0xb0, 0x31, 0x60, 0x20, 0x17, 0x93, 0x07, 0xbe,
+	0x0e, 0xf3, 0x9a, 0x69, 0x92, 0xa7, 0x7e, 0x5e, 0x15, 0x07, 0xc5, 0x1f, 0x41, 0x7a, 0x23, 0xed,
+	0x12, 0x5a, 0x03, 0x14, 0x8d, 0x72, 0xc3, 0x5f, 0xc8, 0x2d, 0x3d, 0x28, 0xce, 0x4a, 0xb9, 0x82,
+	0xee, 0x31, 0x4c, 0xe3, 0xa7, 0x32, 0xde, 0x1a, 0x80, 0x54, 0x2e, 0xaa, 0x99, 0x1f, 0x40, 0x35,
+	0x0b, 0x5f, 0x3c, 0xaa, 0x69, 0x38, 0x30, 0xd3, 0xc7, 0xf4, 0xa3, 0x1e, 0x76, 0x32, 0xed, 0x5b,
+	0x18, 0xd6, 0xbe, 0x52, 0x96, 0x7d, 0x9b, 0x7f, 0x92, 0xc2, 0x74, 0xad, 0xaf, 0x60, 0xc7, 0x26,
+	0xbd, 0x6e, 0x6e, 0x76, 0xe3, 0x3d, 0x57, 0x4e, 0x78, 0xee, 0xe0, 0x4a, 0x8f, 0x34, 0x96, 0x2a,
+	0x65, 0xb0, 0xd4, 0x35, 0x00, 0x4d, 0x0f, 0x26, 0xea, 0xd0, 0xb7, 0x3e, 0x35, 0x95, 0x91, 0xf8,
+	0x95, 0x8e, 0x1d, 0x72, 0x8c, 0xc3, 0x2e, 0x15, 0xda, 0x85, 0x17, 0x66, 0xb2, 0xd9, 0x08, 0xe5,
+	0x1c, 0xcd, 0x7f, 0x15, 0x60, 0x91, 0xad, 0xf7, 0xc9, 0x46, 0x91, 0x47, 0x4c, 0x4a, 0x20, 0xc6,
+	0xcf, 0x51, 0x1e, 0x3c, 0xc7, 0xa2, 0x78, 0x8e, 0xa5, 0xac, 0x39, 0x0e, 0x5b, 0xc2, 0xd7, 0xfc,
+	0x75, 0x21, 0x4c, 0x88, 0x0d, 0x9a, 0x63, 0xff, 0xe9, 0x12, 0xf7, 0xf4, 0x41, 0xde, 0xc2, 0x8c,
+	0xae, 0x28, 0x1c, 0x5d, 0x29, 0x39, 0xba, 0xcf, 0x0b, 0x70, 0x35, 0xe1, 0xc7, 0xc2, 0x5a, 0x0f,
+	0x05, 0x2a, 0x8f, 0xbd, 0x4e, 0xd1, 0x30, 0xc3, 0x66, 0x66, 0x1d, 0xd8, 0x6d, 0xa8, 0x85, 0x2f,
+	0xc8, 0x9c, 0xe0, 0x35, 0xd7, 0x97, 0x06, 0x14, 0x17, 0xa9, 0xfd, 0x3b, 0xc6, 0x59, 0x5f, 0x49,
+	0xa7, 0x9f, 0x30, 0xce, 0xf9, 0x4d, 0x3f, 0xab, 0x5c, 0xe8, 0xbc, 0xa6, 0xff, 0x77, 0x09, 0x66,
+	0x7d, 0x22, 0x64, 0x2a, 0x44, 0x6f, 0x40, 0x5d, 0xe3, 0xdf, 0x05, 0xfa, 0xb3, 0x8f, 0x49, 0xbd,
+	0x7e, 0x6d, 0x62, 0x59, 0xb8, 0x4d, 0xd9, 0xdf, 0x0b, 0x74, 0x3e, 0x20, 0x31, 0x29, 0x57, 0x79,
+	0x29, 0x73, 0x95, 0x97, 0xf1, 0x47, 0x67, 0xc6, 0xc0, 0x6c, 0x0f, 0xef, 0x17, 0x7b, 0x94, 0x04,
+	0xc5, 0x1e, 0xe5, 0x81, 0xc5, 0x1e, 0x95, 0x64, 0xb1, 0xc7, 0x68, 0x1b, 0xe0, 0xdf, 0xd0, 0x9a,
+	0xe5, 0x17, 0x06, 0xed, 0x16, 0x1e, 0x19, 0x5a, 0x1e, 0xc2, 0x52, 0x1c, 0xc2, 0xd1, 0xe1, 0xd9,
+	0x3e, 0xe9, 0x6a, 0x96, 0xfe, 0x42, 0xe0, 0x89, 0x3f, 0xfa, 0xa2, 0xc1, 0xf3, 0xb1, 0x04, 0xe8,
+	0xe1, 0x91, 0x4d, 0x5c, 0xd7, 0xc4, 0x63, 0x04, 0x68, 0x33, 0x01, 0xd0, 0x57, 0x02, 0x80, 0x92,
+	0x0f, 0x1f, 0x1e, 0xa2, 0xd1, 0x20, 0xf8, 0x67, 0x01, 0x66, 0xef, 0xda, 0x18, 0x3f, 0x63, 0x01,
+	0xe0, 0x41, 0x2f, 0x24, 0x96, 0x35, 0x82, 0xa2, 0x7b, 0xa4, 0x3d, 0xa5, 0xda, 0xaa, 0x2a, 0xfd,
+	0x2f, 0xf0, 0x82, 0xb8, 0xfa, 0xf3, 0x9e, 0xe2, 0x7f, 0x0a, 0x30, 0xb3, 0x83, 0x2d, 0x6c, 0x1b,
+	0x6d, 0x15, 0x3b, 0x5d, 0x62, 0x39, 0x18, 0xdd, 0x82, 0xb2, 0x8d, 0x9d, 0x9e, 0xe9, 0xcf, 0x6e,
+	0x6a, 0xfd, 0x6a, 0x30, 0xd6, 0x58, 0xbf, 0x35, 0x95, 0x76, 0xba, 0xf7, 0x8a, 0x1a, 0x74, 0x47,
+	0xdf, 0x84, 0x12, 0xb6, 0x6d, 0x62, 0x07, 0x55, 0xda, 0xcb, 0x19, 0xf7, 0x6d, 0x7b, 0x7d, 0xee,
+	0xbd, 0xa2, 0xfa, 0x9d, 0x1b, 0x4d, 0x28, 0xfb, 0x9a, 0xbc, 0x39, 0x76, 0xb0, 0xe3, 0x68, 0x8f,
+	0x71, 0x30, 0xf8, 0xb0, 0xd9, 0xb8, 0x0d, 0x25, 0x7a, 0x97, 0x87, 0x6e, 0x9b, 0xe8, 0xe1, 0x75,
+	0xfa, 0x3f, 0xbe, 0x55, 0x95, 0x12, 0x5b, 0xd5, 0x8d, 0x0a, 0x94, 0xbc, 0x37, 0xb1, 0xa7, 0xcd,
+	0x77, 0xa0, 0xbe, 0xd7, 0x33, 0x4d, 0xc1, 0xc7, 0x08, 0x0c, 0xce, 0x12, 0x87, 0x73, 0x73, 0x03,
+	0x96, 0xfa, 0xf7, 0x0e, 0x0a, 0xd0, 0x19, 0x3a, 0xbe, 0x0a, 0xd3, 0xde, 0x1b, 0xe3, 0x3d, 0x42,
+	0x4c, 0xbf, 0xcc, 0x9d, 0xe9, 0x5a, 0xe0, 0xbb, 0xde, 0x84, 0xf9, 0x1d, 0xec, 0x6e, 0x6a, 0x5d,
+	0x7f, 0x1f, 0x6d, 0xe0, 0x01, 0x37, 0xac, 0xff, 0x6e, 0x1a, 0x60, 0x93, 0x58, 0xae, 0x4d, 0x4c,
+	0x13, 0xdb, 0xe8, 0x0e, 0x5c, 0x62, 0xb7, 0x36, 0xe8, 0x72, 0xc6, 0x77, 0x49, 0x8d, 0xa5, 0x74,
+	0x33, 0x35, 0x5f, 0xf1, 0x54, 0xb0, 0xdb, 0x83, 0x48, 0x45, 0xfc, 0xbb, 0x14, 0xb1, 0x0a, 0xf6,
+	0xe3, 0x86, 0x48, 0x45, 0xfc, 0x8b, 0x07, 0x81, 0x8a, 0x1d, 0x98, 0x89, 0x15, 0xe4, 0xa3, 0x46,
+	0x76, 0xa1, 0xbe, 0x40, 0xd1, 0x3e, 0x2c, 0xa4, 0x6d, 0xf6, 0xd0, 0xa0, 0x9d, 0xa0, 0x58, 0x65,
+	0xda, 0x06, 0x0a, 0x0d, 0xda, 0x5d, 0x89, 0x55, 0xa6, 0x55, 0x25, 0x47, 0x2a, 0xb3, 0x4a, 0x96,
+	0x05, 0x2a, 0xdf, 0x87, 0xa5, 0xf4, 0x42, 0x5a, 0xf4, 0xda, 0xc0, 0x3a, 0x5b, 0xb1, 0xda, 0xf4,
+	0x0a, 0xcc, 0x48, 0x6d, 0x76, 0x81, 0xa6, 0x40, 0xed, 0x0f, 0xc3, 0xef, 0x16, 0x92, 0xa5, 0x67,
+	0xe8, 0xf5, 0x1c, 0x95, 0x82, 0x62, 0xd5, 0x59, 0x55, 0x6d, 0x91, 0x6a, 0x51, 0xd9, 0x9b, 0x40,
+	0xf5, 0x7d, 0x98, 0x4b, 0xd4, 0x9b, 0xa0, 0x65, 0x51, 0x25, 0x8a, 0x58, 0x59, 0xe2, 0x95, 0x70,
+	0xa4, 0x2c, 0xf5, 0x65, 0xb1, 0x58, 0x59, 0xe2, 0xf5, 0x52, 0xa4, 0x2c, 0xf5, 0xc5, 0x93, 0x40,
+	0xd9, 0x03, 0x40, 0xc9, 0x3c, 0x35, 0xba, 0x2a, 0x4c, 0x61, 0x0b, 0xd4, 0xed, 0xc2, 0x7c, 0x4a,
+	0x32, 0x0a, 0x5d, 0x13, 0x27, 0xaa, 0xf2, 0x98, 0x81, 0x39, 0x4c, 0xc5, 0xcc, 0x10, 0x3b, 0x03,
+	0x8b, 0x95, 0x25, 0x52, 0x03, 0x91, 0xb2, 0xd4, 0xa4, 0x41, 0x1e, 0x9b, 0xa6, 0x29, 0x4b, 0x3d,
+	0x9d, 0x0b, 0x94, 0x7d, 0x08, 0x57, 0x32, 0x8f, 0xcc, 0xe8, 0x7a, 0xd6, 0x74, 0x73, 0xd2, 0xc5,
+	0x87, 0x70, 0x25, 0xf3, 0x40, 0x1a, 0x29, 0x17, 0x1e, 0x59, 0xb3, 0x95, 0xaf, 0xff, 0x7e, 0x1a,
+	0xa6, 0xf7, 0x6c, 0x72, 0x6c, 0x38, 0xde, 0x0e, 0x90, 0xb4, 0x9f, 0x4c, 0x02, 0xd5, 0x24, 0x50,
+	0x4d, 0x02, 0xd5, 0x24, 0x50, 0x4d, 0x02, 0xd5, 0x24, 0x50, 0x5d, 0xa4, 0x40, 0xf5, 0x99, 0x04,
+	0xe0, 0x2f, 0xaa, 0x30, 0x4a, 0xb1, 0xb9, 0xc2, 0x28, 0x3e, 0xc4, 0x13, 0x88, 0x83, 0xa2, 0x54,
+	0x8a, 0x8a, 0x2d, 0x3c, 0x8c, 0x0a, 0x36, 0x6f, 0xc4, 0x44, 0x29, 0x3e, 0x99, 0x24, 0x50, 0xb1,
+	0x0d, 0x75, 0x3e, 0xb3, 0x82, 0xae, 0x64, 0x26, 0x5c, 0xc4, 0x23, 0x61, 0x73, 0x17, 0xd1, 0x48,
+	0xe2, 0x09, 0x0d, 0x01, 0xc2, 0x7f, 0xad, 0x01, 0xf2, 0x3b, 0xfa, 0x2f, 0xee, 0xf6, 0xcc, 0xde,
+	0x63, 0xc3, 0x3a, 0x8b, 0xfd, 0xc0, 0x6d, 0x80, 0xfe, 0x51, 0x1d, 0x2d, 0x06, 0xfd, 0xf8, 0x93,
+	0xff, 0x85, 0xdf, 0x4e, 0x1c, 0xc0, 0x42, 0x8b, 0x7e, 0x13, 0x62, 0x1a, 0xcf, 0xf0, 0x66, 0x94,
+	0x35, 0x1b, 0x2d, 0x14, 0xaa, 0x30, 0xff, 0x10, 0xdb, 0x1d, 0xc3, 0xd2, 0xdc, 0x34, 0x9d, 0xcf,
+	0x15, 0x07, 0xef, 0x43, 0x9d, 0x0f, 0x73, 0xa3, 0x6c, 0x54, 0x76, 0xe0, 0x92, 0x67, 0xa7, 0x48,
+	0xd5, 0xd5, 0x84, 0xf1, 0x72, 0x2a, 0xba, 0x0f, 0x75, 0x3e, 0x42, 0x8e, 0xb2, 0xd7, 0xf9, 0x09,
+	0x2c, 0xf7, 0x6d, 0x11, 0xde, 0xc3, 0xe0, 0x37, 0x62, 0xb8, 0xff, 0x31, 0xbc, 0x1a, 0x59, 0x45,
+	0xa0, 0x7d, 0xd4, 0x88, 0xff, 0xff, 0x1d, 0x6a, 0xde, 0x85, 0x5a, 0x94, 0x5b, 0x43, 0x0b, 0x41,
+	0x37, 0x2e, 0xdb, 0x26, 0xde, 0xbb, 0xc7, 0xb2, 0x6d, 0xd1, 0xde, 0x3d, 0x25, 0x0b, 0x27, 0x20,
+	0xb5, 0xdf, 0xca, 0x70, 0x39, 0x51, 0x92, 0x10, 0x30, 0xdb, 0x64, 0xeb, 0x75, 0x11, 0xb6, 0x5e,
+	0x8f, 0xca, 0xf4, 0xc2, 0x37, 0xfe, 0x37, 0x00, 0x37, 0x31, 0x37, 0x6a, 0x67, 0x4b, 0x00, 0x00,
 }
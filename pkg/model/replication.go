@@ -23,6 +23,7 @@ import "github.com/opensds/opensds/pkg/model/proto"
 const (
 	ReplicationModeSync         = "sync"
 	ReplicationModeAsync        = "async"
+	ReplicationModeActive       = "active"
 	ReplicationDefaultBackendId = "default"
 	ReplicationDefaultPeriod    = 60
 )
@@ -67,7 +68,7 @@ type ReplicationSpec struct {
 	SecondaryReplicationDriverData map[string]string `json:"secondaryReplicationDriverData,omitempty"`
 	// replication status
 	ReplicationStatus string `json:"replicationStatus,omitempty"`
-	// supports "async" or "sync" now
+	// supports "async", "sync" or "active" now
 	ReplicationMode string `json:"replicationMode,omitempty"`
 	// 0 means sync replication.
 	ReplicationPeriod int64 `json:"replicationPeriod,omitempty"`
@@ -43,14 +43,26 @@ const (
 	VolumeSnapDeleting      = "deleting"
 	VolumeSnapError         = "error"
 	VolumeSnapErrorDeleting = "errorDeleting"
+	// The backup of snapshot in the cloud is being checked, which fails
+	// the check if it's corrupted.
+	VolumeSnapVerifying      = "verifying"
+	VolumeSnapErrorVerifying = "errorVerifying"
 )
 
 // volume attachment status
 const (
-	VolumeAttachCreating      = "creating"
-	VolumeAttachAvailable     = "available"
-	VolumeAttachErrorDeleting = "errorDeleting"
-	VolumeAttachError         = "error"
+	VolumeAttachCreating        = "creating"
+	VolumeAttachAvailable       = "available"
+	VolumeAttachErrorDeleting   = "errorDeleting"
+	VolumeAttachErrorExpanding  = "errorExpanding"
+	VolumeAttachErrorThrottling = "errorThrottling"
+	VolumeAttachError           = "error"
+)
+
+// volume attachment mount status
+const (
+	VolumeAttachMounted   = "mounted"
+	VolumeAttachUnmounted = "unmounted"
 )
 
 //volume replication status
@@ -84,3 +96,15 @@ const (
 	VolumeGroupUpdating      = "updating"
 	VolumeGroupInUse         = "inUse"
 )
+
+// dock status
+const (
+	DockAvailable   = "available"
+	DockUnavailable = "unavailable"
+)
+
+// pool status
+const (
+	PoolAvailable   = "available"
+	PoolUnavailable = "unavailable"
+)
@@ -79,6 +79,12 @@ type VolumeSpec struct {
 
 	// The uuid of the replication which the volume belongs to.
 	ReplicationDriverData map[string]string `json:"replicationDriverData,omitempty"`
+
+	// The limits of I/O of the volume, which are taken from the IO
+	// connectivity of its profile when created.
+	// +readOnly
+	Qos *QosSpec `json:"qos,omitempty"`
+
 	// Attach status of the volume.
 	AttachStatus string
 }
@@ -97,9 +103,31 @@ type VolumeAttachmentSpec struct {
 	// The uuid of the volume which the attachment belongs to.
 	VolumeId string `json:"volumeId,omitempty"`
 
-	// The locaility when the volume was attached to a host.
+	// The locaility when the volume was attached to a host. If it is given
+	// when creating the attachment, the volume is mounted on it by the
+	// attacher dock of the host.
 	Mountpoint string `json:"mountpoint,omitempty"`
 
+	// The file system type which the volume is formatted with before mounted
+	// if it has no file system, "ext4" by default.
+	// +optional
+	FsType string `json:"fsType,omitempty"`
+
+	// The options which the volume is mounted with.
+	// +optional
+	MountOptions []string `json:"mountOptions,omitempty"`
+
+	// The mount state of the volume on the host.
+	// One of: "mounted", "unmounted", or empty if the volume is not required
+	// to be mounted.
+	MountStatus string `json:"mountStatus,omitempty"`
+
+	// The transport of NVMe-oF through which the volume is attached, one of
+	// "tcp", "rdma" and "loop". The default transport of the backend is used
+	// if it is not specified.
+	// +optional
+	Transport string `json:"transport,omitempty"`
+
 	// The status of the attachment.
 	// One of: "attaching", "attached", "error", etc.
 	Status string `json:"status,omitempty"`
@@ -117,6 +145,11 @@ type VolumeAttachmentSpec struct {
 
 	// The protocol
 	AccessProtocol string `json:"accessProtocol,omitempty"`
+
+	// Whether the volume is attached through all the paths with multipath
+	// I/O, so that losing one path doesn't take the volume down.
+	// +optional
+	MultiPath bool `json:"multiPath,omitempty"`
 }
 
 // HostInfo is a structure for all properties of host when create a volume
@@ -174,17 +207,77 @@ type VolumeSnapshotSpec struct {
 	// The uuid of the volume which the snapshot belongs to.
 	VolumeId string `json:"volumeId,omitempty"`
 
+	// The uuid of the volume group snapshot which the snapshot is taken
+	// along with, such snapshot can only be deleted with the group snapshot.
+	// +readOnly
+	GroupSnapshotId string `json:"groupSnapshotId,omitempty"`
+
 	// Metadata should be kept until the scemantics between opensds volume
 	// snapshot and backend storage resouce snapshot description are clear.
 	// +optional
 	Metadata map[string]string `json:"metadata,omitempty"`
 }
 
+// SnapshotAttachmentSpec is a description of snapshot attached resource,
+// through which the snapshot is exposed read-only to a host for backup or
+// inspection.
+type SnapshotAttachmentSpec struct {
+	*BaseModel
+
+	// The uuid of the project that the snapshot belongs to.
+	TenantId string `json:"tenantId,omitempty"`
+
+	// The uuid of the user that the snapshot belongs to.
+	// +optional
+	UserId string `json:"userId,omitempty"`
+
+	// The uuid of the snapshot which the attachment belongs to.
+	SnapshotId string `json:"snapshotId,omitempty"`
+
+	// The status of the attachment.
+	// One of: "creating", "available", "error", etc.
+	Status string `json:"status,omitempty"`
+
+	// Metadata should be kept until the scemantics between opensds snapshot
+	// attachment and backend attached storage resouce description are clear.
+	// +optional
+	Metadata map[string]string `json:"metadata,omitempty"`
+
+	// See details in `HostInfo`
+	HostInfo `json:"hostInfo,omitempty"`
+
+	// See details in `ConnectionInfo`
+	ConnectionInfo `json:"connectionInfo,omitempty"`
+
+	// The protocol
+	AccessProtocol string `json:"accessProtocol,omitempty"`
+}
+
 // ExtendVolumeSpec ...
 type ExtendVolumeSpec struct {
 	NewSize int64 `json:"newSize,omitempty"`
 }
 
+// QosSpec describes the limits of I/O of a volume, which are enforced by the
+// storage backend or by the hosts the volume is attached to.
+type QosSpec struct {
+	// MaxIOPS is the maximum IOs per second of the volume, zero means no
+	// limit.
+	// +units:[IO]/s
+	MaxIOPS int64 `json:"maxIOPS,omitempty"`
+
+	// MaxBWS is the maximum bandwidth of the volume, zero means no limit.
+	// +units:[MB]/s
+	MaxBWS int64 `json:"maxBWS,omitempty"`
+}
+
+func (q QosSpec) IsEmpty() bool {
+	if (QosSpec{}) == q {
+		return true
+	}
+	return false
+}
+
 type VolumeGroupSpec struct {
 	*BaseModel
 	// The name of the volume group.
@@ -220,5 +313,29 @@ type VolumeGroupSpec struct {
 	// +readOnly
 	PoolId string `json:"poolId,omitempty"`
 
+	// The uuids of the snapshots taken of the whole volume group.
+	// +readOnly
 	GroupSnapshots []string `json:"groupSnapshots,omitempty"`
 }
+
+// VolumeGroupSnapshotSpec is a description of the snapshot of all the volumes
+// in a volume group, which is taken at the same point in time and made up of
+// a volume snapshot for each of them.
+type VolumeGroupSnapshotSpec struct {
+	*BaseModel
+
+	// The uuid of the volume group which the snapshot belongs to.
+	GroupId string `json:"groupId,omitempty"`
+
+	// The name of the volume group snapshot, which is given to the snapshots
+	// of the volumes as well.
+	Name string `json:"name,omitempty"`
+
+	// The description of the volume group snapshot.
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// The snapshots of the volumes in the group.
+	// +readOnly
+	Snapshots []*VolumeSnapshotSpec `json:"snapshots,omitempty"`
+}
@@ -27,6 +27,7 @@ import (
 
 	"github.com/go-ini/ini"
 	"github.com/opensds/opensds/pkg/utils/constants"
+	"github.com/satori/go.uuid"
 )
 
 const (
@@ -158,6 +159,9 @@ func parseItems(section string, v reflect.Value, cfg *ini.File) error {
 
 		field := v.Field(i)
 		tag := v.Type().Field(i).Tag.Get("conf")
+		if "-" == tag {
+			continue
+		}
 		if "" == tag {
 			parseSections(cfg, field.Type(), field)
 		}
@@ -250,6 +254,39 @@ func initConf(confFile string, conf interface{}) {
 	if err := parseSections(cfg, t, v); err != nil {
 		log.Fatalf("[ERROR] parse configure file failed: %v", err)
 	}
+	if c, ok := conf.(*Config); ok {
+		c.Backends = parseBackends(cfg, c.EnabledBackends)
+	}
+}
+
+// parseBackends loads the backend sections named in enabled_backends. Both
+// the name and the driver name of backend default to its section name, so a
+// section such as [lvm] works without them.
+func parseBackends(cfg *ini.File, enabledBackends []string) map[string]BackendProperties {
+	backends := map[string]BackendProperties{}
+	if cfg == nil {
+		return backends
+	}
+
+	for _, name := range enabledBackends {
+		if _, ok := backends[name]; ok {
+			log.Printf("[ERROR] backend %s is enabled more than once", name)
+			continue
+		}
+		var b BackendProperties
+		if err := parseItems(name, reflect.ValueOf(&b).Elem(), cfg); err != nil {
+			log.Printf("[ERROR] parse backend %s failed: %v", name, err)
+			continue
+		}
+		if b.Name == "" {
+			b.Name = name
+		}
+		if b.DriverName == "" {
+			b.DriverName = name
+		}
+		backends[name] = b
+	}
+	return backends
 }
 
 // Global Configuration Variable
@@ -282,14 +319,54 @@ func (c *Config) Load() {
 }
 
 func GetBackendsMap() map[string]BackendProperties {
-	backendsMap := map[string]BackendProperties{}
-	v := reflect.ValueOf(CONF.Backends)
-	t := reflect.TypeOf(CONF.Backends)
+	return CONF.Backends
+}
 
-	for i := 0; i < t.NumField(); i++ {
-		feild := v.Field(i)
-		name := t.Field(i).Tag.Get("conf")
-		backendsMap[name] = feild.Interface().(BackendProperties)
+// GetBackend returns the enabled backend whose section name is name.
+func GetBackend(name string) (BackendProperties, bool) {
+	b, ok := CONF.Backends[name]
+	return b, ok
+}
+
+// IsDefaultBackend tells whether the backend is the default one of its
+// driver, which is the backend named after the driver, or the first enabled
+// backend of the driver if there isn't such one. A backend which is not
+// enabled is treated as the default one.
+func IsDefaultBackend(name string) bool {
+	b, ok := CONF.Backends[name]
+	if !ok || name == b.DriverName {
+		return true
+	}
+	if _, ok := CONF.Backends[b.DriverName]; ok {
+		return false
+	}
+	for _, n := range CONF.EnabledBackends {
+		if CONF.Backends[n].DriverName == b.DriverName {
+			return n == name
+		}
+	}
+	return true
+}
+
+// DockIdOf returns the id of the provisioner dock which serves the backend on
+// the host. The docks of default backends keep the ids which are keyed by the
+// driver name, as they were before several backends of one driver are
+// supported, so that the existing resources still refer to them.
+func DockIdOf(host, backendName string) string {
+	key := backendName
+	if b, ok := CONF.Backends[backendName]; ok && IsDefaultBackend(backendName) {
+		key = b.DriverName
+	}
+	return uuid.NewV5(uuid.NamespaceOID, host+":"+key).String()
+}
+
+// PoolIdOf returns the id of the pool which the driver of backend reports as
+// poolId. Pools of different backends may have the same id reported even if
+// they use the same driver, so the pool is keyed by the backend name as well
+// unless the backend is the default one of its driver.
+func PoolIdOf(backendName, poolId string) string {
+	if IsDefaultBackend(backendName) {
+		return poolId
 	}
-	return backendsMap
+	return uuid.NewV5(uuid.NamespaceOID, backendName+":"+poolId).String()
 }
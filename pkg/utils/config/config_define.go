@@ -30,9 +30,11 @@ type OsdsApiServer struct {
 }
 
 type OsdsLet struct {
-	ApiEndpoint       string        `conf:"api_endpoint,localhost:50049"`
-	Daemon            bool          `conf:"daemon,false"`
-	LogFlushFrequency time.Duration `conf:"log_flush_frequency,5s"` // Default value is 5s
+	ApiEndpoint            string        `conf:"api_endpoint,localhost:50049"`
+	Daemon                 bool          `conf:"daemon,false"`
+	LogFlushFrequency      time.Duration `conf:"log_flush_frequency,5s"`       // Default value is 5s
+	BackupScheduleInterval time.Duration `conf:"backup_schedule_interval,60s"` // Default value is 60s
+	DockHeartbeatTimeout   time.Duration `conf:"dock_heartbeat_timeout,120s"`  // Default value is 120s
 }
 
 type OsdsDock struct {
@@ -43,7 +45,11 @@ type OsdsDock struct {
 	BindIp                     string        `conf:"bind_ip"` // Just used for attacher dock
 	HostBasedReplicationDriver string        `conf:"host_based_replication_driver,drbd"`
 	LogFlushFrequency          time.Duration `conf:"log_flush_frequency,5s"` // Default value is 5s
-	Backends
+	HeartbeatInterval          time.Duration `conf:"heartbeat_interval,30s"` // Default value is 30s
+	// Backends are loaded from the sections named in enabled_backends and
+	// keyed by the section name, several backends may use the same driver
+	// with different driver configuration files.
+	Backends map[string]BackendProperties `conf:"-"`
 }
 
 type Database struct {
@@ -53,20 +59,19 @@ type Database struct {
 }
 
 type BackendProperties struct {
+	// Name is the name of dock which serves the backend, it's the section
+	// name of backend by default.
 	Name               string `conf:"name"`
 	Description        string `conf:"description"`
 	DriverName         string `conf:"driver_name"`
 	ConfigPath         string `conf:"config_path"`
 	SupportReplication bool   `conf:"support_replication,false"`
-}
-
-type Backends struct {
-	Ceph                BackendProperties `conf:"ceph"`
-	Cinder              BackendProperties `conf:"cinder"`
-	Sample              BackendProperties `conf:"sample"`
-	LVM                 BackendProperties `conf:"lvm"`
-	HuaweiDorado        BackendProperties `conf:"huawei_dorado"`
-	HuaweiFusionStorage BackendProperties `conf:"huawei_fusionstorage"`
+	// PluginEndpoint is the gRPC endpoint of an out-of-tree driver plugin,
+	// such as unix:///var/run/opensds/sample.sock or 127.0.0.1:50060.
+	PluginEndpoint string `conf:"plugin_endpoint"`
+	// PluginCommand is the command which osdsdock runs to launch the driver
+	// plugin, leave it empty if the plugin is managed by other means.
+	PluginCommand string `conf:"plugin_command"`
 }
 
 type KeystoneAuthToken struct {
@@ -87,6 +92,27 @@ type KeystoneAuthToken struct {
 	AuthType        string `conf:"auth_type"`
 }
 
+// OidcAuthToken configures the oidc auth strategy, which accepts the JWTs
+// issued by an OpenID Connect provider as bearer tokens.
+type OidcAuthToken struct {
+	// Issuer is the iss claim which the tokens must carry.
+	Issuer string `conf:"issuer"`
+	// Audience is the aud claim which the tokens must contain, it's not
+	// checked if empty.
+	Audience string `conf:"audience"`
+	// JwksUri is the file path or the http(s) url of the JSON web key set
+	// which the tokens are signed with.
+	JwksUri string `conf:"jwks_uri"`
+	// The claims which are mapped to the tenant id, user id and roles of the
+	// request context, a nested claim is named like realm_access.roles.
+	TenantIdClaim string `conf:"tenant_id_claim,tenant_id"`
+	UserIdClaim   string `conf:"user_id_claim,sub"`
+	RolesClaim    string `conf:"roles_claim,roles"`
+	// AdminTenantId is the tenant whose users having the admin role are
+	// administrators of the whole cloud.
+	AdminTenantId string `conf:"admin_tenant_id,e93b4c0934da416eb9c8d120c5d04d96"`
+}
+
 type Config struct {
 	Default           `conf:"default"`
 	OsdsApiServer     `conf:"osdsapiserver"`
@@ -94,4 +120,5 @@ type Config struct {
 	OsdsDock          `conf:"osdsdock"`
 	Database          `conf:"database"`
 	KeystoneAuthToken `conf:"keystone_authtoken"`
+	OidcAuthToken     `conf:"oidc_authtoken"`
 }
@@ -18,6 +18,8 @@ import (
 	"reflect"
 	"testing"
 	"time"
+
+	"github.com/satori/go.uuid"
 )
 
 type TestStruct struct {
@@ -302,55 +304,52 @@ func TestOpensdsConfig(t *testing.T) {
 	if CONF.Database.Driver != "etcd" {
 		t.Error("Test Database.Driver error")
 	}
-	if CONF.Backends.Ceph.Name != "ceph" {
-		t.Error("Test Ceph.Backends.Name error")
-	}
-	if CONF.Ceph.Name != "ceph" {
+	if CONF.Backends["ceph"].Name != "ceph" {
 		t.Error("Test Ceph.Name error")
 	}
-	if CONF.Ceph.Description != "Ceph Test" {
+	if CONF.Backends["ceph"].Description != "Ceph Test" {
 		t.Error("Test Ceph.Description error")
 	}
-	if CONF.Ceph.DriverName != "ceph" {
+	if CONF.Backends["ceph"].DriverName != "ceph" {
 		t.Error("Test Ceph.DriverName error")
 	}
-	if CONF.Ceph.ConfigPath != "/etc/opensds/driver/ceph.yaml" {
+	if CONF.Backends["ceph"].ConfigPath != "/etc/opensds/driver/ceph.yaml" {
 		t.Error("Test Ceph.ConfigPath error")
 	}
-	if CONF.Cinder.Name != "cinder" {
+	if CONF.Backends["cinder"].Name != "cinder" {
 		t.Error("Test Cinder.Name error")
 	}
-	if CONF.Cinder.Description != "Cinder Test" {
+	if CONF.Backends["cinder"].Description != "Cinder Test" {
 		t.Error("Test Cinder.Description error")
 	}
-	if CONF.Cinder.DriverName != "cinder" {
+	if CONF.Backends["cinder"].DriverName != "cinder" {
 		t.Error("Test Cinder.DriverName error")
 	}
-	if CONF.Cinder.ConfigPath != "/etc/opensds/driver/cinder.yaml" {
+	if CONF.Backends["cinder"].ConfigPath != "/etc/opensds/driver/cinder.yaml" {
 		t.Error("Test Cinder.ConfigPath error")
 	}
-	if CONF.Sample.Name != "sample" {
+	if CONF.Backends["sample"].Name != "sample" {
 		t.Error("Test Sample.Name error")
 	}
-	if CONF.Sample.Description != "Sample Test" {
+	if CONF.Backends["sample"].Description != "Sample Test" {
 		t.Error("Test Sample.Description error")
 	}
-	if CONF.Sample.DriverName != "sample" {
+	if CONF.Backends["sample"].DriverName != "sample" {
 		t.Error("Test Sample.DriverName error")
 	}
-	if CONF.Sample.ConfigPath != "/etc/opensds/driver/sample.yaml" {
+	if CONF.Backends["sample"].ConfigPath != "/etc/opensds/driver/sample.yaml" {
 		t.Error("Test Sample.ConfigPath error")
 	}
-	if CONF.LVM.Name != "lvm" {
+	if CONF.Backends["lvm"].Name != "lvm" {
 		t.Error("Test LVM.Name error")
 	}
-	if CONF.LVM.Description != "LVM Test" {
+	if CONF.Backends["lvm"].Description != "LVM Test" {
 		t.Error("Test Sample.Description error")
 	}
-	if CONF.LVM.DriverName != "lvm" {
+	if CONF.Backends["lvm"].DriverName != "lvm" {
 		t.Error("Test LVM.DriverName error")
 	}
-	if CONF.LVM.ConfigPath != "/etc/opensds/driver/lvm.yaml" {
+	if CONF.Backends["lvm"].ConfigPath != "/etc/opensds/driver/lvm.yaml" {
 		t.Error("Test LVM.ConfigPath error")
 	}
 	bm := GetBackendsMap()
@@ -366,4 +365,69 @@ func TestOpensdsConfig(t *testing.T) {
 	if _, ok := bm["lvm"]; !ok {
 		t.Error("Test bm[\"lvm\"].Name error")
 	}
+	if len(bm) != 6 {
+		t.Errorf("Test length of backends map error, expected 6, got %d", len(bm))
+	}
+	lvmSsd, ok := GetBackend("lvm_ssd")
+	if !ok {
+		t.Fatal("Test GetBackend error")
+	}
+	if lvmSsd.Name != "lvm_ssd" {
+		t.Error("Test lvmSsd.Name error")
+	}
+	if lvmSsd.DriverName != "lvm" {
+		t.Error("Test lvmSsd.DriverName error")
+	}
+	if lvmSsd.ConfigPath != "/etc/opensds/driver/lvm_ssd.yaml" {
+		t.Error("Test lvmSsd.ConfigPath error")
+	}
+	vendor, ok := GetBackend("vendor")
+	if !ok {
+		t.Fatal("Test GetBackend error")
+	}
+	if vendor.Name != "vendor" {
+		t.Error("Test vendor.Name error")
+	}
+	if vendor.DriverName != "vendor_driver" {
+		t.Error("Test vendor.DriverName error")
+	}
+	if vendor.PluginEndpoint != "unix:///var/run/opensds/vendor.sock" {
+		t.Error("Test vendor.PluginEndpoint error")
+	}
+	if vendor.PluginCommand != "/usr/local/bin/vendor-plugin" {
+		t.Error("Test vendor.PluginCommand error")
+	}
+	if _, ok := GetBackend("huawei_dorado"); ok {
+		t.Error("Test GetBackend with disabled backend error")
+	}
+}
+
+func TestBackendIds(t *testing.T) {
+	initConf("testdata/opensds.conf", CONF)
+
+	for name, expected := range map[string]bool{
+		"lvm":      true,
+		"lvm_ssd":  false,
+		"vendor":   true,
+		"ceph":     true,
+		"disabled": true,
+	} {
+		if IsDefaultBackend(name) != expected {
+			t.Errorf("Expected backend %s to be default: %v", name, expected)
+		}
+	}
+
+	// The default backend keeps the ids keyed by the driver name.
+	if DockIdOf("host", "lvm") == DockIdOf("host", "lvm_ssd") {
+		t.Error("Expected docks of different backends to have different ids")
+	}
+	if id := uuid.NewV5(uuid.NamespaceOID, "host:vendor_driver").String(); DockIdOf("host", "vendor") != id {
+		t.Errorf("Expected dock id %s of default backend, got %s", id, DockIdOf("host", "vendor"))
+	}
+	if PoolIdOf("lvm", "pool001") != "pool001" {
+		t.Errorf("Expected pool id of default backend unchanged, got %s", PoolIdOf("lvm", "pool001"))
+	}
+	if PoolIdOf("lvm_ssd", "pool001") == "pool001" {
+		t.Error("Expected pool id of other backend keyed by the backend name")
+	}
 }
@@ -28,6 +28,11 @@ const (
 	AuthTokenHeader    = "X-Auth-Token"
 	SubjectTokenHeader = "X-Subject-Token"
 
+	// The bearer token of oidc auth strategy is carried by the authorization
+	// header like "Authorization: Bearer <token>".
+	AuthorizationHeader = "Authorization"
+	BearerPrefix        = "Bearer "
+
 	// OpenSDS current api version
 	APIVersion = "v1beta"
 
@@ -45,8 +45,10 @@ func Run(name string, arg ...string) (string, error) {
 	return string(info), nil
 }
 
+// NewBaseExecuter returns the executer which runs commands as the current
+// user, the commands are recorded if the recording mode is turned on.
 func NewBaseExecuter() Executer {
-	return &BaseExecuter{}
+	return Recordable(&BaseExecuter{})
 }
 
 type BaseExecuter struct{}
@@ -55,8 +57,10 @@ func (r *BaseExecuter) Run(name string, arg ...string) (string, error) {
 	return Run(name, arg...)
 }
 
+// NewRootExecuter returns the executer which runs commands as root, the
+// commands are recorded if the recording mode is turned on.
 func NewRootExecuter() Executer {
-	return &RootExeucter{}
+	return Recordable(&RootExeucter{})
 }
 
 type RootExeucter struct{}
@@ -0,0 +1,176 @@
+// Copyright (c) 2018 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+//    Licensed under the Apache License, Version 2.0 (the "License"); you may
+//    not use this file except in compliance with the License. You may obtain
+//    a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//    WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//    License for the specific language governing permissions and limitations
+//    under the License.
+
+package exec
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"sync"
+
+	log "github.com/golang/glog"
+)
+
+// RecordEnv is the environment variable which holds the path of file that the
+// commands run by executers are recorded into. Nothing is recorded if it's
+// not set.
+const RecordEnv = "OPENSDS_EXEC_RECORD"
+
+// Record is a command run by executer and its response.
+type Record struct {
+	Name   string   `json:"name"`
+	Args   []string `json:"args"`
+	Output string   `json:"output"`
+	Error  string   `json:"error,omitempty"`
+}
+
+// Recordable wraps the executer with a RecordExecuter if the recording mode
+// is turned on by RecordEnv, otherwise the executer is returned as it is.
+func Recordable(e Executer) Executer {
+	if path := os.Getenv(RecordEnv); path != "" {
+		return NewRecordExecuter(e, path)
+	}
+	return e
+}
+
+func NewRecordExecuter(e Executer, path string) Executer {
+	return &RecordExecuter{Executer: e, Path: path}
+}
+
+// RecordExecuter runs the commands by the executer it wraps and appends each
+// of them with its response to the file of path, one record of json per
+// line, so that they can be replayed by ReplayExecuter.
+type RecordExecuter struct {
+	Executer
+	Path string
+
+	mutex sync.Mutex
+}
+
+func (r *RecordExecuter) Run(name string, arg ...string) (string, error) {
+	out, err := r.Executer.Run(name, arg...)
+	rec := Record{Name: name, Args: arg, Output: out}
+	if err != nil {
+		rec.Error = err.Error()
+	}
+	if e := r.append(&rec); e != nil {
+		log.Warningf("Record command %s failed: %v", name, e)
+	}
+	return out, err
+}
+
+func (r *RecordExecuter) append(rec *Record) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	f, err := os.OpenFile(r.Path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return json.NewEncoder(f).Encode(rec)
+}
+
+// LoadRecords reads the records from the file written by RecordExecuter.
+func LoadRecords(path string) ([]Record, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var recs []Record
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, 1<<24)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		var rec Record
+		if err := json.Unmarshal([]byte(line), &rec); err != nil {
+			return nil, fmt.Errorf("invalid record %q in %s: %v", line, path, err)
+		}
+		recs = append(recs, rec)
+	}
+	return recs, scanner.Err()
+}
+
+func NewReplayExecuter(recs ...Record) *ReplayExecuter {
+	return &ReplayExecuter{records: recs, played: make([]bool, len(recs))}
+}
+
+// NewReplayExecuterFromFile returns the ReplayExecuter of the records in the
+// file written by RecordExecuter.
+func NewReplayExecuterFromFile(path string) (*ReplayExecuter, error) {
+	recs, err := LoadRecords(path)
+	if err != nil {
+		return nil, err
+	}
+	return NewReplayExecuter(recs...), nil
+}
+
+// ReplayExecuter responds to the commands with the records instead of running
+// them. Each record is replayed once, and the records of the same command are
+// replayed in order, so a command which is run repeatedly can get different
+// responses.
+type ReplayExecuter struct {
+	mutex   sync.Mutex
+	records []Record
+	played  []bool
+}
+
+func (r *ReplayExecuter) Run(name string, arg ...string) (string, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for i, rec := range r.records {
+		if r.played[i] || rec.Name != name || !sameArgs(rec.Args, arg) {
+			continue
+		}
+		r.played[i] = true
+		if rec.Error != "" {
+			return rec.Output, errors.New(rec.Error)
+		}
+		return rec.Output, nil
+	}
+	return "", fmt.Errorf("no recorded response for command: %s %s", name, strings.Join(arg, " "))
+}
+
+// Unplayed returns the records which haven't been replayed, which means the
+// commands expected are not run.
+func (r *ReplayExecuter) Unplayed() []Record {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	var recs []Record
+	for i, rec := range r.records {
+		if !r.played[i] {
+			recs = append(recs, rec)
+		}
+	}
+	return recs
+}
+
+func sameArgs(a, b []string) bool {
+	if len(a) == 0 && len(b) == 0 {
+		return true
+	}
+	return reflect.DeepEqual(a, b)
+}
@@ -0,0 +1,99 @@
+// Copyright (c) 2018 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+//    Licensed under the Apache License, Version 2.0 (the "License"); you may
+//    not use this file except in compliance with the License. You may obtain
+//    a copy of the License at
+//
+//         http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+//    WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+//    License for the specific language governing permissions and limitations
+//    under the License.
+
+package exec
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// fakeExecuter responds to every command with its name, and fails the ones
+// named false.
+type fakeExecuter struct{}
+
+func (*fakeExecuter) Run(name string, arg ...string) (string, error) {
+	if name == "false" {
+		return "", errors.New("exit status 1")
+	}
+	return name + "\n", nil
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	dir, err := ioutil.TempDir("", "exec-test-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "commands.record")
+
+	recorder := NewRecordExecuter(&fakeExecuter{}, path)
+	recorder.Run("lvs", "--noheadings", "vg001")
+	recorder.Run("false")
+	recorder.Run("vgs")
+
+	replayer, err := NewReplayExecuterFromFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out, err := replayer.Run("vgs"); out != "vgs\n" || err != nil {
+		t.Errorf("Expected output of vgs, got %q, %v", out, err)
+	}
+	if _, err := replayer.Run("false"); err == nil || err.Error() != "exit status 1" {
+		t.Errorf("Expected recorded error of false, got %v", err)
+	}
+	if _, err := replayer.Run("lvs", "vg001"); err == nil {
+		t.Error("Expected error of command with different arguments, got nil")
+	}
+	if recs := replayer.Unplayed(); len(recs) != 1 || recs[0].Name != "lvs" {
+		t.Errorf("Expected lvs not to be replayed, got %v", recs)
+	}
+	if out, _ := replayer.Run("lvs", "--noheadings", "vg001"); out != "lvs\n" {
+		t.Errorf("Expected output of lvs, got %q", out)
+	}
+	// Each record is replayed only once.
+	if _, err := replayer.Run("vgs"); err == nil {
+		t.Error("Expected error of command replayed twice, got nil")
+	}
+}
+
+func TestReplayInOrder(t *testing.T) {
+	replayer := NewReplayExecuter(
+		Record{Name: "multipathd", Args: []string{"show", "status"}, Error: "exit status 1"},
+		Record{Name: "multipathd", Args: []string{"show", "status"}, Output: "path checker states:\n"},
+	)
+	if _, err := replayer.Run("multipathd", "show", "status"); err == nil {
+		t.Error("Expected error of the first run, got nil")
+	}
+	if out, err := replayer.Run("multipathd", "show", "status"); err != nil || out == "" {
+		t.Errorf("Expected output of the second run, got %q, %v", out, err)
+	}
+}
+
+func TestRecordable(t *testing.T) {
+	oldPath := os.Getenv(RecordEnv)
+	defer os.Setenv(RecordEnv, oldPath)
+
+	os.Setenv(RecordEnv, "")
+	if _, ok := Recordable(&fakeExecuter{}).(*RecordExecuter); ok {
+		t.Error("Expected commands not to be recorded if recording mode is off")
+	}
+	os.Setenv(RecordEnv, "/tmp/commands.record")
+	if _, ok := NewRootExecuter().(*RecordExecuter); !ok {
+		t.Error("Expected commands to be recorded if recording mode is on")
+	}
+}
@@ -54,6 +54,10 @@ func GenerateSnapshotURL(urlType int, tenantId string, in ...string) string {
 	return generateURL("block/snapshots", urlType, tenantId, in...)
 }
 
+func GenerateSnapshotAttachmentURL(urlType int, tenantId string, in ...string) string {
+	return generateURL("block/snapshotAttachments", urlType, tenantId, in...)
+}
+
 func GenerateReplicationURL(urlType int, tenantId string, in ...string) string {
 	return generateURL("block/replications", urlType, tenantId, in...)
 }
@@ -62,6 +66,10 @@ func GenerateVolumeGroupURL(urlType int, tenantId string, in ...string) string {
 	return generateURL("block/volumeGroups", urlType, tenantId, in...)
 }
 
+func GenerateBackupScheduleURL(urlType int, tenantId string, in ...string) string {
+	return generateURL("block/backupSchedules", urlType, tenantId, in...)
+}
+
 func generateURL(resource string, urlType int, tenantId string, in ...string) string {
 	// If project id is not specified, ignore it.
 	if tenantId == "" {
@@ -0,0 +1,81 @@
+#!/bin/bash
+
+# Copyright (c) 2018 Huawei Technologies Co., Ltd. All Rights Reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# This script runs the CSI sanity suite (csi-sanity, which is built from
+# github.com/kubernetes-csi/csi-test) against osdscsi backed by the sample
+# driver. The etcd database should be listening on localhost:2379.
+#
+# The node tests of staging and publishing are skipped, because the volumes
+# of sample driver can't really be attached to the host.
+
+set -o errexit
+
+# Keep track of the script directory
+TOP_DIR=$(cd $(dirname "$0") && pwd)
+
+# OpenSDS Root directory
+OPENSDS_DIR=$(cd $TOP_DIR/../.. && pwd)
+OPENSDS_BIN=${OPENSDS_DIR}/build/out/bin
+OPENSDS_CONF=/etc/opensds/opensds.conf
+
+CSI_DIR=$(mktemp -d /tmp/opensds-csi.XXXXXX)
+CSI_ENDPOINT=unix://${CSI_DIR}/csi.sock
+
+# Config backend info.
+mkdir -p /etc/opensds
+cat > ${OPENSDS_CONF} << OPENSDS_GLOBAL_CONFIG_DOC
+[osdslet]
+api_endpoint = 0.0.0.0:50049
+
+[osdsdock]
+api_endpoint = 0.0.0.0:50050
+# Choose the type of dock resource, only support 'provisioner' and 'attacher'.
+dock_type = provisioner
+# Specify which backends should be enabled, sample,ceph,cinder,lvm and so on.
+enabled_backends = sample
+
+[sample]
+name = sample
+description = Sample backend for testing
+driver_name = default
+
+[database]
+endpoint = localhost:2379,localhost:2380
+driver = etcd
+OPENSDS_GLOBAL_CONFIG_DOC
+
+cleanup() {
+    kill ${PIDS} >/dev/null 2>&1 || :
+    rm -rf ${CSI_DIR}
+}
+trap cleanup EXIT
+
+# The provisioner dock serves the sample driver, the attacher dock registers
+# the node which osdscsi reports in NodeGetInfo.
+${OPENSDS_BIN}/osdsdock &
+PIDS="$!"
+${OPENSDS_BIN}/osdsdock -dock-type attacher -api-endpoint 0.0.0.0:50051 &
+PIDS="${PIDS} $!"
+${OPENSDS_BIN}/osdslet &
+PIDS="${PIDS} $!"
+${OPENSDS_BIN}/osdscsi -mode all -csi-endpoint ${CSI_ENDPOINT} -dock-endpoint 0.0.0.0:50051 &
+PIDS="${PIDS} $!"
+sleep 5
+
+csi-sanity -csi.endpoint ${CSI_ENDPOINT} \
+    -csi.stagingdir ${CSI_DIR}/staging \
+    -csi.mountdir ${CSI_DIR}/mount \
+    -ginkgo.skip "NodeStageVolume|NodePublishVolume|should work"
@@ -74,6 +74,7 @@ var (
 			Description: "sample backend service",
 			Endpoint:    "localhost:50050",
 			DriverName:  "sample",
+			BackendName: "sample",
 			Type:        model.DockTypeProvioner,
 		},
 	}
@@ -103,6 +104,14 @@ var (
 					"diskType": "SSD",
 					"latency":  "3ms",
 				},
+				Capabilities: model.DriverCapabilitiesSpec{
+					VolumeGroup:        true,
+					SnapshotAttachment: true,
+					Clone:              true,
+					Qos:                true,
+					OnlineExtend:       true,
+					Replication:        true,
+				},
 			},
 		},
 		{
@@ -213,6 +222,27 @@ var (
 		},
 	}
 
+	SampleSnapshotAttachments = []model.SnapshotAttachmentSpec{
+		{
+			BaseModel: &model.BaseModel{
+				Id: "a0cbbd38-c6a1-11e8-9c6b-0f5a3b8e2d51",
+			},
+			Status:     "available",
+			SnapshotId: "3769855c-a102-11e7-b772-17b880d2f537",
+			HostInfo:   model.HostInfo{},
+			ConnectionInfo: model.ConnectionInfo{
+				DriverVolumeType: "iscsi",
+				ConnectionData: map[string]interface{}{
+					"targetDiscovered": true,
+					"targetIqn":        "iqn.2017-10.io.opensds:volume:00000001",
+					"targetPortal":     "127.0.0.0.1:3260",
+					"discard":          false,
+					"readOnly":         true,
+				},
+			},
+		},
+	}
+
 	SampleReplications = []model.ReplicationSpec{
 		{
 			BaseModel: &model.BaseModel{
@@ -248,6 +278,23 @@ var (
 			Status:      "available",
 		},
 	}
+
+	SampleBackupSchedules = []model.BackupScheduleSpec{
+		{
+			BaseModel: &model.BaseModel{
+				Id: "5f5c7f1e-a7d2-11e8-8c4e-3b6d86f2e1a7",
+			},
+			VolumeId:         "bd5b12a8-a101-11e7-941e-d77981b584d8",
+			ProfileId:        "1106b972-66ef-11e7-b172-db03f3689c9c",
+			NextRunAt:        "2018-08-28T10:00:00",
+			LastRunAt:        "2018-08-27T10:00:00",
+			LastResult:       "success",
+			LastBackupId:     "3769855c-a102-11e7-b772-17b880d2f537",
+			LastBackupMode:   "full",
+			LastFullBackupId: "3769855c-a102-11e7-b772-17b880d2f537",
+			RunCount:         1,
+		},
+	}
 )
 
 // The Byte*** variable here is designed for unit test in client package.
@@ -301,7 +348,8 @@ var (
 		"name":        "sample",
 		"description": "sample backend service",
 		"endpoint":    "localhost:50050",
-		"driverName":  "sample"
+		"driverName":  "sample",
+		"backendName": "sample"
 	}`
 
 	ByteDocks = `[
@@ -310,7 +358,8 @@ var (
 			"name":        "sample",
 			"description": "sample backend service",
 			"endpoint":    "localhost:50050",
-			"driverName":  "sample"
+			"driverName":  "sample",
+			"backendName": "sample"
 		}
 	]`
 
@@ -358,6 +407,16 @@ var (
 				"advanced": {
 					"diskType": "SSD",
 					"latency":  "3ms"
+				},
+				"capabilities": {
+					"volumeGroup":        true,
+					"snapshotAttachment": true,
+					"clone":              true,
+					"revert":             false,
+					"qos":                true,
+					"onlineExtend":       true,
+					"replication":        true,
+					"incrementalBackup":  false
 				}
 			}
 		},
@@ -446,6 +505,42 @@ var (
 		}
 	]`
 
+	ByteSnapshotAttachment = `{
+		"id": "a0cbbd38-c6a1-11e8-9c6b-0f5a3b8e2d51",
+		"status": "available",
+		"snapshotId": "3769855c-a102-11e7-b772-17b880d2f537",
+		"hostInfo": {},
+		"connectionInfo": {
+			"driverVolumeType": "iscsi",
+			"data": {
+				"targetDiscovered": true,
+				"targetIqn": "iqn.2017-10.io.opensds:volume:00000001",
+				"targetPortal": "127.0.0.0.1:3260",
+				"discard": false,
+				"readOnly": true
+			}
+		}
+	}`
+
+	ByteSnapshotAttachments = `[
+		{
+			"id": "a0cbbd38-c6a1-11e8-9c6b-0f5a3b8e2d51",
+			"status": "available",
+			"snapshotId": "3769855c-a102-11e7-b772-17b880d2f537",
+			"hostInfo": {},
+			"connectionInfo": {
+				"driverVolumeType": "iscsi",
+				"data": {
+					"targetDiscovered": true,
+					"targetIqn": "iqn.2017-10.io.opensds:volume:00000001",
+					"targetPortal": "127.0.0.0.1:3260",
+					"discard": false,
+					"readOnly": true
+				}
+			}
+		}
+	]`
+
 	ByteSnapshot = `{
 		"id": "3769855c-a102-11e7-b772-17b880d2f537",
 		"name": "sample-snapshot-01",
@@ -500,6 +595,34 @@ var (
 			"ProfileId": "1106b972-66ef-11e7-b172-db03f3689c9c"
 	}`
 
+	ByteBackupSchedule = `{
+		"id": "5f5c7f1e-a7d2-11e8-8c4e-3b6d86f2e1a7",
+		"volumeId": "bd5b12a8-a101-11e7-941e-d77981b584d8",
+		"profileId": "1106b972-66ef-11e7-b172-db03f3689c9c",
+		"nextRunAt": "2018-08-28T10:00:00",
+		"lastRunAt": "2018-08-27T10:00:00",
+		"lastResult": "success",
+		"lastBackupId": "3769855c-a102-11e7-b772-17b880d2f537",
+		"lastBackupMode": "full",
+		"lastFullBackupId": "3769855c-a102-11e7-b772-17b880d2f537",
+		"runCount": 1
+	}`
+
+	ByteBackupSchedules = `[
+		{
+			"id": "5f5c7f1e-a7d2-11e8-8c4e-3b6d86f2e1a7",
+			"volumeId": "bd5b12a8-a101-11e7-941e-d77981b584d8",
+			"profileId": "1106b972-66ef-11e7-b172-db03f3689c9c",
+			"nextRunAt": "2018-08-28T10:00:00",
+			"lastRunAt": "2018-08-27T10:00:00",
+			"lastResult": "success",
+			"lastBackupId": "3769855c-a102-11e7-b772-17b880d2f537",
+			"lastBackupMode": "full",
+			"lastFullBackupId": "3769855c-a102-11e7-b772-17b880d2f537",
+			"runCount": 1
+		}
+	]`
+
 	ByteReplications = `[
 		{
 			"id": "c299a978-4f3e-11e8-8a5c-977218a83359",
@@ -572,6 +695,7 @@ var (
 			"description": "sample backend service",
 			"endpoint":    "localhost:50050",
 			"driverName":  "sample",
+			"backendName": "sample",
 			"type":        "provisioner"
 		}`,
 	}
@@ -598,6 +722,16 @@ var (
 				"advanced": {
 					"diskType": "SSD",
 					"latency":  "3ms"
+				},
+				"capabilities": {
+					"volumeGroup":        true,
+					"snapshotAttachment": true,
+					"clone":              true,
+					"revert":             false,
+					"qos":                true,
+					"onlineExtend":       true,
+					"replication":        true,
+					"incrementalBackup":  false
 				}
 			}
 		}`,
@@ -652,6 +786,25 @@ var (
 		}`,
 	}
 
+	StringSliceSnapshotAttachments = []string{
+		`{
+			"id": "a0cbbd38-c6a1-11e8-9c6b-0f5a3b8e2d51",
+			"status":     "available",
+			"snapshotId": "3769855c-a102-11e7-b772-17b880d2f537",
+			"hostInfo": {},
+			"connectionInfo": {
+				"driverVolumeType": "iscsi",
+				"data": {
+					"targetDiscovered": true,
+					"targetIqn":        "iqn.2017-10.io.opensds:volume:00000001",
+					"targetPortal":     "127.0.0.0.1:3260",
+					"discard":          false,
+					"readOnly":         true
+				}
+			}
+		}`,
+	}
+
 	StringSliceReplications = []string{
 		`{
 			"id":                "c299a978-4f3e-11e8-8a5c-977218a83359",
@@ -672,4 +825,19 @@ var (
 			"profileId":         "1106b972-66ef-11e7-b172-db03f3689c9c"
 		}`,
 	}
+
+	StringSliceBackupSchedules = []string{
+		`{
+			"id":               "5f5c7f1e-a7d2-11e8-8c4e-3b6d86f2e1a7",
+			"volumeId":         "bd5b12a8-a101-11e7-941e-d77981b584d8",
+			"profileId":        "1106b972-66ef-11e7-b172-db03f3689c9c",
+			"nextRunAt":        "2018-08-28T10:00:00",
+			"lastRunAt":        "2018-08-27T10:00:00",
+			"lastResult":       "success",
+			"lastBackupId":     "3769855c-a102-11e7-b772-17b880d2f537",
+			"lastBackupMode":   "full",
+			"lastFullBackupId": "3769855c-a102-11e7-b772-17b880d2f537",
+			"runCount":         1
+		}`,
+	}
 )
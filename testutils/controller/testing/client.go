@@ -61,6 +61,36 @@ func (_m *Client) CreateReplication(ctx context.Context, in *proto.CreateReplica
 	return r0, r1
 }
 
+// CreateSnapshotAttachment provides a mock function with given fields: ctx, in, opts
+func (_m *Client) CreateSnapshotAttachment(ctx context.Context, in *proto.CreateSnapshotAttachmentOpts, opts ...grpc.CallOption) (*proto.GenericResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *proto.GenericResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.CreateSnapshotAttachmentOpts, ...grpc.CallOption) *proto.GenericResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proto.GenericResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.CreateSnapshotAttachmentOpts, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateVolume provides a mock function with given fields: ctx, in, opts
 func (_m *Client) CreateVolume(ctx context.Context, in *proto.CreateVolumeOpts, opts ...grpc.CallOption) (*proto.GenericResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -151,6 +181,36 @@ func (_m *Client) CreateVolumeGroup(ctx context.Context, in *proto.CreateVolumeG
 	return r0, r1
 }
 
+// CreateVolumeGroupSnapshot provides a mock function with given fields: ctx, in, opts
+func (_m *Client) CreateVolumeGroupSnapshot(ctx context.Context, in *proto.CreateVolumeGroupSnapshotOpts, opts ...grpc.CallOption) (*proto.GenericResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *proto.GenericResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.CreateVolumeGroupSnapshotOpts, ...grpc.CallOption) *proto.GenericResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proto.GenericResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.CreateVolumeGroupSnapshotOpts, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateVolumeSnapshot provides a mock function with given fields: ctx, in, opts
 func (_m *Client) CreateVolumeSnapshot(ctx context.Context, in *proto.CreateVolumeSnapshotOpts, opts ...grpc.CallOption) (*proto.GenericResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -211,6 +271,36 @@ func (_m *Client) DeleteReplication(ctx context.Context, in *proto.DeleteReplica
 	return r0, r1
 }
 
+// DeleteSnapshotAttachment provides a mock function with given fields: ctx, in, opts
+func (_m *Client) DeleteSnapshotAttachment(ctx context.Context, in *proto.DeleteSnapshotAttachmentOpts, opts ...grpc.CallOption) (*proto.GenericResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *proto.GenericResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.DeleteSnapshotAttachmentOpts, ...grpc.CallOption) *proto.GenericResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proto.GenericResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.DeleteSnapshotAttachmentOpts, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeleteVolume provides a mock function with given fields: ctx, in, opts
 func (_m *Client) DeleteVolume(ctx context.Context, in *proto.DeleteVolumeOpts, opts ...grpc.CallOption) (*proto.GenericResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -301,6 +391,36 @@ func (_m *Client) DeleteVolumeGroup(ctx context.Context, in *proto.DeleteVolumeG
 	return r0, r1
 }
 
+// DeleteVolumeGroupSnapshot provides a mock function with given fields: ctx, in, opts
+func (_m *Client) DeleteVolumeGroupSnapshot(ctx context.Context, in *proto.DeleteVolumeGroupSnapshotOpts, opts ...grpc.CallOption) (*proto.GenericResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *proto.GenericResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.DeleteVolumeGroupSnapshotOpts, ...grpc.CallOption) *proto.GenericResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proto.GenericResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.DeleteVolumeGroupSnapshotOpts, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeleteVolumeSnapshot provides a mock function with given fields: ctx, in, opts
 func (_m *Client) DeleteVolumeSnapshot(ctx context.Context, in *proto.DeleteVolumeSnapshotOpts, opts ...grpc.CallOption) (*proto.GenericResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -480,3 +600,63 @@ func (_m *Client) UpdateVolumeGroup(ctx context.Context, in *proto.UpdateVolumeG
 
 	return r0, r1
 }
+
+// UpdateVolumeQos provides a mock function with given fields: ctx, in, opts
+func (_m *Client) UpdateVolumeQos(ctx context.Context, in *proto.UpdateVolumeQosOpts, opts ...grpc.CallOption) (*proto.GenericResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *proto.GenericResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.UpdateVolumeQosOpts, ...grpc.CallOption) *proto.GenericResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proto.GenericResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.UpdateVolumeQosOpts, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VerifyVolumeSnapshot provides a mock function with given fields: ctx, in, opts
+func (_m *Client) VerifyVolumeSnapshot(ctx context.Context, in *proto.VerifyVolumeSnapshotOpts, opts ...grpc.CallOption) (*proto.GenericResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *proto.GenericResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *proto.VerifyVolumeSnapshotOpts, ...grpc.CallOption) *proto.GenericResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proto.GenericResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *proto.VerifyVolumeSnapshotOpts, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
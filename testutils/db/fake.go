@@ -297,6 +297,37 @@ func (fc *FakeDbClient) DeleteVolumeAttachment(ctx *c.Context, attachmentId stri
 	return nil
 }
 
+// CreateSnapshotAttachment
+func (fc *FakeDbClient) CreateSnapshotAttachment(ctx *c.Context, attachment *model.SnapshotAttachmentSpec) (*model.SnapshotAttachmentSpec, error) {
+	return attachment, nil
+}
+
+// GetSnapshotAttachment
+func (fc *FakeDbClient) GetSnapshotAttachment(ctx *c.Context, attachmentId string) (*model.SnapshotAttachmentSpec, error) {
+	attach := SampleSnapshotAttachments[0]
+	return &attach, nil
+}
+
+// ListSnapshotAttachments
+func (fc *FakeDbClient) ListSnapshotAttachments(ctx *c.Context, snapshotId string) ([]*model.SnapshotAttachmentSpec, error) {
+	var atcs []*model.SnapshotAttachmentSpec
+
+	for i := range SampleSnapshotAttachments {
+		atcs = append(atcs, &SampleSnapshotAttachments[i])
+	}
+	return atcs, nil
+}
+
+// UpdateSnapshotAttachment
+func (fc *FakeDbClient) UpdateSnapshotAttachment(ctx *c.Context, attachmentId string, attachment *model.SnapshotAttachmentSpec) (*model.SnapshotAttachmentSpec, error) {
+	return nil, nil
+}
+
+// DeleteSnapshotAttachment
+func (fc *FakeDbClient) DeleteSnapshotAttachment(ctx *c.Context, attachmentId string) error {
+	return nil
+}
+
 // CreateVolumeSnapshot
 func (fc *FakeDbClient) CreateVolumeSnapshot(ctx *c.Context, vs *model.VolumeSnapshotSpec) (*model.VolumeSnapshotSpec, error) {
 	return vs, nil
@@ -424,3 +455,32 @@ func (fc *FakeDbClient) ListVolumeGroups(ctx *c.Context) ([]*model.VolumeGroupSp
 func (fc *FakeDbClient) VolumesToUpdate(ctx *c.Context, volumeList []*model.VolumeSpec) ([]*model.VolumeSpec, error) {
 	return nil, nil
 }
+
+func (fc *FakeDbClient) CreateBackupSchedule(ctx *c.Context, bs *model.BackupScheduleSpec) (*model.BackupScheduleSpec, error) {
+	return &SampleBackupSchedules[0], nil
+}
+
+func (fc *FakeDbClient) GetBackupSchedule(ctx *c.Context, volumeId string) (*model.BackupScheduleSpec, error) {
+	for _, bs := range SampleBackupSchedules {
+		if bs.VolumeId == volumeId {
+			return &bs, nil
+		}
+	}
+	return nil, errors.New("Can't find this backup schedule resource!")
+}
+
+func (fc *FakeDbClient) ListBackupSchedules(ctx *c.Context) ([]*model.BackupScheduleSpec, error) {
+	var bss []*model.BackupScheduleSpec
+	for i := range SampleBackupSchedules {
+		bss = append(bss, &SampleBackupSchedules[i])
+	}
+	return bss, nil
+}
+
+func (fc *FakeDbClient) UpdateBackupSchedule(ctx *c.Context, bs *model.BackupScheduleSpec) (*model.BackupScheduleSpec, error) {
+	return bs, nil
+}
+
+func (fc *FakeDbClient) DeleteBackupSchedule(ctx *c.Context, volumeId string) error {
+	return nil
+}
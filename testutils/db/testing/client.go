@@ -35,6 +35,29 @@ func (_m *Client) AddCustomProperty(ctx *context.Context, prfID string, custom m
 	return r0, r1
 }
 
+// CreateBackupSchedule provides a mock function with given fields: ctx, bs
+func (_m *Client) CreateBackupSchedule(ctx *context.Context, bs *model.BackupScheduleSpec) (*model.BackupScheduleSpec, error) {
+	ret := _m.Called(ctx, bs)
+
+	var r0 *model.BackupScheduleSpec
+	if rf, ok := ret.Get(0).(func(*context.Context, *model.BackupScheduleSpec) *model.BackupScheduleSpec); ok {
+		r0 = rf(ctx, bs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.BackupScheduleSpec)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*context.Context, *model.BackupScheduleSpec) error); ok {
+		r1 = rf(ctx, bs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateDock provides a mock function with given fields: ctx, dck
 func (_m *Client) CreateDock(ctx *context.Context, dck *model.DockSpec) (*model.DockSpec, error) {
 	ret := _m.Called(ctx, dck)
@@ -127,6 +150,29 @@ func (_m *Client) CreateReplication(ctx *context.Context, replication *model.Rep
 	return r0, r1
 }
 
+// CreateSnapshotAttachment provides a mock function with given fields: ctx, attachment
+func (_m *Client) CreateSnapshotAttachment(ctx *context.Context, attachment *model.SnapshotAttachmentSpec) (*model.SnapshotAttachmentSpec, error) {
+	ret := _m.Called(ctx, attachment)
+
+	var r0 *model.SnapshotAttachmentSpec
+	if rf, ok := ret.Get(0).(func(*context.Context, *model.SnapshotAttachmentSpec) *model.SnapshotAttachmentSpec); ok {
+		r0 = rf(ctx, attachment)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.SnapshotAttachmentSpec)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*context.Context, *model.SnapshotAttachmentSpec) error); ok {
+		r1 = rf(ctx, attachment)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateVolume provides a mock function with given fields: ctx, vol
 func (_m *Client) CreateVolume(ctx *context.Context, vol *model.VolumeSpec) (*model.VolumeSpec, error) {
 	ret := _m.Called(ctx, vol)
@@ -219,6 +265,20 @@ func (_m *Client) CreateVolumeSnapshot(ctx *context.Context, vs *model.VolumeSna
 	return r0, r1
 }
 
+// DeleteBackupSchedule provides a mock function with given fields: ctx, volumeId
+func (_m *Client) DeleteBackupSchedule(ctx *context.Context, volumeId string) error {
+	ret := _m.Called(ctx, volumeId)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*context.Context, string) error); ok {
+		r0 = rf(ctx, volumeId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteDock provides a mock function with given fields: ctx, dckID
 func (_m *Client) DeleteDock(ctx *context.Context, dckID string) error {
 	ret := _m.Called(ctx, dckID)
@@ -275,6 +335,20 @@ func (_m *Client) DeleteReplication(ctx *context.Context, replicationId string)
 	return r0
 }
 
+// DeleteSnapshotAttachment provides a mock function with given fields: ctx, attachmentId
+func (_m *Client) DeleteSnapshotAttachment(ctx *context.Context, attachmentId string) error {
+	ret := _m.Called(ctx, attachmentId)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*context.Context, string) error); ok {
+		r0 = rf(ctx, attachmentId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteVolume provides a mock function with given fields: ctx, volID
 func (_m *Client) DeleteVolume(ctx *context.Context, volID string) error {
 	ret := _m.Called(ctx, volID)
@@ -354,6 +428,29 @@ func (_m *Client) ExtendVolume(ctx *context.Context, vol *model.VolumeSpec) (*mo
 	return r0, r1
 }
 
+// GetBackupSchedule provides a mock function with given fields: ctx, volumeId
+func (_m *Client) GetBackupSchedule(ctx *context.Context, volumeId string) (*model.BackupScheduleSpec, error) {
+	ret := _m.Called(ctx, volumeId)
+
+	var r0 *model.BackupScheduleSpec
+	if rf, ok := ret.Get(0).(func(*context.Context, string) *model.BackupScheduleSpec); ok {
+		r0 = rf(ctx, volumeId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.BackupScheduleSpec)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*context.Context, string) error); ok {
+		r1 = rf(ctx, volumeId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetDefaultProfile provides a mock function with given fields: ctx
 func (_m *Client) GetDefaultProfile(ctx *context.Context) (*model.ProfileSpec, error) {
 	ret := _m.Called(ctx)
@@ -515,6 +612,29 @@ func (_m *Client) GetReplicationByVolumeId(ctx *context.Context, volumeId string
 	return r0, r1
 }
 
+// GetSnapshotAttachment provides a mock function with given fields: ctx, attachmentId
+func (_m *Client) GetSnapshotAttachment(ctx *context.Context, attachmentId string) (*model.SnapshotAttachmentSpec, error) {
+	ret := _m.Called(ctx, attachmentId)
+
+	var r0 *model.SnapshotAttachmentSpec
+	if rf, ok := ret.Get(0).(func(*context.Context, string) *model.SnapshotAttachmentSpec); ok {
+		r0 = rf(ctx, attachmentId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.SnapshotAttachmentSpec)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*context.Context, string) error); ok {
+		r1 = rf(ctx, attachmentId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetVolume provides a mock function with given fields: ctx, volID
 func (_m *Client) GetVolume(ctx *context.Context, volID string) (*model.VolumeSpec, error) {
 	ret := _m.Called(ctx, volID)
@@ -653,6 +773,29 @@ func (_m *Client) ListAvailabilityZones(ctx *context.Context) ([]string, error)
 	return r0, r1
 }
 
+// ListBackupSchedules provides a mock function with given fields: ctx
+func (_m *Client) ListBackupSchedules(ctx *context.Context) ([]*model.BackupScheduleSpec, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*model.BackupScheduleSpec
+	if rf, ok := ret.Get(0).(func(*context.Context) []*model.BackupScheduleSpec); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.BackupScheduleSpec)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListCustomProperties provides a mock function with given fields: ctx, prfID
 func (_m *Client) ListCustomProperties(ctx *context.Context, prfID string) (*model.CustomPropertiesSpec, error) {
 	ret := _m.Called(ctx, prfID)
@@ -860,6 +1003,29 @@ func (_m *Client) ListReplicationWithFilter(ctx *context.Context, m map[string][
 	return r0, r1
 }
 
+// ListSnapshotAttachments provides a mock function with given fields: ctx, snapshotId
+func (_m *Client) ListSnapshotAttachments(ctx *context.Context, snapshotId string) ([]*model.SnapshotAttachmentSpec, error) {
+	ret := _m.Called(ctx, snapshotId)
+
+	var r0 []*model.SnapshotAttachmentSpec
+	if rf, ok := ret.Get(0).(func(*context.Context, string) []*model.SnapshotAttachmentSpec); ok {
+		r0 = rf(ctx, snapshotId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.SnapshotAttachmentSpec)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*context.Context, string) error); ok {
+		r1 = rf(ctx, snapshotId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListSnapshotsByVolumeId provides a mock function with given fields: ctx, volId
 func (_m *Client) ListSnapshotsByVolumeId(ctx *context.Context, volId string) ([]*model.VolumeSnapshotSpec, error) {
 	ret := _m.Called(ctx, volId)
@@ -1104,6 +1270,29 @@ func (_m *Client) RemoveCustomProperty(ctx *context.Context, prfID string, custo
 	return r0
 }
 
+// UpdateBackupSchedule provides a mock function with given fields: ctx, bs
+func (_m *Client) UpdateBackupSchedule(ctx *context.Context, bs *model.BackupScheduleSpec) (*model.BackupScheduleSpec, error) {
+	ret := _m.Called(ctx, bs)
+
+	var r0 *model.BackupScheduleSpec
+	if rf, ok := ret.Get(0).(func(*context.Context, *model.BackupScheduleSpec) *model.BackupScheduleSpec); ok {
+		r0 = rf(ctx, bs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.BackupScheduleSpec)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*context.Context, *model.BackupScheduleSpec) error); ok {
+		r1 = rf(ctx, bs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateDock provides a mock function with given fields: ctx, dckID, name, desp
 func (_m *Client) UpdateDock(ctx *context.Context, dckID string, name string, desp string) (*model.DockSpec, error) {
 	ret := _m.Called(ctx, dckID, name, desp)
@@ -1196,6 +1385,29 @@ func (_m *Client) UpdateReplication(ctx *context.Context, replicationId string,
 	return r0, r1
 }
 
+// UpdateSnapshotAttachment provides a mock function with given fields: ctx, attachmentId, attachment
+func (_m *Client) UpdateSnapshotAttachment(ctx *context.Context, attachmentId string, attachment *model.SnapshotAttachmentSpec) (*model.SnapshotAttachmentSpec, error) {
+	ret := _m.Called(ctx, attachmentId, attachment)
+
+	var r0 *model.SnapshotAttachmentSpec
+	if rf, ok := ret.Get(0).(func(*context.Context, string, *model.SnapshotAttachmentSpec) *model.SnapshotAttachmentSpec); ok {
+		r0 = rf(ctx, attachmentId, attachment)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.SnapshotAttachmentSpec)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*context.Context, string, *model.SnapshotAttachmentSpec) error); ok {
+		r1 = rf(ctx, attachmentId, attachment)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateStatus provides a mock function with given fields: ctx, object, status
 func (_m *Client) UpdateStatus(ctx *context.Context, object interface{}, status string) error {
 	ret := _m.Called(ctx, object, status)
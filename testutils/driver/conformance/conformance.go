@@ -0,0 +1,228 @@
+// Copyright (c) 2018 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+This module implements a conformance test suite of volume drivers, which runs
+the same scenarios against every driver to verify that it honors the contract
+the dock relies on:
+
+  * Volumes and snapshots keep the ids given by OpenSDS, and their sizes are
+    reported in GB, the same unit as they are requested in.
+  * The status of a volume or snapshot, if the driver reports one, is
+    available once the operation returns successfully.
+  * Deleting a volume or snapshot which has already gone succeeds.
+  * Operating on a volume which doesn't exist fails.
+
+The backends of drivers are supposed to be mocked, so that the suite can run
+as the unit tests of each driver.
+
+*/
+
+package conformance
+
+import (
+	"testing"
+
+	"github.com/opensds/opensds/pkg/model"
+	pb "github.com/opensds/opensds/pkg/model/proto"
+	"github.com/satori/go.uuid"
+)
+
+// VolumeDriver is the part of volume driver interface which is verified by
+// the suite, any volume driver satisfies it.
+type VolumeDriver interface {
+	Setup() error
+	Unset() error
+	CreateVolume(opt *pb.CreateVolumeOpts) (*model.VolumeSpec, error)
+	PullVolume(volIdentifier string) (*model.VolumeSpec, error)
+	DeleteVolume(opt *pb.DeleteVolumeOpts) error
+	ExtendVolume(opt *pb.ExtendVolumeOpts) (*model.VolumeSpec, error)
+	CreateSnapshot(opt *pb.CreateVolumeSnapshotOpts) (*model.VolumeSnapshotSpec, error)
+	PullSnapshot(snapIdentifier string) (*model.VolumeSnapshotSpec, error)
+	DeleteSnapshot(opt *pb.DeleteVolumeSnapshotOpts) error
+	ListPools() ([]*model.StoragePoolSpec, error)
+	Capabilities() model.DriverCapabilitiesSpec
+}
+
+// Constructor builds the driver under test, which has been set up against a
+// fresh mocked backend. It's called once for each scenario.
+type Constructor func(t *testing.T) VolumeDriver
+
+// Run runs all scenarios of the suite as subtests of t against the drivers
+// built by newDriver.
+func Run(t *testing.T, newDriver Constructor) {
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.name, func(t *testing.T) {
+			d := newDriver(t)
+			defer d.Unset()
+
+			pols, err := d.ListPools()
+			if err != nil {
+				t.Fatal("List pools failed:", err)
+			}
+			if len(pols) == 0 {
+				t.Fatal("No pool is reported by the driver")
+			}
+			s.run(&env{T: t, d: d, pols: pols, pol: pols[0]})
+		})
+	}
+}
+
+// The size in GB of volumes created by the scenarios.
+const volumeSize = 1
+
+var scenarios = []struct {
+	name string
+	run  func(e *env)
+}{
+	{"ListPools", testListPools},
+	{"CreateVolume", testCreateVolume},
+	{"ExtendVolume", testExtendVolume},
+	{"DeleteVolume", testDeleteVolume},
+	{"DeleteMissingVolume", testDeleteMissingVolume},
+	{"ExtendMissingVolume", testExtendMissingVolume},
+	{"PullMissingVolume", testPullMissingVolume},
+	{"CreateSnapshot", testCreateSnapshot},
+	{"DeleteSnapshot", testDeleteSnapshot},
+	{"SnapshotMissingVolume", testSnapshotMissingVolume},
+	{"PullMissingSnapshot", testPullMissingSnapshot},
+}
+
+// env is the environment of a scenario, which holds the driver under test,
+// the pools reported by it and the one which volumes are created in.
+type env struct {
+	*testing.T
+	d    VolumeDriver
+	pols []*model.StoragePoolSpec
+	pol  *model.StoragePoolSpec
+}
+
+func (e *env) createVolume() *model.VolumeSpec {
+	opt := &pb.CreateVolumeOpts{
+		Id:               uuid.NewV4().String(),
+		Name:             "conformance-volume",
+		Description:      "volume for conformance test",
+		Size:             volumeSize,
+		AvailabilityZone: e.pol.AvailabilityZone,
+		PoolId:           e.pol.Id,
+		PoolName:         e.pol.Name,
+	}
+	vol, err := e.d.CreateVolume(opt)
+	if err != nil {
+		e.Fatal("Create volume failed:", err)
+	}
+	e.checkVolume(vol, opt.Id, opt.Size)
+	return vol
+}
+
+func (e *env) deleteVolume(vol *model.VolumeSpec) error {
+	return e.d.DeleteVolume(&pb.DeleteVolumeOpts{
+		Id:       vol.Id,
+		PoolId:   e.pol.Id,
+		Metadata: vol.Metadata,
+	})
+}
+
+func (e *env) extendVolume(vol *model.VolumeSpec, size int64) (*model.VolumeSpec, error) {
+	return e.d.ExtendVolume(&pb.ExtendVolumeOpts{
+		Id:               vol.Id,
+		Name:             vol.Name,
+		Size:             size,
+		Description:      vol.Description,
+		AvailabilityZone: e.pol.AvailabilityZone,
+		PoolId:           e.pol.Id,
+		PoolName:         e.pol.Name,
+		Metadata:         vol.Metadata,
+	})
+}
+
+// createSnapshot creates the snapshot of volume, the metadata of volume is
+// passed to the driver as the controller does.
+func (e *env) createSnapshot(id string, vol *model.VolumeSpec) (*model.VolumeSnapshotSpec, error) {
+	return e.d.CreateSnapshot(&pb.CreateVolumeSnapshotOpts{
+		Id:          id,
+		Name:        "conformance-snapshot",
+		Description: "snapshot for conformance test",
+		Size:        vol.Size,
+		VolumeId:    vol.Id,
+		Metadata:    vol.Metadata,
+	})
+}
+
+func (e *env) deleteSnapshot(snap *model.VolumeSnapshotSpec) error {
+	return e.d.DeleteSnapshot(&pb.DeleteVolumeSnapshotOpts{
+		Id:       snap.Id,
+		VolumeId: snap.VolumeId,
+		Metadata: snap.Metadata,
+	})
+}
+
+// checkVolume verifies the volume returned by a successful operation.
+func (e *env) checkVolume(vol *model.VolumeSpec, id string, size int64) {
+	if vol == nil || vol.BaseModel == nil {
+		e.Fatalf("Expected volume %s, got %+v", id, vol)
+	}
+	if vol.Id != id {
+		e.Errorf("Expected volume id %s, got %s", id, vol.Id)
+	}
+	if vol.Size != size {
+		e.Errorf("Expected volume size %d GB, got %d", size, vol.Size)
+	}
+	if vol.Status != "" && vol.Status != model.VolumeAvailable {
+		e.Errorf("Expected volume status empty or %s, got %s", model.VolumeAvailable, vol.Status)
+	}
+}
+
+// checkPulledVolume verifies the volume pulled from the backend, it's skipped
+// if the driver doesn't implement pulling volumes.
+func (e *env) checkPulledVolume(id string, size int64) {
+	vol, err := e.d.PullVolume(id)
+	if _, ok := err.(*model.NotImplementError); ok {
+		return
+	}
+	if err != nil {
+		e.Fatal("Pull volume failed:", err)
+	}
+	e.checkVolume(vol, id, size)
+}
+
+// checkSnapshot verifies the snapshot returned by a successful operation.
+func (e *env) checkSnapshot(snap *model.VolumeSnapshotSpec, id string, size int64) {
+	if snap == nil || snap.BaseModel == nil {
+		e.Fatalf("Expected snapshot %s, got %+v", id, snap)
+	}
+	if snap.Id != id {
+		e.Errorf("Expected snapshot id %s, got %s", id, snap.Id)
+	}
+	if snap.Size != size {
+		e.Errorf("Expected snapshot size %d GB, got %d", size, snap.Size)
+	}
+	if snap.Status != "" && snap.Status != model.VolumeSnapAvailable {
+		e.Errorf("Expected snapshot status empty or %s, got %s", model.VolumeSnapAvailable, snap.Status)
+	}
+}
+
+// checkPulledSnapshot verifies the snapshot pulled from the backend, it's
+// skipped if the driver doesn't implement pulling snapshots.
+func (e *env) checkPulledSnapshot(id string, size int64) {
+	snap, err := e.d.PullSnapshot(id)
+	if _, ok := err.(*model.NotImplementError); ok {
+		return
+	}
+	if err != nil {
+		e.Fatal("Pull snapshot failed:", err)
+	}
+	e.checkSnapshot(snap, id, size)
+}
@@ -0,0 +1,135 @@
+// Copyright (c) 2018 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conformance
+
+import (
+	"github.com/opensds/opensds/pkg/model"
+	"github.com/satori/go.uuid"
+)
+
+func testListPools(e *env) {
+	for _, pol := range e.pols {
+		if pol.BaseModel == nil || pol.Id == "" || pol.Name == "" {
+			e.Errorf("Expected pool with id and name, got %+v", pol)
+			continue
+		}
+		// The free capacity of thin pools may exceed the total capacity
+		// because of over subscription.
+		if pol.TotalCapacity <= 0 || pol.FreeCapacity < 0 {
+			e.Errorf("Expected capacity of pool %s in GB, got total %d, free %d",
+				pol.Name, pol.TotalCapacity, pol.FreeCapacity)
+		}
+	}
+}
+
+func testCreateVolume(e *env) {
+	vol := e.createVolume()
+	e.checkPulledVolume(vol.Id, volumeSize)
+}
+
+func testExtendVolume(e *env) {
+	vol := e.createVolume()
+	ext, err := e.extendVolume(vol, volumeSize+1)
+	if err != nil {
+		e.Fatal("Extend volume failed:", err)
+	}
+	e.checkVolume(ext, vol.Id, volumeSize+1)
+	e.checkPulledVolume(vol.Id, volumeSize+1)
+}
+
+func testDeleteVolume(e *env) {
+	vol := e.createVolume()
+	if err := e.deleteVolume(vol); err != nil {
+		e.Fatal("Delete volume failed:", err)
+	}
+	if err := e.deleteVolume(vol); err != nil {
+		e.Error("Expected deleting volume again to succeed, got", err)
+	}
+	if _, err := e.d.PullVolume(vol.Id); err == nil {
+		e.Error("Expected error of pulling deleted volume, got nil")
+	}
+}
+
+func testDeleteMissingVolume(e *env) {
+	vol := &model.VolumeSpec{BaseModel: &model.BaseModel{Id: uuid.NewV4().String()}}
+	if err := e.deleteVolume(vol); err != nil {
+		e.Error("Expected deleting missing volume to succeed, got", err)
+	}
+}
+
+func testExtendMissingVolume(e *env) {
+	vol := &model.VolumeSpec{BaseModel: &model.BaseModel{Id: uuid.NewV4().String()}}
+	if _, err := e.extendVolume(vol, volumeSize+1); err == nil {
+		e.Error("Expected error of extending missing volume, got nil")
+	}
+}
+
+func testPullMissingVolume(e *env) {
+	_, err := e.d.PullVolume(uuid.NewV4().String())
+	if _, ok := err.(*model.NotImplementError); ok {
+		e.Skip("Pulling volume is not implemented")
+	}
+	if err == nil {
+		e.Error("Expected error of pulling missing volume, got nil")
+	}
+}
+
+func testCreateSnapshot(e *env) {
+	vol := e.createVolume()
+	id := uuid.NewV4().String()
+	snap, err := e.createSnapshot(id, vol)
+	if err != nil {
+		e.Fatal("Create snapshot failed:", err)
+	}
+	e.checkSnapshot(snap, id, vol.Size)
+	if snap.VolumeId != vol.Id {
+		e.Errorf("Expected snapshot of volume %s, got %s", vol.Id, snap.VolumeId)
+	}
+	e.checkPulledSnapshot(id, vol.Size)
+}
+
+func testDeleteSnapshot(e *env) {
+	vol := e.createVolume()
+	snap, err := e.createSnapshot(uuid.NewV4().String(), vol)
+	if err != nil {
+		e.Fatal("Create snapshot failed:", err)
+	}
+	if err := e.deleteSnapshot(snap); err != nil {
+		e.Fatal("Delete snapshot failed:", err)
+	}
+	if err := e.deleteSnapshot(snap); err != nil {
+		e.Error("Expected deleting snapshot again to succeed, got", err)
+	}
+}
+
+func testSnapshotMissingVolume(e *env) {
+	vol := &model.VolumeSpec{
+		BaseModel: &model.BaseModel{Id: uuid.NewV4().String()},
+		Size:      volumeSize,
+	}
+	if _, err := e.createSnapshot(uuid.NewV4().String(), vol); err == nil {
+		e.Error("Expected error of creating snapshot of missing volume, got nil")
+	}
+}
+
+func testPullMissingSnapshot(e *env) {
+	_, err := e.d.PullSnapshot(uuid.NewV4().String())
+	if _, ok := err.(*model.NotImplementError); ok {
+		e.Skip("Pulling snapshot is not implemented")
+	}
+	if err == nil {
+		e.Error("Expected error of pulling missing snapshot, got nil")
+	}
+}
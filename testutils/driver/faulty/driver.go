@@ -0,0 +1,240 @@
+// Copyright (c) 2018 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package faulty
+
+import (
+	"github.com/opensds/opensds/pkg/model"
+	pb "github.com/opensds/opensds/pkg/model/proto"
+	sample "github.com/opensds/opensds/testutils/driver"
+)
+
+// Driver is the volume driver which injects faults into the operations of
+// sample driver.
+type Driver struct {
+	// ConfigPath is the path of driver configuration file of the backend,
+	// which holds the fault rules.
+	ConfigPath string
+
+	inj  *injector
+	base sample.Driver
+}
+
+// Setup
+func (d *Driver) Setup() (err error) {
+	d.inj, err = newInjector(d.ConfigPath)
+	return err
+}
+
+// Unset
+func (d *Driver) Unset() error { return nil }
+
+// CreateVolume
+func (d *Driver) CreateVolume(opt *pb.CreateVolumeOpts) (*model.VolumeSpec, error) {
+	partial, err := d.inj.inject("CreateVolume")
+	if err != nil {
+		return nil, err
+	}
+	vol, err := d.base.CreateVolume(opt)
+	if err != nil || !partial {
+		return vol, err
+	}
+	return partialVolume(vol), nil
+}
+
+// PullVolume
+func (d *Driver) PullVolume(volIdentifier string) (*model.VolumeSpec, error) {
+	partial, err := d.inj.inject("PullVolume")
+	if err != nil {
+		return nil, err
+	}
+	vol, err := d.base.PullVolume(volIdentifier)
+	if err != nil || !partial {
+		return vol, err
+	}
+	return partialVolume(vol), nil
+}
+
+// DeleteVolume
+func (d *Driver) DeleteVolume(opt *pb.DeleteVolumeOpts) error {
+	partial, err := d.inj.inject("DeleteVolume")
+	if err != nil {
+		return err
+	}
+	return partialError("DeleteVolume", d.base.DeleteVolume(opt), partial)
+}
+
+// ExtendVolume
+func (d *Driver) ExtendVolume(opt *pb.ExtendVolumeOpts) (*model.VolumeSpec, error) {
+	partial, err := d.inj.inject("ExtendVolume")
+	if err != nil {
+		return nil, err
+	}
+	vol, err := d.base.ExtendVolume(opt)
+	if err != nil || !partial {
+		return vol, err
+	}
+	return partialVolume(vol), nil
+}
+
+// InitializeConnection
+func (d *Driver) InitializeConnection(opt *pb.CreateVolumeAttachmentOpts) (*model.ConnectionInfo, error) {
+	partial, err := d.inj.inject("InitializeConnection")
+	if err != nil {
+		return nil, err
+	}
+	info, err := d.base.InitializeConnection(opt)
+	if err != nil || !partial {
+		return info, err
+	}
+	return partialConnection(info), nil
+}
+
+// TerminateConnection
+func (d *Driver) TerminateConnection(opt *pb.DeleteVolumeAttachmentOpts) error {
+	partial, err := d.inj.inject("TerminateConnection")
+	if err != nil {
+		return err
+	}
+	return partialError("TerminateConnection", d.base.TerminateConnection(opt), partial)
+}
+
+// CreateSnapshot
+func (d *Driver) CreateSnapshot(opt *pb.CreateVolumeSnapshotOpts) (*model.VolumeSnapshotSpec, error) {
+	partial, err := d.inj.inject("CreateSnapshot")
+	if err != nil {
+		return nil, err
+	}
+	snap, err := d.base.CreateSnapshot(opt)
+	if err != nil || !partial {
+		return snap, err
+	}
+	return partialSnapshot(snap), nil
+}
+
+// PullSnapshot
+func (d *Driver) PullSnapshot(snapIdentifier string) (*model.VolumeSnapshotSpec, error) {
+	partial, err := d.inj.inject("PullSnapshot")
+	if err != nil {
+		return nil, err
+	}
+	snap, err := d.base.PullSnapshot(snapIdentifier)
+	if err != nil || !partial {
+		return snap, err
+	}
+	return partialSnapshot(snap), nil
+}
+
+// DeleteSnapshot
+func (d *Driver) DeleteSnapshot(opt *pb.DeleteVolumeSnapshotOpts) error {
+	partial, err := d.inj.inject("DeleteSnapshot")
+	if err != nil {
+		return err
+	}
+	return partialError("DeleteSnapshot", d.base.DeleteSnapshot(opt), partial)
+}
+
+// InitializeSnapshotConnection
+func (d *Driver) InitializeSnapshotConnection(opt *pb.CreateSnapshotAttachmentOpts) (*model.ConnectionInfo, error) {
+	partial, err := d.inj.inject("InitializeSnapshotConnection")
+	if err != nil {
+		return nil, err
+	}
+	info, err := d.base.InitializeSnapshotConnection(opt)
+	if err != nil || !partial {
+		return info, err
+	}
+	return partialConnection(info), nil
+}
+
+// TerminateSnapshotConnection
+func (d *Driver) TerminateSnapshotConnection(opt *pb.DeleteSnapshotAttachmentOpts) error {
+	partial, err := d.inj.inject("TerminateSnapshotConnection")
+	if err != nil {
+		return err
+	}
+	return partialError("TerminateSnapshotConnection", d.base.TerminateSnapshotConnection(opt), partial)
+}
+
+// CreateVolumeGroup
+func (d *Driver) CreateVolumeGroup(opt *pb.CreateVolumeGroupOpts) (*model.VolumeGroupSpec, error) {
+	partial, err := d.inj.inject("CreateVolumeGroup")
+	if err != nil {
+		return nil, err
+	}
+	vg, err := d.base.CreateVolumeGroup(opt)
+	if err != nil || !partial {
+		return vg, err
+	}
+	return partialVolumeGroup(vg), nil
+}
+
+// UpdateVolumeGroup
+func (d *Driver) UpdateVolumeGroup(opt *pb.UpdateVolumeGroupOpts) (*model.VolumeGroupSpec, error) {
+	partial, err := d.inj.inject("UpdateVolumeGroup")
+	if err != nil {
+		return nil, err
+	}
+	vg, err := d.base.UpdateVolumeGroup(opt)
+	if err != nil || !partial {
+		return vg, err
+	}
+	return partialVolumeGroup(vg), nil
+}
+
+// DeleteVolumeGroup
+func (d *Driver) DeleteVolumeGroup(opt *pb.DeleteVolumeGroupOpts) error {
+	partial, err := d.inj.inject("DeleteVolumeGroup")
+	if err != nil {
+		return err
+	}
+	return partialError("DeleteVolumeGroup", d.base.DeleteVolumeGroup(opt), partial)
+}
+
+// ListPools returns only the first pool if the result is partial.
+func (d *Driver) ListPools() ([]*model.StoragePoolSpec, error) {
+	partial, err := d.inj.inject("ListPools")
+	if err != nil {
+		return nil, err
+	}
+	pols, err := d.base.ListPools()
+	if err != nil || !partial || len(pols) == 0 {
+		return pols, err
+	}
+	return pols[:1], nil
+}
+
+// Capabilities
+func (d *Driver) Capabilities() model.DriverCapabilitiesSpec {
+	return d.base.Capabilities()
+}
+
+// The partial* functions below keep only the id of the result of operation.
+
+func partialVolume(vol *model.VolumeSpec) *model.VolumeSpec {
+	return &model.VolumeSpec{BaseModel: &model.BaseModel{Id: vol.Id}}
+}
+
+func partialSnapshot(snap *model.VolumeSnapshotSpec) *model.VolumeSnapshotSpec {
+	return &model.VolumeSnapshotSpec{BaseModel: &model.BaseModel{Id: snap.Id}}
+}
+
+func partialVolumeGroup(vg *model.VolumeGroupSpec) *model.VolumeGroupSpec {
+	return &model.VolumeGroupSpec{BaseModel: &model.BaseModel{Id: vg.Id}}
+}
+
+// partialConnection keeps the type of connection but drops its data.
+func partialConnection(info *model.ConnectionInfo) *model.ConnectionInfo {
+	return &model.ConnectionInfo{DriverVolumeType: info.DriverVolumeType}
+}
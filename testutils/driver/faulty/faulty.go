@@ -0,0 +1,204 @@
+// Copyright (c) 2018 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+This module implements a fault-injecting driver for OpenSDS. It behaves as the
+sample driver except that the faults described by the rules of its driver
+configuration file are injected into its operations, so that the error paths
+of the whole stack can be exercised. For example:
+
+  rules:
+    # Fail the third volume created since the dock starts.
+    - operation: CreateVolume
+      fault: error
+      nth: 3
+      message: "disk array is out of space"
+    # Slow down every snapshot deletion.
+    - operation: DeleteSnapshot
+      fault: latency
+      latency: 5s
+    # Never return from failover.
+    - operation: FailoverReplication
+      fault: hang
+
+*/
+
+package faulty
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	log "github.com/golang/glog"
+	. "github.com/opensds/opensds/contrib/drivers/utils/config"
+)
+
+// These constants below represent the faults which can be injected into the
+// operations of driver.
+const (
+	// FaultError fails the operation without doing it.
+	FaultError = "error"
+	// FaultLatency delays the operation by the latency of rule.
+	FaultLatency = "latency"
+	// FaultHang blocks the operation forever.
+	FaultHang = "hang"
+	// FaultPartial does the operation but only returns the id of the result,
+	// the operations which have no result report an error after being done.
+	FaultPartial = "partial"
+)
+
+// The operations of volume and replication drivers which faults can be
+// injected into.
+var operations = map[string]bool{
+	"CreateVolume":                 true,
+	"PullVolume":                   true,
+	"DeleteVolume":                 true,
+	"ExtendVolume":                 true,
+	"InitializeConnection":         true,
+	"TerminateConnection":          true,
+	"CreateSnapshot":               true,
+	"PullSnapshot":                 true,
+	"DeleteSnapshot":               true,
+	"InitializeSnapshotConnection": true,
+	"TerminateSnapshotConnection":  true,
+	"CreateVolumeGroup":            true,
+	"UpdateVolumeGroup":            true,
+	"DeleteVolumeGroup":            true,
+	"ListPools":                    true,
+	"CreateReplication":            true,
+	"DeleteReplication":            true,
+	"EnableReplication":            true,
+	"DisableReplication":           true,
+	"FailoverReplication":          true,
+}
+
+// Rule describes the fault injected into an operation of driver.
+type Rule struct {
+	// Operation is the name of the method of driver, such as CreateVolume.
+	Operation string `yaml:"operation"`
+	// Fault is one of error, latency, hang and partial.
+	Fault string `yaml:"fault"`
+	// Nth is the call of the operation which the fault is injected into,
+	// counted from 1 since the dock starts. The fault is injected into every
+	// call if it's zero.
+	Nth int `yaml:"nth,omitempty"`
+	// Latency is how long the operation is delayed by the latency fault.
+	Latency time.Duration `yaml:"latency,omitempty"`
+	// Message is the message of the error returned by the error fault.
+	Message string `yaml:"message,omitempty"`
+}
+
+type Config struct {
+	Rules []Rule `yaml:"rules"`
+}
+
+func (c *Config) validate() error {
+	for _, r := range c.Rules {
+		if !operations[r.Operation] {
+			return fmt.Errorf("operation %s of rule is not supported", r.Operation)
+		}
+		switch r.Fault {
+		case FaultError, FaultHang, FaultPartial:
+		case FaultLatency:
+			if r.Latency <= 0 {
+				return fmt.Errorf("latency of %s rule of %s must be positive", r.Fault, r.Operation)
+			}
+		default:
+			return fmt.Errorf("fault %s of rule of %s is not supported", r.Fault, r.Operation)
+		}
+		if r.Nth < 0 {
+			return fmt.Errorf("nth of %s rule of %s can not be negative", r.Fault, r.Operation)
+		}
+	}
+	return nil
+}
+
+// The calls of operations are counted by the configuration file of drivers,
+// since the dock initializes a new driver for each request.
+var (
+	mutex sync.Mutex
+	calls = map[string]int{}
+)
+
+// hang is never closed except by tests, which release the hung operations
+// by it.
+var hang = make(chan struct{})
+
+// injector injects the faults of rules into the operations of a driver.
+type injector struct {
+	path  string
+	rules []Rule
+}
+
+func newInjector(path string) (*injector, error) {
+	inj := &injector{path: path}
+	if path == "" {
+		return inj, nil
+	}
+	conf := &Config{}
+	if _, err := Parse(conf, path); err != nil {
+		return nil, err
+	}
+	if err := conf.validate(); err != nil {
+		log.Error("Invalid fault rules:", err)
+		return nil, err
+	}
+	inj.rules = conf.Rules
+	return inj, nil
+}
+
+// inject counts the call of the operation and applies the faults of rules
+// which match it. It returns whether the result of operation is partial, or
+// the error which fails the operation.
+func (i *injector) inject(op string) (partial bool, err error) {
+	if i == nil {
+		return false, nil
+	}
+	mutex.Lock()
+	calls[i.path+":"+op]++
+	n := calls[i.path+":"+op]
+	mutex.Unlock()
+
+	for _, r := range i.rules {
+		if r.Operation != op || (r.Nth != 0 && r.Nth != n) {
+			continue
+		}
+		log.Warningf("Inject %s fault into call %d of %s", r.Fault, n, op)
+		switch r.Fault {
+		case FaultError:
+			if r.Message != "" {
+				return false, errors.New(r.Message)
+			}
+			return false, fmt.Errorf("fault injected into call %d of %s", n, op)
+		case FaultLatency:
+			time.Sleep(r.Latency)
+		case FaultHang:
+			<-hang
+		case FaultPartial:
+			partial = true
+		}
+	}
+	return partial, nil
+}
+
+// partialError fails the operation which has been done if its result is
+// partial.
+func partialError(op string, err error, partial bool) error {
+	if err != nil || !partial {
+		return err
+	}
+	return fmt.Errorf("%s is done but fault is injected into its result", op)
+}
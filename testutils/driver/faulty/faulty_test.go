@@ -0,0 +1,180 @@
+// Copyright (c) 2018 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package faulty
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	pb "github.com/opensds/opensds/pkg/model/proto"
+	"github.com/opensds/opensds/testutils/driver/conformance"
+	"github.com/satori/go.uuid"
+)
+
+// newDriver returns the driver set up with the rules, each driver gets its
+// own configuration file so that the calls are counted separately.
+func newDriver(t *testing.T, rules string) *Driver {
+	f, err := ioutil.TempFile("", "faulty")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if _, err := f.WriteString(rules); err != nil {
+		t.Fatal(err)
+	}
+
+	d := &Driver{ConfigPath: f.Name()}
+	err = d.Setup()
+	os.Remove(f.Name())
+	if err != nil {
+		t.Fatal("Setup faulty driver failed:", err)
+	}
+	return d
+}
+
+func newVolumeOpts() *pb.CreateVolumeOpts {
+	return &pb.CreateVolumeOpts{Id: uuid.NewV4().String(), Name: "faulty-volume", Size: 1}
+}
+
+func TestConformance(t *testing.T) {
+	conformance.Run(t, func(t *testing.T) conformance.VolumeDriver {
+		d := &Driver{}
+		if err := d.Setup(); err != nil {
+			t.Fatal("Setup faulty driver failed:", err)
+		}
+		return d
+	})
+}
+
+func TestNthError(t *testing.T) {
+	d := newDriver(t, `
+rules:
+  - operation: CreateVolume
+    fault: error
+    nth: 2
+    message: "out of space"
+`)
+	for i, expectErr := range []bool{false, true, false} {
+		_, err := d.CreateVolume(newVolumeOpts())
+		if expectErr && (err == nil || err.Error() != "out of space") {
+			t.Errorf("Expected injected error of call %d, got %v", i+1, err)
+		}
+		if !expectErr && err != nil {
+			t.Errorf("Expected call %d to succeed, got %v", i+1, err)
+		}
+	}
+}
+
+func TestLatency(t *testing.T) {
+	d := newDriver(t, `
+rules:
+  - operation: DeleteSnapshot
+    fault: latency
+    latency: 50ms
+`)
+	start := time.Now()
+	if err := d.DeleteSnapshot(&pb.DeleteVolumeSnapshotOpts{Id: "not-exist"}); err != nil {
+		t.Error("Delete snapshot failed:", err)
+	}
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+		t.Errorf("Expected latency of 50ms, got %v", elapsed)
+	}
+}
+
+func TestHang(t *testing.T) {
+	d := newDriver(t, `
+rules:
+  - operation: ListPools
+    fault: hang
+`)
+	done := make(chan struct{})
+	go func() {
+		d.ListPools()
+		close(done)
+	}()
+	select {
+	case <-done:
+		t.Fatal("Expected list pools to hang, but it returned")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	release := hang
+	hang = make(chan struct{})
+	close(release)
+	<-done
+}
+
+func TestPartial(t *testing.T) {
+	d := newDriver(t, `
+rules:
+  - operation: CreateVolume
+    fault: partial
+  - operation: DeleteVolume
+    fault: partial
+`)
+	opt := newVolumeOpts()
+	vol, err := d.CreateVolume(opt)
+	if err != nil {
+		t.Fatal("Create volume failed:", err)
+	}
+	if vol.Id != opt.Id || vol.Name != "" || vol.Size != 0 {
+		t.Errorf("Expected partial volume with id %s only, got %+v", opt.Id, vol)
+	}
+
+	if err := d.DeleteVolume(&pb.DeleteVolumeOpts{Id: opt.Id}); err == nil {
+		t.Error("Expected error of partial delete volume, got nil")
+	}
+	if _, err := d.PullVolume(opt.Id); err == nil {
+		t.Error("Expected volume to be deleted by partial delete volume")
+	}
+}
+
+func TestInvalidRules(t *testing.T) {
+	for _, rules := range []string{
+		"rules:\n  - operation: FormatVolume\n    fault: error\n",
+		"rules:\n  - operation: CreateVolume\n    fault: crash\n",
+		"rules:\n  - operation: CreateVolume\n    fault: latency\n",
+	} {
+		f, err := ioutil.TempFile("", "faulty")
+		if err != nil {
+			t.Fatal(err)
+		}
+		f.WriteString(rules)
+		f.Close()
+
+		d := &Driver{ConfigPath: f.Name()}
+		if err := d.Setup(); err == nil {
+			t.Errorf("Expected error of invalid rules %q, got nil", rules)
+		}
+		os.Remove(f.Name())
+	}
+}
+
+func TestReplicationFault(t *testing.T) {
+	d := newDriver(t, `
+rules:
+  - operation: FailoverReplication
+    fault: error
+`)
+	r := &ReplicationDriver{ConfigPath: d.ConfigPath, inj: d.inj}
+	if err := r.FailoverReplication(&pb.FailoverReplicationOpts{}); err == nil {
+		t.Error("Expected injected error of failover replication, got nil")
+	}
+	if err := r.EnableReplication(&pb.EnableReplicationOpts{}); err != nil {
+		t.Error("Enable replication failed:", err)
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright (c) 2018 Huawei Technologies Co., Ltd. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package faulty
+
+import (
+	"github.com/opensds/opensds/pkg/model"
+	pb "github.com/opensds/opensds/pkg/model/proto"
+	sample "github.com/opensds/opensds/testutils/driver"
+)
+
+// ReplicationDriver is the replication driver which injects faults into the
+// operations of sample replication driver, the rules are shared with the
+// volume driver of the same backend.
+type ReplicationDriver struct {
+	// ConfigPath is the path of driver configuration file of the backend,
+	// which holds the fault rules.
+	ConfigPath string
+
+	inj  *injector
+	base sample.ReplicationDriver
+}
+
+// Setup
+func (r *ReplicationDriver) Setup() (err error) {
+	r.inj, err = newInjector(r.ConfigPath)
+	return err
+}
+
+// Unset
+func (r *ReplicationDriver) Unset() error { return nil }
+
+// CreateReplication
+func (r *ReplicationDriver) CreateReplication(opt *pb.CreateReplicationOpts) (*model.ReplicationSpec, error) {
+	partial, err := r.inj.inject("CreateReplication")
+	if err != nil {
+		return nil, err
+	}
+	replica, err := r.base.CreateReplication(opt)
+	if err != nil || !partial {
+		return replica, err
+	}
+	return &model.ReplicationSpec{BaseModel: &model.BaseModel{Id: replica.Id}}, nil
+}
+
+func (r *ReplicationDriver) DeleteReplication(opt *pb.DeleteReplicationOpts) error {
+	partial, err := r.inj.inject("DeleteReplication")
+	if err != nil {
+		return err
+	}
+	return partialError("DeleteReplication", r.base.DeleteReplication(opt), partial)
+}
+
+func (r *ReplicationDriver) EnableReplication(opt *pb.EnableReplicationOpts) error {
+	partial, err := r.inj.inject("EnableReplication")
+	if err != nil {
+		return err
+	}
+	return partialError("EnableReplication", r.base.EnableReplication(opt), partial)
+}
+
+func (r *ReplicationDriver) DisableReplication(opt *pb.DisableReplicationOpts) error {
+	partial, err := r.inj.inject("DisableReplication")
+	if err != nil {
+		return err
+	}
+	return partialError("DisableReplication", r.base.DisableReplication(opt), partial)
+}
+
+func (r *ReplicationDriver) FailoverReplication(opt *pb.FailoverReplicationOpts) error {
+	partial, err := r.inj.inject("FailoverReplication")
+	if err != nil {
+		return err
+	}
+	return partialError("FailoverReplication", r.base.FailoverReplication(opt), partial)
+}
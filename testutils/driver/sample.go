@@ -14,7 +14,8 @@
 
 /*
 This module implements a sample driver for OpenSDS. This driver will handle all
-operations of volume and return a fake value.
+operations of volume and return a fake value, the volumes and snapshots are
+kept in memory so that it behaves like a real backend.
 
 */
 
@@ -22,12 +23,31 @@ package sample
 
 import (
 	"errors"
+	"sync"
 
 	"github.com/opensds/opensds/pkg/model"
 	pb "github.com/opensds/opensds/pkg/model/proto"
 	. "github.com/opensds/opensds/testutils/collection"
 )
 
+// The volumes and snapshots are shared by all sample drivers, since the dock
+// initializes a new driver for each request.
+var (
+	mutex     sync.Mutex
+	volumes   = map[string]model.VolumeSpec{}
+	snapshots = map[string]model.VolumeSnapshotSpec{}
+)
+
+func init() {
+	// The first one wins if some sample volumes share the same id.
+	for i := len(SampleVolumes) - 1; i >= 0; i-- {
+		volumes[SampleVolumes[i].Id] = SampleVolumes[i]
+	}
+	for i := len(SampleSnapshots) - 1; i >= 0; i-- {
+		snapshots[SampleSnapshots[i].Id] = SampleSnapshots[i]
+	}
+}
+
 // Driver
 type Driver struct{}
 
@@ -39,15 +59,32 @@ func (*Driver) Unset() error { return nil }
 
 // CreateVolume
 func (*Driver) CreateVolume(opt *pb.CreateVolumeOpts) (*model.VolumeSpec, error) {
-	return &SampleVolumes[0], nil
+	vol := model.VolumeSpec{
+		BaseModel: &model.BaseModel{
+			Id: opt.GetId(),
+		},
+		Name:             opt.GetName(),
+		Description:      opt.GetDescription(),
+		Size:             opt.GetSize(),
+		AvailabilityZone: opt.GetAvailabilityZone(),
+		Status:           model.VolumeAvailable,
+		PoolId:           opt.GetPoolId(),
+		ProfileId:        opt.GetProfileId(),
+		SnapshotId:       opt.GetSnapshotId(),
+	}
+
+	mutex.Lock()
+	defer mutex.Unlock()
+	volumes[vol.Id] = vol
+	return &vol, nil
 }
 
 // PullVolume
 func (*Driver) PullVolume(volIdentifier string) (*model.VolumeSpec, error) {
-	for _, volume := range SampleVolumes {
-		if volIdentifier == volume.Id {
-			return &volume, nil
-		}
+	mutex.Lock()
+	defer mutex.Unlock()
+	if volume, ok := volumes[volIdentifier]; ok {
+		return &volume, nil
 	}
 
 	return nil, errors.New("Can't find volume " + volIdentifier)
@@ -55,12 +92,23 @@ func (*Driver) PullVolume(volIdentifier string) (*model.VolumeSpec, error) {
 
 // DeleteVolume
 func (*Driver) DeleteVolume(opt *pb.DeleteVolumeOpts) error {
+	mutex.Lock()
+	defer mutex.Unlock()
+	delete(volumes, opt.GetId())
 	return nil
 }
 
 // ExtendVolume ...
 func (*Driver) ExtendVolume(opt *pb.ExtendVolumeOpts) (*model.VolumeSpec, error) {
-	return &SampleVolumes[0], nil
+	mutex.Lock()
+	defer mutex.Unlock()
+	volume, ok := volumes[opt.GetId()]
+	if !ok {
+		return nil, errors.New("Can't find volume " + opt.GetId())
+	}
+	volume.Size = opt.GetSize()
+	volumes[volume.Id] = volume
+	return &volume, nil
 }
 
 // InitializeConnection
@@ -73,15 +121,31 @@ func (*Driver) TerminateConnection(opt *pb.DeleteVolumeAttachmentOpts) error { r
 
 // CreateSnapshot
 func (*Driver) CreateSnapshot(opt *pb.CreateVolumeSnapshotOpts) (*model.VolumeSnapshotSpec, error) {
-	return &SampleSnapshots[0], nil
+	mutex.Lock()
+	defer mutex.Unlock()
+	if _, ok := volumes[opt.GetVolumeId()]; !ok {
+		return nil, errors.New("Can't find volume " + opt.GetVolumeId())
+	}
+	snap := model.VolumeSnapshotSpec{
+		BaseModel: &model.BaseModel{
+			Id: opt.GetId(),
+		},
+		Name:        opt.GetName(),
+		Description: opt.GetDescription(),
+		Size:        opt.GetSize(),
+		Status:      model.VolumeSnapAvailable,
+		VolumeId:    opt.GetVolumeId(),
+	}
+	snapshots[snap.Id] = snap
+	return &snap, nil
 }
 
 // PullSnapshot
 func (*Driver) PullSnapshot(snapIdentifier string) (*model.VolumeSnapshotSpec, error) {
-	for _, snapshot := range SampleSnapshots {
-		if snapIdentifier == snapshot.Id {
-			return &snapshot, nil
-		}
+	mutex.Lock()
+	defer mutex.Unlock()
+	if snapshot, ok := snapshots[snapIdentifier]; ok {
+		return &snapshot, nil
 	}
 
 	return nil, errors.New("Can't find snapshot " + snapIdentifier)
@@ -89,6 +153,9 @@ func (*Driver) PullSnapshot(snapIdentifier string) (*model.VolumeSnapshotSpec, e
 
 // DeleteSnapshot
 func (*Driver) DeleteSnapshot(opt *pb.DeleteVolumeSnapshotOpts) error {
+	mutex.Lock()
+	defer mutex.Unlock()
+	delete(snapshots, opt.GetId())
 	return nil
 }
 
@@ -102,6 +169,14 @@ func (*Driver) ListPools() ([]*model.StoragePoolSpec, error) {
 	return pols, nil
 }
 
+// Capabilities
+func (*Driver) Capabilities() model.DriverCapabilitiesSpec {
+	return model.DriverCapabilitiesSpec{
+		Clone:        true,
+		OnlineExtend: true,
+	}
+}
+
 func (d *Driver) InitializeSnapshotConnection(opt *pb.CreateSnapshotAttachmentOpts) (*model.ConnectionInfo, error) {
 	return nil, &model.NotImplementError{S: "method InitializeSnapshotConnection has not been implemented yet"}
 }
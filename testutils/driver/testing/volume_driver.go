@@ -191,6 +191,20 @@ func (_m *VolumeDriver) InitializeSnapshotConnection(opt *proto.CreateSnapshotAt
 	return r0, r1
 }
 
+// Capabilities provides a mock function with given fields:
+func (_m *VolumeDriver) Capabilities() model.DriverCapabilitiesSpec {
+	ret := _m.Called()
+
+	var r0 model.DriverCapabilitiesSpec
+	if rf, ok := ret.Get(0).(func() model.DriverCapabilitiesSpec); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(model.DriverCapabilitiesSpec)
+	}
+
+	return r0
+}
+
 // ListPools provides a mock function with given fields:
 func (_m *VolumeDriver) ListPools() ([]*model.StoragePoolSpec, error) {
 	ret := _m.Called()